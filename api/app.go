@@ -4,11 +4,14 @@ import (
 	"api/internal/config"
 	repository "api/internal/db"
 	"api/internal/handlers"
+	"api/internal/lib/calendars"
 	"api/internal/lib/logger"
 	"api/internal/lib/workers"
+	"api/internal/ports"
 	"api/internal/server"
 	"api/pkg/calendar"
 	"context"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
@@ -73,11 +76,19 @@ func Run(ctx context.Context, stdout io.Writer, getenv func(string, string) stri
 
 	dbService := repository.NewDBService(db, log)
 
-	cal, err := createCalendar(ctx, config, log)
+	cal, err := createCalendar(ctx, config, db, dbService.FacilityStore, log)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("create calendar provider: %w", err)
 	}
-	h := handlers.New(dbService, log, config, cal)
+	text, err := calendars.NewEventText(config.EventSummary, config.EventDescription)
+	if err != nil {
+		return err
+	}
+	calendarSync := calendars.NewBuildingSync(dbService.CalendarSyncStore, dbService.FacilityStore, cal, config.CalendarFullSync, log)
+	reservationSync := calendars.NewReservationSync(dbService.ReservationStore, dbService.FacilityStore, dbService.CalendarSyncStore, cal, text, &config.Location, log)
+	reconciler := calendars.NewReconciler(dbService.ReservationStore, dbService.FacilityStore, cal, text, &config.Location, log)
+	outbox := calendars.NewOutbox(dbService.CalendarOutboxStore, dbService.ReservationStore, cal, text, log)
+	h := handlers.New(dbService, log, config, cal, calendarSync, reservationSync, reconciler, outbox, text)
 	s := server.NewServer(h, log)
 	handler := h2c.NewHandler(s, &http2.Server{})
 	srv := &http.Server{
@@ -103,16 +114,37 @@ func Run(ctx context.Context, stdout io.Writer, getenv func(string, string) stri
 		Logger:    log,
 	})
 	mgr.Add(janitor)
+	mgr.Add(workers.NewWorker(&workers.BalanceReminders{
+		Payments: h.PaymentHandler,
+		Lead:     7 * 24 * time.Hour,
+		Interval: 6 * time.Hour,
+		Logger:   log,
+	}))
 
-	// Only add calendar sync worker if calendar was created successfully
 	if cal != nil {
-		calendarSync := workers.NewWorker(&workers.CalendarSync{
+		mgr.Add(workers.NewWorker(&workers.CalendarOutbox{
+			Outbox:   outbox,
+			Interval: 30 * time.Second,
+			Logger:   log,
+		}))
+		mgr.Add(workers.NewWorker(&workers.CalendarSync{
 			FacilityStore: dbService.FacilityStore,
-			Calendar:      cal,
+			Sync:          calendarSync,
 			Interval:      2 * time.Hour,
 			Logger:        log,
-		})
-		mgr.Add(calendarSync)
+		}))
+		mgr.Add(workers.NewWorker(&workers.ReservationCalendarSync{
+			FacilityStore: dbService.FacilityStore,
+			Sync:          reservationSync,
+			Interval:      15 * time.Minute,
+			Logger:        log,
+		}))
+		mgr.Add(workers.NewWorker(&workers.CalendarReconcile{
+			FacilityStore: dbService.FacilityStore,
+			Reconciler:    reconciler,
+			Interval:      24 * time.Hour,
+			Logger:        log,
+		}))
 	}
 
 	mgr.Start(ctx)
@@ -122,24 +154,64 @@ func Run(ctx context.Context, stdout io.Writer, getenv func(string, string) stri
 	return nil
 }
 
-func createCalendar(ctx context.Context, config *config.Config, log *slog.Logger) (*calendar.Calendar, error) {
-	// Configure rate limiting to prevent hitting Google Calendar API limits
-	// Google allows ~10 queries per second per user
-	rateLimitConfig := &calendar.RateLimitConfig{
-		MaxConcurrent: 5, // Limit to 5 concurrent API calls
-		MaxRetries:    5, // Retry up to 5 times with exponential backoff
-		Logger:        log,
+// createCalendar builds every configured calendar provider and routes each
+// calendar to its building's provider, defaulting to CALENDAR_PROVIDER.
+// "local" keeps events in Postgres and needs no external credentials.
+func createCalendar(ctx context.Context, config *config.Config, db *repository.DB, facilityStore ports.FacilityStore, log *slog.Logger) (ports.CalendarProvider, error) {
+	fallback := strings.ToLower(strings.TrimSpace(config.CalendarProvider))
+	if fallback == "" {
+		fallback = calendar.ProviderGoogle
+	}
+	providers := map[string]ports.CalendarProvider{
+		calendar.ProviderLocal: calendar.NewLocal(db.DB, config.Location, config.Timezone, log),
+	}
+
+	if config.GoogleRefreshToken != "" || fallback == calendar.ProviderGoogle {
+		// Configure rate limiting to prevent hitting Google Calendar API limits
+		// Google allows ~10 queries per second per user
+		rateLimitConfig := &calendar.RateLimitConfig{
+			MaxConcurrent: 5, // Limit to 5 concurrent API calls
+			MaxRetries:    5, // Retry up to 5 times with exponential backoff
+			Logger:        log,
+		}
+		google, err := calendar.NewCalendarWithRateLimit(
+			ctx,
+			config.GoogleClientID,
+			config.GoogleClientSecret,
+			config.GoogleRefreshToken,
+			config.Location,
+			config.Timezone,
+			rateLimitConfig,
+		)
+		if err != nil {
+			return nil, err
+		}
+		providers[calendar.ProviderGoogle] = google
+	}
+
+	if config.EntraClientID != "" && config.EntraClientSecret != "" && config.EntraTenant != "" {
+		providers[calendar.ProviderMicrosoft] = calendar.NewGraph(ctx, calendar.GraphConfig{
+			TenantID:     config.EntraTenant,
+			ClientID:     config.EntraClientID,
+			ClientSecret: config.EntraClientSecret,
+			Logger:       log,
+		}, config.Location, config.Timezone)
+	}
+
+	if config.CalDAVURL != "" {
+		caldav, err := calendar.NewCalDAV(calendar.CalDAVConfig{
+			BaseURL:  config.CalDAVURL,
+			Username: config.CalDAVUsername,
+			Password: config.CalDAVPassword,
+			Logger:   log,
+		}, config.Location, config.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		providers[calendar.ProviderCalDAV] = caldav
 	}
 
-	return calendar.NewCalendarWithRateLimit(
-		ctx,
-		config.GoogleClientID,
-		config.GoogleClientSecret,
-		config.GoogleRefreshToken,
-		config.Location,
-		config.Timezone,
-		rateLimitConfig,
-	)
+	return calendars.NewRouter(fallback, providers, facilityStore, log)
 }
 
 func waitForShutdown(srv *http.Server, ctx context.Context, cancel context.CancelFunc, log *slog.Logger) {
@@ -102,6 +102,7 @@ func requiresAuth(procedure string) bool {
 		FacilitiesServiceGetEventsByBuildingProcedure:   true,
 		FacilitiesServiceGetAllEventsProcedure:          true,
 		FacilitiesServiceGetCategoryProcedure:           true,
+		FacilitiesServiceGetFormFieldsProcedure:         true,
 
 		AuthLoginProcedure:                true,
 		AuthRegisterProcedure:             true,
@@ -221,12 +222,17 @@ func (s *Auth) GetSessionHandler(w http.ResponseWriter, r *http.Request) {
 
 	user := authCTX.User
 	sessionID := authCTX.SessionID
+	buildingIDs, err := s.db.GetAdminBuildingIDs(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("failed to get admin buildings", "error", err)
+	}
 	res := &service.GetSessionResponse{
-		SessionId: sessionID,
-		UserId:    user.ID,
-		UserEmail: user.Email,
-		UserName:  user.Name,
-		UserRole:  user.Role.String(),
+		SessionId:        sessionID,
+		UserId:           user.ID,
+		UserEmail:        user.Email,
+		UserName:         user.Name,
+		UserRole:         user.Role.String(),
+		AdminBuildingIds: buildingIDs,
 	}
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte(res.String()))
@@ -260,12 +266,17 @@ func (s *Auth) GetSession(ctx context.Context, req *connect.Request[service.GetS
 
 	user := authCTX.User
 	sessionID := authCTX.SessionID
+	buildingIDs, err := s.db.GetAdminBuildingIDs(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&service.GetSessionResponse{
-		SessionId: sessionID,
-		UserId:    user.ID,
-		UserEmail: user.Email,
-		UserName:  user.Name,
-		UserRole:  user.Role.String(),
+		SessionId:        sessionID,
+		UserId:           user.ID,
+		UserEmail:        user.Email,
+		UserName:         user.Name,
+		UserRole:         user.Role.String(),
+		AdminBuildingIds: buildingIDs,
 	}), nil
 }
 
@@ -31,6 +31,9 @@ const (
 	// FacilitiesServiceGetAllCoordsProcedure is the fully-qualified name of the FacilitiesService's
 	// GetAllCoords RPC.
 	FacilitiesServiceGetAllCoordsProcedure = "/api.facilities.FacilitiesService/GetAllCoords"
+	// FacilitiesServiceGetFormFieldsProcedure is the fully-qualified name of the FacilitiesService's
+	// GetFormFields RPC.
+	FacilitiesServiceGetFormFieldsProcedure = "/api.facilities.FacilitiesService/GetFormFields"
 
 	// AuthLoginProcedure is the fully-qualified name of the Auth's Login RPC.
 	AuthLoginProcedure = "/api.auth.Auth/Login" // nolint:gosec
@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -26,6 +28,10 @@ type Config struct {
 	GoogleClientID     string        `mapstructure:"GOOGLE_CLIENT_ID"`
 	GoogleClientSecret string        `mapstructure:"GOOGLE_CLIENT_SECRET"`
 	GoogleRefreshToken string        `mapstructure:"GOOGLE_REFRESH_TOKEN"`
+	CalendarProvider   string        `mapstructure:"CALENDAR_PROVIDER"`
+	CalDAVURL          string        `mapstructure:"CALDAV_URL"`
+	CalDAVUsername     string        `mapstructure:"CALDAV_USERNAME"`
+	CalDAVPassword     string        `mapstructure:"CALDAV_PASSWORD"`
 	DatabaseURL        string        `mapstructure:"DATABASE_URL"`
 	AuthSecret         string        `mapstructure:"AUTH_SECRET"`
 	AuthSalt           string        `mapstructure:"AUTH_SALT"`
@@ -39,6 +45,15 @@ type Config struct {
 	Location           time.Location `mapstructure:"-"`
 	StripeSecretKey    string        `mapstructure:"STRIPE_SECRET_KEY"`
 	StripePublicKey    string        `mapstructure:"STRIPE_PUBLIC_KEY"`
+	StripeWebhookKey   string        `mapstructure:"STRIPE_WEBHOOK_SECRET"`
+	DoorBufferBefore   time.Duration `mapstructure:"DOOR_BUFFER_BEFORE"`
+	DoorBufferAfter    time.Duration `mapstructure:"DOOR_BUFFER_AFTER"`
+	CalendarFullSync   time.Duration `mapstructure:"CALENDAR_FULL_SYNC"`
+	CalendarHolds      bool          `mapstructure:"CALENDAR_TENTATIVE_HOLDS"`
+	RefundOnCancel     bool          `mapstructure:"REFUND_ON_CANCEL"`
+	PaymentMethods     []string      `mapstructure:"PAYMENT_METHODS"`
+	EventSummary       string        `mapstructure:"CALENDAR_SUMMARY_TEMPLATE"`
+	EventDescription   string        `mapstructure:"CALENDAR_DESCRIPTION_TEMPLATE"`
 }
 
 func New(getenv func(string, string) string, AppEnv string) (*Config, error) {
@@ -52,6 +67,10 @@ func New(getenv func(string, string) string, AppEnv string) (*Config, error) {
 		GoogleClientID:     getenv("GOOGLE_CLIENT_ID", ""),
 		GoogleClientSecret: getenv("GOOGLE_CLIENT_SECRET", ""),
 		GoogleRefreshToken: getenv("GOOGLE_REFRESH_TOKEN", ""),
+		CalendarProvider:   getenv("CALENDAR_PROVIDER", "google"),
+		CalDAVURL:          getenv("CALDAV_URL", ""),
+		CalDAVUsername:     getenv("CALDAV_USERNAME", ""),
+		CalDAVPassword:     getenv("CALDAV_PASSWORD", ""),
 		DatabaseURL:        getenv("DATABASE_URL", "postgres://postgres@localhost:5432/postgres?sslmode=disable"),
 		AuthSecret:         getenv("AUTH_SECRET", ""),
 		AuthSalt:           getenv("AUTH_SALT", ""),
@@ -64,11 +83,44 @@ func New(getenv func(string, string) string, AppEnv string) (*Config, error) {
 		Timezone:           getenv("TIMEZONE", "America/Denver"),
 		StripeSecretKey:    getenv("STRIPE_SECRET_KEY", ""),
 		StripePublicKey:    getenv("STRIPE_PUBLIC_KEY", ""),
+		StripeWebhookKey:   getenv("STRIPE_WEBHOOK_SECRET", ""),
+		EventSummary:       getenv("CALENDAR_SUMMARY_TEMPLATE", "{{.Title}}"),
+		EventDescription:   getenv("CALENDAR_DESCRIPTION_TEMPLATE", "{{.Details}}"),
 	}
 	loc, err := time.LoadLocation(cfg.Timezone)
 	if err != nil {
 		return nil, fmt.Errorf("invalid TIMEZONE %q: %w", cfg.Timezone, err)
 	}
 	cfg.Location = *loc
+	// doors unlock this long before an event starts and lock this long after it ends
+	cfg.DoorBufferBefore, err = time.ParseDuration(getenv("DOOR_BUFFER_BEFORE", "30m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid DOOR_BUFFER_BEFORE: %w", err)
+	}
+	cfg.DoorBufferAfter, err = time.ParseDuration(getenv("DOOR_BUFFER_AFTER", "15m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid DOOR_BUFFER_AFTER: %w", err)
+	}
+	// building calendar syncs list facility calendars in full at least this often
+	cfg.CalendarFullSync, err = time.ParseDuration(getenv("CALENDAR_FULL_SYNC", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid CALENDAR_FULL_SYNC: %w", err)
+	}
+	// pending requests go on facility calendars as tentative holds
+	cfg.CalendarHolds, err = strconv.ParseBool(getenv("CALENDAR_TENTATIVE_HOLDS", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid CALENDAR_TENTATIVE_HOLDS: %w", err)
+	}
+	// canceling paid dates refunds what was paid beyond the new total
+	cfg.RefundOnCancel, err = strconv.ParseBool(getenv("REFUND_ON_CANCEL", "true"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid REFUND_ON_CANCEL: %w", err)
+	}
+	// ways requesters may pay, offered in this order
+	for _, method := range strings.Split(getenv("PAYMENT_METHODS", "stripe,check,purchase_order,account_code"), ",") {
+		if method = strings.TrimSpace(method); method != "" {
+			cfg.PaymentMethods = append(cfg.PaymentMethods, method)
+		}
+	}
 	return cfg, nil
 }
@@ -0,0 +1,226 @@
+package db
+
+import (
+	"api/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jmoiron/sqlx"
+)
+
+type CalendarOutboxStore struct {
+	log *slog.Logger
+	db  *DB
+}
+
+func NewCalendarOutboxStore(db *DB, log *slog.Logger) *CalendarOutboxStore {
+	log.With("layer", "db", "store", "calendar_outbox")
+	return &CalendarOutboxStore{db: db, log: log}
+}
+
+const insertCalendarOpQuery = `INSERT INTO calendar_outbox (
+	reservation_id,
+	kind,
+	calendar_id,
+	payload
+) VALUES (
+	$1,
+	$2,
+	$3,
+	$4
+)`
+
+// CommitStatusChange writes a reservation status change and queues its
+// calendar operations in one transaction.
+func (s *CalendarOutboxStore) CommitStatusChange(ctx context.Context, change *models.StatusChange) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if res := change.Reservation; res != nil {
+		params := map[string]any{
+			"eventName":     res.EventName,
+			"details":       res.Details,
+			"visibility":    res.Visibility,
+			"publicTitle":   res.PublicTitle,
+			"approved":      res.Approved.String(),
+			"updatedAt":     pgtype.Timestamp{Time: time.Now(), Valid: true},
+			"insurance":     res.Insurance,
+			"categoryId":    res.CategoryID,
+			"totalHours":    res.TotalHours,
+			"inPerson":      res.InPerson,
+			"insuranceLink": res.InsuranceLink,
+			"gcalEventid":   res.GCalEventID,
+			"id":            res.ID,
+		}
+		if _, err := tx.NamedExecContext(ctx, updateReservationQuery, params); err != nil {
+			return err
+		}
+	}
+	for _, date := range change.Dates {
+		params := map[string]any{
+			"approved":     date.Approved.String(),
+			"gcal_eventid": date.GcalEventid,
+			"local_start":  date.LocalStart,
+			"local_end":    date.LocalEnd,
+			"id":           date.ID,
+		}
+		if _, err := tx.NamedExecContext(ctx, updateReservationDatesQuery, params); err != nil {
+			return err
+		}
+	}
+	if len(change.DeleteDateIDs) > 0 {
+		query, args, err := sqlx.In(deleteReservationDatesQuery, change.DeleteDateIDs)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+			return err
+		}
+	}
+	if change.Exdates != nil {
+		if _, err := tx.ExecContext(ctx, setExdatesQuery, change.ReservationID, *change.Exdates); err != nil {
+			return err
+		}
+	}
+	for _, op := range change.Ops {
+		if _, err := tx.ExecContext(ctx, insertCalendarOpQuery, op.ReservationID, op.Kind, op.CalendarID, op.Payload); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Operations of a reservation run one at a time in id order, so a later
+// delete never overtakes the publish it undoes.
+const claimCalendarOpsQuery = `UPDATE calendar_outbox SET
+	attempts = attempts + 1,
+	run_at = now() + make_interval(secs => $2)
+WHERE id IN (
+	SELECT o.id FROM calendar_outbox o
+	WHERE o.status = 'pending'
+	AND o.run_at <= now()
+	AND NOT EXISTS (
+		SELECT 1 FROM calendar_outbox p
+		WHERE p.reservation_id = o.reservation_id
+		AND p.status = 'pending'
+		AND p.id < o.id
+	)
+	ORDER BY o.id
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+// ClaimCalendarOps leases up to limit due operations. A claimed operation
+// is not handed out again until the lease runs out.
+func (s *CalendarOutboxStore) ClaimCalendarOps(ctx context.Context, limit int, lease time.Duration) ([]models.CalendarOp, error) {
+	var ops []models.CalendarOp
+	if err := s.db.SelectContext(ctx, &ops, claimCalendarOpsQuery, limit, lease.Seconds()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.CalendarOp{}, nil
+		}
+		return nil, err
+	}
+	return ops, nil
+}
+
+const completeCalendarOpQuery = `UPDATE calendar_outbox SET
+	status = 'done',
+	last_error = NULL,
+	done_at = now()
+WHERE id = $1`
+
+const setReservationEventQuery = `UPDATE reservation SET
+	gcal_eventid = $2
+WHERE id = $1
+AND approved = $3
+AND (gcal_eventid IS NULL OR gcal_eventid = '')`
+
+const setDateEventQuery = `UPDATE reservation_date SET
+	gcal_eventid = $2
+WHERE id = $1
+AND approved = 'approved'
+AND (gcal_eventid IS NULL OR gcal_eventid = '')`
+
+const setHoldEventQuery = `UPDATE reservation_date d SET
+	gcal_eventid = $2
+FROM reservation r
+WHERE d.id = $1
+AND r.id = d.reservation_id
+AND d.approved = 'pending'
+AND r.approved = 'pending'
+AND (d.gcal_eventid IS NULL OR d.gcal_eventid = '')`
+
+// CompleteCalendarOp marks an operation done and records the event ids it
+// published. An event whose reservation or date stopped being approved
+// while it was published, or a hold whose request was decided meanwhile, is
+// queued for deletion instead.
+func (s *CalendarOutboxStore) CompleteCalendarOp(ctx context.Context, op *models.CalendarOp, result *models.CalendarOpResult) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, completeCalendarOpQuery, op.ID); err != nil {
+		return err
+	}
+	if result != nil {
+		resStatus, dateQuery := models.ReservationApprovedApproved, setDateEventQuery
+		if opts := op.Payload.Options; opts != nil && opts.Tentative {
+			resStatus, dateQuery = models.ReservationApprovedPending, setHoldEventQuery
+		}
+		var stale []string
+		if result.MasterEventID != "" {
+			res, err := tx.ExecContext(ctx, setReservationEventQuery, op.ReservationID, result.MasterEventID, resStatus)
+			if err != nil {
+				return err
+			}
+			if n, _ := res.RowsAffected(); n == 0 {
+				stale = append(stale, result.MasterEventID)
+			} else {
+				for _, id := range op.Payload.DateIDs {
+					if _, err := tx.ExecContext(ctx, dateQuery, id, result.MasterEventID); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		for dateID, eventID := range result.DateEventIDs {
+			res, err := tx.ExecContext(ctx, dateQuery, dateID, eventID)
+			if err != nil {
+				return err
+			}
+			if n, _ := res.RowsAffected(); n == 0 {
+				stale = append(stale, eventID)
+			}
+		}
+		for _, eventID := range stale {
+			payload := models.CalendarOpPayload{EventID: eventID}
+			if _, err := tx.ExecContext(ctx, insertCalendarOpQuery, op.ReservationID, models.CalendarOpKindDelete, op.CalendarID, payload); err != nil {
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+const retryCalendarOpQuery = `UPDATE calendar_outbox SET
+	status = $2,
+	run_at = $3,
+	last_error = $4
+WHERE id = $1`
+
+// RetryCalendarOp records a failed attempt. The operation runs again at
+// runAt, or never if status is failed.
+func (s *CalendarOutboxStore) RetryCalendarOp(ctx context.Context, id int64, status models.CalendarOpStatus, runAt time.Time, lastError string) error {
+	_, err := s.db.ExecContext(ctx, retryCalendarOpQuery, id, status, runAt, lastError)
+	return err
+}
@@ -0,0 +1,206 @@
+package db
+
+import (
+	"api/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+)
+
+type CalendarSyncStore struct {
+	log *slog.Logger
+	db  *DB
+}
+
+func NewCalendarSyncStore(db *DB, log *slog.Logger) *CalendarSyncStore {
+	log.With("layer", "db", "store", "calendar_sync")
+	return &CalendarSyncStore{db: db, log: log}
+}
+
+const getSyncStatesQuery = `SELECT * FROM calendar_sync_state WHERE building_id = $1`
+
+func (s *CalendarSyncStore) GetSyncStates(ctx context.Context, buildingID int64) ([]models.CalendarSyncState, error) {
+	var states []models.CalendarSyncState
+	if err := s.db.SelectContext(ctx, &states, getSyncStatesQuery, buildingID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.CalendarSyncState{}, nil
+		}
+		return nil, err
+	}
+	return states, nil
+}
+
+const getSyncStateQuery = `SELECT * FROM calendar_sync_state WHERE building_id = $1 AND source_calendar_id = $2`
+
+func (s *CalendarSyncStore) GetSyncState(ctx context.Context, buildingID int64, sourceCalendarID string) (*models.CalendarSyncState, error) {
+	var state models.CalendarSyncState
+	if err := s.db.GetContext(ctx, &state, getSyncStateQuery, buildingID, sourceCalendarID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &state, nil
+}
+
+const saveSyncStateQuery = `INSERT INTO calendar_sync_state (
+	building_id, source_calendar_id, target_calendar_id, sync_token, full_synced_at, synced_at
+) VALUES (
+	:building_id, :source_calendar_id, :target_calendar_id, :sync_token, :full_synced_at, CURRENT_TIMESTAMP
+)
+ON CONFLICT (building_id, source_calendar_id) DO UPDATE SET
+	target_calendar_id = EXCLUDED.target_calendar_id,
+	sync_token = EXCLUDED.sync_token,
+	full_synced_at = EXCLUDED.full_synced_at,
+	synced_at = CURRENT_TIMESTAMP`
+
+func (s *CalendarSyncStore) SaveSyncState(ctx context.Context, state *models.CalendarSyncState) error {
+	_, err := s.db.NamedExecContext(ctx, saveSyncStateQuery, state)
+	return err
+}
+
+const getSyncEventsQuery = `SELECT * FROM calendar_sync_events WHERE building_id = $1 AND source_calendar_id = $2`
+
+func (s *CalendarSyncStore) GetSyncEvents(ctx context.Context, buildingID int64, sourceCalendarID string) ([]models.CalendarSyncEvent, error) {
+	var events []models.CalendarSyncEvent
+	if err := s.db.SelectContext(ctx, &events, getSyncEventsQuery, buildingID, sourceCalendarID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.CalendarSyncEvent{}, nil
+		}
+		return nil, err
+	}
+	return events, nil
+}
+
+const saveSyncEventQuery = `INSERT INTO calendar_sync_events (
+	building_id, source_calendar_id, source_event_id, target_event_id, fingerprint, ends_at
+) VALUES (
+	:building_id, :source_calendar_id, :source_event_id, :target_event_id, :fingerprint, :ends_at
+)
+ON CONFLICT (building_id, source_calendar_id, source_event_id) DO UPDATE SET
+	target_event_id = EXCLUDED.target_event_id,
+	fingerprint = EXCLUDED.fingerprint,
+	ends_at = EXCLUDED.ends_at,
+	updated_at = CURRENT_TIMESTAMP`
+
+func (s *CalendarSyncStore) SaveSyncEvent(ctx context.Context, ev *models.CalendarSyncEvent) error {
+	_, err := s.db.NamedExecContext(ctx, saveSyncEventQuery, ev)
+	return err
+}
+
+const deleteSyncEventQuery = `DELETE FROM calendar_sync_events WHERE id = $1`
+
+func (s *CalendarSyncStore) DeleteSyncEvent(ctx context.Context, id int64) error {
+	_, err := s.db.ExecContext(ctx, deleteSyncEventQuery, id)
+	return err
+}
+
+const (
+	clearSyncEventsQuery = `DELETE FROM calendar_sync_events WHERE building_id = $1 AND ($2 = '' OR source_calendar_id = $2)`
+	clearSyncStateQuery  = `DELETE FROM calendar_sync_state WHERE building_id = $1 AND ($2 = '' OR source_calendar_id = $2)`
+)
+
+// ClearSync forgets the mapping and sync token of one facility calendar, or
+// of the whole building when sourceCalendarID is empty. The copied events
+// are left on the building calendar.
+func (s *CalendarSyncStore) ClearSync(ctx context.Context, buildingID int64, sourceCalendarID string) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, clearSyncEventsQuery, buildingID, sourceCalendarID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, clearSyncStateQuery, buildingID, sourceCalendarID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+const saveCalendarChangeQuery = `INSERT INTO calendar_changes (
+	reservation_date_id, kind, status, event_id, old_start, old_end, new_start, new_end, note, resolved_at
+) VALUES (
+	:reservation_date_id, :kind, :status, :event_id, :old_start, :old_end, :new_start, :new_end, :note, :resolved_at
+)
+ON CONFLICT (reservation_date_id) WHERE status = 'review' DO UPDATE SET
+	kind = EXCLUDED.kind,
+	event_id = EXCLUDED.event_id,
+	new_start = EXCLUDED.new_start,
+	new_end = EXCLUDED.new_end,
+	note = EXCLUDED.note,
+	detected_at = CURRENT_TIMESTAMP`
+
+// SaveCalendarChange records a detected change. A date with an open review
+// keeps one review row, refreshed with the latest detection.
+func (s *CalendarSyncStore) SaveCalendarChange(ctx context.Context, change *models.CalendarChange) error {
+	_, err := s.db.NamedExecContext(ctx, saveCalendarChangeQuery, change)
+	return err
+}
+
+const calendarChangeSelect = `SELECT
+	c.*,
+	d.reservation_id,
+	r.event_name,
+	f.id AS facility_id,
+	f.name AS facility_name,
+	b.id AS building_id,
+	b.name AS building_name
+FROM calendar_changes c
+JOIN reservation_date d ON d.id = c.reservation_date_id
+JOIN reservation r ON r.id = d.reservation_id
+JOIN facility f ON f.id = r.facility_id
+JOIN building b ON b.id = f.building_id`
+
+const getCalendarChangesQuery = calendarChangeSelect + `
+WHERE ($1 = '' OR c.status::text = $1)
+AND ($2::bigint = 0 OR b.id = $2)
+ORDER BY c.detected_at DESC
+LIMIT 500`
+
+// GetCalendarChanges lists the latest changes, newest first. An empty status
+// or zero building matches all.
+func (s *CalendarSyncStore) GetCalendarChanges(ctx context.Context, status string, buildingID int64) ([]models.CalendarChange, error) {
+	var changes []models.CalendarChange
+	if err := s.db.SelectContext(ctx, &changes, getCalendarChangesQuery, status, buildingID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.CalendarChange{}, nil
+		}
+		return nil, err
+	}
+	return changes, nil
+}
+
+const getCalendarChangeQuery = calendarChangeSelect + `
+WHERE c.id = $1`
+
+func (s *CalendarSyncStore) GetCalendarChange(ctx context.Context, id int64) (*models.CalendarChange, error) {
+	var change models.CalendarChange
+	if err := s.db.GetContext(ctx, &change, getCalendarChangeQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &change, nil
+}
+
+const resolveCalendarChangeQuery = `UPDATE calendar_changes SET
+	status = $2,
+	resolved_at = CURRENT_TIMESTAMP,
+	resolved_by = $3,
+	note = COALESCE(NULLIF($4, ''), note)
+WHERE id = $1 AND status = 'review'`
+
+// ResolveCalendarChange closes an open review. It reports false when the
+// change was already resolved.
+func (s *CalendarSyncStore) ResolveCalendarChange(ctx context.Context, id int64, status models.CalendarChangeStatus, userID string, note string) (bool, error) {
+	res, err := s.db.ExecContext(ctx, resolveCalendarChangeQuery, id, status, userID, note)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
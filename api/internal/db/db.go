@@ -48,13 +48,25 @@ type DBService struct {
 	*UserStore
 	*ReservationStore
 	*BrandingStore
+	*OrganizationStore
+	*StaffStore
+	*CalendarSyncStore
+	*CalendarOutboxStore
+	*PaymentStore
+	*InvoiceStore
 }
 
 func NewDBService(db *DB, log *slog.Logger) *DBService {
 	return &DBService{
-		FacilityStore:    NewFacilityStore(db, log),
-		UserStore:        NewUserStore(db, log),
-		ReservationStore: NewReservationStore(db, log),
-		BrandingStore:    NewBrandingStore(db, log),
+		FacilityStore:       NewFacilityStore(db, log),
+		UserStore:           NewUserStore(db, log),
+		ReservationStore:    NewReservationStore(db, log),
+		BrandingStore:       NewBrandingStore(db, log),
+		OrganizationStore:   NewOrganizationStore(db, log),
+		StaffStore:          NewStaffStore(db, log),
+		CalendarSyncStore:   NewCalendarSyncStore(db, log),
+		CalendarOutboxStore: NewCalendarOutboxStore(db, log),
+		PaymentStore:        NewPaymentStore(db, log),
+		InvoiceStore:        NewInvoiceStore(db, log),
 	}
 }
@@ -110,6 +110,31 @@ func (f *FacilityStore) GetBuilding(ctx context.Context, id int64) (*models.Buil
 	return &building, nil
 }
 
+const getCalendarProviderQuery = `SELECT COALESCE(b.calendar_provider, '') FROM building b
+WHERE b.google_calendar_id = $1
+OR EXISTS (SELECT 1 FROM facility f WHERE f.building_id = b.id AND f.google_calendar_id = $1)
+LIMIT 1`
+
+// GetCalendarProvider returns the provider override of the building that owns
+// calendarID, either as its building calendar or through one of its facilities.
+func (f *FacilityStore) GetCalendarProvider(ctx context.Context, calendarID string) (string, error) {
+	var provider string
+	if err := f.db.GetContext(ctx, &provider, getCalendarProviderQuery, calendarID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return provider, nil
+}
+
+const setBuildingCalendarProviderQuery = `UPDATE building SET calendar_provider = $2 WHERE id = $1`
+
+func (f *FacilityStore) SetBuildingCalendarProvider(ctx context.Context, buildingID int64, provider sql.NullString) error {
+	_, err := f.db.ExecContext(ctx, setBuildingCalendarProviderQuery, buildingID, provider)
+	return err
+}
+
 const getAllCategoriesQuery = `SELECT * FROM category`
 const getAllFacilitiesForBuildingQuery = `SELECT * FROM facility WHERE building_id = $1`
 const getAllFacilitiesQuery = `SELECT * FROM facility`
@@ -262,15 +287,20 @@ func (f *FacilityStore) Delete(ctx context.Context, id int64) error {
 const editCategoryQuery = ` UPDATE category SET
 	name = :name,
 	description = :description,
-	price = :price
+	deposit_type = :deposit_type,
+	deposit_amount = COALESCE(:deposit_amount, 0),
+	balance_due_days = :balance_due_days
 	WHERE id = :id
 `
 
 func (f *FacilityStore) EditCategory(ctx context.Context, category *models.Category) error {
 	params := map[string]any{
-		"name":        category.Name,
-		"description": category.Description,
-		"id":          category.ID,
+		"name":             category.Name,
+		"description":      category.Description,
+		"deposit_type":     category.DepositType,
+		"deposit_amount":   category.DepositAmount,
+		"balance_due_days": category.BalanceDueDays,
+		"id":               category.ID,
 	}
 	stmt, err := f.db.PrepareNamedContext(ctx, editCategoryQuery)
 	if err != nil {
@@ -355,3 +385,210 @@ func (f *FacilityStore) GetPricing(ctx context.Context, pricingID string) (model
 	}
 	return pricing, nil
 }
+
+// a field scoped to both a facility and a category only applies where both match
+const getFormFieldsQuery = `SELECT * FROM form_fields
+WHERE (facility_id = $1 OR facility_id IS NULL)
+AND (category_id = $2 OR category_id IS NULL)
+ORDER BY sort_order, id`
+
+// GetFormFields returns the fields that apply to a booking of the category
+// at the facility.
+func (f *FacilityStore) GetFormFields(ctx context.Context, facilityID, categoryID int64) ([]models.FormField, error) {
+	var fields []models.FormField
+	if err := f.db.SelectContext(ctx, &fields, getFormFieldsQuery, facilityID, categoryID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.FormField{}, nil
+		}
+		return nil, err
+	}
+	return fields, nil
+}
+
+const getFormFieldQuery = `SELECT * FROM form_fields WHERE id = $1`
+
+func (f *FacilityStore) GetFormField(ctx context.Context, id int64) (*models.FormField, error) {
+	var field models.FormField
+	if err := f.db.GetContext(ctx, &field, getFormFieldQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &field, nil
+}
+
+const createFormFieldQuery = `INSERT INTO form_fields (
+	facility_id,
+	category_id,
+	field_key,
+	label,
+	field_type,
+	required,
+	options,
+	condition,
+	help_text,
+	sort_order
+) VALUES (
+	:facility_id,
+	:category_id,
+	:field_key,
+	:label,
+	:field_type,
+	:required,
+	:options,
+	:condition,
+	:help_text,
+	:sort_order
+)
+RETURNING id`
+
+func (f *FacilityStore) CreateFormField(ctx context.Context, field *models.FormField) (int64, error) {
+	stmt, err := f.db.PrepareNamedContext(ctx, createFormFieldQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	var id int64
+	if err := stmt.GetContext(ctx, &id, formFieldParams(field)); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+const updateFormFieldQuery = `UPDATE form_fields SET
+	facility_id = :facility_id,
+	category_id = :category_id,
+	field_key = :field_key,
+	label = :label,
+	field_type = :field_type,
+	required = :required,
+	options = :options,
+	condition = :condition,
+	help_text = :help_text,
+	sort_order = :sort_order
+WHERE id = :id`
+
+func (f *FacilityStore) UpdateFormField(ctx context.Context, field *models.FormField) error {
+	_, err := f.db.NamedExecContext(ctx, updateFormFieldQuery, formFieldParams(field))
+	return err
+}
+
+const deleteFormFieldQuery = `DELETE FROM form_fields WHERE id = $1`
+
+func (f *FacilityStore) DeleteFormField(ctx context.Context, id int64) error {
+	_, err := f.db.ExecContext(ctx, deleteFormFieldQuery, id)
+	return err
+}
+
+func formFieldParams(field *models.FormField) map[string]any {
+	return map[string]any{
+		"id":          field.ID,
+		"facility_id": field.FacilityID,
+		"category_id": field.CategoryID,
+		"field_key":   field.Key,
+		"label":       field.Label,
+		"field_type":  field.Type,
+		"required":    field.Required,
+		"options":     field.Options,
+		"condition":   field.Condition,
+		"help_text":   field.HelpText,
+		"sort_order":  field.SortOrder,
+	}
+}
+
+const getEquipmentQuery = `SELECT * FROM equipment
+WHERE building_id = $1
+AND (active OR $2)
+ORDER BY name`
+
+func (f *FacilityStore) GetEquipment(ctx context.Context, buildingID int64, includeInactive bool) ([]models.Equipment, error) {
+	var equipment []models.Equipment
+	if err := f.db.SelectContext(ctx, &equipment, getEquipmentQuery, buildingID, includeInactive); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.Equipment{}, nil
+		}
+		return nil, err
+	}
+	return equipment, nil
+}
+
+const getEquipmentItemQuery = `SELECT * FROM equipment WHERE id = $1`
+
+func (f *FacilityStore) GetEquipmentItem(ctx context.Context, id int64) (*models.Equipment, error) {
+	var equipment models.Equipment
+	if err := f.db.GetContext(ctx, &equipment, getEquipmentItemQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &equipment, nil
+}
+
+const createEquipmentQuery = `INSERT INTO equipment (
+	building_id,
+	name,
+	description,
+	quantity,
+	fee,
+	fee_unit,
+	active
+) VALUES (
+	:building_id,
+	:name,
+	:description,
+	:quantity,
+	:fee,
+	:fee_unit,
+	:active
+)
+RETURNING id`
+
+func (f *FacilityStore) CreateEquipment(ctx context.Context, equipment *models.Equipment) (int64, error) {
+	stmt, err := f.db.PrepareNamedContext(ctx, createEquipmentQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	var id int64
+	if err := stmt.GetContext(ctx, &id, equipmentParams(equipment)); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+const updateEquipmentQuery = `UPDATE equipment SET
+	building_id = :building_id,
+	name = :name,
+	description = :description,
+	quantity = :quantity,
+	fee = :fee,
+	fee_unit = :fee_unit,
+	active = :active
+WHERE id = :id`
+
+func (f *FacilityStore) UpdateEquipment(ctx context.Context, equipment *models.Equipment) error {
+	_, err := f.db.NamedExecContext(ctx, updateEquipmentQuery, equipmentParams(equipment))
+	return err
+}
+
+const deleteEquipmentQuery = `DELETE FROM equipment WHERE id = $1`
+
+func (f *FacilityStore) DeleteEquipment(ctx context.Context, id int64) error {
+	_, err := f.db.ExecContext(ctx, deleteEquipmentQuery, id)
+	return err
+}
+
+func equipmentParams(equipment *models.Equipment) map[string]any {
+	return map[string]any{
+		"id":          equipment.ID,
+		"building_id": equipment.BuildingID,
+		"name":        equipment.Name,
+		"description": equipment.Description,
+		"quantity":    equipment.Quantity,
+		"fee":         equipment.Fee,
+		"fee_unit":    equipment.FeeUnit,
+		"active":      equipment.Active,
+	}
+}
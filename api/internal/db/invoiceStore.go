@@ -0,0 +1,141 @@
+package db
+
+import (
+	"api/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"strconv"
+)
+
+type InvoiceStore struct {
+	log *slog.Logger
+	db  *DB
+}
+
+func NewInvoiceStore(db *DB, log *slog.Logger) *InvoiceStore {
+	log.With("layer", "db", "store", "invoice")
+	return &InvoiceStore{db: db, log: log}
+}
+
+// Locking the counter row serializes everyone issuing the same kind.
+const lockInvoiceCounterQuery = `SELECT last_number FROM invoice_counter WHERE kind = $1 FOR UPDATE`
+
+const latestInvoiceQuery = `SELECT * FROM invoices
+WHERE reservation_id = $1 AND kind = $2
+ORDER BY number DESC
+LIMIT 1`
+
+const bumpInvoiceCounterQuery = `UPDATE invoice_counter SET last_number = last_number + 1 WHERE kind = $1 RETURNING last_number`
+
+const insertInvoiceQuery = `INSERT INTO invoices (
+	reservation_id,
+	kind,
+	number,
+	total_cents,
+	paid_cents,
+	file_path
+) VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6
+) RETURNING *`
+
+const insertInvoiceLineQuery = `INSERT INTO invoice_lines (
+	invoice_id,
+	position,
+	description,
+	quantity,
+	unit_cents,
+	amount_cents
+) VALUES (
+	:invoice_id,
+	:position,
+	:description,
+	:quantity,
+	:unit_cents,
+	:amount_cents
+)`
+
+// IssueInvoice numbers and records an invoice with its lines. When the
+// reservation's latest document of the same kind has the same total and
+// paid amounts, that one is returned instead with false, using no number.
+// The file path is the reservation's invoices folder and the document
+// number.
+func (s *InvoiceStore) IssueInvoice(ctx context.Context, invoice *models.Invoice, lines []models.InvoiceLine) (*models.Invoice, bool, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var last int64
+	if err := tx.GetContext(ctx, &last, lockInvoiceCounterQuery, invoice.Kind); err != nil {
+		return nil, false, fmt.Errorf("lock %s counter: %w", invoice.Kind, err)
+	}
+	var latest models.Invoice
+	err = tx.GetContext(ctx, &latest, latestInvoiceQuery, invoice.ReservationID, invoice.Kind)
+	switch {
+	case err == nil:
+		if latest.TotalCents == invoice.TotalCents && latest.PaidCents == invoice.PaidCents {
+			return &latest, false, nil
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, false, err
+	}
+
+	var number int64
+	if err := tx.GetContext(ctx, &number, bumpInvoiceCounterQuery, invoice.Kind); err != nil {
+		return nil, false, err
+	}
+	issued := models.Invoice{Kind: invoice.Kind, Number: number}
+	filePath := path.Join("invoices", strconv.FormatInt(invoice.ReservationID, 10), issued.DocumentNumber()+".pdf")
+	var created models.Invoice
+	if err := tx.GetContext(ctx, &created, insertInvoiceQuery, invoice.ReservationID, invoice.Kind, number, invoice.TotalCents, invoice.PaidCents, filePath); err != nil {
+		return nil, false, err
+	}
+	for i, line := range lines {
+		line.InvoiceID = created.ID
+		line.Position = int32(i)
+		if _, err := tx.NamedExecContext(ctx, insertInvoiceLineQuery, line); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	return &created, true, nil
+}
+
+const getInvoicesQuery = `SELECT * FROM invoices WHERE reservation_id = $1 ORDER BY created_at, id`
+
+// GetInvoices returns a reservation's invoices and receipts, oldest first.
+func (s *InvoiceStore) GetInvoices(ctx context.Context, reservationID int64) ([]models.Invoice, error) {
+	var invoices []models.Invoice
+	if err := s.db.SelectContext(ctx, &invoices, getInvoicesQuery, reservationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.Invoice{}, nil
+		}
+		return nil, err
+	}
+	return invoices, nil
+}
+
+const getInvoiceLinesQuery = `SELECT * FROM invoice_lines WHERE invoice_id = $1 ORDER BY position`
+
+func (s *InvoiceStore) GetInvoiceLines(ctx context.Context, invoiceID int64) ([]models.InvoiceLine, error) {
+	var lines []models.InvoiceLine
+	if err := s.db.SelectContext(ctx, &lines, getInvoiceLinesQuery, invoiceID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.InvoiceLine{}, nil
+		}
+		return nil, err
+	}
+	return lines, nil
+}
@@ -0,0 +1,13 @@
+-- Building admins
+-- Same building/user link as notifications, but grants management of the
+-- building's facilities and requests rather than only email alerts.
+CREATE TABLE IF NOT EXISTS building_admins (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    building_id BIGINT NOT NULL,
+    user_id TEXT NOT NULL,
+    CONSTRAINT fk_building_id FOREIGN KEY (building_id) REFERENCES building (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT unique_building_admin UNIQUE (building_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_building_admins_user_id ON building_admins (user_id);
@@ -0,0 +1,45 @@
+-- Organizations
+-- Groups like scout troops or leagues that book on behalf of many users
+CREATE TYPE organization_role AS ENUM (
+    'owner',
+    'manager',
+    'member'
+);
+
+CREATE TABLE IF NOT EXISTS organizations (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name TEXT NOT NULL,
+    billing_name TEXT,
+    billing_email TEXT,
+    billing_phone TEXT,
+    created_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp(3) with time zone
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    user_id TEXT NOT NULL,
+    role organization_role DEFAULT 'member'::organization_role NOT NULL,
+    created_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT unique_organization_member UNIQUE (organization_id, user_id)
+);
+
+-- Insurance documents shared by every booking the organization owns
+CREATE TABLE IF NOT EXISTS organization_insurance (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    file_path TEXT NOT NULL,
+    file_name TEXT NOT NULL,
+    expires_at DATE,
+    created_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+ALTER TABLE reservation
+ADD COLUMN IF NOT EXISTS organization_id BIGINT,
+ADD CONSTRAINT fk_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_reservation_organization_id ON reservation (organization_id);
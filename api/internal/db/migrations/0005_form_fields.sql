@@ -0,0 +1,33 @@
+-- Custom intake form fields
+-- Attached to a facility, a category, or both. Answers live on the reservation.
+CREATE TYPE form_field_type AS ENUM (
+    'text',
+    'number',
+    'select',
+    'checkbox',
+    'file'
+);
+
+CREATE TABLE IF NOT EXISTS form_fields (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    facility_id BIGINT,
+    category_id BIGINT,
+    field_key TEXT NOT NULL,
+    label TEXT NOT NULL,
+    field_type form_field_type NOT NULL,
+    required boolean DEFAULT false NOT NULL,
+    options JSONB, -- choices for select fields
+    condition JSONB, -- {"field": "<key>", "equals": <value>}
+    help_text TEXT,
+    sort_order INTEGER DEFAULT 0 NOT NULL,
+    created_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_facility_id FOREIGN KEY (facility_id) REFERENCES facility (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_category_id FOREIGN KEY (category_id) REFERENCES category (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT form_field_target CHECK (facility_id IS NOT NULL OR category_id IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_fields_facility_id ON form_fields (facility_id);
+CREATE INDEX IF NOT EXISTS idx_form_fields_category_id ON form_fields (category_id);
+
+ALTER TABLE reservation
+ADD COLUMN IF NOT EXISTS intake_answers JSONB DEFAULT '{}'::jsonb NOT NULL;
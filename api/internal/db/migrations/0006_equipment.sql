@@ -0,0 +1,38 @@
+-- Bookable equipment and add-ons
+-- Inventory is kept per building. Each booking of an item is charged through a
+-- linked reservation_fees row so the reducer and checkout pick it up.
+CREATE TYPE equipment_fee_unit AS ENUM (
+    'unit',
+    'hour'
+);
+
+CREATE TABLE IF NOT EXISTS equipment (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    building_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT,
+    quantity INTEGER DEFAULT 0 NOT NULL,
+    fee numeric(15,4),
+    fee_unit equipment_fee_unit DEFAULT 'unit'::equipment_fee_unit NOT NULL,
+    active boolean DEFAULT true NOT NULL,
+    created_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_building_id FOREIGN KEY (building_id) REFERENCES building (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT equipment_quantity_nonnegative CHECK (quantity >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_equipment_building_id ON equipment (building_id);
+
+CREATE TABLE IF NOT EXISTS reservation_equipment (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    reservation_id BIGINT NOT NULL,
+    equipment_id BIGINT NOT NULL,
+    quantity INTEGER NOT NULL,
+    fee_id BIGINT,
+    CONSTRAINT fk_reservation_id FOREIGN KEY (reservation_id) REFERENCES reservation (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_equipment_id FOREIGN KEY (equipment_id) REFERENCES equipment (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_fee_id FOREIGN KEY (fee_id) REFERENCES reservation_fees (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    CONSTRAINT unique_reservation_equipment UNIQUE (reservation_id, equipment_id),
+    CONSTRAINT reservation_equipment_quantity_positive CHECK (quantity > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reservation_equipment_equipment_id ON reservation_equipment (equipment_id);
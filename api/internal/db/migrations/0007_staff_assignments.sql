@@ -0,0 +1,21 @@
+-- Staff shift assignments
+-- Custodial, tech and security coverage for individual reservation dates.
+CREATE TYPE staff_role AS ENUM (
+    'custodian',
+    'tech',
+    'security'
+);
+
+CREATE TABLE IF NOT EXISTS staff_assignments (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    reservation_date_id BIGINT NOT NULL,
+    user_id TEXT NOT NULL,
+    role staff_role NOT NULL,
+    notes TEXT,
+    created_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_reservation_date_id FOREIGN KEY (reservation_date_id) REFERENCES reservation_date (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT unique_staff_assignment UNIQUE (reservation_date_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_staff_assignments_user_id ON staff_assignments (user_id);
@@ -0,0 +1,23 @@
+-- Local calendar events
+-- Backs the built-in calendar provider so the app runs without Google. Series
+-- keep their RRULE/EXDATE/RDATE lines in recurrence; per-instance overrides
+-- point back at their master and the occurrence they replace.
+CREATE TABLE IF NOT EXISTS calendar_events (
+    id TEXT NOT NULL PRIMARY KEY,
+    calendar_id TEXT NOT NULL,
+    master_id TEXT,
+    original_start timestamp(3) without time zone,
+    summary TEXT DEFAULT '' NOT NULL,
+    description TEXT DEFAULT '' NOT NULL,
+    location TEXT DEFAULT '' NOT NULL,
+    start_time timestamp(3) without time zone NOT NULL,
+    end_time timestamp(3) without time zone NOT NULL,
+    all_day boolean DEFAULT false NOT NULL,
+    recurrence TEXT,
+    created_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_master_id FOREIGN KEY (master_id) REFERENCES calendar_events (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT unique_calendar_event_override UNIQUE (master_id, original_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_events_calendar_id ON calendar_events (calendar_id, start_time);
@@ -0,0 +1,3 @@
+-- Per-building calendar provider
+-- NULL keeps the building on the provider named by CALENDAR_PROVIDER.
+ALTER TABLE building ADD COLUMN IF NOT EXISTS calendar_provider TEXT;
@@ -0,0 +1,9 @@
+-- Calendar feed tokens
+-- One secret per user for subscribing to iCalendar feeds. The token unlocks
+-- the user's own reservations feed and private details on building feeds.
+CREATE TABLE IF NOT EXISTS calendar_feed_tokens (
+    user_id TEXT PRIMARY KEY,
+    token TEXT NOT NULL UNIQUE,
+    created_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,27 @@
+-- Building calendar sync
+-- Maps each facility event to the copy on its building's calendar so the sync
+-- only inserts, patches and deletes what changed, and keeps each facility
+-- calendar's sync token between runs.
+CREATE TABLE IF NOT EXISTS calendar_sync_state (
+    building_id BIGINT NOT NULL,
+    source_calendar_id TEXT NOT NULL,
+    target_calendar_id TEXT NOT NULL,
+    sync_token TEXT,
+    full_synced_at timestamp(3) with time zone,
+    synced_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (building_id, source_calendar_id),
+    CONSTRAINT fk_building_id FOREIGN KEY (building_id) REFERENCES building (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS calendar_sync_events (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    building_id BIGINT NOT NULL,
+    source_calendar_id TEXT NOT NULL,
+    source_event_id TEXT NOT NULL,
+    target_event_id TEXT NOT NULL,
+    fingerprint TEXT NOT NULL,
+    ends_at timestamp(3) with time zone NOT NULL,
+    updated_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_building_id FOREIGN KEY (building_id) REFERENCES building (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT unique_calendar_sync_event UNIQUE (building_id, source_calendar_id, source_event_id)
+);
@@ -0,0 +1,35 @@
+-- Calendar changes
+-- Edits made directly on facility calendars to events the app published. A
+-- move that fits is applied to its reservation date; anything else waits for
+-- an admin. Rows are kept as the audit trail.
+CREATE TYPE calendar_change_kind AS ENUM (
+    'moved',
+    'deleted'
+);
+
+CREATE TYPE calendar_change_status AS ENUM (
+    'review',
+    'applied',
+    'reverted'
+);
+
+CREATE TABLE IF NOT EXISTS calendar_changes (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    reservation_date_id BIGINT NOT NULL,
+    kind calendar_change_kind NOT NULL,
+    status calendar_change_status NOT NULL,
+    event_id TEXT NOT NULL,
+    old_start timestamp without time zone,
+    old_end timestamp without time zone,
+    new_start timestamp without time zone,
+    new_end timestamp without time zone,
+    note TEXT,
+    detected_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    resolved_at timestamp(3) with time zone,
+    resolved_by TEXT,
+    CONSTRAINT fk_reservation_date_id FOREIGN KEY (reservation_date_id) REFERENCES reservation_date (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_resolved_by FOREIGN KEY (resolved_by) REFERENCES users (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+-- one open review per date; later detections refresh it
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendar_changes_review ON calendar_changes (reservation_date_id) WHERE status = 'review';
@@ -0,0 +1,35 @@
+-- Calendar outbox
+-- Calendar operations queued by reservation status changes. Rows are written
+-- in the same transaction as the change and executed by the outbox worker,
+-- which retries with backoff and records the resulting event ids.
+CREATE TYPE calendar_op_kind AS ENUM (
+    'publish',
+    'patch',
+    'delete',
+    'exdates'
+);
+
+CREATE TYPE calendar_op_status AS ENUM (
+    'pending',
+    'done',
+    'failed'
+);
+
+CREATE TABLE IF NOT EXISTS calendar_outbox (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    -- kept after the reservation is deleted so its events can still go
+    reservation_id BIGINT,
+    kind calendar_op_kind NOT NULL,
+    status calendar_op_status NOT NULL DEFAULT 'pending',
+    calendar_id TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    run_at timestamp(3) with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at timestamp(3) with time zone DEFAULT CURRENT_TIMESTAMP,
+    done_at timestamp(3) with time zone,
+    CONSTRAINT fk_reservation_id FOREIGN KEY (reservation_id) REFERENCES reservation (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_outbox_due ON calendar_outbox (run_at, id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_calendar_outbox_reservation ON calendar_outbox (reservation_id) WHERE status = 'pending';
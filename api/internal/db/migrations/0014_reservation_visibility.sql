@@ -0,0 +1,14 @@
+-- Reservation visibility
+-- What a reservation shows on calendars the public can read. Public events
+-- show their title and details, hide_details keeps the details off the
+-- calendar, and private events only show that the time is reserved.
+-- public_title replaces the event name on published calendars when set.
+CREATE TYPE event_visibility AS ENUM (
+    'public',
+    'hide_details',
+    'private'
+);
+
+ALTER TABLE reservation
+    ADD COLUMN visibility event_visibility NOT NULL DEFAULT 'public',
+    ADD COLUMN public_title TEXT;
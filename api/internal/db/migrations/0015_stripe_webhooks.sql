@@ -0,0 +1,28 @@
+-- Stripe webhooks
+-- Payment state is driven by Stripe events instead of the browser returning
+-- from checkout. Every handled event is recorded in stripe_event so a
+-- redelivered event is applied only once.
+CREATE TYPE payment_status AS ENUM (
+    'unpaid',
+    'paid',
+    'partially_refunded',
+    'refunded',
+    'disputed',
+    'dispute_lost'
+);
+
+ALTER TABLE reservation
+    ADD COLUMN payment_status payment_status NOT NULL DEFAULT 'unpaid',
+    ADD COLUMN payment_intent_id TEXT;
+
+UPDATE reservation SET payment_status = 'paid' WHERE paid;
+
+CREATE INDEX idx_reservation_payment_intent ON reservation (payment_intent_id)
+    WHERE payment_intent_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS stripe_event (
+    id TEXT PRIMARY KEY,
+    type TEXT NOT NULL,
+    reservation_id BIGINT REFERENCES reservation (id) ON DELETE SET NULL,
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
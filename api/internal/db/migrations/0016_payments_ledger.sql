@@ -0,0 +1,33 @@
+-- Payments ledger
+-- Every movement of money for a reservation. The balance due is the
+-- reservation total minus the sum of its entries: charges and offline
+-- payments are positive, refunds negative, and adjustments either way (a
+-- positive adjustment is a credit, a negative one an extra charge).
+CREATE TYPE payment_entry_kind AS ENUM (
+    'charge',
+    'refund',
+    'adjustment',
+    'offline'
+);
+
+CREATE TABLE IF NOT EXISTS payments (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    reservation_id BIGINT NOT NULL REFERENCES reservation (id) ON DELETE CASCADE,
+    kind payment_entry_kind NOT NULL,
+    amount_cents BIGINT NOT NULL,
+    currency TEXT NOT NULL DEFAULT 'usd',
+    -- stripe, or manual for entries recorded by an admin
+    provider TEXT NOT NULL DEFAULT 'manual',
+    -- payment intent, refund or dispute id, or a check or receipt number
+    provider_ref TEXT,
+    -- the admin who recorded the entry; NULL for provider events
+    actor_id TEXT REFERENCES users (id) ON DELETE SET NULL,
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_payments_reservation ON payments (reservation_id);
+
+-- a provider object is recorded once however many events mention it
+CREATE UNIQUE INDEX idx_payments_provider_ref ON payments (provider, provider_ref)
+    WHERE provider <> 'manual' AND provider_ref IS NOT NULL;
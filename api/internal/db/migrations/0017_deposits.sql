@@ -0,0 +1,22 @@
+-- Deposits
+-- A category can take a deposit up front, as a percentage of the total or a
+-- fixed amount, with the balance due balance_due_days before the first
+-- date. The balance worker emails a payment link once the due date is near
+-- and flags reservations still unpaid after it.
+CREATE TYPE deposit_type AS ENUM (
+    'none',
+    'percent',
+    'fixed'
+);
+
+ALTER TABLE category
+    ADD COLUMN deposit_type deposit_type NOT NULL DEFAULT 'none',
+    -- percent (0-100) or dollars, depending on deposit_type
+    ADD COLUMN deposit_amount NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN balance_due_days INTEGER NOT NULL DEFAULT 14;
+
+ALTER TYPE payment_status ADD VALUE IF NOT EXISTS 'deposit_paid' AFTER 'unpaid';
+
+ALTER TABLE reservation
+    ADD COLUMN balance_reminded_at TIMESTAMPTZ,
+    ADD COLUMN payment_overdue BOOLEAN NOT NULL DEFAULT false;
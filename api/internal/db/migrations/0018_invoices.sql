@@ -0,0 +1,45 @@
+-- Invoices and receipts
+-- Each kind is numbered in its own gapless sequence: the counter row is
+-- locked and bumped in the transaction that issues the document, so numbers
+-- are never skipped or reused. The lines are kept so the PDF can be
+-- rendered again exactly as issued.
+CREATE TYPE invoice_kind AS ENUM (
+    'invoice',
+    'receipt'
+);
+
+CREATE TABLE IF NOT EXISTS invoice_counter (
+    kind invoice_kind PRIMARY KEY,
+    last_number BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO invoice_counter (kind) VALUES ('invoice'), ('receipt')
+    ON CONFLICT (kind) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS invoices (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    reservation_id BIGINT NOT NULL REFERENCES reservation (id) ON DELETE CASCADE,
+    kind invoice_kind NOT NULL,
+    number BIGINT NOT NULL,
+    -- what the reservation cost and what was paid when it was issued
+    total_cents BIGINT NOT NULL,
+    paid_cents BIGINT NOT NULL,
+    -- where the PDF is kept in file storage
+    file_path TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (kind, number)
+);
+
+CREATE INDEX idx_invoices_reservation ON invoices (reservation_id);
+
+CREATE TABLE IF NOT EXISTS invoice_lines (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    description TEXT NOT NULL,
+    quantity BIGINT NOT NULL,
+    unit_cents BIGINT NOT NULL,
+    amount_cents BIGINT NOT NULL
+);
+
+CREATE INDEX idx_invoice_lines_invoice ON invoice_lines (invoice_id);
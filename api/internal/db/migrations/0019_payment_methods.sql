@@ -0,0 +1,44 @@
+-- Offline payment methods
+-- Checks, purchase orders and internal account transfers are recorded as
+-- submissions with their reference number. They take nothing off the
+-- balance until an admin verifies them, which adds the offline entry to the
+-- payments ledger; a rejected one leaves the ledger untouched. While one is
+-- pending the reservation shows pending_verification and gets no balance
+-- reminders.
+CREATE TYPE manual_payment_method AS ENUM (
+    'check',
+    'purchase_order',
+    'account_code'
+);
+
+CREATE TYPE payment_submission_status AS ENUM (
+    'pending',
+    'verified',
+    'rejected'
+);
+
+CREATE TABLE IF NOT EXISTS payment_submissions (
+    id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    reservation_id BIGINT NOT NULL REFERENCES reservation (id) ON DELETE CASCADE,
+    method manual_payment_method NOT NULL,
+    -- full, deposit or balance, as offered when it was submitted
+    payment TEXT NOT NULL,
+    amount_cents BIGINT NOT NULL CHECK (amount_cents > 0),
+    -- check number, PO number or internal account code
+    reference TEXT NOT NULL,
+    note TEXT,
+    status payment_submission_status NOT NULL DEFAULT 'pending',
+    submitted_by TEXT REFERENCES users (id) ON DELETE SET NULL,
+    reviewed_by TEXT REFERENCES users (id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMPTZ,
+    review_note TEXT,
+    -- the ledger entry a verified submission added
+    payment_id BIGINT REFERENCES payments (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_payment_submissions_reservation ON payment_submissions (reservation_id);
+CREATE INDEX idx_payment_submissions_pending ON payment_submissions (created_at)
+    WHERE status = 'pending';
+
+ALTER TYPE payment_status ADD VALUE IF NOT EXISTS 'pending_verification' AFTER 'deposit_paid';
@@ -0,0 +1,10 @@
+-- Private visibility by default
+-- 0014 added visibility defaulting to 'public', which put the title and
+-- details of every reservation made before it on public calendars. Those
+-- reservations are made private; ones created since chose their own
+-- visibility. New rows that do not name one are private as well.
+UPDATE reservation
+SET visibility = 'private'
+WHERE created_at < (SELECT applied_at FROM schema_migrations WHERE version = 14);
+
+ALTER TABLE reservation ALTER COLUMN visibility SET DEFAULT 'private';
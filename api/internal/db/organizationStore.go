@@ -0,0 +1,294 @@
+package db
+
+import (
+	"api/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type OrganizationStore struct {
+	log *slog.Logger
+	db  *DB
+}
+
+func NewOrganizationStore(db *DB, log *slog.Logger) *OrganizationStore {
+	log.With("layer", "db", "store", "organization")
+	return &OrganizationStore{db: db, log: log}
+}
+
+const getAllOrganizationsQuery = `SELECT * FROM organizations ORDER BY name`
+
+func (s *OrganizationStore) GetAll(ctx context.Context) ([]models.Organization, error) {
+	var organizations []models.Organization
+	if err := s.db.SelectContext(ctx, &organizations, getAllOrganizationsQuery); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.Organization{}, nil
+		}
+		return nil, err
+	}
+	return organizations, nil
+}
+
+const getOrganizationQuery = `SELECT * FROM organizations WHERE id = $1`
+const getOrganizationMembersQuery = `SELECT m.id, m.organization_id, m.user_id, m.role, u.name AS user_name, u.email AS user_email
+FROM organization_members m
+JOIN users u ON m.user_id = u.id
+WHERE m.organization_id = $1
+ORDER BY m.role, u.name`
+const getOrganizationInsuranceQuery = `SELECT * FROM organization_insurance WHERE organization_id = $1 ORDER BY created_at DESC`
+
+func (s *OrganizationStore) Get(ctx context.Context, id int64) (*models.FullOrganization, error) {
+	var organization models.Organization
+	if err := s.db.GetContext(ctx, &organization, getOrganizationQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var members []models.OrganizationMember
+	if err := s.db.SelectContext(ctx, &members, getOrganizationMembersQuery, id); err != nil {
+		return nil, err
+	}
+	var insurance []models.OrganizationInsurance
+	if err := s.db.SelectContext(ctx, &insurance, getOrganizationInsuranceQuery, id); err != nil {
+		return nil, err
+	}
+	return &models.FullOrganization{
+		Organization: organization,
+		Members:      members,
+		Insurance:    insurance,
+	}, nil
+}
+
+const getUserOrganizationsQuery = `SELECT o.*, m.role
+FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+WHERE m.user_id = $1
+ORDER BY o.name`
+
+func (s *OrganizationStore) GetUserOrganizations(ctx context.Context, userID string) ([]models.UserOrganization, error) {
+	var organizations []models.UserOrganization
+	if err := s.db.SelectContext(ctx, &organizations, getUserOrganizationsQuery, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.UserOrganization{}, nil
+		}
+		return nil, err
+	}
+	return organizations, nil
+}
+
+const createOrganizationQuery = `INSERT INTO organizations (
+	name,
+	billing_name,
+	billing_email,
+	billing_phone
+) VALUES (
+	:name,
+	:billing_name,
+	:billing_email,
+	:billing_phone
+)
+RETURNING id`
+
+const addOrganizationMemberQuery = `INSERT INTO organization_members (
+	organization_id,
+	user_id,
+	role
+) VALUES (
+	:organization_id,
+	:user_id,
+	:role
+)
+ON CONFLICT (organization_id, user_id) DO NOTHING
+RETURNING id`
+
+// Create inserts the organization and makes ownerID its first owner.
+func (s *OrganizationStore) Create(ctx context.Context, organization *models.Organization, ownerID string) (int64, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	params := map[string]any{
+		"name":          organization.Name,
+		"billing_name":  organization.BillingName,
+		"billing_email": organization.BillingEmail,
+		"billing_phone": organization.BillingPhone,
+	}
+	stmt, err := tx.PrepareNamedContext(ctx, createOrganizationQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	var id int64
+	if err := stmt.GetContext(ctx, &id, params); err != nil {
+		return 0, err
+	}
+
+	memberParams := map[string]any{
+		"organization_id": id,
+		"user_id":         ownerID,
+		"role":            models.OrganizationRoleOwner,
+	}
+	if _, err := tx.NamedExecContext(ctx, addOrganizationMemberQuery, memberParams); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+const updateOrganizationQuery = `UPDATE organizations SET
+	name = :name,
+	billing_name = :billing_name,
+	billing_email = :billing_email,
+	billing_phone = :billing_phone,
+	updated_at = :updated_at
+WHERE id = :id`
+
+func (s *OrganizationStore) Update(ctx context.Context, organization *models.Organization) error {
+	params := map[string]any{
+		"id":            organization.ID,
+		"name":          organization.Name,
+		"billing_name":  organization.BillingName,
+		"billing_email": organization.BillingEmail,
+		"billing_phone": organization.BillingPhone,
+		"updated_at":    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	_, err := s.db.NamedExecContext(ctx, updateOrganizationQuery, params)
+	return err
+}
+
+const deleteOrganizationQuery = `DELETE FROM organizations WHERE id = $1`
+
+func (s *OrganizationStore) Delete(ctx context.Context, id int64) error {
+	_, err := s.db.ExecContext(ctx, deleteOrganizationQuery, id)
+	return err
+}
+
+// AddMember adds a user to the organization. It returns 0 if they are
+// already a member, leaving their role as it is.
+func (s *OrganizationStore) AddMember(ctx context.Context, member *models.OrganizationMember) (int64, error) {
+	params := map[string]any{
+		"organization_id": member.OrganizationID,
+		"user_id":         member.UserID,
+		"role":            member.Role,
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, addOrganizationMemberQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	var id int64
+	if err := stmt.GetContext(ctx, &id, params); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+const getOrganizationMemberQuery = `SELECT m.id, m.organization_id, m.user_id, m.role, u.name AS user_name, u.email AS user_email
+FROM organization_members m
+JOIN users u ON m.user_id = u.id
+WHERE m.id = $1`
+
+func (s *OrganizationStore) GetMember(ctx context.Context, id int64) (*models.OrganizationMember, error) {
+	var member models.OrganizationMember
+	if err := s.db.GetContext(ctx, &member, getOrganizationMemberQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &member, nil
+}
+
+const getMemberRoleQuery = `SELECT role FROM organization_members WHERE organization_id = $1 AND user_id = $2`
+
+// GetMemberRole returns the user's role in the organization, or an empty role
+// if they are not a member.
+func (s *OrganizationStore) GetMemberRole(ctx context.Context, organizationID int64, userID string) (models.OrganizationRole, error) {
+	var role models.OrganizationRole
+	if err := s.db.GetContext(ctx, &role, getMemberRoleQuery, organizationID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return role, nil
+}
+
+const countOwnersQuery = `SELECT count(*) FROM organization_members WHERE organization_id = $1 AND role = 'owner'`
+
+// CountOwners returns how many owners the organization has.
+func (s *OrganizationStore) CountOwners(ctx context.Context, organizationID int64) (int, error) {
+	var n int
+	err := s.db.GetContext(ctx, &n, countOwnersQuery, organizationID)
+	return n, err
+}
+
+const updateMemberRoleQuery = `UPDATE organization_members SET role = $1 WHERE id = $2`
+
+func (s *OrganizationStore) UpdateMemberRole(ctx context.Context, id int64, role models.OrganizationRole) error {
+	_, err := s.db.ExecContext(ctx, updateMemberRoleQuery, role, id)
+	return err
+}
+
+const removeMemberQuery = `DELETE FROM organization_members WHERE id = $1`
+
+func (s *OrganizationStore) RemoveMember(ctx context.Context, id int64) error {
+	_, err := s.db.ExecContext(ctx, removeMemberQuery, id)
+	return err
+}
+
+const createOrganizationInsuranceQuery = `INSERT INTO organization_insurance (
+	organization_id,
+	file_path,
+	file_name,
+	expires_at
+) VALUES (
+	:organization_id,
+	:file_path,
+	:file_name,
+	:expires_at
+)`
+
+func (s *OrganizationStore) CreateInsurance(ctx context.Context, insurance *models.OrganizationInsurance) error {
+	params := map[string]any{
+		"organization_id": insurance.OrganizationID,
+		"file_path":       insurance.FilePath,
+		"file_name":       insurance.FileName,
+		"expires_at":      insurance.ExpiresAt,
+	}
+	_, err := s.db.NamedExecContext(ctx, createOrganizationInsuranceQuery, params)
+	return err
+}
+
+const getInsuranceQuery = `SELECT * FROM organization_insurance WHERE id = $1`
+
+func (s *OrganizationStore) GetInsurance(ctx context.Context, id int64) (*models.OrganizationInsurance, error) {
+	var insurance models.OrganizationInsurance
+	if err := s.db.GetContext(ctx, &insurance, getInsuranceQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &insurance, nil
+}
+
+const deleteInsuranceQuery = `DELETE FROM organization_insurance WHERE id = $1`
+
+func (s *OrganizationStore) DeleteInsurance(ctx context.Context, id int64) error {
+	_, err := s.db.ExecContext(ctx, deleteInsuranceQuery, id)
+	return err
+}
@@ -0,0 +1,336 @@
+package db
+
+import (
+	"api/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+type PaymentStore struct {
+	log *slog.Logger
+	db  *DB
+}
+
+func NewPaymentStore(db *DB, log *slog.Logger) *PaymentStore {
+	log.With("layer", "db", "store", "payment")
+	return &PaymentStore{db: db, log: log}
+}
+
+const insertStripeEventQuery = `INSERT INTO stripe_event (
+	id,
+	type,
+	reservation_id
+) VALUES (
+	$1,
+	$2,
+	NULLIF($3::bigint, 0)
+) ON CONFLICT (id) DO NOTHING`
+
+// A late or redelivered payment never overwrites a refund, a lost dispute,
+// or a later payment. Pending verification only stands in for unpaid or
+// deposit paid, and clearing it is the only way back to unpaid.
+const updatePaymentStatusQuery = `UPDATE reservation SET
+	payment_status = $2,
+	paid = $3,
+	payment_intent_id = COALESCE(NULLIF($4, ''), payment_intent_id),
+	payment_overdue = payment_overdue AND NOT $3
+WHERE id = $1
+AND CASE $2
+	WHEN 'paid' THEN payment_status IN ('unpaid', 'deposit_paid', 'pending_verification', 'paid', 'disputed')
+	WHEN 'deposit_paid' THEN payment_status IN ('unpaid', 'deposit_paid', 'pending_verification', 'disputed')
+	WHEN 'pending_verification' THEN payment_status IN ('unpaid', 'deposit_paid', 'pending_verification')
+	WHEN 'unpaid' THEN payment_status = 'pending_verification'
+	ELSE true
+END`
+
+// RecordStripeEvent records a webhook event and applies its payment change
+// in one transaction. It reports false, changing nothing, when the event
+// was already recorded.
+func (s *PaymentStore) RecordStripeEvent(ctx context.Context, event *models.StripeEvent) (bool, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, insertStripeEventQuery, event.ID, event.Type, event.ReservationID)
+	if err != nil {
+		return false, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return false, nil
+	}
+	if event.ReservationID == 0 {
+		return true, tx.Commit()
+	}
+	if event.Status != "" {
+		if _, err := tx.ExecContext(ctx, updatePaymentStatusQuery, event.ReservationID, event.Status, event.Status.Paid(), event.PaymentIntentID); err != nil {
+			return false, err
+		}
+	}
+	for _, entry := range event.Entries {
+		entry.ReservationID = event.ReservationID
+		if _, err := tx.NamedExecContext(ctx, insertPaymentEntryQuery, entryParams(&entry)); err != nil {
+			return false, err
+		}
+	}
+	return true, tx.Commit()
+}
+
+// UpdatePaymentStatus sets a reservation's payment status outside of a
+// webhook, such as after a refund or a manual payment.
+func (s *PaymentStore) UpdatePaymentStatus(ctx context.Context, reservationID int64, status models.PaymentStatus, paymentIntentID string) error {
+	_, err := s.db.ExecContext(ctx, updatePaymentStatusQuery, reservationID, status, status.Paid(), paymentIntentID)
+	return err
+}
+
+const reservationByPaymentIntentQuery = `SELECT id FROM reservation WHERE payment_intent_id = $1 LIMIT 1`
+
+// ReservationByPaymentIntent returns the reservation paid by a payment
+// intent, or 0 when there is none.
+func (s *PaymentStore) ReservationByPaymentIntent(ctx context.Context, paymentIntentID string) (int64, error) {
+	var id int64
+	if err := s.db.GetContext(ctx, &id, reservationByPaymentIntentQuery, paymentIntentID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// Provider objects mentioned by several events are only recorded once.
+const insertPaymentEntryQuery = `INSERT INTO payments (
+	reservation_id,
+	kind,
+	amount_cents,
+	currency,
+	provider,
+	provider_ref,
+	actor_id,
+	note
+) VALUES (
+	:reservation_id,
+	:kind,
+	:amount_cents,
+	:currency,
+	:provider,
+	:provider_ref,
+	:actor_id,
+	:note
+) ON CONFLICT (provider, provider_ref) WHERE provider <> 'manual' AND provider_ref IS NOT NULL DO NOTHING
+RETURNING *`
+
+func entryParams(entry *models.PaymentEntry) map[string]any {
+	currency := entry.Currency
+	if currency == "" {
+		currency = "usd"
+	}
+	provider := entry.Provider
+	if provider == "" {
+		provider = models.PaymentProviderManual
+	}
+	return map[string]any{
+		"reservation_id": entry.ReservationID,
+		"kind":           entry.Kind,
+		"amount_cents":   entry.AmountCents,
+		"currency":       currency,
+		"provider":       provider,
+		"provider_ref":   entry.ProviderRef,
+		"actor_id":       entry.ActorID,
+		"note":           entry.Note,
+	}
+}
+
+// CreatePaymentEntry adds an entry to a reservation's ledger. It returns nil
+// when the provider reference is already recorded.
+func (s *PaymentStore) CreatePaymentEntry(ctx context.Context, entry *models.PaymentEntry) (*models.PaymentEntry, error) {
+	query, args, err := s.db.BindNamed(insertPaymentEntryQuery, entryParams(entry))
+	if err != nil {
+		return nil, err
+	}
+	var created models.PaymentEntry
+	if err := s.db.GetContext(ctx, &created, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &created, nil
+}
+
+const getPaymentEntriesQuery = `SELECT * FROM payments WHERE reservation_id = $1 ORDER BY created_at, id`
+
+// GetPaymentEntries returns a reservation's ledger, oldest first.
+func (s *PaymentStore) GetPaymentEntries(ctx context.Context, reservationID int64) ([]models.PaymentEntry, error) {
+	var entries []models.PaymentEntry
+	if err := s.db.SelectContext(ctx, &entries, getPaymentEntriesQuery, reservationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.PaymentEntry{}, nil
+		}
+		return nil, err
+	}
+	return entries, nil
+}
+
+// approved bookings still owing whose balance is due by $1, counted back
+// from their first remaining date
+const getBalancesDueQuery = `SELECT
+	r.id AS reservation_id,
+	c.balance_due_days,
+	MIN(d.local_start) AS first_start,
+	r.balance_reminded_at,
+	r.payment_overdue
+FROM reservation r
+JOIN category c ON c.id = r.category_id
+JOIN reservation_date d ON d.reservation_id = r.id
+WHERE r.approved = 'approved'
+AND NOT r.paid
+AND r.payment_status IN ('unpaid', 'deposit_paid')
+AND (r.price_id IS NOT NULL OR r.cost_override IS NOT NULL)
+AND d.approved NOT IN ('denied', 'canceled')
+GROUP BY r.id, c.balance_due_days
+HAVING (MIN(d.local_start) - make_interval(days => c.balance_due_days))::date <= $1::date
+ORDER BY r.id`
+
+// GetBalancesDue returns the reservations whose balance is due on or before
+// the given day.
+func (s *PaymentStore) GetBalancesDue(ctx context.Context, before time.Time) ([]models.BalanceDue, error) {
+	var due []models.BalanceDue
+	if err := s.db.SelectContext(ctx, &due, getBalancesDueQuery, before); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.BalanceDue{}, nil
+		}
+		return nil, err
+	}
+	return due, nil
+}
+
+const markBalanceRemindedQuery = `UPDATE reservation SET balance_reminded_at = now() WHERE id = $1`
+
+func (s *PaymentStore) MarkBalanceReminded(ctx context.Context, reservationID int64) error {
+	_, err := s.db.ExecContext(ctx, markBalanceRemindedQuery, reservationID)
+	return err
+}
+
+const setPaymentOverdueQuery = `UPDATE reservation SET payment_overdue = $2 WHERE id = $1`
+
+func (s *PaymentStore) SetPaymentOverdue(ctx context.Context, reservationID int64, overdue bool) error {
+	_, err := s.db.ExecContext(ctx, setPaymentOverdueQuery, reservationID, overdue)
+	return err
+}
+
+const insertPaymentSubmissionQuery = `INSERT INTO payment_submissions (
+	reservation_id,
+	method,
+	payment,
+	amount_cents,
+	reference,
+	note,
+	submitted_by
+) VALUES (
+	:reservation_id,
+	:method,
+	:payment,
+	:amount_cents,
+	:reference,
+	:note,
+	:submitted_by
+) RETURNING *`
+
+// CreatePaymentSubmission records a check, purchase order or internal
+// transfer pending verification.
+func (s *PaymentStore) CreatePaymentSubmission(ctx context.Context, submission *models.PaymentSubmission) (*models.PaymentSubmission, error) {
+	query, args, err := s.db.BindNamed(insertPaymentSubmissionQuery, submission)
+	if err != nil {
+		return nil, err
+	}
+	var created models.PaymentSubmission
+	if err := s.db.GetContext(ctx, &created, query, args...); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+const selectPaymentSubmissionsQuery = `SELECT s.*, r.facility_id
+FROM payment_submissions s
+JOIN reservation r ON r.id = s.reservation_id`
+
+const getPaymentSubmissionQuery = selectPaymentSubmissionsQuery + `
+WHERE s.id = $1`
+
+func (s *PaymentStore) GetPaymentSubmission(ctx context.Context, id int64) (*models.PaymentSubmission, error) {
+	var submission models.PaymentSubmission
+	if err := s.db.GetContext(ctx, &submission, getPaymentSubmissionQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &submission, nil
+}
+
+// a reservation of 0 or an empty status matches any
+const getPaymentSubmissionsQuery = selectPaymentSubmissionsQuery + `
+WHERE ($1 = 0 OR s.reservation_id = $1)
+AND ($2 = '' OR s.status::text = $2)
+ORDER BY s.created_at, s.id`
+
+// GetPaymentSubmissions returns submissions oldest first, for one
+// reservation or, given 0, for all of them.
+func (s *PaymentStore) GetPaymentSubmissions(ctx context.Context, reservationID int64, status models.PaymentSubmissionStatus) ([]models.PaymentSubmission, error) {
+	var submissions []models.PaymentSubmission
+	if err := s.db.SelectContext(ctx, &submissions, getPaymentSubmissionsQuery, reservationID, string(status)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.PaymentSubmission{}, nil
+		}
+		return nil, err
+	}
+	return submissions, nil
+}
+
+const reviewPaymentSubmissionQuery = `UPDATE payment_submissions SET
+	status = $2,
+	reviewed_by = $3,
+	reviewed_at = now(),
+	review_note = $4,
+	payment_id = $5
+WHERE id = $1
+AND status = 'pending'
+RETURNING *`
+
+// ReviewPaymentSubmission verifies or rejects a pending submission. A
+// verified one adds entry to the ledger in the same transaction. It returns
+// nil, changing nothing, when the submission was already reviewed.
+func (s *PaymentStore) ReviewPaymentSubmission(ctx context.Context, id int64, status models.PaymentSubmissionStatus, reviewer, note sql.NullString, entry *models.PaymentEntry) (*models.PaymentSubmission, *models.PaymentEntry, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var created *models.PaymentEntry
+	var paymentID sql.NullInt64
+	if entry != nil {
+		query, args, err := tx.BindNamed(insertPaymentEntryQuery, entryParams(entry))
+		if err != nil {
+			return nil, nil, err
+		}
+		created = &models.PaymentEntry{}
+		if err := tx.GetContext(ctx, created, query, args...); err != nil {
+			return nil, nil, err
+		}
+		paymentID = sql.NullInt64{Int64: created.ID, Valid: true}
+	}
+	var submission models.PaymentSubmission
+	if err := tx.GetContext(ctx, &submission, reviewPaymentSubmissionQuery, id, status, reviewer, note, paymentID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	return &submission, created, tx.Commit()
+}
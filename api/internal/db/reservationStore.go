@@ -6,6 +6,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -107,7 +108,10 @@ func (s *ReservationStore) GetAllIn(ctx context.Context, ids []int64) ([]models.
 	return toFullReservations(reservations, dates, fees), nil
 }
 
-const getUserReservationsQuery = "SELECT * FROM reservation WHERE user_id = $1"
+// includes bookings owned by any organization the user belongs to
+const getUserReservationsQuery = `SELECT * FROM reservation
+WHERE user_id = $1
+OR organization_id IN (SELECT organization_id FROM organization_members WHERE user_id = $1)`
 
 func (s *ReservationStore) GetUserReservations(ctx context.Context, userID string) ([]models.FullReservation, error) {
 	var reservations []models.Reservation
@@ -138,6 +142,34 @@ func (s *ReservationStore) GetUserReservations(ctx context.Context, userID strin
 	return result, nil
 }
 
+const getOrganizationReservationsQuery = "SELECT * FROM reservation WHERE organization_id = $1"
+
+func (s *ReservationStore) GetOrganizationReservations(ctx context.Context, organizationID int64) ([]models.FullReservation, error) {
+	var reservations []models.Reservation
+	if err := s.db.SelectContext(ctx, &reservations, getOrganizationReservationsQuery, organizationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(reservations) == 0 {
+		return []models.FullReservation{}, nil
+	}
+	reservationIds := make([]int64, 0, len(reservations))
+	for _, res := range reservations {
+		reservationIds = append(reservationIds, res.ID)
+	}
+	dates, err := s.GetDates(ctx, reservationIds)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := s.GetFees(ctx, reservationIds)
+	if err != nil {
+		return nil, err
+	}
+	return toFullReservations(reservations, dates, fees), nil
+}
+
 const createReservationQuery = `
 INSERT INTO reservation (
     user_id,
@@ -156,7 +188,12 @@ INSERT INTO reservation (
 		rrule,
 		rdates,
 		exdates,
-		price_id
+		price_id,
+		organization_id,
+		insurance_link,
+		intake_answers,
+		visibility,
+		public_title
 ) VALUES (
     :user_id,
     :event_name,
@@ -174,31 +211,45 @@ INSERT INTO reservation (
 		:rrule,
 		:rdates,
 		:exdates,
-		:price_id
+		:price_id,
+		:organization_id,
+		:insurance_link,
+		:intake_answers,
+		:visibility,
+		:public_title
 )
 RETURNING id`
 
 func (s *ReservationStore) Create(ctx context.Context, reservation *models.Reservation) (int64, error) {
 
 	var id int64
+	visibility := reservation.Visibility
+	if visibility == "" {
+		visibility = models.EventVisibilityPrivate
+	}
 	args := map[string]any{
-		"user_id":       reservation.UserID,
-		"event_name":    reservation.EventName,
-		"facility_id":   reservation.FacilityID,
-		"approved":      reservation.Approved,
-		"details":       reservation.Details,
-		"insurance":     reservation.Insurance,
-		"door_access":   reservation.DoorAccess,
-		"doors_details": reservation.DoorsDetails,
-		"name":          reservation.Name,
-		"tech_details":  reservation.TechDetails,
-		"tech_support":  reservation.TechSupport,
-		"phone":         reservation.Phone,
-		"category_id":   reservation.CategoryID,
-		"rrule":         reservation.RRule,
-		"rdates":        reservation.RDates,
-		"exdates":       reservation.EXDates,
-		"price_id":      reservation.PriceID,
+		"user_id":         reservation.UserID,
+		"event_name":      reservation.EventName,
+		"facility_id":     reservation.FacilityID,
+		"approved":        reservation.Approved,
+		"details":         reservation.Details,
+		"insurance":       reservation.Insurance,
+		"door_access":     reservation.DoorAccess,
+		"doors_details":   reservation.DoorsDetails,
+		"name":            reservation.Name,
+		"tech_details":    reservation.TechDetails,
+		"tech_support":    reservation.TechSupport,
+		"phone":           reservation.Phone,
+		"category_id":     reservation.CategoryID,
+		"rrule":           reservation.RRule,
+		"rdates":          reservation.RDates,
+		"exdates":         reservation.EXDates,
+		"price_id":        reservation.PriceID,
+		"organization_id": reservation.OrganizationID,
+		"insurance_link":  reservation.InsuranceLink,
+		"intake_answers":  reservation.IntakeAnswers,
+		"visibility":      visibility,
+		"public_title":    reservation.PublicTitle,
 	}
 	rows, err := s.db.NamedQueryContext(ctx, createReservationQuery, args)
 	if err != nil {
@@ -258,43 +309,63 @@ const createReservationFeesQuery = `INSERT INTO reservation_fees (
 )`
 
 func (s *ReservationStore) CreateFee(ctx context.Context, fee models.ReservationFee) error {
-	stmt, err := s.db.PreparexContext(ctx, createReservationFeesQuery)
-	if err != nil {
+	params := map[string]any{
+		"additionalFees": fee.AdditionalFees,
+		"feesType":       fee.FeesType,
+		"reservationId":  fee.ReservationID,
+	}
+	if _, err := s.db.NamedExecContext(ctx, createReservationFeesQuery, params); err != nil {
 		return err
 	}
-	defer stmt.Close()
+	return nil
+}
+
+const updateReservationFeeQuery = `UPDATE reservation_fees SET
+	additional_fees = :additionalFees,
+	fees_type = :feesType
+WHERE id = :id`
+
+func (s *ReservationStore) UpdateFee(ctx context.Context, fee models.ReservationFee) error {
 	params := map[string]any{
 		"additionalFees": fee.AdditionalFees,
 		"feesType":       fee.FeesType,
-		"reservationId":  fee.ReservationID,
+		"id":             fee.ID,
 	}
-	if _, err := stmt.ExecContext(ctx, params); err != nil {
+	if _, err := s.db.NamedExecContext(ctx, updateReservationFeeQuery, params); err != nil {
 		return err
 	}
 	return nil
 }
 
+// paid is left to UpdatePaymentStatus, which keeps it in step with the
+// payment status.
 const updateReservationQuery = `UPDATE reservation SET
+	event_name = :eventName,
+	details = :details,
+	visibility = :visibility,
+	public_title = :publicTitle,
 	approved = :approved,
 	updated_at = :updatedAt,
 	insurance = :insurance,
 	category_id = :categoryId,
 	total_hours = :totalHours,
 	in_person = :inPerson,
-	paid = :paid,
 	insurance_link = :insuranceLink,
 	gcal_eventid = :gcalEventid
 WHERE id = :id`
 
 func (s *ReservationStore) Update(ctx context.Context, reservation *models.Reservation) error {
 	params := map[string]any{
+		"eventName":     reservation.EventName,
+		"details":       reservation.Details,
+		"visibility":    reservation.Visibility,
+		"publicTitle":   reservation.PublicTitle,
 		"approved":      reservation.Approved.String(),
 		"updatedAt":     pgtype.Timestamp{Time: time.Now(), Valid: true},
 		"insurance":     reservation.Insurance,
 		"categoryId":    reservation.CategoryID,
 		"totalHours":    reservation.TotalHours,
 		"inPerson":      reservation.InPerson,
-		"paid":          reservation.Paid,
 		"insuranceLink": reservation.InsuranceLink,
 		"gcalEventid":   reservation.GCalEventID,
 		"id":            reservation.ID,
@@ -325,6 +396,19 @@ func (s *ReservationStore) DeleteDates(ctx context.Context, id []int64) error {
 	return err
 }
 
+const getReservationFeeQuery = `SELECT * FROM reservation_fees WHERE id = $1`
+
+func (s *ReservationStore) GetFee(ctx context.Context, id int64) (*models.ReservationFee, error) {
+	var fee models.ReservationFee
+	if err := s.db.GetContext(ctx, &fee, getReservationFeeQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &fee, nil
+}
+
 const deleteReservationFeesQuery = `DELETE FROM reservation_fees WHERE id = $1`
 
 func (s *ReservationStore) DeleteFees(ctx context.Context, id int64) error {
@@ -332,6 +416,21 @@ func (s *ReservationStore) DeleteFees(ctx context.Context, id int64) error {
 	return err
 }
 
+const updateIntakeAnswersQuery = `UPDATE reservation SET
+	intake_answers = :intake_answers,
+	updated_at = :updated_at
+WHERE id = :id`
+
+func (s *ReservationStore) UpdateIntakeAnswers(ctx context.Context, id int64, answers models.JSONMap) error {
+	params := map[string]any{
+		"intake_answers": answers,
+		"updated_at":     pgtype.Timestamp{Time: time.Now(), Valid: true},
+		"id":             id,
+	}
+	_, err := s.db.NamedExecContext(ctx, updateIntakeAnswersQuery, params)
+	return err
+}
+
 const updateCostOverrideQuery = `UPDATE reservation SET
 	cost_override = :costOverride,
 	updated_at = :updatedAt
@@ -396,6 +495,28 @@ func (s *ReservationStore) GetDates(ctx context.Context, ids []int64) ([]models.
 	return dates, nil
 }
 
+const getReservationDatesByIDQuery = `SELECT * FROM reservation_date WHERE id IN (?)`
+
+// GetDatesByID looks dates up by their own ids rather than the reservation's.
+func (s *ReservationStore) GetDatesByID(ctx context.Context, ids []int64) ([]models.ReservationDate, error) {
+	if len(ids) == 0 {
+		return []models.ReservationDate{}, nil
+	}
+	var dates []models.ReservationDate
+	query, args, err := sqlx.In(getReservationDatesByIDQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	query = s.db.Rebind(query)
+	if err := s.db.SelectContext(ctx, &dates, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.ReservationDate{}, nil
+		}
+		return nil, err
+	}
+	return dates, nil
+}
+
 const getReservationFeesQuery = `SELECT * FROM reservation_fees WHERE reservation_id IN (?)`
 
 func (s *ReservationStore) GetFees(ctx context.Context, ids []int64) ([]models.ReservationFee, error) {
@@ -474,15 +595,408 @@ func (s *ReservationStore) Aggregate(ctx context.Context) ([]models.Aggregate, e
 	return aggregates, nil
 }
 
-const updatePaymentIDQuery = `UPDATE reservation SET payment_id = :payment_id WHERE id = :id`
+const updatePaymentIDQuery = `UPDATE reservation SET payment_intent_id = :payment_intent_id WHERE id = :id`
 
 func (s *ReservationStore) UpdatePaymentIntent(ctx context.Context, id int64, paymentID string) error {
 	params := map[string]any{
-		"payment_id": paymentID,
-		"id":         id,
+		"payment_intent_id": paymentID,
+		"id":                id,
 	}
 	if _, err := s.db.NamedExecContext(ctx, updatePaymentIDQuery, params); err != nil {
 		return err
 	}
 	return nil
 }
+
+const getReservationEquipmentQuery = `SELECT re.*, e.name AS equipment_name
+FROM reservation_equipment re
+JOIN equipment e ON e.id = re.equipment_id
+WHERE re.reservation_id = $1
+ORDER BY e.name`
+
+func (s *ReservationStore) GetReservationEquipment(ctx context.Context, reservationID int64) ([]models.ReservationEquipment, error) {
+	var items []models.ReservationEquipment
+	if err := s.db.SelectContext(ctx, &items, getReservationEquipmentQuery, reservationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.ReservationEquipment{}, nil
+		}
+		return nil, err
+	}
+	return items, nil
+}
+
+// equipmentBookedQuery returns the most units of an item held by other live
+// reservations during any one window. Bookings overlapping the same window are
+// summed even if they do not overlap each other, which errs on the safe side.
+const equipmentBookedQuery = `SELECT COALESCE(MAX(booked), 0) FROM (
+	SELECT w.idx, SUM(re.quantity) AS booked
+	FROM unnest($2::timestamp[], $3::timestamp[]) WITH ORDINALITY AS w(local_start, local_end, idx)
+	JOIN reservation_equipment re ON re.equipment_id = $1 AND re.reservation_id <> $4
+	JOIN reservation r ON r.id = re.reservation_id AND r.approved IN ('pending', 'approved')
+	WHERE EXISTS (
+		SELECT 1 FROM reservation_date d
+		WHERE d.reservation_id = re.reservation_id
+		AND d.approved IN ('pending', 'approved')
+		AND d.local_start < w.local_end
+		AND d.local_end > w.local_start
+	)
+	GROUP BY w.idx
+) booked`
+
+// EquipmentBooked reports how many units of the item are taken during the
+// windows, ignoring excludeReservationID.
+func (s *ReservationStore) EquipmentBooked(ctx context.Context, equipmentID, excludeReservationID int64, starts, ends []time.Time) (int32, error) {
+	return equipmentBooked(ctx, s.db, equipmentID, excludeReservationID, starts, ends)
+}
+
+func equipmentBooked(ctx context.Context, q sqlx.QueryerContext, equipmentID, excludeReservationID int64, starts, ends []time.Time) (int32, error) {
+	if len(starts) == 0 {
+		return 0, nil
+	}
+	var booked int32
+	if err := sqlx.GetContext(ctx, q, &booked, equipmentBookedQuery, equipmentID, starts, ends, excludeReservationID); err != nil {
+		return 0, err
+	}
+	return booked, nil
+}
+
+// rows are locked in id order so concurrent bookings of the same items
+// cannot deadlock
+const lockEquipmentQuery = `SELECT * FROM equipment WHERE id IN (?) ORDER BY id FOR UPDATE`
+
+const activeReservationDatesQuery = `SELECT local_start, local_end FROM reservation_date
+WHERE reservation_id = $1 AND approved IN ('pending', 'approved')`
+
+const deleteReservationEquipmentFeesQuery = `DELETE FROM reservation_fees
+WHERE id IN (SELECT fee_id FROM reservation_equipment WHERE reservation_id = $1)`
+
+const deleteReservationEquipmentQuery = `DELETE FROM reservation_equipment WHERE reservation_id = $1`
+
+const createEquipmentFeeQuery = `INSERT INTO reservation_fees (
+	additional_fees,
+	fees_type,
+	reservation_id
+) VALUES (
+	:additional_fees,
+	:fees_type,
+	:reservation_id
+)
+RETURNING id`
+
+const createReservationEquipmentQuery = `INSERT INTO reservation_equipment (
+	reservation_id,
+	equipment_id,
+	quantity,
+	fee_id
+) VALUES (
+	:reservation_id,
+	:equipment_id,
+	:quantity,
+	:fee_id
+)`
+
+// SetReservationEquipment replaces the reservation's add-ons and their fees.
+// Each item is locked and re-checked against the reservation's dates so two
+// bookings cannot take the last unit at the same time.
+func (s *ReservationStore) SetReservationEquipment(ctx context.Context, reservationID int64, items []models.ReservationEquipment) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var dates []models.ReservationDate
+	if err := tx.SelectContext(ctx, &dates, activeReservationDatesQuery, reservationID); err != nil {
+		return err
+	}
+	starts := make([]time.Time, len(dates))
+	ends := make([]time.Time, len(dates))
+	for i, d := range dates {
+		starts[i] = d.LocalStart.Time
+		ends[i] = d.LocalEnd.Time
+	}
+
+	locked := make(map[int64]models.Equipment, len(items))
+	if len(items) > 0 {
+		ids := make([]int64, len(items))
+		for i, item := range items {
+			ids[i] = item.EquipmentID
+		}
+		query, args, err := sqlx.In(lockEquipmentQuery, ids)
+		if err != nil {
+			return err
+		}
+		var rows []models.Equipment
+		if err := tx.SelectContext(ctx, &rows, tx.Rebind(query), args...); err != nil {
+			return err
+		}
+		for _, e := range rows {
+			locked[e.ID] = e
+		}
+	}
+	for _, item := range items {
+		equipment, ok := locked[item.EquipmentID]
+		if !ok {
+			return fmt.Errorf("equipment %d: %w", item.EquipmentID, sql.ErrNoRows)
+		}
+		booked, err := equipmentBooked(ctx, tx, item.EquipmentID, reservationID, starts, ends)
+		if err != nil {
+			return err
+		}
+		if booked+item.Quantity > equipment.Quantity {
+			return fmt.Errorf("%w: %d of %d %s left", models.ErrEquipmentUnavailable, max(equipment.Quantity-booked, 0), equipment.Quantity, equipment.Name)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteReservationEquipmentFeesQuery, reservationID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, deleteReservationEquipmentQuery, reservationID); err != nil {
+		return err
+	}
+
+	feeStmt, err := tx.PrepareNamedContext(ctx, createEquipmentFeeQuery)
+	if err != nil {
+		return err
+	}
+	defer feeStmt.Close()
+	for _, item := range items {
+		var feeID sql.NullInt64
+		if item.Fee != nil {
+			params := map[string]any{
+				"additional_fees": item.Fee.AdditionalFees,
+				"fees_type":       item.Fee.FeesType,
+				"reservation_id":  reservationID,
+			}
+			if err := feeStmt.GetContext(ctx, &feeID, params); err != nil {
+				return err
+			}
+		}
+		params := map[string]any{
+			"reservation_id": reservationID,
+			"equipment_id":   item.EquipmentID,
+			"quantity":       item.Quantity,
+			"fee_id":         feeID,
+		}
+		if _, err := tx.NamedExecContext(ctx, createReservationEquipmentQuery, params); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// only approved dates on approved bookings that asked for door access
+const getDoorAccessDatesQuery = `SELECT
+	d.id AS reservation_date_id,
+	d.reservation_id,
+	r.event_name,
+	r.doors_details,
+	f.id AS facility_id,
+	f.name AS facility_name,
+	b.id AS building_id,
+	b.name AS building_name,
+	d.local_start,
+	d.local_end
+FROM reservation_date d
+JOIN reservation r ON r.id = d.reservation_id
+JOIN facility f ON f.id = r.facility_id
+JOIN building b ON b.id = f.building_id
+WHERE b.id = $1
+AND r.door_access
+AND r.approved = 'approved'
+AND d.approved = 'approved'
+AND d.local_start < $3
+AND d.local_end > $2
+ORDER BY d.local_start`
+
+func (s *ReservationStore) GetDoorAccessDates(ctx context.Context, buildingID int64, from, to time.Time) ([]models.DoorAccessDate, error) {
+	var dates []models.DoorAccessDate
+	if err := s.db.SelectContext(ctx, &dates, getDoorAccessDatesQuery, buildingID, from, to); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.DoorAccessDate{}, nil
+		}
+		return nil, err
+	}
+	return dates, nil
+}
+
+// approved dates on approved bookings, plus pending ones when asked for
+const getFeedDatesQuery = `SELECT
+	d.id AS reservation_date_id,
+	d.reservation_id,
+	(r.approved = 'approved' AND d.approved = 'approved') AS confirmed,
+	r.user_id,
+	r.event_name,
+	r.details,
+	r.name,
+	r.rrule,
+	r.visibility,
+	r.public_title,
+	f.id AS facility_id,
+	f.name AS facility_name,
+	b.id AS building_id,
+	b.name AS building_name,
+	d.local_start,
+	d.local_end
+FROM reservation_date d
+JOIN reservation r ON r.id = d.reservation_id
+JOIN facility f ON f.id = r.facility_id
+JOIN building b ON b.id = f.building_id
+WHERE ($1::bigint = 0 OR f.id = $1)
+AND ($2::bigint = 0 OR b.id = $2)
+AND ($3::text = '' OR r.user_id = $3)
+AND d.local_end > $4
+AND (
+	(r.approved = 'approved' AND d.approved = 'approved')
+	OR ($5::boolean AND r.approved IN ('approved', 'pending') AND d.approved IN ('approved', 'pending'))
+)
+ORDER BY d.reservation_id, d.local_start`
+
+// GetFeedDates returns the reservation dates published on an iCalendar feed.
+func (s *ReservationStore) GetFeedDates(ctx context.Context, filter models.FeedFilter) ([]models.FeedDate, error) {
+	var dates []models.FeedDate
+	if err := s.db.SelectContext(ctx, &dates, getFeedDatesQuery, filter.FacilityID, filter.BuildingID, filter.UserID, filter.From, filter.IncludePending); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.FeedDate{}, nil
+		}
+		return nil, err
+	}
+	return dates, nil
+}
+
+const getBookedDatesQuery = `SELECT
+	d.id AS reservation_date_id,
+	d.reservation_id,
+	r.event_name,
+	d.local_start,
+	d.local_end
+FROM reservation_date d
+JOIN reservation r ON r.id = d.reservation_id
+WHERE r.facility_id = $1
+AND r.approved IN ('approved', 'pending')
+AND d.approved IN ('approved', 'pending')
+AND d.local_start < $3
+AND d.local_end > $2
+ORDER BY d.local_start`
+
+// GetBookedDates returns the dates holding the facility between from and to.
+func (s *ReservationStore) GetBookedDates(ctx context.Context, facilityID int64, from, to time.Time) ([]models.BookedDate, error) {
+	var dates []models.BookedDate
+	if err := s.db.SelectContext(ctx, &dates, getBookedDatesQuery, facilityID, from, to); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.BookedDate{}, nil
+		}
+		return nil, err
+	}
+	return dates, nil
+}
+
+const getPublishedDatesQuery = `SELECT
+	d.id AS reservation_date_id,
+	d.reservation_id,
+	r.event_name,
+	d.gcal_eventid AS event_id,
+	CASE WHEN d.gcal_eventid = r.gcal_eventid THEN r.gcal_eventid END AS series_id,
+	d.local_start,
+	d.local_end
+FROM reservation_date d
+JOIN reservation r ON r.id = d.reservation_id
+WHERE r.facility_id = $1
+AND r.approved = 'approved'
+AND d.approved = 'approved'
+AND d.gcal_eventid IS NOT NULL AND d.gcal_eventid <> ''
+AND d.local_start < $3
+AND d.local_end > $2
+ORDER BY d.local_start`
+
+// GetPublishedDates returns the approved dates with a calendar event between
+// from and to.
+func (s *ReservationStore) GetPublishedDates(ctx context.Context, facilityID int64, from, to time.Time) ([]models.PublishedDate, error) {
+	var dates []models.PublishedDate
+	if err := s.db.SelectContext(ctx, &dates, getPublishedDatesQuery, facilityID, from, to); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.PublishedDate{}, nil
+		}
+		return nil, err
+	}
+	return dates, nil
+}
+
+const getCalendarDatesQuery = `SELECT
+	d.id AS reservation_date_id,
+	d.reservation_id,
+	r.facility_id,
+	r.event_name,
+	r.approved AS reservation_status,
+	d.approved AS date_status,
+	NULLIF(d.gcal_eventid, '') AS event_id,
+	NULLIF(r.gcal_eventid, '') AS series_id,
+	d.local_start,
+	d.local_end
+FROM reservation_date d
+JOIN reservation r ON r.id = d.reservation_id
+JOIN facility f ON f.id = r.facility_id
+WHERE f.google_calendar_id = $1
+AND d.local_start < $3
+AND d.local_end > $2
+ORDER BY d.local_start`
+
+// GetCalendarDates returns every reservation date between from and to for
+// the facilities publishing to calendarID.
+func (s *ReservationStore) GetCalendarDates(ctx context.Context, calendarID string, from, to time.Time) ([]models.CalendarDate, error) {
+	var dates []models.CalendarDate
+	if err := s.db.SelectContext(ctx, &dates, getCalendarDatesQuery, calendarID, from, to); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.CalendarDate{}, nil
+		}
+		return nil, err
+	}
+	return dates, nil
+}
+
+// Building calendars hold copies of facility events, so their ids are
+// traced back to the source event first. Occurrence ids resolve to their
+// series master.
+const getEventReservationsQuery = `WITH ids AS (
+	SELECT DISTINCT e.id AS event_id, COALESCE(m.source_event_id, e.id) AS source_id
+	FROM unnest($1::text[]) AS e(id)
+	LEFT JOIN calendar_sync_events m ON m.target_event_id = e.id
+)
+SELECT DISTINCT ON (event_id) event_id, event_name, visibility, public_title FROM (
+	SELECT ids.event_id, r.event_name, r.visibility, r.public_title
+	FROM ids
+	JOIN reservation_date d ON d.gcal_eventid = ids.source_id
+	JOIN reservation r ON r.id = d.reservation_id
+	UNION ALL
+	SELECT ids.event_id, r.event_name, r.visibility, r.public_title
+	FROM ids
+	JOIN reservation r ON r.gcal_eventid = COALESCE(substring(ids.source_id FROM '^(.+)_[0-9]{8}T[0-9]{6}Z$'), ids.source_id)
+) owners
+ORDER BY event_id`
+
+// GetEventReservations returns the visibility of the reservations behind
+// published events, for the events that belong to one.
+func (s *ReservationStore) GetEventReservations(ctx context.Context, eventIDs []string) ([]models.EventReservation, error) {
+	var out []models.EventReservation
+	if len(eventIDs) == 0 {
+		return out, nil
+	}
+	if err := s.db.SelectContext(ctx, &out, getEventReservationsQuery, eventIDs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.EventReservation{}, nil
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+const setExdatesQuery = `UPDATE reservation SET
+	exdates = $2,
+	updated_at = now()
+	WHERE id = $1`
+
+// SetExdates records the starts excluded from a reservation's series.
+func (s *ReservationStore) SetExdates(ctx context.Context, id int64, exdates []sql.NullTime) error {
+	_, err := s.db.ExecContext(ctx, setExdatesQuery, id, exdates)
+	return err
+}
@@ -0,0 +1,237 @@
+package db
+
+import (
+	"api/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type StaffStore struct {
+	log *slog.Logger
+	db  *DB
+}
+
+func NewStaffStore(db *DB, log *slog.Logger) *StaffStore {
+	log.With("layer", "db", "store", "staff")
+	return &StaffStore{db: db, log: log}
+}
+
+const staffAssignmentSelect = `SELECT
+	sa.id,
+	sa.reservation_date_id,
+	sa.user_id,
+	sa.role,
+	sa.notes,
+	sa.created_at,
+	u.name AS user_name,
+	u.email AS user_email,
+	d.reservation_id,
+	r.event_name,
+	f.id AS facility_id,
+	f.name AS facility_name,
+	b.id AS building_id,
+	b.name AS building_name,
+	d.local_start,
+	d.local_end
+FROM staff_assignments sa
+JOIN users u ON u.id = sa.user_id
+JOIN reservation_date d ON d.id = sa.reservation_date_id
+JOIN reservation r ON r.id = d.reservation_id
+JOIN facility f ON f.id = r.facility_id
+JOIN building b ON b.id = f.building_id`
+
+const getReservationAssignmentsQuery = staffAssignmentSelect + `
+WHERE d.reservation_id = $1
+ORDER BY d.local_start, sa.role, u.name`
+
+func (s *StaffStore) GetReservationAssignments(ctx context.Context, reservationID int64) ([]models.StaffAssignment, error) {
+	var assignments []models.StaffAssignment
+	if err := s.db.SelectContext(ctx, &assignments, getReservationAssignmentsQuery, reservationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.StaffAssignment{}, nil
+		}
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// only shifts on live dates of live bookings are listed
+const getUserAssignmentsQuery = staffAssignmentSelect + `
+WHERE sa.user_id = $1
+AND d.approved IN ('pending', 'approved')
+AND r.approved IN ('pending', 'approved')
+AND ($2::timestamp IS NULL OR d.local_start >= $2)
+AND ($3::timestamp IS NULL OR d.local_start < $3)
+ORDER BY d.local_start`
+
+func (s *StaffStore) GetUserAssignments(ctx context.Context, userID string, from, to sql.NullTime) ([]models.StaffAssignment, error) {
+	var assignments []models.StaffAssignment
+	if err := s.db.SelectContext(ctx, &assignments, getUserAssignmentsQuery, userID, from, to); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.StaffAssignment{}, nil
+		}
+		return nil, err
+	}
+	return assignments, nil
+}
+
+const getStaffAssignmentQuery = staffAssignmentSelect + `
+WHERE sa.id = $1`
+
+func (s *StaffStore) GetAssignment(ctx context.Context, id int64) (*models.StaffAssignment, error) {
+	var assignment models.StaffAssignment
+	if err := s.db.GetContext(ctx, &assignment, getStaffAssignmentQuery, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+const getStaffAssignmentsInQuery = staffAssignmentSelect + `
+WHERE sa.id IN (?)
+ORDER BY d.local_start`
+
+func (s *StaffStore) GetAssignmentsIn(ctx context.Context, ids []int64) ([]models.StaffAssignment, error) {
+	if len(ids) == 0 {
+		return []models.StaffAssignment{}, nil
+	}
+	query, args, err := sqlx.In(getStaffAssignmentsInQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	query = s.db.Rebind(query)
+	var assignments []models.StaffAssignment
+	if err := s.db.SelectContext(ctx, &assignments, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.StaffAssignment{}, nil
+		}
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// lockStaffUserQuery serializes assignment changes per person so two admins
+// cannot double-book the same staff member at once.
+const lockStaffUserQuery = `SELECT id FROM users WHERE id = $1 FOR UPDATE`
+
+// staffConflictsQuery finds the person's existing shifts that overlap any of
+// the requested dates, including shifts already on those dates.
+const staffConflictsQuery = staffAssignmentSelect + `
+JOIN reservation_date nd ON nd.id IN (?)
+WHERE sa.user_id = ?
+AND d.approved IN ('pending', 'approved')
+AND r.approved IN ('pending', 'approved')
+AND d.local_start < nd.local_end
+AND d.local_end > nd.local_start
+ORDER BY d.local_start`
+
+const createStaffAssignmentQuery = `INSERT INTO staff_assignments (
+	reservation_date_id,
+	user_id,
+	role,
+	notes
+) VALUES (
+	:reservation_date_id,
+	:user_id,
+	:role,
+	:notes
+)
+RETURNING id`
+
+// CreateAssignments assigns the user to every date. When any date overlaps one
+// of the user's existing shifts nothing is saved and the clashing shifts are
+// returned instead.
+func (s *StaffStore) CreateAssignments(ctx context.Context, userID string, role models.StaffRole, notes sql.NullString, reservationDateIDs []int64) ([]int64, []models.StaffAssignment, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var locked string
+	if err := tx.GetContext(ctx, &locked, lockStaffUserQuery, userID); err != nil {
+		return nil, nil, err
+	}
+
+	query, args, err := sqlx.In(staffConflictsQuery, reservationDateIDs, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query = tx.Rebind(query)
+	var conflicts []models.StaffAssignment
+	if err := tx.SelectContext(ctx, &conflicts, query, args...); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, conflicts, nil
+	}
+
+	stmt, err := tx.PrepareNamedContext(ctx, createStaffAssignmentQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer stmt.Close()
+	ids := make([]int64, 0, len(reservationDateIDs))
+	for _, dateID := range reservationDateIDs {
+		params := map[string]any{
+			"reservation_date_id": dateID,
+			"user_id":             userID,
+			"role":                role,
+			"notes":               notes,
+		}
+		var id int64
+		if err := stmt.GetContext(ctx, &id, params); err != nil {
+			return nil, nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	return ids, nil, nil
+}
+
+const deleteStaffAssignmentQuery = `DELETE FROM staff_assignments WHERE id = $1`
+
+func (s *StaffStore) DeleteAssignment(ctx context.Context, id int64) error {
+	_, err := s.db.ExecContext(ctx, deleteStaffAssignmentQuery, id)
+	return err
+}
+
+// staffHoursQuery totals shift length per person and role. Shifts on denied or
+// canceled dates are not paid.
+const staffHoursQuery = `SELECT
+	sa.user_id,
+	u.name AS user_name,
+	sa.role,
+	COALESCE(SUM(EXTRACT(EPOCH FROM d.local_end - d.local_start)) / 3600, 0)::float8 AS hours,
+	COUNT(*) AS shifts
+FROM staff_assignments sa
+JOIN users u ON u.id = sa.user_id
+JOIN reservation_date d ON d.id = sa.reservation_date_id
+JOIN reservation r ON r.id = d.reservation_id
+JOIN facility f ON f.id = r.facility_id
+WHERE d.approved IN ('pending', 'approved')
+AND r.approved IN ('pending', 'approved')
+AND ($1::timestamp IS NULL OR d.local_start >= $1)
+AND ($2::timestamp IS NULL OR d.local_start < $2)
+AND ($3::bigint = 0 OR f.building_id = $3)
+GROUP BY sa.user_id, u.name, sa.role
+ORDER BY u.name, sa.role`
+
+func (s *StaffStore) GetHoursReport(ctx context.Context, from, to sql.NullTime, buildingID int64) ([]models.StaffHours, error) {
+	var rows []models.StaffHours
+	if err := s.db.SelectContext(ctx, &rows, staffHoursQuery, from, to, buildingID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []models.StaffHours{}, nil
+		}
+		return nil, err
+	}
+	return rows, nil
+}
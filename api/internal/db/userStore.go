@@ -352,3 +352,118 @@ func (s *UserStore) NotificationUsersByBuilding(ctx context.Context, buildingID
 	}
 	return emails, nil
 }
+
+const getBuildingAdminsQuery = `SELECT a.id, a.building_id, a.user_id, b.name AS building_name, u.name AS user_name
+FROM building_admins a
+JOIN building b ON a.building_id = b.id
+JOIN users u ON a.user_id = u.id
+ORDER BY b.name, u.name`
+
+func (s *UserStore) GetBuildingAdmins(ctx context.Context) ([]*models.BuildingAdmin, error) {
+	var admins []*models.BuildingAdmin
+	if err := s.db.SelectContext(ctx, &admins, getBuildingAdminsQuery); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*models.BuildingAdmin{}, nil
+		}
+		return nil, err
+	}
+	return admins, nil
+}
+
+const getAdminBuildingIDsQuery = `SELECT building_id FROM building_admins WHERE user_id = $1`
+
+// GetAdminBuildingIDs returns the buildings a user has been assigned to administer.
+func (s *UserStore) GetAdminBuildingIDs(ctx context.Context, userID string) ([]int64, error) {
+	var ids []int64
+	if err := s.db.SelectContext(ctx, &ids, getAdminBuildingIDsQuery, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []int64{}, nil
+		}
+		return nil, err
+	}
+	return ids, nil
+}
+
+const createBuildingAdminQuery = `INSERT INTO building_admins (
+	building_id,
+	user_id
+)
+VALUES (
+	:building_id,
+	:user_id
+)
+ON CONFLICT (building_id, user_id) DO NOTHING
+`
+
+// building admins also receive the building's request notifications
+const createAdminNotificationQuery = `INSERT INTO notifications (building_id, user_id)
+SELECT :building_id, :user_id
+WHERE NOT EXISTS (
+	SELECT 1 FROM notifications WHERE building_id = :building_id AND user_id = :user_id
+)`
+
+func (s *UserStore) CreateBuildingAdmin(ctx context.Context, admin *models.BuildingAdmin) error {
+	params := map[string]any{
+		"building_id": admin.BuildingID,
+		"user_id":     admin.UserID,
+	}
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := tx.NamedExecContext(ctx, createBuildingAdminQuery, params); err != nil {
+		return err
+	}
+	if _, err := tx.NamedExecContext(ctx, createAdminNotificationQuery, params); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+const deleteBuildingAdminQuery = `DELETE FROM building_admins WHERE id = $1`
+
+func (s *UserStore) DeleteBuildingAdmin(ctx context.Context, id int64) error {
+	_, err := s.db.ExecContext(ctx, deleteBuildingAdminQuery, id)
+	return err
+}
+
+const getCalendarFeedTokenQuery = `SELECT token FROM calendar_feed_tokens WHERE user_id = $1`
+
+// GetCalendarFeedToken returns the user's feed token, or "" if none was issued.
+func (s *UserStore) GetCalendarFeedToken(ctx context.Context, userID string) (string, error) {
+	var token string
+	if err := s.db.GetContext(ctx, &token, getCalendarFeedTokenQuery, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return token, nil
+}
+
+const setCalendarFeedTokenQuery = `INSERT INTO calendar_feed_tokens (user_id, token)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET token = EXCLUDED.token, created_at = CURRENT_TIMESTAMP`
+
+// SetCalendarFeedToken issues token to the user, revoking any earlier one.
+func (s *UserStore) SetCalendarFeedToken(ctx context.Context, userID, token string) error {
+	_, err := s.db.ExecContext(ctx, setCalendarFeedTokenQuery, userID, token)
+	return err
+}
+
+const getUserByCalendarFeedTokenQuery = `SELECT u.id, u.name, u.email, u.provider, u.role
+FROM calendar_feed_tokens t
+JOIN users u ON u.id = t.user_id
+WHERE t.token = $1`
+
+func (s *UserStore) GetByCalendarFeedToken(ctx context.Context, token string) (*models.Users, error) {
+	var user models.Users
+	if err := s.db.GetContext(ctx, &user, getUserByCalendarFeedTokenQuery, token); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
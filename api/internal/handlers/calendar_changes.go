@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"api/internal/lib/calendars"
+	"api/internal/models"
+	service "api/internal/proto/reservation"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// ListCalendarChanges lists edits made directly on facility calendars, for
+// the buildings the caller manages.
+func (a *ReservationHandler) ListCalendarChanges(ctx context.Context, req *connect.Request[service.ListCalendarChangesRequest]) (*connect.Response[service.ListCalendarChangesResponse], error) {
+	scope, err := callerScope(ctx, a.userStore)
+	if err != nil {
+		return nil, err
+	}
+	status := req.Msg.GetStatus()
+	if status != "" && !slices.Contains(models.AllCalendarChangeStatusValues(), models.CalendarChangeStatus(status)) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown status %q", status))
+	}
+	buildingID := req.Msg.GetBuildingId()
+	if buildingID != 0 && !scope.allows(buildingID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errNotAdmin)
+	}
+	changes, err := a.calendarSyncStore.GetCalendarChanges(ctx, status, buildingID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*service.CalendarChange, 0, len(changes))
+	for i := range changes {
+		if scope.allows(changes[i].BuildingID) {
+			out = append(out, changes[i].ToProto())
+		}
+	}
+	return connect.NewResponse(&service.ListCalendarChangesResponse{Changes: out}), nil
+}
+
+// ResolveCalendarChange applies a change under review to its reservation
+// date, or reverts the calendar event.
+func (a *ReservationHandler) ResolveCalendarChange(ctx context.Context, req *connect.Request[service.ResolveCalendarChangeRequest]) (*connect.Response[service.CalendarChange], error) {
+	caller, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change, err := a.calendarSyncStore.GetCalendarChange(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if change == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("calendar change %d not found", req.Msg.GetId()))
+	}
+	if err := requireBuilding(ctx, a.userStore, change.BuildingID); err != nil {
+		return nil, err
+	}
+	action := req.Msg.GetAction()
+	if action != calendars.ResolveApply && action != calendars.ResolveRevert {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown action %q, expected %s or %s", action, calendars.ResolveApply, calendars.ResolveRevert))
+	}
+	if change.Status != models.CalendarChangeStatusReview {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("calendar change %d is already %s", change.ID, change.Status))
+	}
+	if err := a.reservationSync.Resolve(ctx, change, action, caller.ID, req.Msg.GetNote()); err != nil {
+		return nil, err
+	}
+	a.log.InfoContext(ctx, "Resolved calendar change", "id", change.ID, "action", action, "user", caller.ID)
+	a.refreshEquipmentFees(ctx, change.ReservationID)
+
+	change, err = a.calendarSyncStore.GetCalendarChange(ctx, change.ID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(change.ToProto()), nil
+}
+
+// ReconcileCalendars diffs reservation dates against facility calendars and
+// reports missing events, orphan events and drifted series. With apply set
+// the calendars are repaired to match the database.
+func (a *ReservationHandler) ReconcileCalendars(ctx context.Context, req *connect.Request[service.ReconcileCalendarsRequest]) (*connect.Response[service.ReconcileCalendarsResponse], error) {
+	if a.reconciler == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("calendar reconciliation is not configured"))
+	}
+	var facilities []models.Facility
+	switch {
+	case req.Msg.GetFacilityId() != 0:
+		if err := requireFacility(ctx, a.userStore, a.facilityStore, req.Msg.GetFacilityId()); err != nil {
+			return nil, err
+		}
+		facility, err := a.facilityStore.Get(ctx, req.Msg.GetFacilityId())
+		if err != nil {
+			return nil, err
+		}
+		if facility == nil || facility.Facility == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("facility %d not found", req.Msg.GetFacilityId()))
+		}
+		facilities = append(facilities, *facility.Facility)
+	case req.Msg.GetBuildingId() != 0:
+		if err := requireBuilding(ctx, a.userStore, req.Msg.GetBuildingId()); err != nil {
+			return nil, err
+		}
+		building, err := a.facilityStore.GetByBuilding(ctx, req.Msg.GetBuildingId())
+		if err != nil {
+			return nil, err
+		}
+		if building == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("building %d not found", req.Msg.GetBuildingId()))
+		}
+		facilities = building.Facilities
+	default:
+		scope, err := callerScope(ctx, a.userStore)
+		if err != nil {
+			return nil, err
+		}
+		all, err := a.facilityStore.GetAllFacilities(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, facility := range all {
+			if scope.allows(facility.BuildingID) {
+				facilities = append(facilities, *facility)
+			}
+		}
+	}
+
+	reports, err := a.reconciler.ReconcileFacilities(ctx, facilities, req.Msg.GetApply())
+	if err != nil && len(reports) == 0 {
+		return nil, err
+	}
+	if err != nil {
+		a.log.ErrorContext(ctx, "Calendar reconciliation finished with errors", "error", err)
+	}
+
+	out := make([]*service.CalendarReconciliation, len(reports))
+	for i, rec := range reports {
+		out[i] = reconciliationToProto(rec)
+	}
+	return connect.NewResponse(&service.ReconcileCalendarsResponse{Calendars: out}), nil
+}
+
+func reconciliationToProto(rec *calendars.Reconciliation) *service.CalendarReconciliation {
+	discrepancies := make([]*service.CalendarDiscrepancy, len(rec.Discrepancies))
+	for i, d := range rec.Discrepancies {
+		discrepancies[i] = &service.CalendarDiscrepancy{
+			Kind:              d.Kind.String(),
+			FacilityId:        d.FacilityID,
+			ReservationId:     d.ReservationID,
+			ReservationDateId: d.ReservationDateID,
+			EventId:           d.EventID,
+			Summary:           d.Summary,
+			Start:             d.Start.Format(time.RFC3339),
+			End:               d.End.Format(time.RFC3339),
+			Detail:            d.Detail,
+			Repaired:          d.Repaired,
+		}
+	}
+	return &service.CalendarReconciliation{
+		FacilityId:    rec.FacilityID,
+		CalendarId:    rec.CalendarID,
+		Skipped:       rec.Skipped,
+		MissingEvents: int32(rec.Count(calendars.DiscrepancyMissingEvent)),
+		OrphanEvents:  int32(rec.Count(calendars.DiscrepancyOrphanEvent)),
+		SeriesDrift:   int32(rec.Count(calendars.DiscrepancySeriesDrift)),
+		Discrepancies: discrepancies,
+		Errors:        rec.Errors,
+	}
+}
@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"api/internal/config"
+	"api/internal/lib/emails"
+	"api/internal/lib/utils"
+	"api/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/stripe/stripe-go/v83"
+)
+
+// paymentMetadata is the Stripe metadata key saying what a checkout pays
+// for: the full cost, the deposit, or the balance left after the deposit.
+const paymentMetadata = "payment"
+
+const (
+	paymentFull    = "full"
+	paymentDeposit = "deposit"
+	paymentBalance = "balance"
+)
+
+// paymentSchedule is what a reservation costs, what has been paid, and the
+// deposit and balance due date its category sets.
+type paymentSchedule struct {
+	total   int64
+	paid    int64
+	deposit int64
+	// due is the day the balance is due; zero without active dates.
+	due time.Time
+}
+
+// schedule works out a reservation's payment schedule from its ledger.
+func (p *PaymentHandler) schedule(ctx context.Context, reservation *models.FullReservation, entries []models.PaymentEntry) (*paymentSchedule, error) {
+	total, err := reservationTotalCents(ctx, p.facilityStore, p.sc, reservation)
+	if err != nil {
+		return nil, err
+	}
+	category, err := p.facilityStore.GetCategory(ctx, reservation.Reservation.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, errors.New("category not found")
+	}
+	s := &paymentSchedule{
+		total:   total,
+		paid:    ledgerCents(entries),
+		deposit: depositCents(category, total),
+	}
+	if first, ok := firstActiveStart(reservation.Dates); ok {
+		s.due = balanceDueDay(first, category.BalanceDueDays)
+	}
+	return s, nil
+}
+
+func (s *paymentSchedule) balance() int64 {
+	return s.total - s.paid
+}
+
+// next is the payment to collect now and what it is for. The deposit is
+// offered until the balance comes due, unless nothing has been paid and the
+// requester chooses to pay in full; once anything is paid the rest is the
+// balance.
+func (s *paymentSchedule) next(payInFull bool, today time.Time) (string, int64) {
+	balance := s.balance()
+	switch {
+	case balance <= 0:
+		return "", 0
+	case s.paid > 0:
+		return paymentBalance, balance
+	case !payInFull && s.deposit > 0 && s.deposit < s.total && (s.due.IsZero() || today.Before(s.due)):
+		return paymentDeposit, s.deposit
+	}
+	return paymentFull, balance
+}
+
+// depositCents is the category's deposit on a total, never more than the
+// total itself.
+func depositCents(category *models.Category, total int64) int64 {
+	amount := utils.PGNumericToFloat64(category.DepositAmount)
+	var cents int64
+	switch category.DepositType {
+	case models.DepositTypePercent:
+		cents = int64(math.Round(float64(total) * amount / 100))
+	case models.DepositTypeFixed:
+		cents = int64(math.Round(amount * 100))
+	}
+	return max(0, min(cents, total))
+}
+
+// firstActiveStart is the wall-clock start of the earliest date that is
+// neither denied nor canceled.
+func firstActiveStart(dates []models.ReservationDate) (time.Time, bool) {
+	var first time.Time
+	for _, d := range dates {
+		if d.Approved == models.ReservationDateApprovedDenied || d.Approved == models.ReservationDateApprovedCanceled || !d.LocalStart.Valid {
+			continue
+		}
+		if first.IsZero() || d.LocalStart.Time.Before(first) {
+			first = d.LocalStart.Time
+		}
+	}
+	return first, !first.IsZero()
+}
+
+// balanceDueDay is the day, as a UTC date, the balance is due for a booking
+// first starting at the wall-clock time start.
+func balanceDueDay(start time.Time, days int32) time.Time {
+	y, m, d := start.Date()
+	return time.Date(y, m, d-int(days), 0, 0, 0, 0, time.UTC)
+}
+
+// localToday is the current day in the handler's timezone as a UTC date,
+// comparable with balanceDueDay.
+func localToday(loc *time.Location) time.Time {
+	y, m, d := time.Now().In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// partialLineItem charges a payment as a single checkout line, labelled with
+// what it pays for.
+func partialLineItem(kind, name string, cents int64) *stripe.CheckoutSessionCreateLineItemParams {
+	label := "Payment"
+	switch kind {
+	case paymentDeposit:
+		label = "Deposit"
+	case paymentBalance:
+		label = "Balance"
+	}
+	return &stripe.CheckoutSessionCreateLineItemParams{
+		PriceData: &stripe.CheckoutSessionCreateLineItemPriceDataParams{
+			Currency:    stripe.String(string(stripe.CurrencyUSD)),
+			UnitAmount:  stripe.Int64(cents),
+			ProductData: &stripe.CheckoutSessionCreateLineItemPriceDataProductDataParams{Name: stripe.String(fmt.Sprintf("%s: %s", label, name))},
+		},
+		Quantity: stripe.Int64(1),
+	}
+}
+
+// checkoutMetadata tags a checkout with its reservation and what it pays for.
+func checkoutMetadata(id int64, kind string) map[string]string {
+	metadata := reservationMetadataFor(id)
+	metadata[paymentMetadata] = kind
+	return metadata
+}
+
+// paidStatus is the payment status a successful payment with the given
+// metadata sets.
+func paidStatus(metadata map[string]string) models.PaymentStatus {
+	if metadata[paymentMetadata] == paymentDeposit {
+		return models.PaymentStatusDepositPaid
+	}
+	return models.PaymentStatusPaid
+}
+
+// CollectBalances goes through the approved reservations whose balance is
+// due within lead. Requesters are emailed once with a link to pay, and
+// reservations past their due date are flagged overdue for the building's
+// admins. It returns how many were reminded and newly flagged.
+func (p *PaymentHandler) CollectBalances(ctx context.Context, lead time.Duration) (int, int, error) {
+	today := localToday(p.timezone)
+	due, err := p.paymentStore.GetBalancesDue(ctx, today.Add(lead))
+	if err != nil {
+		return 0, 0, err
+	}
+	var reminded, overdue int
+	for _, b := range due {
+		dueDay := balanceDueDay(b.FirstStart.Time, b.BalanceDueDays)
+		if today.After(dueDay) && !b.PaymentOverdue {
+			if err := p.flagOverdue(ctx, b.ReservationID); err != nil {
+				p.log.Error("failed to flag overdue balance", "reservation_id", b.ReservationID, "error", err)
+			} else {
+				overdue++
+			}
+		}
+		if b.BalanceReminded.Valid {
+			continue
+		}
+		if err := p.remindBalance(ctx, b.ReservationID, dueDay); err != nil {
+			p.log.Error("failed to send balance reminder", "reservation_id", b.ReservationID, "error", err)
+			continue
+		}
+		reminded++
+	}
+	return reminded, overdue, nil
+}
+
+func (p *PaymentHandler) flagOverdue(ctx context.Context, id int64) error {
+	if err := p.paymentStore.SetPaymentOverdue(ctx, id, true); err != nil {
+		return err
+	}
+	res, err := p.reservationStore.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return errors.New("reservation not found")
+	}
+	return notifyPaymentAdmins(ctx, p.facilityStore, p.userStore, p.config, res, "overdue")
+}
+
+// remindBalance emails the requester what is left to pay. The link goes to
+// the reservation page rather than a checkout, which would expire before
+// the due date.
+func (p *PaymentHandler) remindBalance(ctx context.Context, id int64, dueDay time.Time) error {
+	res, err := p.reservationStore.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return errors.New("reservation not found")
+	}
+	entries, err := p.paymentStore.GetPaymentEntries(ctx, id)
+	if err != nil {
+		return err
+	}
+	s, err := p.schedule(ctx, res, entries)
+	if err != nil {
+		return err
+	}
+	if s.balance() <= 0 {
+		// settled since it was listed, such as by an offline payment
+		return p.paymentStore.MarkBalanceReminded(ctx, id)
+	}
+	user, err := p.userStore.Get(ctx, res.Reservation.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("requester not found")
+	}
+	emailData := &emails.EmailData{
+		To:       user.Email,
+		Template: "balanceDue.html",
+		Subject:  "Balance Due",
+		Data: map[string]any{
+			"Name":    res.Reservation.EventName,
+			"Amount":  models.CentsToString(s.balance()),
+			"DueDate": dueDay.Format("January 2, 2006"),
+			"URL":     fmt.Sprintf("%s/reservation/%d", p.config.FrontendUrl, id),
+		},
+	}
+	if p.config.AppEnv == config.PROD {
+		go emails.Send(emailData)
+	}
+	return p.paymentStore.MarkBalanceReminded(ctx, id)
+}
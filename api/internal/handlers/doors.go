@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"api/internal/lib/doors"
+	service "api/internal/proto/reservation"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// maxDoorScheduleDays keeps exports to a size access-control imports can handle.
+const maxDoorScheduleDays = 366
+
+func (a *ReservationHandler) ExportDoorSchedule(ctx context.Context, req *connect.Request[service.ExportDoorScheduleRequest]) (*connect.Response[service.ExportDoorScheduleResponse], error) {
+	buildingID := req.Msg.GetBuildingId()
+	if err := requireBuilding(ctx, a.userStore, buildingID); err != nil {
+		return nil, err
+	}
+	format := req.Msg.GetFormat()
+	if format == "" {
+		format = "csv"
+	}
+	exporter, ok := doors.Lookup(format)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown format %q, expected one of %s", format, strings.Join(doors.Formats(), ", ")))
+	}
+
+	y, m, d := time.Now().In(a.timezone).Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if req.Msg.GetStartDate() != "" {
+		t, err := time.Parse(time.DateOnly, req.Msg.GetStartDate())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		from = t
+	}
+	to := from.AddDate(0, 0, 7)
+	if req.Msg.GetEndDate() != "" {
+		t, err := time.Parse(time.DateOnly, req.Msg.GetEndDate())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		to = t.AddDate(0, 0, 1)
+	}
+	if !to.After(from) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("end date must not be before start date"))
+	}
+	if to.Sub(from) > maxDoorScheduleDays*24*time.Hour {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("date range is limited to %d days", maxDoorScheduleDays))
+	}
+
+	before := a.config.DoorBufferBefore
+	if req.Msg.BufferBeforeMinutes != nil {
+		before = time.Duration(req.Msg.GetBufferBeforeMinutes()) * time.Minute
+	}
+	after := a.config.DoorBufferAfter
+	if req.Msg.BufferAfterMinutes != nil {
+		after = time.Duration(req.Msg.GetBufferAfterMinutes()) * time.Minute
+	}
+	if before < 0 || after < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("buffers cannot be negative"))
+	}
+
+	building, err := a.facilityStore.GetBuilding(ctx, buildingID)
+	if err != nil {
+		return nil, err
+	}
+	if building == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("building %d not found", buildingID))
+	}
+	dates, err := a.reservationStore.GetDoorAccessDates(ctx, buildingID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	schedule := &doors.Schedule{
+		BuildingID:   buildingID,
+		BuildingName: building.Name,
+		From:         from,
+		To:           to,
+		Before:       before,
+		After:        after,
+		Windows:      doors.Build(dates, before, after),
+	}
+	var buf bytes.Buffer
+	if err := exporter.Export(&buf, schedule); err != nil {
+		a.log.Error("failed to export door schedule", "building", buildingID, "format", format, "err", err)
+		return nil, err
+	}
+	filename := fmt.Sprintf("door-schedule-%d-%s-%s.%s", buildingID, from.Format(time.DateOnly), to.AddDate(0, 0, -1).Format(time.DateOnly), exporter.Extension())
+	return connect.NewResponse(&service.ExportDoorScheduleResponse{
+		Filename:    filename,
+		ContentType: exporter.ContentType(),
+		Data:        buf.Bytes(),
+	}), nil
+}
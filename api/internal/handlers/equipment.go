@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"api/internal/lib/recur"
+	"api/internal/lib/utils"
+	"api/internal/models"
+	service "api/internal/proto/reservation"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+func (a *ReservationHandler) GetReservationEquipment(ctx context.Context, req *connect.Request[service.GetReservationEquipmentRequest]) (*connect.Response[service.GetReservationEquipmentResponse], error) {
+	res, err := a.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
+	}
+	if err := a.authorizeRequester(ctx, res.Reservation); err != nil {
+		return nil, err
+	}
+	items, err := a.reservationStore.GetReservationEquipment(ctx, res.Reservation.ID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.GetReservationEquipmentResponse{
+		Equipment: models.ReservationEquipmentToProto(items),
+	}), nil
+}
+
+func (a *ReservationHandler) SetReservationEquipment(ctx context.Context, req *connect.Request[service.SetReservationEquipmentRequest]) (*connect.Response[service.GetReservationEquipmentResponse], error) {
+	res, err := a.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
+	}
+	// requesters may change add-ons until the booking is reviewed
+	if res.Reservation.Approved == models.ReservationApprovedPending {
+		err = a.authorizeRequester(ctx, res.Reservation)
+	} else {
+		err = requireFacility(ctx, a.userStore, a.facilityStore, res.Reservation.FacilityID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := a.setEquipment(ctx, res, req.Msg.GetEquipment()); err != nil {
+		return nil, err
+	}
+	items, err := a.reservationStore.GetReservationEquipment(ctx, res.Reservation.ID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.GetReservationEquipmentResponse{
+		Equipment: models.ReservationEquipmentToProto(items),
+	}), nil
+}
+
+func (a *ReservationHandler) GetEquipmentAvailability(ctx context.Context, req *connect.Request[service.GetEquipmentAvailabilityRequest]) (*connect.Response[service.GetEquipmentAvailabilityResponse], error) {
+	equipment, err := a.facilityStore.GetEquipmentItem(ctx, req.Msg.GetEquipmentId())
+	if err != nil {
+		return nil, err
+	}
+	if equipment == nil || !equipment.Active {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("equipment %d not found", req.Msg.GetEquipmentId()))
+	}
+	starts := make([]time.Time, 0, len(req.Msg.GetOccurrences()))
+	ends := make([]time.Time, 0, len(req.Msg.GetOccurrences()))
+	for _, o := range req.Msg.GetOccurrences() {
+		start, startErr := recur.ParseLocal(o.Start, a.timezone)
+		end, endErr := recur.ParseLocal(o.End, a.timezone)
+		if startErr != nil || endErr != nil || !end.After(start) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid occurrence: %v", o))
+		}
+		starts = append(starts, start)
+		ends = append(ends, end)
+	}
+	booked, err := a.reservationStore.EquipmentBooked(ctx, equipment.ID, req.Msg.GetReservationId(), starts, ends)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.GetEquipmentAvailabilityResponse{
+		Available: max(equipment.Quantity-booked, 0),
+	}), nil
+}
+
+// setEquipment prices the requested add-ons against the reservation's dates and
+// replaces what is booked. Each item gets its own reservation_fees row.
+func (a *ReservationHandler) setEquipment(ctx context.Context, res *models.FullReservation, requests []*service.EquipmentRequest) error {
+	quantities := make(map[int64]int32, len(requests))
+	var order []int64
+	for _, r := range requests {
+		if r.GetQuantity() <= 0 {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("quantity for equipment %d must be positive", r.GetEquipmentId()))
+		}
+		if _, ok := quantities[r.GetEquipmentId()]; !ok {
+			order = append(order, r.GetEquipmentId())
+		}
+		quantities[r.GetEquipmentId()] += r.GetQuantity()
+	}
+
+	var items []models.ReservationEquipment
+	if len(order) > 0 {
+		facility, err := a.facilityStore.Get(ctx, res.Reservation.FacilityID)
+		if err != nil {
+			return err
+		}
+		if facility == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("facility %d not found", res.Reservation.FacilityID))
+		}
+		hours, err := billableHours(res.Dates)
+		if err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		for _, id := range order {
+			equipment, err := a.facilityStore.GetEquipmentItem(ctx, id)
+			if err != nil {
+				return err
+			}
+			if equipment == nil || !equipment.Active || equipment.BuildingID != facility.Facility.BuildingID {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("equipment %d is not available at this building", id))
+			}
+			item := models.ReservationEquipment{
+				ReservationID: res.Reservation.ID,
+				EquipmentID:   id,
+				Quantity:      quantities[id],
+				Name:          equipment.Name,
+			}
+			if charge := equipment.Charge(item.Quantity, hours); charge > 0 {
+				label := fmt.Sprintf("%s x%d", equipment.Name, item.Quantity)
+				if equipment.FeeUnit == models.EquipmentFeeUnitHour {
+					label = fmt.Sprintf("%s (%d hrs)", label, hours)
+				}
+				item.Fee = &models.ReservationFee{
+					AdditionalFees: utils.StringToPgNumeric(fmt.Sprintf("%.2f", charge)),
+					FeesType:       sql.NullString{String: label, Valid: true},
+					ReservationID:  res.Reservation.ID,
+				}
+			}
+			items = append(items, item)
+		}
+	}
+
+	if err := a.reservationStore.SetReservationEquipment(ctx, res.Reservation.ID, items); err != nil {
+		if errors.Is(err, models.ErrEquipmentUnavailable) {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// refreshEquipmentFees re-prices booked add-ons after the reservation's dates
+// change. Failures are logged so the date change itself still goes through.
+func (a *ReservationHandler) refreshEquipmentFees(ctx context.Context, reservationID int64) {
+	items, err := a.reservationStore.GetReservationEquipment(ctx, reservationID)
+	if err != nil || len(items) == 0 {
+		if err != nil {
+			a.log.Error("failed to get reservation equipment", "id", reservationID, "err", err)
+		}
+		return
+	}
+	res, err := a.reservationStore.Get(ctx, reservationID)
+	if err != nil || res == nil {
+		a.log.Error("failed to get reservation", "id", reservationID, "err", err)
+		return
+	}
+	requests := make([]*service.EquipmentRequest, len(items))
+	for i, item := range items {
+		requests[i] = &service.EquipmentRequest{EquipmentId: item.EquipmentID, Quantity: item.Quantity}
+	}
+	if err := a.setEquipment(ctx, res, requests); err != nil {
+		a.log.Warn("failed to refresh equipment fees", "id", reservationID, "err", err)
+	}
+}
+
+// billableHours totals the live dates in whole hours, with a one hour minimum.
+func billableHours(dates []models.ReservationDate) (int64, error) {
+	var total time.Duration
+	for _, date := range dates {
+		if date.Approved == models.ReservationDateApprovedDenied || date.Approved == models.ReservationDateApprovedCanceled {
+			continue
+		}
+		start := date.LocalStart.Time
+		end := date.LocalEnd.Time
+		if end.Before(start) {
+			return 0, fmt.Errorf("end before start for date id %d", date.ID)
+		}
+		total += end.Sub(start)
+	}
+	return max(int64(total/time.Hour), 1), nil
+}
@@ -1,9 +1,17 @@
 package handlers
 
 import (
+	"api/internal/lib/calendars"
+	"api/internal/lib/forms"
+	"api/internal/lib/utils"
 	"api/internal/models"
+	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"api/internal/ports"
 	service "api/internal/proto/facilities"
@@ -18,15 +26,19 @@ import (
 
 type FacilityHandler struct {
 	log           *slog.Logger
-	calendar      *calendar.Calendar
+	calendar      ports.CalendarProvider
 	facilityStore ports.FacilityStore
+	userStore     ports.UserStore
 	cache         *cache.Cache
 	sc            *stripe.Client
+	calendarSync  *calendars.BuildingSync
+	timezone      *time.Location
+	reservations  ports.ReservationStore
 }
 
-func NewFacilityHandler(facilityStore ports.FacilityStore, log *slog.Logger, calendar *calendar.Calendar, cache *cache.Cache, sc *stripe.Client) *FacilityHandler {
+func NewFacilityHandler(facilityStore ports.FacilityStore, userStore ports.UserStore, log *slog.Logger, calendar ports.CalendarProvider, cache *cache.Cache, sc *stripe.Client, calendarSync *calendars.BuildingSync, timezone *time.Location, reservations ports.ReservationStore) *FacilityHandler {
 	log.With(slog.Group("Core_Handler", slog.String("name", "facility")))
-	return &FacilityHandler{facilityStore: facilityStore, log: log, calendar: calendar, cache: cache, sc: sc}
+	return &FacilityHandler{facilityStore: facilityStore, userStore: userStore, log: log, calendar: calendar, cache: cache, sc: sc, calendarSync: calendarSync, timezone: timezone, reservations: reservations}
 }
 
 func (a *FacilityHandler) GetAllFacilities(ctx context.Context, req *connect.Request[service.GetAllFacilitiesRequest]) (*connect.Response[service.GetAllFacilitiesResponse], error) {
@@ -126,6 +138,9 @@ func (a *FacilityHandler) GetBuildingFacilities(ctx context.Context, req *connec
 }
 func (a *FacilityHandler) CreateFacility(ctx context.Context, req *connect.Request[service.CreateFacilityRequest]) (*connect.Response[service.CreateFacilityResponse], error) {
 	facility := models.ToFacility(req.Msg.GetFacility())
+	if err := requireBuilding(ctx, a.userStore, facility.BuildingID); err != nil {
+		return nil, err
+	}
 
 	err := a.facilityStore.Create(ctx, facility)
 
@@ -137,7 +152,15 @@ func (a *FacilityHandler) CreateFacility(ctx context.Context, req *connect.Reque
 
 }
 func (a *FacilityHandler) UpdateFacility(ctx context.Context, req *connect.Request[service.UpdateFacilityRequest]) (*connect.Response[service.UpdateFacilityResponse], error) {
-	err := a.facilityStore.Update(ctx, models.ToFacility(req.Msg.GetFacility()))
+	facility := models.ToFacility(req.Msg.GetFacility())
+	// both the current and the target building must be in scope
+	if err := requireFacility(ctx, a.userStore, a.facilityStore, facility.ID); err != nil {
+		return nil, err
+	}
+	if err := requireBuilding(ctx, a.userStore, facility.BuildingID); err != nil {
+		return nil, err
+	}
+	err := a.facilityStore.Update(ctx, facility)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +171,9 @@ func (a *FacilityHandler) UpdateFacility(ctx context.Context, req *connect.Reque
 
 }
 func (a *FacilityHandler) DeleteFacility(ctx context.Context, req *connect.Request[service.DeleteFacilityRequest]) (*connect.Response[service.DeleteFacilityResponse], error) {
+	if err := requireFacility(ctx, a.userStore, a.facilityStore, req.Msg.GetId()); err != nil {
+		return nil, err
+	}
 	err := a.facilityStore.Delete(ctx, req.Msg.GetId())
 	if err != nil {
 		return nil, err
@@ -157,7 +183,14 @@ func (a *FacilityHandler) DeleteFacility(ctx context.Context, req *connect.Reque
 }
 
 func (a *FacilityHandler) UpdateFacilityCategory(ctx context.Context, req *connect.Request[service.UpdateFacilityCategoryRequest]) (*connect.Response[service.Category], error) {
+	// categories are shared by every building
+	if err := requireSiteAdmin(ctx, a.userStore); err != nil {
+		return nil, err
+	}
 	category := models.ToCategory(req.Msg.GetCategory())
+	if err := validateDeposit(&category); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	err := a.facilityStore.EditCategory(ctx, &category)
 	if err != nil {
 		return nil, err
@@ -165,8 +198,29 @@ func (a *FacilityHandler) UpdateFacilityCategory(ctx context.Context, req *conne
 	return connect.NewResponse(&service.Category{}), nil
 }
 
+// validateDeposit checks a category's deposit terms. A percentage is of the
+// reservation total; a fixed deposit is in dollars.
+func validateDeposit(category *models.Category) error {
+	if !slices.Contains(models.AllDepositTypeValues(), category.DepositType) {
+		return fmt.Errorf("invalid deposit type %q", category.DepositType)
+	}
+	amount := utils.PGNumericToFloat64(category.DepositAmount)
+	if amount < 0 || (category.DepositType == models.DepositTypePercent && amount > 100) {
+		return fmt.Errorf("invalid deposit amount %s", utils.PgNumericToString(category.DepositAmount))
+	}
+	if category.BalanceDueDays < 0 {
+		return fmt.Errorf("invalid balance due days %d", category.BalanceDueDays)
+	}
+	return nil
+}
+
 func (a *FacilityHandler) GetAllEvents(ctx context.Context, req *connect.Request[service.GetAllEventsRequest]) (*connect.Response[service.GetAllEventsResponse], error) {
-	cached, ok := a.cache.Get("events")
+	from, to, err := eventWindow(req.Msg.GetStart(), req.Msg.GetEnd(), a.timezone)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("events-%d-%d", from.Unix(), to.Unix())
+	cached, ok := a.cache.Get(key)
 	if ok {
 		return connect.NewResponse(cached.(*service.GetAllEventsResponse)), nil
 	}
@@ -175,46 +229,41 @@ func (a *FacilityHandler) GetAllEvents(ctx context.Context, req *connect.Request
 		return nil, err
 	}
 
-	result := make([]*service.BuildingWithEvents, len(buildings))
-	for i, building := range buildings {
+	result := make([]*service.BuildingWithEvents, 0, len(buildings))
+	for _, building := range buildings {
 		if !building.GoogleCalendarID.Valid {
 			continue
 		}
 		calId := building.GoogleCalendarID.String
 
 		a.log.Debug("GetAllEvents", "calId", calId)
-		res, err := a.calendar.ListEvents(calId)
+		res, err := a.calendar.ListEventsBetween(ctx, calId, from, to)
+		if err == nil {
+			res, err = a.publicEvents(ctx, res)
+		}
 		if err != nil {
-
+			a.log.Error("Failed to list building events", "building_id", building.ID, "error", err)
 			continue
 		}
-		events := make([]*service.Event, len(res.Items))
-		for i, event := range res.Items {
-			events[i] = &service.Event{
-				Summary:     event.Summary,
-				Start:       event.Start.DateTime,
-				End:         event.End.DateTime,
-				Location:    event.Location,
-				Description: event.Description,
-				Title:       event.Summary,
-			}
-			a.log.Debug("GetAllEvents", "event", event)
-		}
-		result[i] = &service.BuildingWithEvents{
+		result = append(result, &service.BuildingWithEvents{
 			Building: building.ToProto(),
-			Events:   events,
-		}
+			Events:   eventsToProto(res),
+		})
 	}
-	a.cache.Set("events", &service.GetAllEventsResponse{
-		Data: result,
-	}, cache.DefaultExpiration)
-	return connect.NewResponse(&service.GetAllEventsResponse{
+	out := &service.GetAllEventsResponse{
 		Data: result,
-	}), nil
+	}
+	a.cache.Set(key, out, cache.DefaultExpiration)
+	return connect.NewResponse(out), nil
 }
 
 func (a *FacilityHandler) GetEventsByFacility(ctx context.Context, req *connect.Request[service.GetEventsByFacilityRequest]) (*connect.Response[service.GetEventsByFacilityResponse], error) {
-	cached, ok := a.cache.Get(fmt.Sprintf("events-%d", req.Msg.GetId()))
+	from, to, err := eventWindow(req.Msg.GetStart(), req.Msg.GetEnd(), a.timezone)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("facility-events-%d-%d-%d", req.Msg.GetId(), from.Unix(), to.Unix())
+	cached, ok := a.cache.Get(key)
 	if ok {
 		return connect.NewResponse(cached.(*service.GetEventsByFacilityResponse)), nil
 	}
@@ -222,37 +271,32 @@ func (a *FacilityHandler) GetEventsByFacility(ctx context.Context, req *connect.
 	if err != nil {
 		return nil, err
 	}
+	if fac == nil || fac.Facility == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("facility %d not found", req.Msg.GetId()))
+	}
 
-	res, err := a.calendar.ListEvents(fac.Facility.GoogleCalendarID)
+	res, err := a.calendar.ListEventsBetween(ctx, fac.Facility.GoogleCalendarID, from, to)
 	if err != nil {
 		return nil, err
 	}
-	if res == nil || len(res.Items) == 0 {
-		return connect.NewResponse(&service.GetEventsByFacilityResponse{
-			Events: []*service.Event{},
-		}), nil
+	res, err = a.publicEvents(ctx, res)
+	if err != nil {
+		return nil, err
 	}
-	events := make([]*service.Event, len(res.Items))
-	for i, event := range res.Items {
-		events[i] = &service.Event{
-			Summary:     event.Summary,
-			Start:       event.Start.DateTime,
-			End:         event.End.DateTime,
-			Location:    event.Location,
-			Description: event.Description,
-			Title:       event.Summary,
-		}
+	out := &service.GetEventsByFacilityResponse{
+		Events: eventsToProto(res),
 	}
-	a.cache.Set(fmt.Sprintf("events-%d", req.Msg.GetId()), &service.GetEventsByFacilityResponse{
-		Events: events,
-	}, cache.DefaultExpiration)
-	return connect.NewResponse(&service.GetEventsByFacilityResponse{
-		Events: events,
-	}), nil
+	a.cache.Set(key, out, cache.DefaultExpiration)
+	return connect.NewResponse(out), nil
 }
 
 func (a *FacilityHandler) GetEventsByBuilding(ctx context.Context, req *connect.Request[service.GetEventsByBuildingRequest]) (*connect.Response[service.GetEventsByBuildingResponse], error) {
-	cached, ok := a.cache.Get(fmt.Sprintf("events-%d", req.Msg.GetId()))
+	from, to, err := eventWindow(req.Msg.GetStart(), req.Msg.GetEnd(), a.timezone)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("building-events-%d-%d-%d", req.Msg.GetId(), from.Unix(), to.Unix())
+	cached, ok := a.cache.Get(key)
 	if ok {
 		return connect.NewResponse(cached.(*service.GetEventsByBuildingResponse)), nil
 	}
@@ -260,6 +304,9 @@ func (a *FacilityHandler) GetEventsByBuilding(ctx context.Context, req *connect.
 	if err != nil {
 		return nil, err
 	}
+	if building == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("building %d not found", req.Msg.GetId()))
+	}
 
 	calendarID := building.GoogleCalendarID
 	if !calendarID.Valid {
@@ -267,27 +314,126 @@ func (a *FacilityHandler) GetEventsByBuilding(ctx context.Context, req *connect.
 			Events: []*service.Event{},
 		}), nil
 	}
-	res, err := a.calendar.ListEvents(calendarID.String)
+	res, err := a.calendar.ListEventsBetween(ctx, calendarID.String, from, to)
 	if err != nil {
 		return nil, err
 	}
-	events := make([]*service.Event, len(res.Items))
-	for i, event := range res.Items {
-		events[i] = &service.Event{
+	res, err = a.publicEvents(ctx, res)
+	if err != nil {
+		return nil, err
+	}
+	out := &service.GetEventsByBuildingResponse{
+		Events: eventsToProto(res),
+	}
+	a.cache.Set(key, out, cache.DefaultExpiration)
+	return connect.NewResponse(out), nil
+}
+
+// publicEvents keeps what each reservation's visibility hides out of the
+// listing. Events are published that way already; this also covers events
+// published before a visibility change and copies on building calendars.
+func (a *FacilityHandler) publicEvents(ctx context.Context, events []calendar.Event) ([]calendar.Event, error) {
+	ids := make([]string, len(events))
+	for i, ev := range events {
+		ids[i] = ev.ID
+	}
+	owners, err := a.reservations.GetEventReservations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byEvent := make(map[string]models.EventReservation, len(owners))
+	for _, o := range owners {
+		byEvent[o.EventID] = o
+	}
+	for i := range events {
+		o, ok := byEvent[events[i].ID]
+		if !ok {
+			continue
+		}
+		ev := &events[i]
+		switch o.Visibility {
+		case models.EventVisibilityPrivate:
+			summary := calendars.PrivateSummary
+			if strings.HasPrefix(ev.Summary, calendar.PendingPrefix) {
+				summary = calendar.PendingPrefix + summary
+			}
+			ev.Summary, ev.Description = summary, ""
+			continue
+		case models.EventVisibilityHideDetails:
+			ev.Description = ""
+		}
+		if o.PublicTitle.String != "" && o.EventName != "" {
+			ev.Summary = strings.ReplaceAll(ev.Summary, o.EventName, o.PublicTitle.String)
+		}
+	}
+	return events, nil
+}
+
+// maxEventWindow bounds how much of a calendar one listing may cover.
+const maxEventWindow = 366 * 24 * time.Hour
+
+// eventWindow parses optional RFC 3339 or YYYY-MM-DD bounds. An end date is
+// inclusive. Missing bounds fall back to the usual listing window, counted
+// from the start of today so cached listings are reused through the day.
+func eventWindow(start, end string, loc *time.Location) (time.Time, time.Time, error) {
+	now := time.Now().In(loc)
+	from, to := calendar.ListWindow(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc))
+	if start != "" {
+		t, err := parseEventBound(start, loc, false)
+		if err != nil {
+			return from, to, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid start: %w", err))
+		}
+		from = t
+	}
+	if end != "" {
+		t, err := parseEventBound(end, loc, true)
+		if err != nil {
+			return from, to, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid end: %w", err))
+		}
+		to = t
+	}
+	if !to.After(from) {
+		return from, to, connect.NewError(connect.CodeInvalidArgument, errors.New("end must be after start"))
+	}
+	if to.Sub(from) > maxEventWindow {
+		return from, to, connect.NewError(connect.CodeInvalidArgument, errors.New("time range cannot be longer than a year"))
+	}
+	return from, to, nil
+}
+
+func parseEventBound(s string, loc *time.Location, end bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, s, loc)
+	if err != nil {
+		return t, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
+func eventsToProto(events []calendar.Event) []*service.Event {
+	out := make([]*service.Event, len(events))
+	for i, event := range events {
+		layout := time.RFC3339
+		if event.AllDay {
+			layout = time.DateOnly
+		}
+		out[i] = &service.Event{
+			Id:          event.ID,
 			Summary:     event.Summary,
-			Start:       event.Start.DateTime,
-			End:         event.End.DateTime,
+			Start:       event.Start.Format(layout),
+			End:         event.End.Format(layout),
+			AllDay:      event.AllDay,
 			Location:    event.Location,
 			Description: event.Description,
 			Title:       event.Summary,
 		}
 	}
-	a.cache.Set(fmt.Sprintf("events-%d", req.Msg.GetId()), &service.GetEventsByBuildingResponse{
-		Events: events,
-	}, cache.DefaultExpiration)
-	return connect.NewResponse(&service.GetEventsByBuildingResponse{
-		Events: events,
-	}), nil
+	return out
 }
 
 func (a *FacilityHandler) GetCategory(ctx context.Context, req *connect.Request[service.GetCategoryRequest]) (*connect.Response[service.Category], error) {
@@ -405,3 +551,231 @@ func (a *FacilityHandler) GetPricing(ctx context.Context, req *connect.Request[s
 
 	return connect.NewResponse(result.ToProto()), nil
 }
+
+func (a *FacilityHandler) GetFormFields(ctx context.Context, req *connect.Request[service.GetFormFieldsRequest]) (*connect.Response[service.GetFormFieldsResponse], error) {
+	fields, err := a.facilityStore.GetFormFields(ctx, req.Msg.GetFacilityId(), req.Msg.GetCategoryId())
+	if err != nil {
+		a.log.Error("error getting form fields", "error", err)
+		return nil, err
+	}
+	return connect.NewResponse(&service.GetFormFieldsResponse{
+		Fields: models.FormFieldsToProto(forms.Merge(fields)),
+	}), nil
+}
+
+func (a *FacilityHandler) CreateFormField(ctx context.Context, req *connect.Request[service.CreateFormFieldRequest]) (*connect.Response[service.FormField], error) {
+	field := models.ToFormField(req.Msg.GetField())
+	if err := forms.CheckDefinition(field); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := a.requireFormField(ctx, field); err != nil {
+		return nil, err
+	}
+	id, err := a.facilityStore.CreateFormField(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+	field.ID = id
+	return connect.NewResponse(field.ToProto()), nil
+}
+
+func (a *FacilityHandler) UpdateFormField(ctx context.Context, req *connect.Request[service.UpdateFormFieldRequest]) (*connect.Response[service.FormField], error) {
+	field := models.ToFormField(req.Msg.GetField())
+	existing, err := a.facilityStore.GetFormField(ctx, field.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("form field %d not found", field.ID))
+	}
+	if err := forms.CheckDefinition(field); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	// the caller must be allowed to manage both the old and the new owner
+	if err := a.requireFormField(ctx, existing); err != nil {
+		return nil, err
+	}
+	if err := a.requireFormField(ctx, field); err != nil {
+		return nil, err
+	}
+	if err := a.facilityStore.UpdateFormField(ctx, field); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(field.ToProto()), nil
+}
+
+func (a *FacilityHandler) DeleteFormField(ctx context.Context, req *connect.Request[service.DeleteFormFieldRequest]) (*connect.Response[service.DeleteFormFieldResponse], error) {
+	existing, err := a.facilityStore.GetFormField(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("form field %d not found", req.Msg.GetId()))
+	}
+	if err := a.requireFormField(ctx, existing); err != nil {
+		return nil, err
+	}
+	if err := a.facilityStore.DeleteFormField(ctx, existing.ID); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.DeleteFormFieldResponse{}), nil
+}
+
+// requireFormField lets building admins manage fields on their own facilities.
+// Category-wide fields apply to every building, so only site admins may touch them.
+func (a *FacilityHandler) requireFormField(ctx context.Context, field *models.FormField) error {
+	if field.FacilityID.Valid {
+		return requireFacility(ctx, a.userStore, a.facilityStore, field.FacilityID.Int64)
+	}
+	return requireSiteAdmin(ctx, a.userStore)
+}
+
+func (a *FacilityHandler) GetEquipment(ctx context.Context, req *connect.Request[service.GetEquipmentRequest]) (*connect.Response[service.GetEquipmentResponse], error) {
+	includeInactive := req.Msg.GetIncludeInactive()
+	if includeInactive {
+		// retired items are only listed for the building's admins
+		if err := requireBuilding(ctx, a.userStore, req.Msg.GetBuildingId()); err != nil {
+			return nil, err
+		}
+	}
+	equipment, err := a.facilityStore.GetEquipment(ctx, req.Msg.GetBuildingId(), includeInactive)
+	if err != nil {
+		a.log.Error("error getting equipment", "error", err)
+		return nil, err
+	}
+	return connect.NewResponse(&service.GetEquipmentResponse{
+		Equipment: models.EquipmentToProto(equipment),
+	}), nil
+}
+
+func (a *FacilityHandler) CreateEquipment(ctx context.Context, req *connect.Request[service.CreateEquipmentRequest]) (*connect.Response[service.Equipment], error) {
+	equipment := models.ToEquipment(req.Msg.GetEquipment())
+	if err := checkEquipment(equipment); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := requireBuilding(ctx, a.userStore, equipment.BuildingID); err != nil {
+		return nil, err
+	}
+	id, err := a.facilityStore.CreateEquipment(ctx, equipment)
+	if err != nil {
+		return nil, err
+	}
+	equipment.ID = id
+	return connect.NewResponse(equipment.ToProto()), nil
+}
+
+func (a *FacilityHandler) UpdateEquipment(ctx context.Context, req *connect.Request[service.UpdateEquipmentRequest]) (*connect.Response[service.Equipment], error) {
+	equipment := models.ToEquipment(req.Msg.GetEquipment())
+	existing, err := a.facilityStore.GetEquipmentItem(ctx, equipment.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("equipment %d not found", equipment.ID))
+	}
+	if err := checkEquipment(equipment); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := requireBuilding(ctx, a.userStore, existing.BuildingID); err != nil {
+		return nil, err
+	}
+	if equipment.BuildingID != existing.BuildingID {
+		if err := requireBuilding(ctx, a.userStore, equipment.BuildingID); err != nil {
+			return nil, err
+		}
+	}
+	if err := a.facilityStore.UpdateEquipment(ctx, equipment); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(equipment.ToProto()), nil
+}
+
+func (a *FacilityHandler) DeleteEquipment(ctx context.Context, req *connect.Request[service.DeleteEquipmentRequest]) (*connect.Response[service.DeleteEquipmentResponse], error) {
+	existing, err := a.facilityStore.GetEquipmentItem(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("equipment %d not found", req.Msg.GetId()))
+	}
+	if err := requireBuilding(ctx, a.userStore, existing.BuildingID); err != nil {
+		return nil, err
+	}
+	if err := a.facilityStore.DeleteEquipment(ctx, existing.ID); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.DeleteEquipmentResponse{}), nil
+}
+
+func (a *FacilityHandler) SetBuildingCalendarProvider(ctx context.Context, req *connect.Request[service.SetBuildingCalendarProviderRequest]) (*connect.Response[service.Building], error) {
+	if err := requireSiteAdmin(ctx, a.userStore); err != nil {
+		return nil, err
+	}
+	provider := strings.ToLower(strings.TrimSpace(req.Msg.GetCalendarProvider()))
+	if provider != "" && !slices.Contains(calendar.ProviderNames(), provider) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown calendar provider %q", provider))
+	}
+	building, err := a.facilityStore.GetBuilding(ctx, req.Msg.GetBuildingId())
+	if err != nil {
+		return nil, err
+	}
+	if building == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("building %d not found", req.Msg.GetBuildingId()))
+	}
+	building.CalendarProvider = sql.NullString{String: provider, Valid: provider != ""}
+	if err := a.facilityStore.SetBuildingCalendarProvider(ctx, building.ID, building.CalendarProvider); err != nil {
+		return nil, err
+	}
+	a.cache.Delete("facilities")
+	return connect.NewResponse(building.ToProto()), nil
+}
+
+// ResyncBuildingCalendar runs the building calendar sync now. Full and
+// rebuild recover a building calendar that drifted from its facilities.
+func (a *FacilityHandler) ResyncBuildingCalendar(ctx context.Context, req *connect.Request[service.ResyncBuildingCalendarRequest]) (*connect.Response[service.ResyncBuildingCalendarResponse], error) {
+	if err := requireBuilding(ctx, a.userStore, req.Msg.GetBuildingId()); err != nil {
+		return nil, err
+	}
+	if a.calendarSync == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("calendar sync is not configured"))
+	}
+	mode := calendars.SyncIncremental
+	switch {
+	case req.Msg.GetRebuild():
+		mode = calendars.SyncRebuild
+	case req.Msg.GetFull():
+		mode = calendars.SyncFull
+	}
+	res, err := a.calendarSync.SyncBuilding(ctx, req.Msg.GetBuildingId(), mode)
+	if errors.Is(err, calendars.ErrNoBuildingCalendar) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if res == nil {
+		return nil, err
+	}
+	if err != nil {
+		a.log.ErrorContext(ctx, "Building calendar resync finished with errors", "building_id", req.Msg.GetBuildingId(), "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("sync stopped after %d inserts, %d updates and %d deletes: %w", res.Inserted, res.Updated, res.Deleted, err))
+	}
+	return connect.NewResponse(&service.ResyncBuildingCalendarResponse{
+		Inserted: int32(res.Inserted),
+		Updated:  int32(res.Updated),
+		Deleted:  int32(res.Deleted),
+	}), nil
+}
+
+func checkEquipment(e *models.Equipment) error {
+	if e.Name == "" || e.BuildingID == 0 {
+		return fmt.Errorf("name and building are required")
+	}
+	if e.Quantity < 0 {
+		return fmt.Errorf("quantity cannot be negative")
+	}
+	if !slices.Contains(models.AllEquipmentFeeUnitValues(), e.FeeUnit) {
+		return fmt.Errorf("unknown fee unit %q", e.FeeUnit)
+	}
+	if utils.PGNumericToFloat64(e.Fee) < 0 {
+		return fmt.Errorf("fee cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"api/internal/config"
+	"api/internal/lib/feeds"
+	"api/internal/models"
+	"api/internal/ports"
+	"api/pkg/calendar"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// feeds reach this far back so recently finished events stay visible
+const feedHistory = 90 * 24 * time.Hour
+
+// FeedHandler serves iCalendar subscription feeds. Building and facility
+// feeds are public; adding ?token= with a building admin's feed token adds
+// private details. The per-user feed is addressed by its token alone.
+type FeedHandler struct {
+	reservationStore ports.ReservationStore
+	facilityStore    ports.FacilityStore
+	userStore        ports.UserStore
+	config           *config.Config
+	log              *slog.Logger
+}
+
+func NewFeedHandler(reservationStore ports.ReservationStore, facilityStore ports.FacilityStore, userStore ports.UserStore, config *config.Config, log *slog.Logger) *FeedHandler {
+	return &FeedHandler{reservationStore: reservationStore, facilityStore: facilityStore, userStore: userStore, config: config, log: log}
+}
+
+func (a *FeedHandler) FacilityFeed(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid facility id", http.StatusBadRequest)
+		return
+	}
+	facility, err := a.facilityStore.Get(r.Context(), id)
+	if err != nil {
+		a.log.Error("Failed to get facility", "err", err)
+		http.Error(w, "failed to get facility", http.StatusInternalServerError)
+		return
+	}
+	if facility == nil || facility.Facility == nil {
+		http.NotFound(w, r)
+		return
+	}
+	private, ok := a.tokenAccess(w, r, facility.Facility.BuildingID)
+	if !ok {
+		return
+	}
+	name := facility.Facility.Name
+	if facility.Building != nil {
+		name = fmt.Sprintf("%s, %s", facility.Facility.Name, facility.Building.Name)
+	}
+	a.serve(w, r, name, models.FeedFilter{FacilityID: id}, private)
+}
+
+func (a *FeedHandler) BuildingFeed(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid building id", http.StatusBadRequest)
+		return
+	}
+	building, err := a.facilityStore.GetBuilding(r.Context(), id)
+	if err != nil {
+		a.log.Error("Failed to get building", "err", err)
+		http.Error(w, "failed to get building", http.StatusInternalServerError)
+		return
+	}
+	if building == nil {
+		http.NotFound(w, r)
+		return
+	}
+	private, ok := a.tokenAccess(w, r, id)
+	if !ok {
+		return
+	}
+	a.serve(w, r, building.Name, models.FeedFilter{BuildingID: id}, private)
+}
+
+// UserFeed lists the token owner's own reservations, pending ones included.
+func (a *FeedHandler) UserFeed(w http.ResponseWriter, r *http.Request) {
+	user, err := a.userStore.GetByCalendarFeedToken(r.Context(), r.PathValue("token"))
+	if err != nil {
+		a.log.Error("Failed to get feed token", "err", err)
+		http.Error(w, "failed to get feed", http.StatusInternalServerError)
+		return
+	}
+	if user == nil {
+		http.NotFound(w, r)
+		return
+	}
+	a.serve(w, r, "My reservations", models.FeedFilter{UserID: user.ID, IncludePending: true}, true)
+}
+
+// tokenAccess checks the optional ?token= of a building or facility feed. It
+// reports whether private details may be shown, and false for ok once it has
+// written an error.
+func (a *FeedHandler) tokenAccess(w http.ResponseWriter, r *http.Request, buildingID int64) (private bool, ok bool) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		return false, true
+	}
+	allowed, err := a.canViewPrivate(r.Context(), token, buildingID)
+	if err != nil {
+		a.log.Error("Failed to check feed token", "err", err)
+		http.Error(w, "failed to check feed token", http.StatusInternalServerError)
+		return false, false
+	}
+	if !allowed {
+		http.Error(w, "invalid feed token", http.StatusForbidden)
+		return false, false
+	}
+	return true, true
+}
+
+func (a *FeedHandler) canViewPrivate(ctx context.Context, token string, buildingID int64) (bool, error) {
+	user, err := a.userStore.GetByCalendarFeedToken(ctx, token)
+	if err != nil || user == nil {
+		return false, err
+	}
+	if user.Role == models.UserRoleADMIN {
+		return true, nil
+	}
+	ids, err := a.userStore.GetAdminBuildingIDs(ctx, user.ID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(ids, buildingID), nil
+}
+
+func (a *FeedHandler) serve(w http.ResponseWriter, r *http.Request, name string, filter models.FeedFilter, private bool) {
+	loc := &a.config.Location
+	filter.From = time.Now().In(loc).Add(-feedHistory)
+	dates, err := a.reservationStore.GetFeedDates(r.Context(), filter)
+	if err != nil {
+		a.log.Error("Failed to get feed dates", "err", err)
+		http.Error(w, "failed to get feed", http.StatusInternalServerError)
+		return
+	}
+	events := feeds.Events(dates, feeds.Options{
+		Loc:            loc,
+		TZ:             a.config.Timezone,
+		Domain:         feedDomain(a.config.ApiHost),
+		Private:        private,
+		ReservationURL: a.config.FrontendUrl + "/reservation",
+	})
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	if private {
+		w.Header().Set("Cache-Control", "private, max-age=300")
+	} else {
+		w.Header().Set("Cache-Control", "public, max-age=300")
+	}
+	if err := calendar.WriteICS(w, name, loc, a.config.Timezone, events); err != nil {
+		a.log.Error("Failed to write feed", "err", err)
+	}
+}
+
+func feedDomain(apiHost string) string {
+	if u, err := url.Parse(apiHost); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return "flexfacilities"
+}
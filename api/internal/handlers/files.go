@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"api/internal/lib/utils"
 	"api/internal/models"
 	"api/internal/ports"
 	"api/pkg/files"
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -14,14 +17,17 @@ import (
 )
 
 type FileHandler struct {
-	fileStorage      files.FileStorage
-	log              *slog.Logger
-	facilityStore    ports.FacilityStore
-	reservationStore ports.ReservationStore
+	fileStorage       files.FileStorage
+	log               *slog.Logger
+	facilityStore     ports.FacilityStore
+	reservationStore  ports.ReservationStore
+	organizationStore ports.OrganizationStore
+	userStore         ports.UserStore
+	invoices          *invoicer
 }
 
-func NewFileHandler(fileStorage files.FileStorage, log *slog.Logger, facilityStore ports.FacilityStore, reservationStore ports.ReservationStore) *FileHandler {
-	return &FileHandler{fileStorage: fileStorage, log: log, facilityStore: facilityStore, reservationStore: reservationStore}
+func NewFileHandler(fileStorage files.FileStorage, log *slog.Logger, facilityStore ports.FacilityStore, reservationStore ports.ReservationStore, organizationStore ports.OrganizationStore, userStore ports.UserStore, invoices *invoicer) *FileHandler {
+	return &FileHandler{fileStorage: fileStorage, log: log, facilityStore: facilityStore, reservationStore: reservationStore, organizationStore: organizationStore, userStore: userStore, invoices: invoices}
 }
 
 func (a *FileHandler) UploadReservationFile(w http.ResponseWriter, r *http.Request) {
@@ -85,6 +91,192 @@ func (a *FileHandler) GetReservationFile(w http.ResponseWriter, r *http.Request)
 	http.ServeContent(w, r, path, time.Now(), reader)
 }
 
+// intakeUploadDir is where a user's intake form files wait until the
+// reservation they are for is created.
+func intakeUploadDir(userID string) string {
+	return "uploads/" + userID + "/"
+}
+
+// Stages a file for an intake form answer before the reservation exists.
+// Only its uploader can name it in an answer, and creating the reservation
+// moves it among the reservation's documents.
+// @path: uploads
+func (a *FileHandler) UploadIntakeFile(w http.ResponseWriter, r *http.Request) {
+	user, err := callerUser(r.Context())
+	if err != nil {
+		http.Error(w, "unauthenticated", http.StatusUnauthorized)
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		http.Error(w, "invalid content type", http.StatusBadRequest)
+		return
+	}
+	if err := r.ParseMultipartForm(32 << 20); err != nil { // 32 MB
+		a.log.Error("Failed to parse multipart form", "err", err)
+		http.Error(w, "failed to parse multipart form", http.StatusBadRequest)
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		a.log.Error("Failed to get file", "err", err)
+		http.Error(w, "failed to get file", http.StatusBadRequest)
+		return
+	}
+	dir := intakeUploadDir(user.ID)
+	if err := a.fileStorage.Store(file, header, dir); err != nil {
+		a.log.Error("Failed to store file", "err", err)
+		http.Error(w, "failed to store file", http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"path": dir + header.Filename})
+}
+
+// Serves an invoice or receipt to the requester or the building's admins,
+// drawing it again when the stored copy is gone
+// @path: invoices/{reservationID}/{file}
+func (a *FileHandler) GetInvoice(w http.ResponseWriter, r *http.Request) {
+	reservationID, err := strconv.ParseInt(r.PathValue("reservationID"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid reservation id", http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	reservation, err := a.reservationStore.Get(ctx, reservationID)
+	if err != nil || reservation == nil {
+		http.Error(w, "reservation not found", http.StatusNotFound)
+		return
+	}
+	if err := requireRequesterOrFacility(ctx, a.userStore, a.facilityStore, &reservation.Reservation); err != nil {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+	invoice, err := a.invoices.find(ctx, reservationID, r.PathValue("file"))
+	if err != nil {
+		a.log.Error("Failed to get invoices", "err", err)
+		http.Error(w, "failed to get invoice", http.StatusInternalServerError)
+		return
+	}
+	if invoice == nil {
+		http.Error(w, "invoice not found", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pdf")
+	reader, err := a.fileStorage.Get(invoice.FilePath)
+	if err != nil {
+		data, err := a.invoices.redraw(ctx, reservation, invoice)
+		if err != nil {
+			a.log.Error("Failed to draw invoice", "err", err)
+			http.Error(w, "failed to get invoice", http.StatusInternalServerError)
+			return
+		}
+		reader = bytes.NewReader(data)
+	}
+	http.ServeContent(w, r, invoice.FilePath, invoice.CreatedAt, reader)
+}
+
+// Stores an insurance document shared by all of an organization's bookings
+// @path: organizations/{organizationID}
+func (a *FileHandler) UploadOrganizationFile(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "multipart/form-data") {
+		http.Error(w, "invalid content type", http.StatusBadRequest)
+		return
+	}
+	err := r.ParseMultipartForm(32 << 20) // 32 MB
+	if err != nil {
+		a.log.Error("Failed to parse multipart form", "err", err)
+		http.Error(w, "failed to parse multipart form", http.StatusBadRequest)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		a.log.Error("Failed to get file", "err", err)
+		http.Error(w, "failed to get file", http.StatusBadRequest)
+		return
+	}
+	organizationID := r.PathValue("organizationID")
+	parsed, err := strconv.ParseInt(organizationID, 10, 64)
+	if err != nil {
+		http.Error(w, "invalid organization id", http.StatusBadRequest)
+		return
+	}
+	if !a.organizationAccess(w, r, parsed, true) {
+		return
+	}
+	path := fmt.Sprintf("organizations/%s", organizationID)
+	err = a.fileStorage.Store(file, header, path)
+	if err != nil {
+		a.log.Error("Failed to store file", "err", err)
+		http.Error(w, "failed to store file", http.StatusBadRequest)
+		return
+	}
+	err = a.organizationStore.CreateInsurance(r.Context(), &models.OrganizationInsurance{
+		OrganizationID: parsed,
+		FilePath:       fmt.Sprintf("%s/%s", path, header.Filename),
+		FileName:       header.Filename,
+		ExpiresAt:      utils.StringToPgDate(r.FormValue("expires_at")),
+	})
+	if err != nil {
+		a.log.Error("Failed to save organization insurance", "err", err)
+		http.Error(w, "failed to save organization insurance", http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Serves an organization's insurance document to its members and to
+// building admins
+// @path: organizations/{organizationID}/{file}
+func (a *FileHandler) GetOrganizationFile(w http.ResponseWriter, r *http.Request) {
+	organizationID := r.PathValue("organizationID")
+	parsed, err := strconv.ParseInt(organizationID, 10, 64)
+	if err != nil {
+		http.Error(w, "invalid organization id", http.StatusBadRequest)
+		return
+	}
+	if !a.organizationAccess(w, r, parsed, false) {
+		return
+	}
+	file := r.PathValue("file")
+	path := filepath.Join("organizations", organizationID, file)
+	reader, err := a.fileStorage.Get(path)
+	if err != nil {
+		a.log.Error("Failed to get file", "err", err)
+		http.Error(w, "failed to get file", http.StatusBadRequest)
+		return
+	}
+	http.ServeContent(w, r, path, time.Now(), reader)
+}
+
+// organizationAccess lets any member read an organization's files and its
+// managers and owners upload them. Building admins, who check insurance on
+// the bookings they review, may do both. It writes the error response and
+// returns false when the caller may not.
+func (a *FileHandler) organizationAccess(w http.ResponseWriter, r *http.Request, organizationID int64, manage bool) bool {
+	ctx := r.Context()
+	user, err := callerUser(ctx)
+	if err != nil {
+		http.Error(w, "unauthenticated", http.StatusUnauthorized)
+		return false
+	}
+	role, err := a.organizationStore.GetMemberRole(ctx, organizationID, user.ID)
+	if err != nil {
+		a.log.Error("Failed to get organization role", "err", err)
+		http.Error(w, "failed to check organization", http.StatusInternalServerError)
+		return false
+	}
+	if role.CanManage() || (role != "" && !manage) {
+		return true
+	}
+	if _, err := callerScope(ctx, a.userStore); err != nil {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
 // Handler works for getting the building image and facility image
 // @path: images/{building}  or  images/{building}/{facility}
 func (a *FileHandler) GetFacilityImage(w http.ResponseWriter, r *http.Request) {
@@ -4,7 +4,8 @@ import (
 	"api/internal/auth"
 	"api/internal/config"
 	repository "api/internal/db"
-	"api/pkg/calendar"
+	"api/internal/lib/calendars"
+	"api/internal/ports"
 	"api/pkg/files"
 	"fmt"
 	"github.com/biohackerellie/flexauth"
@@ -16,19 +17,22 @@ import (
 )
 
 type Handlers struct {
-	UserHandler        *UserHandler
-	FacilityHandler    *FacilityHandler
-	ReservationHandler *ReservationHandler
-	UtilityHandler     *UtilityHandler
-	Auth               *auth.Auth
-	FilesHandler       *FileHandler
-	PaymentHandler     *PaymentHandler
+	UserHandler         *UserHandler
+	FacilityHandler     *FacilityHandler
+	ReservationHandler  *ReservationHandler
+	UtilityHandler      *UtilityHandler
+	Auth                *auth.Auth
+	FilesHandler        *FileHandler
+	PaymentHandler      *PaymentHandler
+	OrganizationHandler *OrganizationHandler
+	StaffHandler        *StaffHandler
+	FeedHandler         *FeedHandler
+	WebhookHandler      *WebhookHandler
 }
 
-func New(dbService *repository.DBService, log *slog.Logger, config *config.Config, cal *calendar.Calendar) *Handlers {
+func New(dbService *repository.DBService, log *slog.Logger, config *config.Config, cal ports.CalendarProvider, calendarSync *calendars.BuildingSync, reservationSync *calendars.ReservationSync, reconciler *calendars.Reconciler, outbox *calendars.Outbox, text *calendars.EventText) *Handlers {
 
 	localFiles := files.NewLocalFileStorage(config.FilesPath, config.FrontendUrl)
-	filesHandler := NewFileHandler(localFiles, log, dbService.FacilityStore, dbService.ReservationStore)
 	stripeClient := stripe.NewClient(config.StripeSecretKey)
 	entraconfig := flexauth.Config{
 		ClientID:     config.EntraClientID,
@@ -47,20 +51,31 @@ func New(dbService *repository.DBService, log *slog.Logger, config *config.Confi
 	}
 
 	c := cache.New(10*time.Minute, 15*time.Minute)
+	refunds := newRefunder(stripeClient, dbService.PaymentStore, dbService.ReservationStore, dbService.FacilityStore, dbService.UserStore, config, log)
+	invoices := newInvoicer(dbService.InvoiceStore, dbService.PaymentStore, dbService.ReservationStore, dbService.FacilityStore, dbService.UserStore, dbService.OrganizationStore, dbService.BrandingStore, localFiles, stripeClient, timezone, config, log)
+	filesHandler := NewFileHandler(localFiles, log, dbService.FacilityStore, dbService.ReservationStore, dbService.OrganizationStore, dbService.UserStore, invoices)
 
-	userHandler := NewUserHandler(dbService.UserStore, log)
-	facilityHandler := NewFacilityHandler(dbService.FacilityStore, log, cal, c, stripeClient)
-	reservationHandler := NewReservationHandler(dbService.ReservationStore, dbService.UserStore, dbService.FacilityStore, log, timezone, config, cal, stripeClient)
+	userHandler := NewUserHandler(dbService.UserStore, log, config)
+	facilityHandler := NewFacilityHandler(dbService.FacilityStore, dbService.UserStore, log, cal, c, stripeClient, calendarSync, timezone, dbService.ReservationStore)
+	reservationHandler := NewReservationHandler(dbService.ReservationStore, dbService.UserStore, dbService.FacilityStore, dbService.OrganizationStore, log, timezone, config, cal, stripeClient, dbService.CalendarSyncStore, reservationSync, reconciler, outbox, text, refunds, localFiles)
 	utilityHandler := NewUtilityHandler(dbService.ReservationStore, dbService.BrandingStore, log, timezone)
-	paymentHandler := NewPaymentHandler(log, config, dbService.FacilityStore, dbService.ReservationStore, stripeClient)
+	paymentHandler := NewPaymentHandler(log, config, timezone, dbService.FacilityStore, dbService.ReservationStore, dbService.PaymentStore, dbService.UserStore, refunds, invoices, stripeClient)
+	organizationHandler := NewOrganizationHandler(dbService.OrganizationStore, dbService.ReservationStore, dbService.FacilityStore, dbService.UserStore, log, stripeClient)
+	staffHandler := NewStaffHandler(dbService.StaffStore, dbService.ReservationStore, dbService.FacilityStore, dbService.UserStore, log, config)
+	feedHandler := NewFeedHandler(dbService.ReservationStore, dbService.FacilityStore, dbService.UserStore, config, log)
+	webhookHandler := NewWebhookHandler(dbService.PaymentStore, dbService.ReservationStore, dbService.FacilityStore, dbService.UserStore, invoices, stripeClient, config, log)
 
 	return &Handlers{
-		UserHandler:        userHandler,
-		FacilityHandler:    facilityHandler,
-		ReservationHandler: reservationHandler,
-		UtilityHandler:     utilityHandler,
-		Auth:               authHandler,
-		FilesHandler:       filesHandler,
-		PaymentHandler:     paymentHandler,
+		UserHandler:         userHandler,
+		FacilityHandler:     facilityHandler,
+		ReservationHandler:  reservationHandler,
+		UtilityHandler:      utilityHandler,
+		Auth:                authHandler,
+		FilesHandler:        filesHandler,
+		PaymentHandler:      paymentHandler,
+		OrganizationHandler: organizationHandler,
+		StaffHandler:        staffHandler,
+		FeedHandler:         feedHandler,
+		WebhookHandler:      webhookHandler,
 	}
 }
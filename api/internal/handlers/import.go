@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"api/internal/lib/importer"
+	service "api/internal/proto/reservation"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// maxImportBytes bounds uploaded spreadsheets and calendar exports.
+const maxImportBytes = 10 << 20
+
+func (a *ReservationHandler) ImportReservations(ctx context.Context, req *connect.Request[service.ImportReservationsRequest]) (*connect.Response[service.ImportReservationsResponse], error) {
+	scope, err := callerScope(ctx, a.userStore)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := req.Msg.GetData()
+	if len(data) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("no data to import"))
+	}
+	if len(data) > maxImportBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("imports are limited to %d MB", maxImportBytes>>20))
+	}
+
+	var bookings []importer.Booking
+	switch req.Msg.GetFormat() {
+	case "ics":
+		bookings, err = importer.ParseICS(bytes.NewReader(data), a.timezone, a.config.Timezone)
+	case "csv":
+		bookings, err = importer.ParseCSV(bytes.NewReader(data), a.timezone)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown format %q, expected ics or csv", req.Msg.GetFormat()))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userID := req.Msg.GetUserId()
+	if userID == "" {
+		userID = caller.ID
+	}
+	report, err := importer.New(a.reservationStore, a.facilityStore, a.userStore, a.calendar, a.text, a.timezone, a.log).Run(ctx, bookings, importer.Options{
+		DryRun:     req.Msg.GetDryRun(),
+		FacilityID: req.Msg.GetFacilityId(),
+		UserID:     userID,
+		CategoryID: req.Msg.GetCategoryId(),
+		Publish:    req.Msg.GetPublish(),
+		Allow:      scope.allows,
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.log.InfoContext(ctx, "Imported reservations", "user", caller.ID, "dry_run", req.Msg.GetDryRun(), "rows", len(report.Rows), "created", report.Created, "skipped", report.Skipped)
+
+	rows := make([]*service.ImportReservationsRow, 0, len(report.Rows))
+	for _, r := range report.Rows {
+		row := &service.ImportReservationsRow{
+			Line:          int32(r.Line),
+			EventName:     r.EventName,
+			FacilityId:    r.FacilityID,
+			FacilityName:  r.FacilityName,
+			UserId:        r.UserID,
+			Occurrences:   int32(r.Occurrences),
+			Status:        r.Status,
+			Problems:      r.Problems,
+			Warnings:      r.Warnings,
+			ReservationId: r.ReservationID,
+		}
+		if !r.FirstStart.IsZero() {
+			row.FirstStart = r.FirstStart.Format(time.RFC3339)
+		}
+		rows = append(rows, row)
+	}
+	return connect.NewResponse(&service.ImportReservationsResponse{
+		Rows:    rows,
+		Created: int32(report.Created),
+		Skipped: int32(report.Skipped),
+	}), nil
+}
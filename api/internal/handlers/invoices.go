@@ -0,0 +1,449 @@
+package handlers
+
+import (
+	"api/internal/config"
+	"api/internal/lib/emails"
+	"api/internal/lib/invoices"
+	"api/internal/lib/utils"
+	"api/internal/models"
+	"api/internal/ports"
+	"api/pkg/files"
+	"api/pkg/pdf"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"log/slog"
+	"math"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	_ "github.com/HugoSmits86/nativewebp"
+	"github.com/stripe/stripe-go/v83"
+)
+
+var errNothingToInvoice = errors.New("nothing to invoice")
+
+// invoices are drawn in this color when branding has no primary color
+var defaultInvoiceColor = pdf.Color{R: 31, G: 58, B: 95}
+
+// datesOnInvoice is how many reservation dates are listed before the rest
+// are counted.
+const datesOnInvoice = 4
+
+// invoicer issues numbered invoice and receipt PDFs for reservations. The
+// lines are kept with each document, so a PDF missing from storage is drawn
+// again as it was issued.
+type invoicer struct {
+	invoiceStore      ports.InvoiceStore
+	paymentStore      ports.PaymentStore
+	reservationStore  ports.ReservationStore
+	facilityStore     ports.FacilityStore
+	userStore         ports.UserStore
+	organizationStore ports.OrganizationStore
+	brandingStore     ports.BrandingStore
+	fileStorage       files.FileStorage
+	sc                *stripe.Client
+	timezone          *time.Location
+	config            *config.Config
+	log               *slog.Logger
+}
+
+func newInvoicer(invoiceStore ports.InvoiceStore, paymentStore ports.PaymentStore, reservationStore ports.ReservationStore, facilityStore ports.FacilityStore, userStore ports.UserStore, organizationStore ports.OrganizationStore, brandingStore ports.BrandingStore, fileStorage files.FileStorage, sc *stripe.Client, timezone *time.Location, config *config.Config, log *slog.Logger) *invoicer {
+	return &invoicer{
+		invoiceStore:      invoiceStore,
+		paymentStore:      paymentStore,
+		reservationStore:  reservationStore,
+		facilityStore:     facilityStore,
+		userStore:         userStore,
+		organizationStore: organizationStore,
+		brandingStore:     brandingStore,
+		fileStorage:       fileStorage,
+		sc:                sc,
+		timezone:          timezone,
+		config:            config,
+		log:               log,
+	}
+}
+
+// issue numbers an invoice or receipt for the reservation, stores its PDF
+// and emails it to the requester and the organization's billing contact.
+// When nothing changed since the latest one of the kind, that one is
+// returned with false.
+func (iv *invoicer) issue(ctx context.Context, res *models.FullReservation, kind models.InvoiceKind) (*models.Invoice, bool, error) {
+	lines, total, err := iv.lines(ctx, res)
+	if err != nil {
+		return nil, false, err
+	}
+	entries, err := iv.paymentStore.GetPaymentEntries(ctx, res.Reservation.ID)
+	if err != nil {
+		return nil, false, err
+	}
+	paid := ledgerCents(entries)
+	if kind == models.InvoiceKindInvoice && total <= 0 {
+		return nil, false, fmt.Errorf("%w: reservation %d has no cost", errNothingToInvoice, res.Reservation.ID)
+	}
+	if kind == models.InvoiceKindReceipt && paid <= 0 {
+		return nil, false, fmt.Errorf("%w: reservation %d has no payments", errNothingToInvoice, res.Reservation.ID)
+	}
+	invoice, created, err := iv.invoiceStore.IssueInvoice(ctx, &models.Invoice{
+		ReservationID: res.Reservation.ID,
+		Kind:          kind,
+		TotalCents:    total,
+		PaidCents:     paid,
+	}, lines)
+	if err != nil || !created {
+		return invoice, false, err
+	}
+
+	// the document is issued; a PDF that fails here is drawn on download
+	data, err := iv.store(ctx, res, invoice, lines)
+	if err != nil {
+		iv.log.Error("failed to store invoice pdf", "reservation_id", res.Reservation.ID, "number", invoice.DocumentNumber(), "error", err)
+		return invoice, true, nil
+	}
+	iv.email(ctx, res, invoice, data)
+	return invoice, true, nil
+}
+
+// issueReceipt issues a receipt after a payment. Failures are logged; the
+// payment itself is already recorded.
+func (iv *invoicer) issueReceipt(ctx context.Context, id int64) {
+	res, err := iv.reservationStore.Get(ctx, id)
+	if err != nil || res == nil {
+		iv.log.Error("failed to get reservation for receipt", "reservation_id", id, "error", err)
+		return
+	}
+	if _, _, err := iv.issue(ctx, res, models.InvoiceKindReceipt); err != nil && !errors.Is(err, errNothingToInvoice) {
+		iv.log.Error("failed to issue receipt", "reservation_id", id, "error", err)
+	}
+}
+
+// lines itemizes the reducer total: the billable hours at the price and
+// each fee, then an adjustment for the admin cost override or a total the
+// reducer raised to zero. They add up to reservationTotalCents.
+func (iv *invoicer) lines(ctx context.Context, res *models.FullReservation) ([]models.InvoiceLine, int64, error) {
+	var lines []models.InvoiceLine
+	var sum int64
+	if res.Reservation.PriceID.Valid {
+		price, err := iv.sc.V1Prices.Retrieve(ctx, res.Reservation.PriceID.String, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		costs, err := costLines(res, price)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, c := range costs {
+			if c.amountCents() == 0 && len(lines) > 0 {
+				continue
+			}
+			lines = append(lines, models.InvoiceLine{
+				Description: c.description,
+				Quantity:    c.quantity,
+				UnitCents:   c.unitCents,
+				AmountCents: c.amountCents(),
+			})
+			sum += c.amountCents()
+		}
+	}
+	total := max(sum, 0)
+	description := "Adjustment"
+	if res.Reservation.CostOverride.Valid {
+		total = int64(math.Round(utils.PGNumericToFloat64(res.Reservation.CostOverride) * 100))
+		description = "Price override"
+	}
+	if adjustment := total - sum; adjustment != 0 {
+		lines = append(lines, models.InvoiceLine{
+			Description: description,
+			Quantity:    1,
+			UnitCents:   adjustment,
+			AmountCents: adjustment,
+		})
+	}
+	return lines, total, nil
+}
+
+// store draws the document and saves it at its file path.
+func (iv *invoicer) store(ctx context.Context, res *models.FullReservation, invoice *models.Invoice, lines []models.InvoiceLine) ([]byte, error) {
+	data, err := iv.render(ctx, res, invoice, lines)
+	if err != nil {
+		return nil, err
+	}
+	if err := iv.fileStorage.Save(invoice.FilePath, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// redraw renders an issued document again from its stored lines and saves
+// it, for when its file is gone.
+func (iv *invoicer) redraw(ctx context.Context, res *models.FullReservation, invoice *models.Invoice) ([]byte, error) {
+	lines, err := iv.invoiceStore.GetInvoiceLines(ctx, invoice.ID)
+	if err != nil {
+		return nil, err
+	}
+	return iv.store(ctx, res, invoice, lines)
+}
+
+func (iv *invoicer) render(ctx context.Context, res *models.FullReservation, invoice *models.Invoice, lines []models.InvoiceLine) ([]byte, error) {
+	doc := &invoices.Document{
+		Title:      strings.ToUpper(invoice.Kind.String()),
+		Number:     invoice.DocumentNumber(),
+		Issued:     invoice.CreatedAt.In(iv.timezone),
+		TotalCents: invoice.TotalCents,
+		PaidCents:  invoice.PaidCents,
+	}
+	for _, l := range lines {
+		doc.Lines = append(doc.Lines, invoices.Line{
+			Description: l.Description,
+			Quantity:    l.Quantity,
+			UnitCents:   l.UnitCents,
+			AmountCents: l.AmountCents,
+		})
+	}
+
+	branding, err := iv.brandingStore.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	doc.Organization = invoices.Organization{
+		Name:        branding.OrganizationName,
+		URL:         branding.OrganizationUrl.String,
+		Email:       branding.OrganizationEmail.String,
+		Description: branding.OrganizationDescription.String,
+		Color:       defaultInvoiceColor,
+		Logo:        iv.logo(branding.OrganizationLogoPath),
+	}
+	if c, ok := pdf.ParseHex(branding.OrganizationPrimaryColor.String); ok {
+		doc.Organization.Color = c
+	}
+
+	doc.BillTo, err = iv.billTo(ctx, &res.Reservation)
+	if err != nil {
+		return nil, err
+	}
+	doc.Details, err = iv.details(ctx, res)
+	if err != nil {
+		return nil, err
+	}
+
+	switch invoice.Kind {
+	case models.InvoiceKindInvoice:
+		if invoice.TotalCents > invoice.PaidCents {
+			category, err := iv.facilityStore.GetCategory(ctx, res.Reservation.CategoryID)
+			if err != nil {
+				return nil, err
+			}
+			if first, ok := firstActiveStart(res.Dates); ok && category != nil {
+				doc.DueDate = balanceDueDay(first, category.BalanceDueDays).Format("January 2, 2006")
+			}
+		}
+	case models.InvoiceKindReceipt:
+		entries, err := iv.paymentStore.GetPaymentEntries(ctx, res.Reservation.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			// a receipt lists what was paid when it was issued
+			if e.CreatedAt.After(invoice.CreatedAt) {
+				continue
+			}
+			doc.Payments = append(doc.Payments, invoices.Payment{
+				Date:        e.CreatedAt.In(iv.timezone),
+				Description: entryDescription(&e),
+				AmountCents: e.AmountCents,
+			})
+		}
+	}
+	return invoices.Render(doc)
+}
+
+// billTo is the organization's billing contact for organization bookings,
+// otherwise the requester.
+func (iv *invoicer) billTo(ctx context.Context, res *models.Reservation) ([]string, error) {
+	if res.OrganizationID.Valid {
+		org, err := iv.organizationStore.Get(ctx, res.OrganizationID.Int64)
+		if err != nil {
+			return nil, err
+		}
+		if org != nil {
+			o := org.Organization
+			lines := []string{o.Name}
+			if o.BillingName.Valid {
+				lines = append(lines, "Attn: "+o.BillingName.String)
+			}
+			return appendValid(lines, o.BillingEmail, o.BillingPhone), nil
+		}
+	}
+	lines := []string{res.Name}
+	user, err := iv.userStore.Get(ctx, res.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		lines = append(lines, user.Email)
+	}
+	return appendValid(lines, res.Phone), nil
+}
+
+// details names the event, where it is and its first few dates.
+func (iv *invoicer) details(ctx context.Context, res *models.FullReservation) ([]string, error) {
+	lines := []string{res.Reservation.EventName}
+	facility, err := iv.facilityStore.Get(ctx, res.Reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	if facility != nil && facility.Facility != nil {
+		name := facility.Facility.Name
+		if facility.Building != nil {
+			name = facility.Building.Name + ", " + name
+		}
+		lines = append(lines, name)
+	}
+	lines = append(lines, fmt.Sprintf("Reservation #%d", res.Reservation.ID))
+
+	var dates []models.ReservationDate
+	for _, d := range res.Dates {
+		if d.Approved != models.ReservationDateApprovedDenied && d.Approved != models.ReservationDateApprovedCanceled && d.LocalStart.Valid {
+			dates = append(dates, d)
+		}
+	}
+	slices.SortFunc(dates, func(a, b models.ReservationDate) int {
+		return a.LocalStart.Time.Compare(b.LocalStart.Time)
+	})
+	for i, d := range dates {
+		if i == datesOnInvoice && len(dates) > datesOnInvoice+1 {
+			lines = append(lines, fmt.Sprintf("and %d more dates", len(dates)-datesOnInvoice))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%s – %s", d.LocalStart.Time.Format("Mon Jan 2, 2006 3:04 PM"), d.LocalEnd.Time.Format("3:04 PM")))
+	}
+	return lines, nil
+}
+
+// logo loads the branding logo from file storage. Invoices go without one
+// that cannot be read.
+func (iv *invoicer) logo(logoPath string) image.Image {
+	if logoPath == "" {
+		return nil
+	}
+	// the logo may be saved as its download URL
+	if i := strings.Index(logoPath, "images/"); i >= 0 {
+		logoPath = logoPath[i:]
+	}
+	r, err := iv.fileStorage.Get(logoPath)
+	if err != nil {
+		iv.log.Debug("invoice logo not found", "path", logoPath, "error", err)
+		return nil
+	}
+	if c, ok := r.(interface{ Close() error }); ok {
+		defer c.Close()
+	}
+	img, _, err := image.Decode(r)
+	if err != nil {
+		iv.log.Debug("invoice logo not decoded", "path", logoPath, "error", err)
+		return nil
+	}
+	return img
+}
+
+// url is where the requester and admins download the PDF.
+func (iv *invoicer) url(invoice *models.Invoice) string {
+	return fmt.Sprintf("%s/api/files/%s", iv.config.FrontendUrl, invoice.FilePath)
+}
+
+// find returns the reservation's document saved under the given file name.
+func (iv *invoicer) find(ctx context.Context, reservationID int64, file string) (*models.Invoice, error) {
+	list, err := iv.invoiceStore.GetInvoices(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list {
+		if path.Base(list[i].FilePath) == file {
+			return &list[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// email sends the PDF to the requester, and for organization bookings to
+// its billing email too.
+func (iv *invoicer) email(ctx context.Context, res *models.FullReservation, invoice *models.Invoice, data []byte) {
+	var to []string
+	user, err := iv.userStore.Get(ctx, res.Reservation.UserID)
+	if err != nil || user == nil {
+		iv.log.Error("failed to get requester for invoice email", "reservation_id", res.Reservation.ID, "error", err)
+	} else {
+		to = append(to, user.Email)
+	}
+	if res.Reservation.OrganizationID.Valid {
+		org, err := iv.organizationStore.Get(ctx, res.Reservation.OrganizationID.Int64)
+		if err == nil && org != nil && org.Organization.BillingEmail.Valid && !slices.Contains(to, org.Organization.BillingEmail.String) {
+			to = append(to, org.Organization.BillingEmail.String)
+		}
+	}
+	if len(to) == 0 {
+		return
+	}
+	title := "Invoice"
+	amount := invoice.TotalCents - invoice.PaidCents
+	if invoice.Kind == models.InvoiceKindReceipt {
+		title = "Receipt"
+		amount = invoice.PaidCents
+	}
+	emailData := &emails.EmailData{
+		To:       strings.Join(to, ","),
+		Template: "invoice.html",
+		Subject:  fmt.Sprintf("%s %s", title, invoice.DocumentNumber()),
+		Data: map[string]any{
+			"Name":   res.Reservation.EventName,
+			"Title":  title,
+			"Number": invoice.DocumentNumber(),
+			"Amount": models.CentsToString(amount),
+			"URL":    iv.url(invoice),
+		},
+		Attachments: []emails.Attachment{{
+			Name:        invoice.DocumentNumber() + ".pdf",
+			ContentType: "application/pdf",
+			Data:        data,
+		}},
+	}
+	if iv.config.AppEnv == config.PROD {
+		go emails.Send(emailData)
+	}
+}
+
+// entryDescription names a ledger entry on a receipt.
+func entryDescription(e *models.PaymentEntry) string {
+	var s string
+	switch e.Kind {
+	case models.PaymentEntryKindCharge:
+		s = "Card payment"
+	case models.PaymentEntryKindOffline:
+		s = "Payment"
+	case models.PaymentEntryKindRefund:
+		s = "Refund"
+	default:
+		s = "Adjustment"
+	}
+	if e.Provider == models.PaymentProviderManual && e.ProviderRef.Valid {
+		s += " (" + e.ProviderRef.String + ")"
+	}
+	return s
+}
+
+func appendValid(lines []string, values ...sql.NullString) []string {
+	for _, v := range values {
+		if v.Valid && v.String != "" {
+			lines = append(lines, v.String)
+		}
+	}
+	return lines
+}
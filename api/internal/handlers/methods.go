@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"api/internal/config"
+	"api/internal/models"
+	"api/internal/ports"
+	service "api/internal/proto/payments"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/stripe/stripe-go/v83"
+)
+
+const stripeMethod = "stripe"
+
+// paymentMethod is a way to pay what a reservation owes. PaymentHandler
+// works out what is due now and hands it to the method the payer chose.
+type paymentMethod interface {
+	info() *service.PaymentMethod
+	start(ctx context.Context, payment *duePayment) (*service.StartPaymentResponse, error)
+}
+
+// duePayment is a payment being started on a reservation.
+type duePayment struct {
+	reservation *models.FullReservation
+	kind        string // full, deposit or balance
+	cents       int64
+	payer       sql.NullString
+	reference   string
+	note        string
+}
+
+// manualMethodLabels names each manual method and the reference it takes.
+var manualMethodLabels = map[models.ManualPaymentMethod]struct{ name, reference string }{
+	models.ManualPaymentMethodCheck:         {"Check", "Check number"},
+	models.ManualPaymentMethodPurchaseOrder: {"Purchase order", "PO number"},
+	models.ManualPaymentMethodAccountCode:   {"Internal transfer", "Account code"},
+}
+
+// newPaymentMethods builds the methods named in PAYMENT_METHODS, in order.
+func newPaymentMethods(names []string, config *config.Config, paymentStore ports.PaymentStore, sc *stripe.Client, log *slog.Logger) []paymentMethod {
+	methods := make([]paymentMethod, 0, len(names))
+	for _, name := range names {
+		if name == stripeMethod {
+			methods = append(methods, &stripeCheckout{config: config, sc: sc})
+			continue
+		}
+		method := models.ManualPaymentMethod(name)
+		if _, ok := manualMethodLabels[method]; !ok {
+			log.Warn("unknown payment method", "method", name)
+			continue
+		}
+		methods = append(methods, &manualPayment{method: method, paymentStore: paymentStore})
+	}
+	return methods
+}
+
+// stripeCheckout sends the payer to a Stripe Checkout page. The webhook
+// records the payment once it goes through.
+type stripeCheckout struct {
+	config *config.Config
+	sc     *stripe.Client
+}
+
+func (c *stripeCheckout) info() *service.PaymentMethod {
+	return &service.PaymentMethod{Id: stripeMethod, Name: "Card"}
+}
+
+func (c *stripeCheckout) start(ctx context.Context, payment *duePayment) (*service.StartPaymentResponse, error) {
+	reservation := payment.reservation
+	// one line for exactly what is due, so the charge always matches the
+	// schedule, cost overrides included
+	lineItems := []*stripe.CheckoutSessionCreateLineItemParams{partialLineItem(payment.kind, reservation.Reservation.EventName, payment.cents)}
+
+	domain := c.config.FrontendUrl
+	success := fmt.Sprintf("%s/reservation/%d/success", domain, reservation.Reservation.ID)
+	// the webhook finds the reservation from the reference and metadata
+	params := &stripe.CheckoutSessionCreateParams{
+		SuccessURL:        stripe.String(success + "?session_id={CHECKOUT_SESSION_ID}"),
+		CancelURL:         stripe.String(domain + fmt.Sprintf("/reservation/%d", reservation.Reservation.ID)),
+		LineItems:         lineItems,
+		Mode:              stripe.String(string(stripe.CheckoutSessionModePayment)),
+		ClientReferenceID: stripe.String(strconv.FormatInt(reservation.Reservation.ID, 10)),
+		Metadata:          checkoutMetadata(reservation.Reservation.ID, payment.kind),
+		PaymentIntentData: &stripe.CheckoutSessionCreatePaymentIntentDataParams{
+			Metadata: checkoutMetadata(reservation.Reservation.ID, payment.kind),
+		},
+	}
+
+	s, err := c.sc.V1CheckoutSessions.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &service.StartPaymentResponse{
+		Amount: models.CentsToString(payment.cents),
+		Kind:   payment.kind,
+		Url:    s.URL,
+	}, nil
+}
+
+// manualPayment records a check, purchase order or internal transfer by its
+// reference. Nothing comes off the balance until an admin verifies it.
+type manualPayment struct {
+	method       models.ManualPaymentMethod
+	paymentStore ports.PaymentStore
+}
+
+func (m *manualPayment) info() *service.PaymentMethod {
+	labels := manualMethodLabels[m.method]
+	return &service.PaymentMethod{
+		Id:             m.method.String(),
+		Name:           labels.name,
+		Manual:         true,
+		ReferenceLabel: labels.reference,
+	}
+}
+
+func (m *manualPayment) start(ctx context.Context, payment *duePayment) (*service.StartPaymentResponse, error) {
+	if payment.reference == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is required", manualMethodLabels[m.method].reference))
+	}
+	submission, err := m.paymentStore.CreatePaymentSubmission(ctx, &models.PaymentSubmission{
+		ReservationID: payment.reservation.Reservation.ID,
+		Method:        m.method,
+		Payment:       payment.kind,
+		AmountCents:   payment.cents,
+		Reference:     payment.reference,
+		Note:          models.CheckNullString(payment.note),
+		SubmittedBy:   payment.payer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &service.StartPaymentResponse{
+		Amount:     models.CentsToString(payment.cents),
+		Kind:       payment.kind,
+		Submission: submission.ToProto(),
+	}, nil
+}
@@ -0,0 +1,521 @@
+package handlers
+
+import (
+	"api/internal/lib/utils"
+	"api/internal/models"
+	"api/internal/ports"
+	service "api/internal/proto/organizations"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stripe/stripe-go/v83"
+)
+
+var (
+	errNotOrgMember = errors.New("not a member of this organization")
+	errNotOrgOwner  = errors.New("only owners can grant or revoke the owner role")
+	errLastOwner    = errors.New("an organization must keep at least one owner")
+)
+
+type OrganizationHandler struct {
+	organizationStore ports.OrganizationStore
+	reservationStore  ports.ReservationStore
+	facilityStore     ports.FacilityStore
+	userStore         ports.UserStore
+	log               *slog.Logger
+	sc                *stripe.Client
+}
+
+func NewOrganizationHandler(
+	organizationStore ports.OrganizationStore,
+	reservationStore ports.ReservationStore,
+	facilityStore ports.FacilityStore,
+	userStore ports.UserStore,
+	log *slog.Logger,
+	sc *stripe.Client,
+) *OrganizationHandler {
+	log.With(slog.Group("Core_OrganizationHandler", slog.String("name", "organization")))
+	return &OrganizationHandler{
+		organizationStore: organizationStore,
+		reservationStore:  reservationStore,
+		facilityStore:     facilityStore,
+		userStore:         userStore,
+		log:               log,
+		sc:                sc,
+	}
+}
+
+// requireMember checks the caller belongs to the organization, and when manage
+// is set that they are an owner or manager. Site admins always pass.
+func (a *OrganizationHandler) requireMember(ctx context.Context, organizationID int64, manage bool) error {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return err
+	}
+	if user.Role == models.UserRoleADMIN {
+		return nil
+	}
+	role, err := a.organizationStore.GetMemberRole(ctx, organizationID, user.ID)
+	if err != nil {
+		return err
+	}
+	if role == "" || (manage && !role.CanManage()) {
+		return connect.NewError(connect.CodePermissionDenied, errNotOrgMember)
+	}
+	return nil
+}
+
+// requireOwner lets only owners, and site admins, grant or take away the
+// owner role, so managers cannot take an organization over.
+func (a *OrganizationHandler) requireOwner(ctx context.Context, organizationID int64) error {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return err
+	}
+	if user.Role == models.UserRoleADMIN {
+		return nil
+	}
+	role, err := a.organizationStore.GetMemberRole(ctx, organizationID, user.ID)
+	if err != nil {
+		return err
+	}
+	if role != models.OrganizationRoleOwner {
+		return connect.NewError(connect.CodePermissionDenied, errNotOrgOwner)
+	}
+	return nil
+}
+
+// requireOtherOwner refuses to demote or remove the organization's last owner.
+func (a *OrganizationHandler) requireOtherOwner(ctx context.Context, organizationID int64) error {
+	owners, err := a.organizationStore.CountOwners(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return connect.NewError(connect.CodeFailedPrecondition, errLastOwner)
+	}
+	return nil
+}
+
+func validOrganizationRole(role string) (models.OrganizationRole, error) {
+	for _, r := range models.AllOrganizationRoleValues() {
+		if r.String() == role {
+			return r, nil
+		}
+	}
+	return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown role %q", role))
+}
+
+func (a *OrganizationHandler) GetOrganizations(ctx context.Context, req *connect.Request[service.GetOrganizationsRequest]) (*connect.Response[service.GetOrganizationsResponse], error) {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []*service.UserOrganization
+	if req.Msg.GetAll() {
+		if user.Role != models.UserRoleADMIN {
+			return nil, connect.NewError(connect.CodePermissionDenied, errNotAdmin)
+		}
+		organizations, err := a.organizationStore.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range organizations {
+			result = append(result, &service.UserOrganization{Organization: organizations[i].ToProto()})
+		}
+	} else {
+		organizations, err := a.organizationStore.GetUserOrganizations(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range organizations {
+			result = append(result, organizations[i].ToProto())
+		}
+	}
+	return connect.NewResponse(&service.GetOrganizationsResponse{
+		Organizations: result,
+	}), nil
+}
+
+func (a *OrganizationHandler) GetOrganization(ctx context.Context, req *connect.Request[service.GetOrganizationRequest]) (*connect.Response[service.FullOrganization], error) {
+	if err := a.requireMember(ctx, req.Msg.GetId(), false); err != nil {
+		return nil, err
+	}
+	organization, err := a.organizationStore.Get(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if organization == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization %d not found", req.Msg.GetId()))
+	}
+	return connect.NewResponse(organization.ToProto()), nil
+}
+
+func (a *OrganizationHandler) CreateOrganization(ctx context.Context, req *connect.Request[service.CreateOrganizationRequest]) (*connect.Response[service.Organization], error) {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	organization := models.ToOrganization(req.Msg.GetOrganization())
+	if organization.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("organization name is required"))
+	}
+	id, err := a.organizationStore.Create(ctx, organization, user.ID)
+	if err != nil {
+		a.log.Error("Error creating organization", "error", err)
+		return nil, err
+	}
+	organization.ID = id
+	return connect.NewResponse(organization.ToProto()), nil
+}
+
+func (a *OrganizationHandler) UpdateOrganization(ctx context.Context, req *connect.Request[service.UpdateOrganizationRequest]) (*connect.Response[service.Organization], error) {
+	organization := models.ToOrganization(req.Msg.GetOrganization())
+	if err := a.requireMember(ctx, organization.ID, true); err != nil {
+		return nil, err
+	}
+	if err := a.organizationStore.Update(ctx, organization); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(organization.ToProto()), nil
+}
+
+func (a *OrganizationHandler) DeleteOrganization(ctx context.Context, req *connect.Request[service.DeleteOrganizationRequest]) (*connect.Response[service.DeleteOrganizationResponse], error) {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role != models.UserRoleADMIN {
+		role, err := a.organizationStore.GetMemberRole(ctx, req.Msg.GetId(), user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if role != models.OrganizationRoleOwner {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("only owners can delete an organization"))
+		}
+	}
+	if err := a.organizationStore.Delete(ctx, req.Msg.GetId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.DeleteOrganizationResponse{}), nil
+}
+
+func (a *OrganizationHandler) AddOrganizationMember(ctx context.Context, req *connect.Request[service.AddOrganizationMemberRequest]) (*connect.Response[service.OrganizationMember], error) {
+	organizationID := req.Msg.GetOrganizationId()
+	if err := a.requireMember(ctx, organizationID, true); err != nil {
+		return nil, err
+	}
+	role := models.OrganizationRoleMember
+	if req.Msg.GetRole() != "" {
+		r, err := validOrganizationRole(req.Msg.GetRole())
+		if err != nil {
+			return nil, err
+		}
+		role = r
+	}
+	if role == models.OrganizationRoleOwner {
+		if err := a.requireOwner(ctx, organizationID); err != nil {
+			return nil, err
+		}
+	}
+	user, err := a.userStore.GetByEmail(ctx, req.Msg.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no user with email %q", req.Msg.GetEmail()))
+	}
+	member := &models.OrganizationMember{
+		OrganizationID: organizationID,
+		UserID:         user.ID,
+		Role:           role,
+		UserName:       user.Name,
+		UserEmail:      user.Email,
+	}
+	id, err := a.organizationStore.AddMember(ctx, member)
+	if err != nil {
+		a.log.Error("Error adding organization member", "error", err)
+		return nil, err
+	}
+	if id == 0 {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s is already a member", user.Email))
+	}
+	member.ID = id
+	return connect.NewResponse(member.ToProto()), nil
+}
+
+func (a *OrganizationHandler) UpdateOrganizationMember(ctx context.Context, req *connect.Request[service.UpdateOrganizationMemberRequest]) (*connect.Response[service.OrganizationMember], error) {
+	member, err := a.organizationStore.GetMember(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("member %d not found", req.Msg.GetId()))
+	}
+	if err := a.requireMember(ctx, member.OrganizationID, true); err != nil {
+		return nil, err
+	}
+	role, err := validOrganizationRole(req.Msg.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	if role == member.Role {
+		return connect.NewResponse(member.ToProto()), nil
+	}
+	if role == models.OrganizationRoleOwner || member.Role == models.OrganizationRoleOwner {
+		if err := a.requireOwner(ctx, member.OrganizationID); err != nil {
+			return nil, err
+		}
+	}
+	if member.Role == models.OrganizationRoleOwner {
+		if err := a.requireOtherOwner(ctx, member.OrganizationID); err != nil {
+			return nil, err
+		}
+	}
+	if err := a.organizationStore.UpdateMemberRole(ctx, member.ID, role); err != nil {
+		return nil, err
+	}
+	member.Role = role
+	return connect.NewResponse(member.ToProto()), nil
+}
+
+func (a *OrganizationHandler) RemoveOrganizationMember(ctx context.Context, req *connect.Request[service.RemoveOrganizationMemberRequest]) (*connect.Response[service.RemoveOrganizationMemberResponse], error) {
+	member, err := a.organizationStore.GetMember(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("member %d not found", req.Msg.GetId()))
+	}
+	// members may always leave, otherwise managers only
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if member.UserID != user.ID {
+		if err := a.requireMember(ctx, member.OrganizationID, true); err != nil {
+			return nil, err
+		}
+	}
+	if member.Role == models.OrganizationRoleOwner {
+		if member.UserID != user.ID {
+			if err := a.requireOwner(ctx, member.OrganizationID); err != nil {
+				return nil, err
+			}
+		}
+		if err := a.requireOtherOwner(ctx, member.OrganizationID); err != nil {
+			return nil, err
+		}
+	}
+	if err := a.organizationStore.RemoveMember(ctx, member.ID); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.RemoveOrganizationMemberResponse{}), nil
+}
+
+func (a *OrganizationHandler) DeleteOrganizationInsurance(ctx context.Context, req *connect.Request[service.DeleteOrganizationInsuranceRequest]) (*connect.Response[service.DeleteOrganizationInsuranceResponse], error) {
+	insurance, err := a.organizationStore.GetInsurance(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if insurance == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("insurance %d not found", req.Msg.GetId()))
+	}
+	if err := a.requireMember(ctx, insurance.OrganizationID, true); err != nil {
+		return nil, err
+	}
+	if err := a.organizationStore.DeleteInsurance(ctx, insurance.ID); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.DeleteOrganizationInsuranceResponse{}), nil
+}
+
+func (a *OrganizationHandler) GetOrganizationReservations(ctx context.Context, req *connect.Request[service.GetOrganizationReservationsRequest]) (*connect.Response[service.GetOrganizationReservationsResponse], error) {
+	organizationID := req.Msg.GetOrganizationId()
+	if err := a.requireMember(ctx, organizationID, false); err != nil {
+		return nil, err
+	}
+	reservations, err := a.reservationStore.GetOrganizationReservations(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	facilities, err := a.facilityStore.GetAllFacilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	facName := make(map[int64]string, len(facilities))
+	for _, f := range facilities {
+		facName[f.ID] = f.Name
+	}
+	result := make([]*service.OrganizationReservation, 0, len(reservations))
+	for _, r := range reservations {
+		sort.Slice(r.Dates, func(i, j int) bool {
+			return r.Dates[i].LocalStart.Time.Before(r.Dates[j].LocalStart.Time)
+		})
+		var firstDate string
+		if len(r.Dates) > 0 {
+			firstDate = utils.PgTimestampToString(r.Dates[0].LocalStart)
+		}
+		result = append(result, &service.OrganizationReservation{
+			ReservationId:   r.Reservation.ID,
+			EventName:       r.Reservation.EventName,
+			FacilityName:    facName[r.Reservation.FacilityID],
+			Approved:        r.Reservation.Approved.String(),
+			ReservationDate: firstDate,
+			UserName:        r.Reservation.Name,
+			Paid:            r.Reservation.Paid,
+		})
+	}
+	return connect.NewResponse(&service.GetOrganizationReservationsResponse{
+		Reservations: result,
+	}), nil
+}
+
+// GetOrganizationReport totals booked hours and cost across the organization's
+// reservations. Denied and canceled reservations or dates are not counted.
+func (a *OrganizationHandler) GetOrganizationReport(ctx context.Context, req *connect.Request[service.GetOrganizationReportRequest]) (*connect.Response[service.OrganizationReport], error) {
+	organizationID := req.Msg.GetOrganizationId()
+	if err := a.requireMember(ctx, organizationID, false); err != nil {
+		return nil, err
+	}
+	var from, to time.Time
+	if req.Msg.GetStartDate() != "" {
+		t, err := time.Parse(time.DateOnly, req.Msg.GetStartDate())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		from = t
+	}
+	if req.Msg.GetEndDate() != "" {
+		t, err := time.Parse(time.DateOnly, req.Msg.GetEndDate())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		to = t.AddDate(0, 0, 1)
+	}
+
+	reservations, err := a.reservationStore.GetOrganizationReservations(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	facilities, err := a.facilityStore.GetAllFacilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	facName := make(map[int64]string, len(facilities))
+	for _, f := range facilities {
+		facName[f.ID] = f.Name
+	}
+
+	prices := make(map[string]*stripe.Price)
+	categories := make(map[int64]*models.Category)
+	usage := make(map[int64]*service.FacilityUsage)
+	spentByFacility := make(map[int64]int64)
+	var totalHours float64
+	var totalSpent, totalPaid, count int64
+
+	for _, r := range reservations {
+		if r.Reservation.Approved == models.ReservationApprovedDenied || r.Reservation.Approved == models.ReservationApprovedCanceled {
+			continue
+		}
+		dates := make([]models.ReservationDate, 0, len(r.Dates))
+		var hours float64
+		for _, d := range r.Dates {
+			if d.Approved == models.ReservationDateApprovedDenied || d.Approved == models.ReservationDateApprovedCanceled {
+				continue
+			}
+			if !from.IsZero() && d.LocalStart.Time.Before(from) {
+				continue
+			}
+			if !to.IsZero() && !d.LocalStart.Time.Before(to) {
+				continue
+			}
+			dates = append(dates, d)
+			hours += d.LocalEnd.Time.Sub(d.LocalStart.Time).Hours()
+		}
+		if len(dates) == 0 {
+			continue
+		}
+		r.Dates = dates
+		cents := a.reservationCostCents(ctx, &r, prices, categories)
+
+		count++
+		totalHours += hours
+		totalSpent += cents
+		if r.Reservation.Paid {
+			totalPaid += cents
+		}
+		u, ok := usage[r.Reservation.FacilityID]
+		if !ok {
+			u = &service.FacilityUsage{
+				FacilityId:   r.Reservation.FacilityID,
+				FacilityName: facName[r.Reservation.FacilityID],
+			}
+			usage[r.Reservation.FacilityID] = u
+		}
+		u.Hours += hours
+		u.Reservations++
+		spentByFacility[r.Reservation.FacilityID] += cents
+	}
+
+	facilityUsage := make([]*service.FacilityUsage, 0, len(usage))
+	for id, u := range usage {
+		u.Spent = models.CentsToString(spentByFacility[id])
+		facilityUsage = append(facilityUsage, u)
+	}
+	sort.Slice(facilityUsage, func(i, j int) bool {
+		return facilityUsage[i].Hours > facilityUsage[j].Hours
+	})
+
+	return connect.NewResponse(&service.OrganizationReport{
+		OrganizationId:    organizationID,
+		TotalReservations: count,
+		TotalHours:        totalHours,
+		TotalSpent:        models.CentsToString(totalSpent),
+		TotalPaid:         models.CentsToString(totalPaid),
+		Facilities:        facilityUsage,
+	}), nil
+}
+
+// reservationCostCents prefers the admin cost override and otherwise runs the
+// same reducer used for checkout. Lookup failures are logged and counted as 0.
+func (a *OrganizationHandler) reservationCostCents(ctx context.Context, r *models.FullReservation, prices map[string]*stripe.Price, categories map[int64]*models.Category) int64 {
+	if r.Reservation.CostOverride.Valid {
+		return int64(math.Round(utils.PGNumericToFloat64(r.Reservation.CostOverride) * 100))
+	}
+	if !r.Reservation.PriceID.Valid {
+		return 0
+	}
+	price, ok := prices[r.Reservation.PriceID.String]
+	if !ok {
+		p, err := a.sc.V1Prices.Retrieve(ctx, r.Reservation.PriceID.String, nil)
+		if err != nil {
+			a.log.Error("error getting price from stripe", "price_id", r.Reservation.PriceID.String, "error", err)
+			return 0
+		}
+		prices[r.Reservation.PriceID.String] = p
+		price = p
+	}
+	category, ok := categories[r.Reservation.CategoryID]
+	if !ok {
+		c, err := a.facilityStore.GetCategory(ctx, r.Reservation.CategoryID)
+		if err != nil {
+			a.log.Error("error getting category", "category_id", r.Reservation.CategoryID, "error", err)
+			return 0
+		}
+		categories[r.Reservation.CategoryID] = c
+		category = c
+	}
+	cost, err := reducer(ctx, category, r, price)
+	if err != nil {
+		a.log.Error("error reducing cost", "reservation_id", r.Reservation.ID, "error", err)
+		return 0
+	}
+	return int64(math.Round(utils.StringToFloat64(cost) * 100))
+}
@@ -6,9 +6,14 @@ import (
 	"api/internal/ports"
 	service "api/internal/proto/payments"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -18,18 +23,30 @@ import (
 type PaymentHandler struct {
 	log              *slog.Logger
 	config           *config.Config
+	timezone         *time.Location
 	facilityStore    ports.FacilityStore
 	reservationStore ports.ReservationStore
+	paymentStore     ports.PaymentStore
+	userStore        ports.UserStore
+	refunds          *refunder
+	invoices         *invoicer
 	sc               *stripe.Client
+	methods          []paymentMethod
 }
 
-func NewPaymentHandler(log *slog.Logger, config *config.Config, facilityStore ports.FacilityStore, reservationStore ports.ReservationStore, sc *stripe.Client) *PaymentHandler {
+func NewPaymentHandler(log *slog.Logger, config *config.Config, timezone *time.Location, facilityStore ports.FacilityStore, reservationStore ports.ReservationStore, paymentStore ports.PaymentStore, userStore ports.UserStore, refunds *refunder, invoices *invoicer, sc *stripe.Client) *PaymentHandler {
 	return &PaymentHandler{
 		log:              log,
 		config:           config,
+		timezone:         timezone,
 		facilityStore:    facilityStore,
 		reservationStore: reservationStore,
+		paymentStore:     paymentStore,
+		userStore:        userStore,
+		refunds:          refunds,
+		invoices:         invoices,
 		sc:               sc,
+		methods:          newPaymentMethods(config.PaymentMethods, config, paymentStore, sc, log),
 	}
 }
 
@@ -71,17 +88,18 @@ func (p *PaymentHandler) CreatePaymentIntent(ctx context.Context, req *connect.R
 		AutomaticPaymentMethods: &stripe.PaymentIntentCreateAutomaticPaymentMethodsParams{
 			Enabled: stripe.Bool(true),
 		},
+		Metadata: reservationMetadataFor(reservationID),
 	}
 	pi, err := p.sc.V1PaymentIntents.Create(ctx, params)
 	if err != nil {
 		p.log.Error("failed to create payment intent", "error", err)
 		return nil, err
 	}
-	// err = p.reservationStore.UpdatePaymentIntent(ctx, reservationID, pi.ID)
-	// if err != nil {
-	// 	p.log.Error("failed to update payment intent", "error", err)
-	// 	return nil, err
-	// }
+	err = p.reservationStore.UpdatePaymentIntent(ctx, reservationID, pi.ID)
+	if err != nil {
+		p.log.Error("failed to update payment intent", "error", err)
+		return nil, err
+	}
 	return connect.NewResponse(&service.CreatePaymentIntentResponse{
 		ClientSecret: pi.ClientSecret,
 	}), nil
@@ -93,54 +111,185 @@ func (p *PaymentHandler) GetStripePublicKey(ctx context.Context, req *connect.Re
 	}), nil
 }
 
+// CreatePaymentSession starts a checkout for what the reservation owes now:
+// the category's deposit for a booking not yet paid for, unless the requester
+// pays in full, or else the balance. The requester and the building's admins
+// may start one.
 func (p *PaymentHandler) CreatePaymentSession(ctx context.Context, req *connect.Request[service.CreatePaymentIntentRequest]) (*connect.Response[service.CreatePaymentSessionResponse], error) {
+	method := p.method(stripeMethod)
+	if method == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("card payments are not offered"))
+	}
 	reservation, err := p.reservationStore.Get(ctx, req.Msg.ReservationId)
 	if err != nil {
 		p.log.Error("failed to get reservation", "error", err)
 		return nil, err
 	}
+	if reservation == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.ReservationId))
+	}
+	if err := requireRequesterOrFacility(ctx, p.userStore, p.facilityStore, &reservation.Reservation); err != nil {
+		return nil, err
+	}
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payment := &duePayment{
+		reservation: reservation,
+		payer:       sql.NullString{String: user.ID, Valid: true},
+	}
+
+	started, err := p.startPayment(ctx, method, payment, req.Msg.GetPayInFull())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.CreatePaymentSessionResponse{
+		Url:    started.Url,
+		Amount: started.Amount,
+		Kind:   started.Kind,
+	}), nil
+}
+
+// ListPaymentMethods lists the ways to pay, in the order they are offered.
+func (p *PaymentHandler) ListPaymentMethods(ctx context.Context, req *connect.Request[service.ListPaymentMethodsRequest]) (*connect.Response[service.ListPaymentMethodsResponse], error) {
+	res := &service.ListPaymentMethodsResponse{Methods: make([]*service.PaymentMethod, len(p.methods))}
+	for i, m := range p.methods {
+		res.Methods[i] = m.info()
+	}
+	return connect.NewResponse(res), nil
+}
 
-	var total time.Duration
-	for _, date := range reservation.Dates {
-		if date.Approved == models.ReservationDateApprovedDenied || date.Approved == models.ReservationDateApprovedCanceled {
-			continue
+// StartPayment pays what the reservation owes now with the chosen method.
+// Stripe returns a checkout to redirect to; a check, purchase order or
+// internal transfer is recorded as pending verification by an admin. The
+// requester and the building's admins may start one.
+func (p *PaymentHandler) StartPayment(ctx context.Context, req *connect.Request[service.StartPaymentRequest]) (*connect.Response[service.StartPaymentResponse], error) {
+	method := p.method(req.Msg.GetMethod())
+	if method == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("payment method %q is not offered", req.Msg.GetMethod()))
+	}
+	payment := &duePayment{
+		reference: strings.TrimSpace(req.Msg.GetReference()),
+		note:      req.Msg.GetNote(),
+	}
+	if req.Msg.GetAmount() != "" {
+		if !method.info().Manual {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("an amount can only be given for manual payment methods"))
 		}
-		start := date.LocalStart.Time
-		end := date.LocalEnd.Time
-		if end.Before(start) {
-			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		cents, err := parseCents(req.Msg.GetAmount())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
-		total += end.Sub(start)
+		if cents <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid amount %q", req.Msg.GetAmount()))
+		}
+		payment.cents = cents
 	}
-	totalHours := max(int64(total/time.Hour), 1)
-
-	domain := p.config.FrontendUrl
-	success := fmt.Sprintf("%s/reservation/%d/success", domain, reservation.Reservation.ID)
-	params := &stripe.CheckoutSessionCreateParams{
-		SuccessURL: stripe.String(success + "?session_id={CHECKOUT_SESSION_ID}"),
-		CancelURL:  stripe.String(domain + fmt.Sprintf("/reservation/%d", reservation.Reservation.ID)),
-		LineItems: []*stripe.CheckoutSessionCreateLineItemParams{
-			{
-				Price:    stripe.String(reservation.Reservation.PriceID.String),
-				Quantity: stripe.Int64(totalHours),
-			},
-		},
-		Mode: stripe.String(string(stripe.CheckoutSessionModePayment)),
+	reservation, err := p.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
 	}
+	if err := requireRequesterOrFacility(ctx, p.userStore, p.facilityStore, &reservation.Reservation); err != nil {
+		return nil, err
+	}
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payment.reservation = reservation
+	payment.payer = sql.NullString{String: user.ID, Valid: true}
 
-	s, err := p.sc.V1CheckoutSessions.Create(ctx, params)
+	started, err := p.startPayment(ctx, method, payment, req.Msg.GetPayInFull())
 	if err != nil {
-		p.log.Error("failed to create checkout session", "error", err)
 		return nil, err
 	}
+	return connect.NewResponse(started), nil
+}
 
-	return connect.NewResponse(&service.CreatePaymentSessionResponse{
-		Url: s.URL,
-	}), nil
+// method finds an offered payment method by id.
+func (p *PaymentHandler) method(id string) paymentMethod {
+	for _, m := range p.methods {
+		if m.info().Id == id {
+			return m
+		}
+	}
+	return nil
+}
 
+// startPayment works out what the reservation owes now and hands it to the
+// method. A manual payment keeps its own amount when it has one. Nothing can
+// be started while a submission awaits verification, so the same balance is
+// never paid twice.
+func (p *PaymentHandler) startPayment(ctx context.Context, method paymentMethod, payment *duePayment, payInFull bool) (*service.StartPaymentResponse, error) {
+	reservation := payment.reservation
+	pending, err := p.paymentStore.GetPaymentSubmissions(ctx, reservation.Reservation.ID, models.PaymentSubmissionStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s %s on reservation %d is awaiting verification", manualMethodLabels[pending[0].Method].reference, pending[0].Reference, reservation.Reservation.ID))
+	}
+	entries, err := p.paymentStore.GetPaymentEntries(ctx, reservation.Reservation.ID)
+	if err != nil {
+		p.log.Error("failed to get ledger", "reservation_id", reservation.Reservation.ID, "error", err)
+		return nil, err
+	}
+	schedule, err := p.schedule(ctx, reservation, entries)
+	if err != nil {
+		p.log.Error("failed to calculate cost", "error", err)
+		return nil, err
+	}
+	kind, cents := schedule.next(payInFull, localToday(p.timezone))
+	if cents <= 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("nothing is due on reservation %d", reservation.Reservation.ID))
+	}
+	payment.kind = kind
+	if payment.cents == 0 {
+		payment.cents = cents
+	}
+
+	info := method.info()
+	started, err := method.start(ctx, payment)
+	if err != nil {
+		p.log.Error("failed to start payment", "reservation_id", reservation.Reservation.ID, "method", info.Id, "error", err)
+		return nil, err
+	}
+	if !info.Manual {
+		return started, nil
+	}
+	if _, err := p.syncPaymentStatus(ctx, reservation); err != nil {
+		p.log.Error("failed to update reservation", "error", err)
+		return nil, err
+	}
+	if err := notifyPaymentAdmins(ctx, p.facilityStore, p.userStore, p.config, reservation, models.PaymentStatusPending.String()); err != nil {
+		p.log.Error("failed to notify admins", "reservation_id", reservation.Reservation.ID, "error", err)
+	}
+	return started, nil
 }
 
+// ValidatePaymentSession records a paid checkout session when the browser
+// returns from Stripe, in case it arrives before the webhook. The session must
+// belong to the reservation, and only its requester or the building's admins
+// may validate it.
 func (p *PaymentHandler) ValidatePaymentSession(ctx context.Context, req *connect.Request[service.ValidatePaymentSessionRequest]) (*connect.Response[service.ValidatePaymentSessionResponse], error) {
+	reservation, err := p.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		p.log.Error("failed to get reservation", "error", err)
+		return nil, err
+	}
+	if reservation == nil {
+		return connect.NewResponse(&service.ValidatePaymentSessionResponse{
+			Valid: false,
+		}), nil
+	}
+	if err := requireRequesterOrFacility(ctx, p.userStore, p.facilityStore, &reservation.Reservation); err != nil {
+		return nil, err
+	}
+
 	s, err := p.sc.V1CheckoutSessions.Retrieve(ctx, req.Msg.SessionId, nil)
 	if err != nil {
 		p.log.Error("failed to get checkout session", "error", err)
@@ -149,34 +298,409 @@ func (p *PaymentHandler) ValidatePaymentSession(ctx context.Context, req *connec
 			Valid: false,
 		}), nil
 	}
-
+	if !sessionFor(s, reservation.Reservation.ID) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("checkout session %s is not for reservation %d", s.ID, reservation.Reservation.ID))
+	}
 	if s.PaymentStatus != stripe.CheckoutSessionPaymentStatusPaid {
 		return connect.NewResponse(&service.ValidatePaymentSessionResponse{
 			Valid: false,
 		}), nil
 	}
 
+	// the session stands in for its webhook event, so validating it again
+	// changes nothing
+	change := &models.StripeEvent{ID: s.ID, Type: string(stripe.EventTypeCheckoutSessionCompleted), ReservationID: reservation.Reservation.ID}
+	checkoutCharge(change, s)
+	if _, err := p.paymentStore.RecordStripeEvent(ctx, change); err != nil {
+		p.log.Error("failed to record checkout session", "reservation_id", reservation.Reservation.ID, "error", err)
+		return nil, err
+	}
+
+	return connect.NewResponse(&service.ValidatePaymentSessionResponse{
+		Valid: true,
+	}), nil
+}
+
+// GetReservationLedger lists a reservation's payments with its total,
+// deposit and balance due. The requester and the building's admins may see it.
+func (p *PaymentHandler) GetReservationLedger(ctx context.Context, req *connect.Request[service.GetReservationLedgerRequest]) (*connect.Response[service.GetReservationLedgerResponse], error) {
 	reservation, err := p.reservationStore.Get(ctx, req.Msg.GetReservationId())
 	if err != nil {
-		p.log.Error("failed to get reservation", "error", err)
 		return nil, err
 	}
+	if reservation == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
+	}
+	if err := requireRequesterOrFacility(ctx, p.userStore, p.facilityStore, &reservation.Reservation); err != nil {
+		return nil, err
+	}
+
+	entries, err := p.paymentStore.GetPaymentEntries(ctx, reservation.Reservation.ID)
+	if err != nil {
+		p.log.Error("failed to get ledger", "reservation_id", reservation.Reservation.ID, "error", err)
+		return nil, err
+	}
+	schedule, err := p.schedule(ctx, reservation, entries)
+	if err != nil {
+		p.log.Error("failed to calculate cost", "error", err)
+		return nil, err
+	}
+	res := &service.GetReservationLedgerResponse{
+		Entries: make([]*service.LedgerEntry, len(entries)),
+		Total:   models.CentsToString(schedule.total),
+		Paid:    models.CentsToString(schedule.paid),
+		Balance: models.CentsToString(schedule.balance()),
+		Deposit: models.CentsToString(schedule.deposit),
+		Overdue: reservation.Reservation.PaymentOverdue,
+	}
+	if !schedule.due.IsZero() {
+		res.BalanceDueDate = schedule.due.Format("2006-01-02")
+	}
+	for i := range entries {
+		res.Entries[i] = entries[i].ToProto()
+	}
+	return connect.NewResponse(res), nil
+}
 
+// RecordManualPayment adds an offline payment or an adjustment to a
+// reservation's ledger, already verified by the admin recording it.
+func (p *PaymentHandler) RecordManualPayment(ctx context.Context, req *connect.Request[service.RecordManualPaymentRequest]) (*connect.Response[service.RecordManualPaymentResponse], error) {
+	kind := models.PaymentEntryKind(req.Msg.GetKind())
+	if kind != models.PaymentEntryKindOffline && kind != models.PaymentEntryKindAdjustment {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("kind must be %s or %s", models.PaymentEntryKindOffline, models.PaymentEntryKindAdjustment))
+	}
+	cents, err := parseCents(req.Msg.GetAmount())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if cents == 0 || (kind == models.PaymentEntryKindOffline && cents < 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid amount %q", req.Msg.GetAmount()))
+	}
+	reservation, err := p.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
 	if reservation == nil {
-		return connect.NewResponse(&service.ValidatePaymentSessionResponse{
-			Valid: false,
-		}), nil
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
+	}
+	if err := requireFacility(ctx, p.userStore, p.facilityStore, reservation.Reservation.FacilityID); err != nil {
+		return nil, err
+	}
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := p.paymentStore.CreatePaymentEntry(ctx, &models.PaymentEntry{
+		ReservationID: reservation.Reservation.ID,
+		Kind:          kind,
+		AmountCents:   cents,
+		Provider:      models.PaymentProviderManual,
+		ProviderRef:   models.CheckNullString(req.Msg.GetReference()),
+		ActorID:       sql.NullString{String: user.ID, Valid: true},
+		Note:          models.CheckNullString(req.Msg.GetNote()),
+	})
+	if err != nil {
+		p.log.Error("failed to record payment", "reservation_id", reservation.Reservation.ID, "error", err)
+		return nil, err
+	}
+
+	schedule, err := p.syncPaymentStatus(ctx, reservation)
+	if err != nil {
+		p.log.Error("failed to update reservation", "error", err)
+		return nil, err
+	}
+	if kind == models.PaymentEntryKindOffline {
+		p.invoices.issueReceipt(ctx, reservation.Reservation.ID)
+	}
+	return connect.NewResponse(&service.RecordManualPaymentResponse{
+		Entry:   entry.ToProto(),
+		Balance: models.CentsToString(schedule.balance()),
+	}), nil
+}
+
+// RefundPayment refunds Stripe payments of a reservation, all that is
+// refundable when no amount is given, and emails the requester.
+func (p *PaymentHandler) RefundPayment(ctx context.Context, req *connect.Request[service.RefundPaymentRequest]) (*connect.Response[service.RefundPaymentResponse], error) {
+	var cents int64
+	if req.Msg.GetAmount() != "" {
+		var err error
+		cents, err = parseCents(req.Msg.GetAmount())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if cents <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid amount %q", req.Msg.GetAmount()))
+		}
+	}
+	reservation, err := p.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
+	}
+	if err := requireFacility(ctx, p.userStore, p.facilityStore, reservation.Reservation.FacilityID); err != nil {
+		return nil, err
+	}
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, refunded, err := p.refunds.refund(ctx, &reservation.Reservation, cents, sql.NullString{String: user.ID, Valid: true}, req.Msg.GetNote())
+	if err != nil {
+		p.log.Error("failed to refund", "reservation_id", reservation.Reservation.ID, "error", err)
+		return nil, refundError(err)
+	}
+	ledger, err := p.paymentStore.GetPaymentEntries(ctx, reservation.Reservation.ID)
+	if err != nil {
+		return nil, err
+	}
+	total, err := reservationTotalCents(ctx, p.facilityStore, p.sc, reservation)
+	if err != nil {
+		p.log.Error("failed to calculate cost", "error", err)
+		return nil, err
 	}
-	if !reservation.Reservation.Paid {
-		reservation.Reservation.Paid = true
-		err = p.reservationStore.Update(ctx, &reservation.Reservation)
+	res := &service.RefundPaymentResponse{
+		Entries:  make([]*service.LedgerEntry, len(entries)),
+		Refunded: models.CentsToString(refunded),
+		Balance:  models.CentsToString(total - ledgerCents(ledger)),
+	}
+	for i := range entries {
+		res.Entries[i] = entries[i].ToProto()
+	}
+	return connect.NewResponse(res), nil
+}
+
+// IssueInvoice issues an invoice or receipt PDF for a reservation and emails
+// it. Asking again before anything changed returns the latest one.
+func (p *PaymentHandler) IssueInvoice(ctx context.Context, req *connect.Request[service.IssueInvoiceRequest]) (*connect.Response[service.IssueInvoiceResponse], error) {
+	kind := models.InvoiceKind(req.Msg.GetKind())
+	if !slices.Contains(models.AllInvoiceKindValues(), kind) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("kind must be %s or %s", models.InvoiceKindInvoice, models.InvoiceKindReceipt))
+	}
+	reservation, err := p.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
+	}
+	if err := requireRequesterOrFacility(ctx, p.userStore, p.facilityStore, &reservation.Reservation); err != nil {
+		return nil, err
+	}
+
+	invoice, created, err := p.invoices.issue(ctx, reservation, kind)
+	if err != nil {
+		if errors.Is(err, errNothingToInvoice) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		p.log.Error("failed to issue invoice", "reservation_id", reservation.Reservation.ID, "kind", kind, "error", err)
+		return nil, err
+	}
+	return connect.NewResponse(&service.IssueInvoiceResponse{
+		Invoice: invoice.ToProto(p.invoices.url(invoice)),
+		Created: created,
+	}), nil
+}
+
+// ListInvoices lists a reservation's invoices and receipts, oldest first.
+func (p *PaymentHandler) ListInvoices(ctx context.Context, req *connect.Request[service.ListInvoicesRequest]) (*connect.Response[service.ListInvoicesResponse], error) {
+	reservation, err := p.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
+	}
+	if err := requireRequesterOrFacility(ctx, p.userStore, p.facilityStore, &reservation.Reservation); err != nil {
+		return nil, err
+	}
+	list, err := p.invoices.invoiceStore.GetInvoices(ctx, reservation.Reservation.ID)
+	if err != nil {
+		return nil, err
+	}
+	res := &service.ListInvoicesResponse{Invoices: make([]*service.Invoice, len(list))}
+	for i := range list {
+		res.Invoices[i] = list[i].ToProto(p.invoices.url(&list[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListPaymentSubmissions lists checks, purchase orders and internal
+// transfers, oldest first. For one reservation the requester and the
+// building's admins may see them; without one it is the admin's queue across
+// the buildings they manage.
+func (p *PaymentHandler) ListPaymentSubmissions(ctx context.Context, req *connect.Request[service.ListPaymentSubmissionsRequest]) (*connect.Response[service.ListPaymentSubmissionsResponse], error) {
+	status := models.PaymentSubmissionStatus(req.Msg.GetStatus())
+	if status != "" && !slices.Contains(models.AllPaymentSubmissionStatusValues(), status) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid status %q", req.Msg.GetStatus()))
+	}
+	allowed := func(int64) bool { return true }
+	if id := req.Msg.GetReservationId(); id != 0 {
+		reservation, err := p.reservationStore.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if reservation == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", id))
+		}
+		if err := requireRequesterOrFacility(ctx, p.userStore, p.facilityStore, &reservation.Reservation); err != nil {
+			return nil, err
+		}
+	} else {
+		scope, err := callerScope(ctx, p.userStore)
+		if err != nil {
+			return nil, err
+		}
+		allowed, err = scope.facilityFilter(ctx, p.facilityStore)
 		if err != nil {
-			p.log.Error("failed to update reservation", "error", err)
 			return nil, err
 		}
 	}
 
-	return connect.NewResponse(&service.ValidatePaymentSessionResponse{
-		Valid: true,
-	}), nil
+	submissions, err := p.paymentStore.GetPaymentSubmissions(ctx, req.Msg.GetReservationId(), status)
+	if err != nil {
+		p.log.Error("failed to get payment submissions", "error", err)
+		return nil, err
+	}
+	res := &service.ListPaymentSubmissionsResponse{Submissions: make([]*service.PaymentSubmission, 0, len(submissions))}
+	for i := range submissions {
+		if allowed(submissions[i].FacilityID) {
+			res.Submissions = append(res.Submissions, submissions[i].ToProto())
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ReviewPaymentSubmission verifies or rejects a pending check, purchase
+// order or internal transfer. A verified one goes on the ledger as an
+// offline payment and a receipt is issued; either way the reservation's
+// payment status is brought up to date.
+func (p *PaymentHandler) ReviewPaymentSubmission(ctx context.Context, req *connect.Request[service.ReviewPaymentSubmissionRequest]) (*connect.Response[service.ReviewPaymentSubmissionResponse], error) {
+	submission, err := p.paymentStore.GetPaymentSubmission(ctx, req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, err
+	}
+	if submission == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("payment submission %d not found", req.Msg.GetSubmissionId()))
+	}
+	if err := requireFacility(ctx, p.userStore, p.facilityStore, submission.FacilityID); err != nil {
+		return nil, err
+	}
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reviewer := sql.NullString{String: user.ID, Valid: true}
+
+	status := models.PaymentSubmissionStatusRejected
+	var entry *models.PaymentEntry
+	if req.Msg.GetVerified() {
+		status = models.PaymentSubmissionStatusVerified
+		note := manualMethodLabels[submission.Method].name
+		if req.Msg.GetNote() != "" {
+			note += ": " + req.Msg.GetNote()
+		}
+		entry = &models.PaymentEntry{
+			ReservationID: submission.ReservationID,
+			Kind:          models.PaymentEntryKindOffline,
+			AmountCents:   submission.AmountCents,
+			Provider:      models.PaymentProviderManual,
+			ProviderRef:   models.CheckNullString(submission.Reference),
+			ActorID:       reviewer,
+			Note:          models.CheckNullString(note),
+		}
+	}
+	reviewed, created, err := p.paymentStore.ReviewPaymentSubmission(ctx, submission.ID, status, reviewer, models.CheckNullString(req.Msg.GetNote()), entry)
+	if err != nil {
+		p.log.Error("failed to review payment submission", "submission_id", submission.ID, "error", err)
+		return nil, err
+	}
+	if reviewed == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("payment submission %d was already %s", submission.ID, submission.Status))
+	}
+
+	reservation, err := p.reservationStore.Get(ctx, submission.ReservationID)
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", submission.ReservationID))
+	}
+	schedule, err := p.syncPaymentStatus(ctx, reservation)
+	if err != nil {
+		p.log.Error("failed to update reservation", "error", err)
+		return nil, err
+	}
+	res := &service.ReviewPaymentSubmissionResponse{
+		Submission: reviewed.ToProto(),
+		Balance:    models.CentsToString(schedule.balance()),
+	}
+	if created != nil {
+		res.Entry = created.ToProto()
+		p.invoices.issueReceipt(ctx, reservation.Reservation.ID)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// syncPaymentStatus sets the payment status the ledger calls for: paid once
+// the balance is settled, pending verification while a submission waits,
+// deposit paid once the deposit is covered, and back to unpaid when a
+// rejected submission leaves nothing else. A paid reservation is left as is.
+func (p *PaymentHandler) syncPaymentStatus(ctx context.Context, reservation *models.FullReservation) (*paymentSchedule, error) {
+	entries, err := p.paymentStore.GetPaymentEntries(ctx, reservation.Reservation.ID)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := p.schedule(ctx, reservation, entries)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := p.paymentStore.GetPaymentSubmissions(ctx, reservation.Reservation.ID, models.PaymentSubmissionStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	var status models.PaymentStatus
+	switch {
+	case reservation.Reservation.Paid:
+	case schedule.balance() <= 0:
+		status = models.PaymentStatusPaid
+	case len(pending) > 0:
+		status = models.PaymentStatusPending
+	case schedule.deposit > 0 && schedule.paid >= schedule.deposit:
+		status = models.PaymentStatusDepositPaid
+	case reservation.Reservation.PaymentStatus == models.PaymentStatusPending:
+		status = models.PaymentStatusUnpaid
+	}
+	if status != "" {
+		if err := p.paymentStore.UpdatePaymentStatus(ctx, reservation.Reservation.ID, status, ""); err != nil {
+			return nil, err
+		}
+	}
+	return schedule, nil
+}
+
+// ledgerCents is how much the entries take off the balance due.
+func ledgerCents(entries []models.PaymentEntry) int64 {
+	var cents int64
+	for _, e := range entries {
+		cents += e.AmountCents
+	}
+	return cents
+}
+
+// parseCents reads a dollar amount such as "12.50".
+func parseCents(amount string) (int64, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(amount), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+	return int64(math.Round(f * 100)), nil
+}
+
+// reservationMetadataFor tags a Stripe object with its reservation.
+func reservationMetadataFor(id int64) map[string]string {
+	return map[string]string{reservationMetadata: strconv.FormatInt(id, 10)}
 }
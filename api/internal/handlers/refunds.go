@@ -0,0 +1,313 @@
+package handlers
+
+import (
+	"api/internal/config"
+	"api/internal/lib/emails"
+	"api/internal/lib/utils"
+	"api/internal/models"
+	"api/internal/ports"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/stripe/stripe-go/v83"
+)
+
+var (
+	errNothingToRefund = errors.New("no refundable stripe payment")
+	errRefundTooLarge  = errors.New("refund is more than what is refundable")
+)
+
+// refunder returns Stripe payments of a reservation and records the refunds
+// in its ledger. The charge.refunded webhook later finds them already there.
+type refunder struct {
+	sc               *stripe.Client
+	paymentStore     ports.PaymentStore
+	reservationStore ports.ReservationStore
+	facilityStore    ports.FacilityStore
+	userStore        ports.UserStore
+	config           *config.Config
+	log              *slog.Logger
+}
+
+func newRefunder(sc *stripe.Client, paymentStore ports.PaymentStore, reservationStore ports.ReservationStore, facilityStore ports.FacilityStore, userStore ports.UserStore, config *config.Config, log *slog.Logger) *refunder {
+	return &refunder{
+		sc:               sc,
+		paymentStore:     paymentStore,
+		reservationStore: reservationStore,
+		facilityStore:    facilityStore,
+		userStore:        userStore,
+		config:           config,
+		log:              log,
+	}
+}
+
+// refundable is what is left to refund of one payment intent.
+type refundable struct {
+	paymentIntent string
+	cents         int64
+}
+
+// refund returns up to cents of the reservation's Stripe payments, newest
+// payment first; cents of 0 refunds everything refundable. It returns the
+// ledger entries written and the total refunded.
+func (r *refunder) refund(ctx context.Context, res *models.Reservation, cents int64, actor sql.NullString, note string) ([]models.PaymentEntry, int64, error) {
+	payments, err := r.refundable(ctx, res)
+	if err != nil {
+		return nil, 0, err
+	}
+	available := refundableCents(payments)
+	if available == 0 {
+		return nil, 0, errNothingToRefund
+	}
+	if cents == 0 {
+		cents = available
+	}
+	if cents > available {
+		return nil, 0, fmt.Errorf("%w: only %s is refundable", errRefundTooLarge, models.CentsToString(available))
+	}
+	entries, refunded, err := r.issue(ctx, res, payments, cents, actor, note)
+	if err != nil {
+		return nil, 0, err
+	}
+	r.settle(ctx, res, refunded, available)
+	return entries, refunded, nil
+}
+
+// settle records the payment status after refunding part or all of what
+// was available, and tells the requester.
+func (r *refunder) settle(ctx context.Context, res *models.Reservation, refunded, available int64) {
+	status := models.PaymentStatusPartiallyRefunded
+	if refunded == available {
+		status = models.PaymentStatusRefunded
+	}
+	if err := r.paymentStore.UpdatePaymentStatus(ctx, res.ID, status, ""); err != nil {
+		r.log.Error("failed to update payment status", "reservation_id", res.ID, "error", err)
+	}
+	r.notify(ctx, res, refunded)
+}
+
+// issue refunds cents from the payments in order. It stops at the first
+// failure, keeping the refunds already made.
+func (r *refunder) issue(ctx context.Context, res *models.Reservation, payments []refundable, cents int64, actor sql.NullString, note string) ([]models.PaymentEntry, int64, error) {
+	var entries []models.PaymentEntry
+	var refunded int64
+	for _, p := range payments {
+		if refunded == cents {
+			break
+		}
+		amount := min(p.cents, cents-refunded)
+		params := &stripe.RefundCreateParams{
+			PaymentIntent: stripe.String(p.paymentIntent),
+			Amount:        stripe.Int64(amount),
+			Reason:        stripe.String(string(stripe.RefundReasonRequestedByCustomer)),
+			Metadata:      reservationMetadataFor(res.ID),
+		}
+		refund, err := r.sc.V1Refunds.Create(ctx, params)
+		if err != nil {
+			r.log.Error("failed to refund payment", "reservation_id", res.ID, "payment_intent", p.paymentIntent, "error", err)
+			if refunded == 0 {
+				return nil, 0, err
+			}
+			break
+		}
+		refunded += refund.Amount
+		entry, err := r.paymentStore.CreatePaymentEntry(ctx, &models.PaymentEntry{
+			ReservationID: res.ID,
+			Kind:          models.PaymentEntryKindRefund,
+			AmountCents:   -refund.Amount,
+			Currency:      string(refund.Currency),
+			Provider:      models.PaymentProviderStripe,
+			ProviderRef:   sql.NullString{String: refund.ID, Valid: true},
+			ActorID:       actor,
+			Note:          models.CheckNullString(note),
+		})
+		if err != nil {
+			r.log.Error("failed to record refund", "reservation_id", res.ID, "refund", refund.ID, "error", err)
+			continue
+		}
+		if entry != nil {
+			entries = append(entries, *entry)
+		}
+	}
+	return entries, refunded, nil
+}
+
+func refundableCents(payments []refundable) int64 {
+	var cents int64
+	for _, p := range payments {
+		cents += p.cents
+	}
+	return cents
+}
+
+// refundable lists the reservation's payment intents with money left to
+// refund, newest first. Stripe is asked for what each has left, since
+// refunds can also be made from its dashboard.
+func (r *refunder) refundable(ctx context.Context, res *models.Reservation) ([]refundable, error) {
+	entries, err := r.paymentStore.GetPaymentEntries(ctx, res.ID)
+	if err != nil {
+		return nil, err
+	}
+	var intents []string
+	for _, e := range slices.Backward(entries) {
+		if e.Provider != models.PaymentProviderStripe || e.Kind != models.PaymentEntryKindCharge || !e.ProviderRef.Valid {
+			continue
+		}
+		ref := e.ProviderRef.String
+		// charges recorded from a checkout without a payment intent yet
+		if strings.HasPrefix(ref, "cs_") {
+			s, err := r.sc.V1CheckoutSessions.Retrieve(ctx, ref, nil)
+			if err != nil {
+				return nil, err
+			}
+			if s.PaymentIntent == nil {
+				continue
+			}
+			ref = s.PaymentIntent.ID
+		}
+		if !slices.Contains(intents, ref) {
+			intents = append(intents, ref)
+		}
+	}
+	if res.PaymentIntent.Valid && res.PaymentIntent.String != "" && !slices.Contains(intents, res.PaymentIntent.String) {
+		intents = append(intents, res.PaymentIntent.String)
+	}
+
+	var payments []refundable
+	for _, id := range intents {
+		params := &stripe.PaymentIntentRetrieveParams{}
+		params.AddExpand("latest_charge")
+		pi, err := r.sc.V1PaymentIntents.Retrieve(ctx, id, params)
+		if err != nil {
+			return nil, err
+		}
+		ch := pi.LatestCharge
+		if pi.Status != stripe.PaymentIntentStatusSucceeded || ch == nil {
+			continue
+		}
+		if left := ch.AmountCaptured - ch.AmountRefunded; left > 0 {
+			payments = append(payments, refundable{paymentIntent: id, cents: left})
+		}
+	}
+	return payments, nil
+}
+
+// refundOverpayment refunds what was paid beyond the reservation's total,
+// such as after paid dates are canceled. A canceled reservation owes
+// nothing. Failures are logged; the cancellation itself already happened.
+func (r *refunder) refundOverpayment(ctx context.Context, id int64) {
+	if !r.config.RefundOnCancel {
+		return
+	}
+	res, err := r.reservationStore.Get(ctx, id)
+	if err != nil || res == nil {
+		r.log.Error("failed to get reservation for refund", "reservation_id", id, "error", err)
+		return
+	}
+	entries, err := r.paymentStore.GetPaymentEntries(ctx, id)
+	if err != nil {
+		r.log.Error("failed to get ledger", "reservation_id", id, "error", err)
+		return
+	}
+	paid := ledgerCents(entries)
+	if paid <= 0 {
+		return
+	}
+	var total int64
+	if res.Reservation.Approved != models.ReservationApprovedCanceled && res.Reservation.Approved != models.ReservationApprovedDenied {
+		total, err = reservationTotalCents(ctx, r.facilityStore, r.sc, res)
+		if err != nil {
+			r.log.Error("failed to calculate cost", "reservation_id", id, "error", err)
+			return
+		}
+	}
+	over := paid - total
+	if over <= 0 {
+		return
+	}
+	payments, err := r.refundable(ctx, &res.Reservation)
+	if err != nil {
+		r.log.Error("failed to list refundable payments", "reservation_id", id, "error", err)
+		return
+	}
+	// offline payments are returned by hand
+	available := refundableCents(payments)
+	if over = min(over, available); over == 0 {
+		return
+	}
+	_, refunded, err := r.issue(ctx, &res.Reservation, payments, over, sql.NullString{}, "Canceled dates")
+	if err != nil {
+		r.log.Error("failed to refund canceled dates", "reservation_id", id, "error", err)
+		return
+	}
+	r.settle(ctx, &res.Reservation, refunded, available)
+}
+
+// notify emails the requester about a refund.
+func (r *refunder) notify(ctx context.Context, res *models.Reservation, cents int64) {
+	if cents == 0 {
+		return
+	}
+	user, err := r.userStore.Get(ctx, res.UserID)
+	if err != nil || user == nil {
+		r.log.Error("failed to get requester for refund email", "reservation_id", res.ID, "error", err)
+		return
+	}
+	emailData := &emails.EmailData{
+		To:       user.Email,
+		Template: "refund.html",
+		Subject:  "Refund Issued",
+		Data: map[string]any{
+			"Name":   res.EventName,
+			"Amount": models.CentsToString(cents),
+			"URL":    fmt.Sprintf("%s/reservation/%d", r.config.FrontendUrl, res.ID),
+		},
+	}
+	if r.config.AppEnv == config.PROD {
+		go emails.Send(emailData)
+	}
+}
+
+// reservationTotalCents is what a reservation costs: the admin cost override
+// when set, otherwise the reducer total for its price. Without a price it is
+// free.
+func reservationTotalCents(ctx context.Context, facilityStore ports.FacilityStore, sc *stripe.Client, reservation *models.FullReservation) (int64, error) {
+	if reservation.Reservation.CostOverride.Valid {
+		return int64(math.Round(utils.PGNumericToFloat64(reservation.Reservation.CostOverride) * 100)), nil
+	}
+	if !reservation.Reservation.PriceID.Valid {
+		return 0, nil
+	}
+	category, err := facilityStore.GetCategory(ctx, reservation.Reservation.CategoryID)
+	if err != nil {
+		return 0, err
+	}
+	price, err := sc.V1Prices.Retrieve(ctx, reservation.Reservation.PriceID.String, nil)
+	if err != nil {
+		return 0, err
+	}
+	cost, err := reducer(ctx, category, reservation, price)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(utils.StringToFloat64(cost) * 100)), nil
+}
+
+// refundError maps refund failures to RPC errors.
+func refundError(err error) error {
+	var stripeErr *stripe.Error
+	switch {
+	case errors.Is(err, errRefundTooLarge):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, errNothingToRefund), errors.As(err, &stripeErr):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return err
+}
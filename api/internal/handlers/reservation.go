@@ -2,19 +2,23 @@ package handlers
 
 import (
 	"api/internal/config"
+	"api/internal/lib/calendars"
 	"api/internal/lib/emails"
+	"api/internal/lib/forms"
 	"api/internal/lib/recur"
 	"api/internal/lib/utils"
 	"api/internal/models"
 	"api/internal/ports"
 	service "api/internal/proto/reservation"
 	"api/pkg/calendar"
+	"api/pkg/files"
 	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
 	"math"
+	"path"
 	"sort"
 	"strings"
 	"sync"
@@ -26,40 +30,191 @@ import (
 )
 
 type ReservationHandler struct {
-	reservationStore ports.ReservationStore
-	userStore        ports.UserStore
-	facilityStore    ports.FacilityStore
-	log              *slog.Logger
-	timezone         *time.Location
-	calendar         *calendar.Calendar
-	config           *config.Config
-	sc               *stripe.Client
+	reservationStore  ports.ReservationStore
+	userStore         ports.UserStore
+	facilityStore     ports.FacilityStore
+	organizationStore ports.OrganizationStore
+	log               *slog.Logger
+	timezone          *time.Location
+	calendar          ports.CalendarProvider
+	config            *config.Config
+	sc                *stripe.Client
+	calendarSyncStore ports.CalendarSyncStore
+	reservationSync   *calendars.ReservationSync
+	reconciler        *calendars.Reconciler
+	outbox            *calendars.Outbox
+	text              *calendars.EventText
+	refunds           *refunder
+	fileStorage       files.FileStorage
 }
 
 func NewReservationHandler(
 	reservationStore ports.ReservationStore,
 	userStore ports.UserStore,
 	facilityStore ports.FacilityStore,
+	organizationStore ports.OrganizationStore,
 	log *slog.Logger,
 	timezone *time.Location,
 	config *config.Config,
-	calendar *calendar.Calendar,
+	calendar ports.CalendarProvider,
 	sc *stripe.Client,
+	calendarSyncStore ports.CalendarSyncStore,
+	reservationSync *calendars.ReservationSync,
+	reconciler *calendars.Reconciler,
+	outbox *calendars.Outbox,
+	text *calendars.EventText,
+	refunds *refunder,
+	fileStorage files.FileStorage,
 ) *ReservationHandler {
 	log.With(slog.Group("Core_ReservationHandler", slog.String("name", "reservation")))
 	return &ReservationHandler{
-		reservationStore: reservationStore,
-		userStore:        userStore,
-		facilityStore:    facilityStore,
-		log:              log,
-		timezone:         timezone,
-		config:           config,
-		calendar:         calendar,
-		sc:               sc,
+		reservationStore:  reservationStore,
+		userStore:         userStore,
+		facilityStore:     facilityStore,
+		organizationStore: organizationStore,
+		log:               log,
+		timezone:          timezone,
+		config:            config,
+		calendar:          calendar,
+		sc:                sc,
+		calendarSyncStore: calendarSyncStore,
+		reservationSync:   reservationSync,
+		reconciler:        reconciler,
+		outbox:            outbox,
+		refunds:           refunds,
+		text:              text,
+		fileStorage:       fileStorage,
 	}
 }
+
+// scopedReservations returns all reservations for facilities the caller administers.
+func (a *ReservationHandler) scopedReservations(ctx context.Context) ([]models.FullReservation, error) {
+	scope, err := callerScope(ctx, a.userStore)
+	if err != nil {
+		return nil, err
+	}
+	reservations, err := a.reservationStore.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return scope.filterReservations(ctx, a.facilityStore, reservations)
+}
+
+// requireReservation checks that the caller administers the reservation's building.
+func (a *ReservationHandler) requireReservation(ctx context.Context, id int64) error {
+	res, err := a.reservationStore.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", id))
+	}
+	return requireFacility(ctx, a.userStore, a.facilityStore, res.Reservation.FacilityID)
+}
+
+// authorizeStatusChange lets requesters and their organization's managers cancel
+// a booking; every other status change needs an admin for the building.
+func (a *ReservationHandler) authorizeStatusChange(ctx context.Context, res models.Reservation, status models.ReservationApproved) error {
+	if status == models.ReservationApprovedCanceled {
+		return a.authorizeRequester(ctx, res)
+	}
+	return requireFacility(ctx, a.userStore, a.facilityStore, res.FacilityID)
+}
+
+// authorizeRequester allows the requester, a manager of the booking organization,
+// or an admin for the building.
+func (a *ReservationHandler) authorizeRequester(ctx context.Context, res models.Reservation) error {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return err
+	}
+	if user.ID == res.UserID {
+		return nil
+	}
+	if res.OrganizationID.Valid {
+		role, err := a.organizationStore.GetMemberRole(ctx, res.OrganizationID.Int64, user.ID)
+		if err != nil {
+			return err
+		}
+		if role.CanManage() {
+			return nil
+		}
+	}
+	return requireFacility(ctx, a.userStore, a.facilityStore, res.FacilityID)
+}
+
+// validateIntake checks raw JSON answers against the facility and category form.
+// File answers must name an upload among the reservation's documents, or
+// among the caller's staged uploads before it has an id of its own.
+func (a *ReservationHandler) validateIntake(ctx context.Context, reservationID, facilityID, categoryID int64, raw string) (models.JSONMap, error) {
+	answers, err := models.ParseJSONMap(raw)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("intake answers must be a JSON object: %w", err))
+	}
+	fields, err := a.facilityStore.GetFormFields(ctx, facilityID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	var dir string
+	if reservationID != 0 {
+		dir = fmt.Sprintf("documents/%d/", reservationID)
+	} else {
+		user, err := callerUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		dir = intakeUploadDir(user.ID)
+	}
+	fileExists := func(p string) bool {
+		return path.Clean(p) == p && strings.HasPrefix(p, dir) && a.fileStorage.Exists(p)
+	}
+	clean, err := forms.Validate(forms.Merge(fields), answers, fileExists)
+	if err != nil {
+		var verr *forms.ValidationError
+		if errors.As(err, &verr) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, err
+	}
+	return clean, nil
+}
+
+// claimIntakeFiles moves the caller's staged uploads named in a new
+// reservation's answers among its documents, and points the answers there.
+func (a *ReservationHandler) claimIntakeFiles(ctx context.Context, reservationID int64, answers models.JSONMap) error {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return err
+	}
+	staged := intakeUploadDir(user.ID)
+	claimed := false
+	for key, value := range answers {
+		p, ok := value.(string)
+		if !ok || !strings.HasPrefix(p, staged) {
+			continue
+		}
+		reader, err := a.fileStorage.Get(p)
+		if err != nil {
+			return err
+		}
+		dst := fmt.Sprintf("documents/%d/%s", reservationID, path.Base(p))
+		if err := a.fileStorage.Save(dst, reader); err != nil {
+			return err
+		}
+		if err := a.fileStorage.Delete(p); err != nil {
+			a.log.Warn("failed to remove staged upload", "path", p, "err", err)
+		}
+		answers[key] = dst
+		claimed = true
+	}
+	if !claimed {
+		return nil
+	}
+	return a.reservationStore.UpdateIntakeAnswers(ctx, reservationID, answers)
+}
+
 func (a *ReservationHandler) GetAllReservations(ctx context.Context, req *connect.Request[service.GetAllReservationsRequest]) (*connect.Response[service.AllReservationsResponse], error) {
-	res, err := a.reservationStore.GetAll(ctx)
+	res, err := a.scopedReservations(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +241,7 @@ func (a *ReservationHandler) GetReservation(ctx context.Context, req *connect.Re
 }
 
 func (a *ReservationHandler) RequestCount(ctx context.Context, req *connect.Request[service.RequestCountRequest]) (*connect.Response[service.RequestCountResponse], error) {
-	res, err := a.reservationStore.GetAll(ctx)
+	res, err := a.scopedReservations(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +257,7 @@ func (a *ReservationHandler) RequestCount(ctx context.Context, req *connect.Requ
 }
 
 func (a *ReservationHandler) GetRequestsThisWeek(ctx context.Context, req *connect.Request[service.GetRequestsThisWeekRequest]) (*connect.Response[service.RequestThisWeekResponse], error) {
-	requests, err := a.reservationStore.GetAll(ctx)
+	requests, err := a.scopedReservations(ctx)
 	if err != nil {
 		a.log.Error("Failed to get requests", "err", err)
 		return nil, err
@@ -208,28 +363,89 @@ func (a *ReservationHandler) CreateReservation(ctx context.Context, req *connect
 		return nil, errors.New("too many occurrences")
 	}
 
+	intakeAnswers, err := a.validateIntake(ctx, 0, req.Msg.FacilityId, pricing.CategoryID, req.Msg.GetIntakeAnswers())
+	if err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.Name
+	phone := req.Msg.Phone
+	var organizationID sql.NullInt64
+	var insuranceLink sql.NullString
+	if orgID := req.Msg.GetOrganizationId(); orgID != 0 {
+		org, err := a.organizationStore.Get(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if org == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization %d not found", orgID))
+		}
+		// membership is the caller's, whoever the booking is entered for
+		user, err := callerUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		role, err := a.organizationStore.GetMemberRole(ctx, orgID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if role == "" {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("not a member of this organization"))
+		}
+		organizationID = sql.NullInt64{Int64: orgID, Valid: true}
+		// fall back to the organization's billing contact
+		if name == "" {
+			name = org.Organization.BillingName.String
+		}
+		if phone == "" {
+			phone = org.Organization.BillingPhone.String
+		}
+		if ins := org.CurrentInsurance(time.Now().In(loc)); ins != nil {
+			insuranceLink = models.CheckNullString(ins.FilePath)
+		}
+	}
+
+	visibility := models.EventVisibility(req.Msg.GetVisibility())
+	if visibility == "" {
+		visibility = models.EventVisibilityPrivate
+	}
+	if !visibility.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown visibility %q", visibility))
+	}
+
 	id, err := a.reservationStore.Create(ctx, &models.Reservation{
-		UserID:       req.Msg.UserId,
-		EventName:    req.Msg.EventName,
-		FacilityID:   req.Msg.FacilityId,
-		Approved:     models.ReservationApprovedPending,
-		Details:      models.CheckNullString(req.Msg.Details),
-		Insurance:    false,
-		Name:         req.Msg.Name,
-		Phone:        models.CheckNullString(req.Msg.Phone),
-		CategoryID:   pricing.CategoryID,
-		TechSupport:  req.Msg.TechSupport,
-		TechDetails:  models.CheckNullString(req.Msg.TechDetails),
-		DoorAccess:   req.Msg.DoorAccess,
-		DoorsDetails: models.CheckNullString(req.Msg.DoorsDetails),
-		RRule:        models.CheckNullString(rruleStr),
-		RDates:       models.DatesArrayToNullDates(rdatesLocal),
-		EXDates:      models.DatesArrayToNullDates(exdatesLocal),
-		PriceID:      models.CheckNullString(req.Msg.PricingId),
+		UserID:         req.Msg.UserId,
+		EventName:      req.Msg.EventName,
+		FacilityID:     req.Msg.FacilityId,
+		Approved:       models.ReservationApprovedPending,
+		Details:        models.CheckNullString(req.Msg.Details),
+		Insurance:      false,
+		Name:           name,
+		Phone:          models.CheckNullString(phone),
+		CategoryID:     pricing.CategoryID,
+		TechSupport:    req.Msg.TechSupport,
+		TechDetails:    models.CheckNullString(req.Msg.TechDetails),
+		DoorAccess:     req.Msg.DoorAccess,
+		DoorsDetails:   models.CheckNullString(req.Msg.DoorsDetails),
+		RRule:          models.CheckNullString(rruleStr),
+		RDates:         models.DatesArrayToNullDates(rdatesLocal),
+		EXDates:        models.DatesArrayToNullDates(exdatesLocal),
+		PriceID:        models.CheckNullString(req.Msg.PricingId),
+		OrganizationID: organizationID,
+		InsuranceLink:  insuranceLink,
+		IntakeAnswers:  intakeAnswers,
+		Visibility:     visibility,
+		PublicTitle:    models.CheckNullString(req.Msg.GetPublicTitle()),
 	})
 	if err != nil {
 		return nil, err
 	}
+	if err := a.claimIntakeFiles(ctx, id, intakeAnswers); err != nil {
+		if delErr := a.reservationStore.Delete(ctx, id); delErr != nil {
+			a.log.Error("failed to remove reservation after upload error", "id", id, "err", delErr)
+		}
+		return nil, err
+	}
 	sort.Slice(occ, func(i, j int) bool { return occ[i].Start.Before(occ[j].Start) })
 	dates := make([]models.ReservationDate, len(occ))
 	for i, o := range occ {
@@ -248,12 +464,29 @@ func (a *ReservationHandler) CreateReservation(ctx context.Context, req *connect
 		return nil, err
 	}
 
+	if len(req.Msg.GetEquipment()) > 0 {
+		full := &models.FullReservation{
+			Reservation: models.Reservation{ID: id, FacilityID: req.Msg.FacilityId},
+			Dates:       dates,
+		}
+		if err := a.setEquipment(ctx, full, req.Msg.GetEquipment()); err != nil {
+			// don't leave a booking behind without the add-ons that were asked for
+			if delErr := a.reservationStore.Delete(ctx, id); delErr != nil {
+				a.log.Error("failed to remove reservation after equipment error", "id", id, "err", delErr)
+			}
+			return nil, err
+		}
+	}
+
 	facilityID := req.Msg.GetFacilityId()
 	facility, err := a.facilityStore.Get(ctx, facilityID)
 	if err != nil {
 		a.log.Error("Facility not found", "id", req.Msg.FacilityId)
 		return nil, err
 	}
+	if a.config.CalendarHolds {
+		a.hold(ctx, id, facility)
+	}
 	toEmails, err := a.userStore.NotificationUsersByBuilding(ctx, facility.Building.ID)
 	if err != nil {
 		return nil, err
@@ -285,13 +518,80 @@ func (a *ReservationHandler) CreateReservation(ctx context.Context, req *connect
 }
 
 func (a *ReservationHandler) UpdateReservation(ctx context.Context, req *connect.Request[service.UpdateReservationRequest]) (*connect.Response[service.UpdateReservationResponse], error) {
-	reservation := req.Msg.GetReservation()
-	err := a.reservationStore.Update(ctx, models.ToReservation(reservation))
+	reservation := models.ToReservation(req.Msg.GetReservation())
+	current, err := a.reservationStore.Get(ctx, reservation.ID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", reservation.ID))
+	}
+	if err := a.authorizeRequester(ctx, current.Reservation); err != nil {
+		return nil, err
+	}
+	// the event id belongs to the calendar, not the client, and approval and
+	// payment change through their own paths
+	reservation.GCalEventID = current.Reservation.GCalEventID
+	reservation.Approved = current.Reservation.Approved
+	reservation.Paid = current.Reservation.Paid
+	// insurance and pricing are for the building's admins to settle; the
+	// requester may only change the event's text and visibility
+	if err := requireFacility(ctx, a.userStore, a.facilityStore, current.Reservation.FacilityID); err != nil {
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			return nil, err
+		}
+		reservation.Insurance = current.Reservation.Insurance
+		reservation.CategoryID = current.Reservation.CategoryID
+		reservation.TotalHours = current.Reservation.TotalHours
+		reservation.InPerson = current.Reservation.InPerson
+		reservation.InsuranceLink = current.Reservation.InsuranceLink
+	}
+	if reservation.Visibility == "" {
+		reservation.Visibility = current.Reservation.Visibility
+	}
+	if !reservation.Visibility.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown visibility %q", reservation.Visibility))
+	}
+	// the outbox renders the published text from the saved reservation
+	if reservation.EventName == current.Reservation.EventName && reservation.Details == current.Reservation.Details &&
+		reservation.Visibility == current.Reservation.Visibility && reservation.PublicTitle == current.Reservation.PublicTitle {
+		if err := a.reservationStore.Update(ctx, reservation); err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&service.UpdateReservationResponse{}), nil
+	}
+
+	facility, err := a.facilityStore.Get(ctx, current.Reservation.FacilityID)
 	if err != nil {
 		return nil, err
 	}
+	calendarID := facility.Facility.GoogleCalendarID
+	location := fmt.Sprintf("%s %s", facility.Building.Name, facility.Facility.Name)
+	change := &models.StatusChange{ReservationID: reservation.ID, Reservation: reservation}
+	master := reservation.GCalEventID.String
+	if master != "" {
+		change.Ops = append(change.Ops, calendars.PatchSeriesOp(reservation.ID, calendarID, master, reservation.EventName, reservation.Details.String, location))
+	}
+	for _, d := range current.Dates {
+		// singles and moved occurrences hold their own copy of the text
+		if d.GcalEventid.String == "" || d.GcalEventid.String == master {
+			continue
+		}
+		change.Ops = append(change.Ops, calendars.PatchOp(reservation.ID, calendarID, calendar.Event{
+			ID:          d.GcalEventid.String,
+			Summary:     reservation.EventName,
+			Description: reservation.Details.String,
+			Location:    location,
+			Start:       wallTime(d.LocalStart.Time, a.timezone),
+			End:         wallTime(d.LocalEnd.Time, a.timezone),
+		}))
+	}
+	if err := a.outbox.Commit(ctx, change); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&service.UpdateReservationResponse{}), nil
 }
+
 func (a *ReservationHandler) UpdateReservationStatus(ctx context.Context, req *connect.Request[service.UpdateReservationStatusRequest]) (*connect.Response[service.UpdateReservationResponse], error) {
 	status := models.ReservationApproved(req.Msg.GetStatus())
 	id := req.Msg.GetId()
@@ -301,7 +601,13 @@ func (a *ReservationHandler) UpdateReservationStatus(ctx context.Context, req *c
 		a.log.Error("Reservation not found", "id", id)
 		return nil, err
 	}
+	if resWrap == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", id))
+	}
 	res := resWrap.Reservation
+	if err := a.authorizeStatusChange(ctx, res, status); err != nil {
+		return nil, err
+	}
 	reservationUser, err := a.userStore.Get(ctx, res.UserID)
 	if err != nil {
 		a.log.Error("User not found", "id", res.UserID)
@@ -310,12 +616,25 @@ func (a *ReservationHandler) UpdateReservationStatus(ctx context.Context, req *c
 	if res.Approved == status && status != models.ReservationApprovedApproved {
 		return connect.NewResponse(&service.UpdateReservationResponse{}), nil
 	}
+	facility, err := a.facilityStore.Get(ctx, res.FacilityID)
+	if err != nil {
+		a.log.Error("Facility not found", "id", res.FacilityID)
+		return nil, err
+	}
+	calendarID := facility.Facility.GoogleCalendarID
 	res.Approved = status
 	if status != models.ReservationApprovedApproved {
-		if err := a.reservationStore.Update(ctx, &res); err != nil {
+		// events of a reservation that is no longer approved come down
+		change := &models.StatusChange{ReservationID: res.ID, Reservation: &res}
+		change.Ops, change.Dates = unpublishOps(&res, resWrap.Dates, calendarID)
+		if err := a.outbox.Commit(ctx, change); err != nil {
 			return nil, err
 		}
+		if status == models.ReservationApprovedPending && a.config.CalendarHolds {
+			a.hold(ctx, res.ID, facility)
+		}
 		if status == models.ReservationApprovedDenied || status == models.ReservationApprovedCanceled {
+			a.refunds.refundOverpayment(ctx, res.ID)
 			emailData := &emails.EmailData{
 				To:       reservationUser.Email,
 				Template: "statusUpdate.html",
@@ -334,76 +653,55 @@ func (a *ReservationHandler) UpdateReservationStatus(ctx context.Context, req *c
 
 	a.log.Debug("Reservation approved", "id", id)
 
-	facility, err := a.facilityStore.Get(ctx, res.FacilityID)
-	if err != nil {
-		a.log.Error("Facility not found", "id", res.FacilityID)
-		return nil, err
+	// events of a pending request are tentative holds, confirmed in place
+	held := resWrap.Reservation.Approved == models.ReservationApprovedPending
+	location := fmt.Sprintf("%s %s", facility.Building.Name, facility.Facility.Name)
+	master := res.GCalEventID.String
+	change := &models.StatusChange{ReservationID: res.ID, Reservation: &res}
+	dateIDs := make([]int64, 0, len(resWrap.Dates))
+	for i := range resWrap.Dates {
+		d := &resWrap.Dates[i]
+		dateIDs = append(dateIDs, d.ID)
+		if d.Approved != models.ReservationDateApprovedApproved {
+			d.Approved = models.ReservationDateApprovedApproved
+			change.Dates = append(change.Dates, *d)
+		}
+		if held && d.GcalEventid.String != "" && d.GcalEventid.String != master {
+			change.Ops = append(change.Ops, calendars.ConfirmOp(res.ID, calendarID, calendar.Event{
+				ID:          d.GcalEventid.String,
+				Summary:     res.EventName,
+				Description: res.Details.String,
+				Location:    location,
+				Start:       wallTime(d.LocalStart.Time, a.timezone),
+				End:         wallTime(d.LocalEnd.Time, a.timezone),
+			}))
+		}
 	}
 	plan := buildPublishPlan(res, resWrap.Dates, true)
-	if plan.Mode == calendar.ModeSeries && res.GCalEventID.Valid {
-		a.log.Warn("Reservation already published", "id", id)
-		for i := range resWrap.Dates {
-			if resWrap.Dates[i].Approved != models.ReservationDateApprovedApproved {
-				resWrap.Dates[i].Approved = models.ReservationDateApprovedApproved
-				if err := a.reservationStore.UpdateDate(ctx, &resWrap.Dates[i]); err != nil {
-					a.log.Error("Failed to update approval for date", slog.Int64("id", resWrap.Dates[i].ID), "err", err)
-				}
-			}
-		}
-		if err := a.reservationStore.Update(ctx, &res); err != nil {
-			return nil, err
+	if plan.Mode == calendar.ModeSeries && master != "" {
+		if held {
+			change.Ops = append(change.Ops, calendars.ConfirmOp(res.ID, calendarID, calendar.Event{
+				ID:          master,
+				Summary:     res.EventName,
+				Description: res.Details.String,
+				Location:    location,
+			}))
+		} else {
+			a.log.Warn("Reservation already published", "id", id)
 		}
-		return connect.NewResponse(&service.UpdateReservationResponse{}), nil
+	} else {
+		// dates that already have an event are skipped when the plan runs
+		change.Ops = append(change.Ops, calendars.PublishOps(res.ID, calendarID, plan, calendar.PublishOptions{
+			Summary:     res.EventName,
+			Description: res.Details.String,
+			Location:    location,
+			SendUpdates: calendar.NoUpdates,
+		}, dateIDs)...)
 	}
-	description := res.Details.String
-	pubRes, err := a.calendar.Publish(ctx, plan, calendar.PublishOptions{
-		CalendarID:  facility.Facility.GoogleCalendarID,
-		Summary:     res.EventName,
-		Description: description,
-		Location:    fmt.Sprintf("%s %s", facility.Building.Name, facility.Facility.Name),
-		SendUpdates: calendar.NoUpdates,
-	})
-	if err != nil {
+	if err := a.outbox.Commit(ctx, change); err != nil {
+		a.log.Error("Failed to approve reservation", "id", id, "err", err)
 		return nil, err
 	}
-	a.log.Debug("Reservation published", "google response", pubRes)
-	switch plan.Mode {
-	case calendar.ModeSingles:
-		dateByID := make(map[int64]*models.ReservationDate, len(resWrap.Dates))
-		for i := range resWrap.Dates {
-			d := &resWrap.Dates[i]
-			dateByID[d.ID] = d
-		}
-
-		for refID, eventID := range pubRes.SingleEventID {
-			d := dateByID[refID]
-			if d == nil {
-				a.log.Warn("Publish returned event for unknown date id", "refID", refID)
-				continue
-			}
-			if d.GcalEventid.Valid && d.GcalEventid.String == eventID && d.Approved == models.ReservationDateApprovedApproved {
-				continue
-			}
-			d.GcalEventid = models.CheckNullString(eventID)
-
-			d.Approved = models.ReservationDateApprovedApproved
-			if err := a.reservationStore.UpdateDate(ctx, d); err != nil {
-				a.log.Error("Failed to update approval for date", slog.Int64("date_id", d.ID), "err", err)
-			}
-		}
-	case calendar.ModeSeries:
-		if pubRes.MasterEventID != nil && *pubRes.MasterEventID != "" {
-			res.GCalEventID.String = *pubRes.MasterEventID
-			res.GCalEventID.Valid = true
-		}
-		for i := range resWrap.Dates {
-			resWrap.Dates[i].Approved = models.ReservationDateApprovedApproved
-			resWrap.Dates[i].GcalEventid = models.CheckNullString(pubRes.MasterEventID)
-			if err := a.reservationStore.UpdateDate(ctx, &resWrap.Dates[i]); err != nil {
-				a.log.Error("Failed to update date after series published", "date_id", resWrap.Dates[i].ID, "err", err)
-			}
-		}
-	}
 	emailData := &emails.EmailData{
 		To:       reservationUser.Email,
 		Template: "statusUpdate.html",
@@ -416,14 +714,76 @@ func (a *ReservationHandler) UpdateReservationStatus(ctx context.Context, req *c
 	if a.config.AppEnv == config.PROD {
 		go emails.Send(emailData)
 	}
-	if err := a.reservationStore.Update(ctx, &res); err != nil {
-		a.log.Error("Failed to update reservation after publish", "id", id, "err", err)
-		return nil, err
-	}
 	return connect.NewResponse(&service.UpdateReservationResponse{}), nil
 }
 
+// hold queues tentative holds for a pending request, so admins see the
+// time is asked for before they approve an overlapping one. A failure is
+// logged; the request itself stands.
+func (a *ReservationHandler) hold(ctx context.Context, id int64, facility *models.FullFacility) {
+	calendarID := facility.Facility.GoogleCalendarID
+	if calendarID == "" {
+		return
+	}
+	wrap, err := a.reservationStore.Get(ctx, id)
+	if err != nil || wrap == nil || len(wrap.Dates) == 0 {
+		a.log.Error("Failed to load reservation for a hold", "id", id, "err", err)
+		return
+	}
+	res := wrap.Reservation
+	dateIDs := make([]int64, len(wrap.Dates))
+	for i, d := range wrap.Dates {
+		dateIDs[i] = d.ID
+	}
+	change := &models.StatusChange{ReservationID: id}
+	change.Ops = calendars.PublishOps(id, calendarID, buildPublishPlan(res, wrap.Dates, true), calendar.PublishOptions{
+		Description: res.Details.String,
+		Location:    fmt.Sprintf("%s %s", facility.Building.Name, facility.Facility.Name),
+		SendUpdates: calendar.NoUpdates,
+		Tentative:   true,
+	}, dateIDs)
+	if err := a.outbox.Commit(ctx, change); err != nil {
+		a.log.Error("Failed to queue calendar hold", "id", id, "err", err)
+	}
+}
+
+// unpublishOps queues the deletion of every event of a reservation and
+// clears the event ids, returning the dates that changed. Moved
+// occurrences of a series carry their own instance ids and are deleted
+// along with the master.
+func unpublishOps(res *models.Reservation, dates []models.ReservationDate, calendarID string) ([]models.CalendarOp, []models.ReservationDate) {
+	var ops []models.CalendarOp
+	var changed []models.ReservationDate
+	deleted := make(map[string]bool)
+	if res.GCalEventID.Valid && res.GCalEventID.String != "" {
+		ops = append(ops, calendars.DeleteOp(res.ID, calendarID, res.GCalEventID.String))
+		deleted[res.GCalEventID.String] = true
+	}
+	for _, d := range dates {
+		if !d.GcalEventid.Valid || d.GcalEventid.String == "" {
+			continue
+		}
+		if !deleted[d.GcalEventid.String] {
+			ops = append(ops, calendars.DeleteOp(res.ID, calendarID, d.GcalEventid.String))
+			deleted[d.GcalEventid.String] = true
+		}
+		d.GcalEventid = models.CheckNullString("")
+		changed = append(changed, d)
+	}
+	res.GCalEventID = models.CheckNullString("")
+	return ops, changed
+}
+
+// wallTime reads a timestamp column, returned as UTC, as a wall-clock time
+// in loc.
+func wallTime(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
 func (a *ReservationHandler) DeleteReservation(ctx context.Context, req *connect.Request[service.DeleteReservationRequest]) (*connect.Response[service.DeleteReservationResponse], error) {
+	if err := a.requireReservation(ctx, req.Msg.GetId()); err != nil {
+		return nil, err
+	}
 	err := a.reservationStore.Delete(ctx, req.Msg.GetId())
 	if err != nil {
 		return nil, err
@@ -486,11 +846,40 @@ func (a *ReservationHandler) UserReservations(ctx context.Context, req *connect.
 	}), nil
 }
 
+func (a *ReservationHandler) UpdateIntakeAnswers(ctx context.Context, req *connect.Request[service.UpdateIntakeAnswersRequest]) (*connect.Response[service.UpdateReservationResponse], error) {
+	res, err := a.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
+	}
+	if err := a.authorizeRequester(ctx, res.Reservation); err != nil {
+		return nil, err
+	}
+	answers, err := a.validateIntake(ctx, res.Reservation.ID, res.Reservation.FacilityID, res.Reservation.CategoryID, req.Msg.GetIntakeAnswers())
+	if err != nil {
+		return nil, err
+	}
+	if err := a.reservationStore.UpdateIntakeAnswers(ctx, res.Reservation.ID, answers); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.UpdateReservationResponse{}), nil
+}
+
 func (a *ReservationHandler) CreateReservationDates(ctx context.Context, req *connect.Request[service.CreateReservationDatesRequest]) (*connect.Response[service.CreateReservationDatesResponse], error) {
 	dates := models.ToReservationDates(req.Msg.GetDate())
 	if len(dates) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no dates provided"))
 	}
+	for _, d := range dates[1:] {
+		if d.ReservationID != dates[0].ReservationID {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("dates must belong to one reservation"))
+		}
+	}
+	if err := a.requireReservation(ctx, dates[0].ReservationID); err != nil {
+		return nil, err
+	}
 	resWrap, err := a.reservationStore.Get(ctx, dates[0].ReservationID)
 	if err != nil {
 		return nil, err
@@ -512,20 +901,109 @@ func (a *ReservationHandler) CreateReservationDates(ctx context.Context, req *co
 	if err != nil {
 		return nil, err
 	}
+	a.refreshEquipmentFees(ctx, reservation.ID)
 	return connect.NewResponse(&service.CreateReservationDatesResponse{}), nil
 }
 
 func (a *ReservationHandler) UpdateReservationDates(ctx context.Context, req *connect.Request[service.UpdateReservationDatesRequest]) (*connect.Response[service.UpdateReservationDatesResponse], error) {
 	dates := models.ToReservationDates(req.Msg.GetDate())
+	if len(dates) == 0 {
+		return connect.NewResponse(&service.UpdateReservationDatesResponse{}), nil
+	}
+	ids := make([]int64, len(dates))
+	for i, d := range dates {
+		ids[i] = d.ID
+	}
+	rows, err := a.reservationStore.GetDatesByID(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[int64]models.ReservationDate, len(rows))
+	checked := make(map[int64]bool)
+	for _, r := range rows {
+		current[r.ID] = r
+		if checked[r.ReservationID] {
+			continue
+		}
+		if err := a.requireReservation(ctx, r.ReservationID); err != nil {
+			return nil, err
+		}
+		checked[r.ReservationID] = true
+	}
+
+	changes := make(map[int64]*models.StatusChange)
+	var order []int64
 	for _, d := range dates {
-		err := a.reservationStore.UpdateDate(ctx, &d)
-		if err != nil {
+		cur, ok := current[d.ID]
+		if !ok {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation date %d not found", d.ID))
+		}
+		// status and event ids change through their own paths
+		d.ReservationID = cur.ReservationID
+		d.Approved = cur.Approved
+		d.GcalEventid = cur.GcalEventid
+		change, ok := changes[d.ReservationID]
+		if !ok {
+			change = &models.StatusChange{ReservationID: d.ReservationID}
+			changes[d.ReservationID] = change
+			order = append(order, d.ReservationID)
+		}
+		moved := !d.LocalStart.Time.Equal(cur.LocalStart.Time) || !d.LocalEnd.Time.Equal(cur.LocalEnd.Time)
+		if moved && cur.GcalEventid.String != "" {
+			op, err := a.moveOp(ctx, cur, d)
+			if err != nil {
+				return nil, err
+			}
+			if op != nil {
+				// a moved occurrence is tracked by its instance id from now on
+				d.GcalEventid = models.CheckNullString(op.Payload.Event.ID)
+				change.Ops = append(change.Ops, *op)
+			}
+		}
+		change.Dates = append(change.Dates, d)
+	}
+	for _, id := range order {
+		if err := a.outbox.Commit(ctx, changes[id]); err != nil {
 			return nil, err
 		}
+		a.refreshEquipmentFees(ctx, id)
 	}
 	return connect.NewResponse(&service.UpdateReservationDatesResponse{}), nil
 }
 
+// moveOp queues the patch that moves a published date's event to its new
+// time. A date still on its series master is patched as an instance, found
+// by the start it had before the move.
+func (a *ReservationHandler) moveOp(ctx context.Context, before, after models.ReservationDate) (*models.CalendarOp, error) {
+	wrap, err := a.reservationStore.Get(ctx, before.ReservationID)
+	if err != nil {
+		return nil, err
+	}
+	if wrap == nil {
+		return nil, nil
+	}
+	res := wrap.Reservation
+	facility, err := a.facilityStore.Get(ctx, res.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	calendarID := facility.Facility.GoogleCalendarID
+	ev := calendar.Event{
+		ID:          before.GcalEventid.String,
+		Summary:     res.EventName,
+		Description: res.Details.String,
+		Location:    fmt.Sprintf("%s %s", facility.Building.Name, facility.Facility.Name),
+		Start:       wallTime(after.LocalStart.Time, a.timezone),
+		End:         wallTime(after.LocalEnd.Time, a.timezone),
+	}
+	if master := res.GCalEventID.String; master != "" && ev.ID == master {
+		op := calendars.PatchInstanceOp(res.ID, calendarID, master, wallTime(before.LocalStart.Time, a.timezone), ev)
+		return &op, nil
+	}
+	op := calendars.PatchOp(res.ID, calendarID, ev)
+	return &op, nil
+}
+
 func (a *ReservationHandler) UpdateReservationDatesStatus(
 	ctx context.Context,
 	req *connect.Request[service.UpdateReservationDatesStatusRequest],
@@ -536,7 +1014,7 @@ func (a *ReservationHandler) UpdateReservationDatesStatus(
 	}
 	targetStatus := models.ReservationDateApproved(req.Msg.GetStatus())
 
-	rows, err := a.reservationStore.GetDates(ctx, ids)
+	rows, err := a.reservationStore.GetDatesByID(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
@@ -561,37 +1039,46 @@ func (a *ReservationHandler) UpdateReservationDatesStatus(
 	if err != nil {
 		return nil, err
 	}
-
-	// Guard: if a series was already published for this reservation, disallow per-date changes (or handle via EXDATE in a separate flow)
-	if res.GCalEventID.Valid {
-		if targetStatus != models.ReservationDateApprovedApproved {
-			exdates := []time.Time{}
-			for _, r := range rows {
-				exdates = append(exdates, r.LocalStart.Time)
-			}
-			if len(exdates) > 0 {
-				err = a.calendar.AddExdatesToMaster(ctx, facility.Facility.GoogleCalendarID, res.GCalEventID.String, res.RRule.String, exdates)
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
+	if err := requireBuilding(ctx, a.userStore, facility.Facility.BuildingID); err != nil {
+		return nil, err
 	}
 
 	calendarID := facility.Facility.GoogleCalendarID
 	summary := res.EventName
 	description := res.Details.String
 	location := fmt.Sprintf("%s %s", facility.Building.Name, facility.Facility.Name)
+	change := &models.StatusChange{ReservationID: res.ID}
 
 	switch targetStatus {
 	case models.ReservationDateApprovedApproved:
+		// events of a pending request are tentative holds, confirmed in place
+		held := res.Approved == models.ReservationApprovedPending
+		master := res.GCalEventID.String
 		var singles []calendar.OccSpec
-		for _, r := range rows {
+		approving := make(map[int64]bool, len(rows))
+		confirmSeries := false
+		for i := range rows {
+			r := &rows[i]
+			approving[r.ID] = true
+			r.Approved = models.ReservationDateApprovedApproved
+			change.Dates = append(change.Dates, *r)
 			if r.GcalEventid.Valid {
+				if held && r.GcalEventid.String == master {
+					confirmSeries = true
+				} else if held {
+					change.Ops = append(change.Ops, calendars.ConfirmOp(res.ID, calendarID, calendar.Event{
+						ID:          r.GcalEventid.String,
+						Summary:     summary,
+						Description: description,
+						Location:    location,
+						Start:       wallTime(r.LocalStart.Time, a.timezone),
+						End:         wallTime(r.LocalEnd.Time, a.timezone),
+					}))
+				}
 				continue // already published
 			}
 			singles = append(singles, calendar.OccSpec{
-				Start:       r.LocalStart.Time, // or r.LocalStart if time.Time directly
+				Start:       r.LocalStart.Time,
 				End:         r.LocalEnd.Time,
 				RefID:       r.ID,
 				Summary:     summary,
@@ -599,82 +1086,103 @@ func (a *ReservationHandler) UpdateReservationDatesStatus(
 				Location:    location,
 			})
 		}
-
-		// Publish in one batch
-		var pubRes *calendar.PublishResult
 		if len(singles) > 0 {
-			pubRes, err = a.calendar.Publish(ctx, &calendar.PublishPlan{
+			change.Ops = append(change.Ops, calendars.PublishOps(res.ID, calendarID, &calendar.PublishPlan{
 				Mode:    calendar.ModeSingles,
 				Singles: singles,
 			}, calendar.PublishOptions{
-				CalendarID:  calendarID,
 				Summary:     summary,
 				Description: description,
 				Location:    location,
 				SendUpdates: calendar.NoUpdates,
-			})
-			if err != nil {
-				return nil, err
-			}
+			}, nil)...)
 		}
-
-		// Update DB rows: set approved + gcal_eventid
-
-		for i := range rows {
-			r := &rows[i]
-			r.Approved = models.ReservationDateApprovedApproved
-			if pubRes != nil {
-				if evID, ok := pubRes.SingleEventID[r.ID]; ok {
-					r.GcalEventid = models.CheckNullString(evID)
+		if confirmSeries {
+			// the confirmed series keeps only the dates approved so far
+			stored := []sql.NullTime{}
+			if res.EXDates != nil {
+				stored = append(stored, *res.EXDates...)
+			}
+			for _, d := range wrap.Dates {
+				if !approving[d.ID] && d.Approved != models.ReservationDateApprovedApproved {
+					stored = append(stored, sql.NullTime{Time: d.LocalStart.Time, Valid: true})
 				}
 			}
-			if err = a.reservationStore.UpdateDate(ctx, r); err != nil {
-				return nil, err
+			change.Ops = append(change.Ops, calendars.ConfirmOp(res.ID, calendarID, calendar.Event{
+				ID:          master,
+				Summary:     summary,
+				Description: description,
+				Location:    location,
+			}))
+			if len(stored) > 0 {
+				change.Exdates = &stored
+				change.Ops = append(change.Ops, calendars.ExdatesOp(res.ID, calendarID, master, res.RRule.String, utils.NullDatesArrayToTimes(stored)))
 			}
 		}
 		// Optionally mark reservation itself approved if all dates are approved
 		if res.Approved == models.ReservationApprovedPending {
 			res.Approved = models.ReservationApprovedApproved
-			if err = a.reservationStore.Update(ctx, &res); err != nil {
-				return nil, err
-			}
+			change.Reservation = &res
 		}
 
-	case models.ReservationDateApprovedDenied, models.ReservationDateApprovedPending:
-		// For deny/pending: delete Google events if present, then update rows
-		for _, r := range rows {
-			if r.GcalEventid.Valid {
-				if err = a.calendar.DeleteEvent(calendarID, r.GcalEventid.String); err != nil {
-					a.log.Error("Failed to delete event", "id", r.GcalEventid.String, "err", err)
-				}
+	case models.ReservationDateApprovedDenied, models.ReservationDateApprovedCanceled, models.ReservationDateApprovedPending:
+		// Occurrences of a published series are excluded from the master;
+		// standalone events are deleted
+		master := res.GCalEventID.String
+		if master != "" {
+			// keep the exclusions already on the series, the master's
+			// recurrence is replaced as a whole
+			stored := []sql.NullTime{}
+			if res.EXDates != nil {
+				stored = append(stored, *res.EXDates...)
 			}
+			for _, r := range rows {
+				stored = append(stored, sql.NullTime{Time: r.LocalStart.Time, Valid: true})
+			}
+			change.Exdates = &stored
+			change.Ops = append(change.Ops, calendars.ExdatesOp(res.ID, calendarID, master, res.RRule.String, utils.NullDatesArrayToTimes(stored)))
 		}
 		for i := range rows {
 			r := &rows[i]
 			r.Approved = targetStatus
-			// Clear gcal id if we deleted it
-			if r.GcalEventid.Valid {
+			if r.GcalEventid.Valid && r.GcalEventid.String != "" {
+				if r.GcalEventid.String != master {
+					change.Ops = append(change.Ops, calendars.DeleteOp(res.ID, calendarID, r.GcalEventid.String))
+				}
 				r.GcalEventid = models.CheckNullString("")
 			}
-			if err = a.reservationStore.UpdateDate(ctx, r); err != nil {
-				return nil, err
-			}
+			change.Dates = append(change.Dates, *r)
 		}
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown status %q", req.Msg.GetStatus()))
 	}
 
+	if err := a.outbox.Commit(ctx, change); err != nil {
+		return nil, err
+	}
+	a.refreshEquipmentFees(ctx, resID)
+	if targetStatus == models.ReservationDateApprovedDenied || targetStatus == models.ReservationDateApprovedCanceled {
+		a.refunds.refundOverpayment(ctx, resID)
+	}
 	return connect.NewResponse(&service.UpdateReservationDatesStatusResponse{}), nil
 }
 
 func (a *ReservationHandler) DeleteReservationDates(ctx context.Context, req *connect.Request[service.DeleteReservationDatesRequest]) (*connect.Response[service.DeleteReservationDatesResponse], error) {
-	dates, err := a.reservationStore.GetDates(ctx, req.Msg.GetId())
+	dates, err := a.reservationStore.GetDatesByID(ctx, req.Msg.GetId())
 	if err != nil {
 		return nil, err
 	}
 	if len(dates) == 0 {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no dates for reservation %q", req.Msg.GetId()))
 	}
+	for _, d := range dates[1:] {
+		if d.ReservationID != dates[0].ReservationID {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("dates must belong to one reservation"))
+		}
+	}
+	if err := a.requireReservation(ctx, dates[0].ReservationID); err != nil {
+		return nil, err
+	}
 	resWrap, err := a.reservationStore.Get(ctx, dates[0].ReservationID)
 	if err != nil {
 		return nil, err
@@ -685,43 +1193,48 @@ func (a *ReservationHandler) DeleteReservationDates(ctx context.Context, req *co
 		a.log.Error("Facility not found", "id", reservation.FacilityID)
 		return nil, err
 	}
+	calendarID := facility.Facility.GoogleCalendarID
 
-	datesToDelete := make([]models.ReservationDate, 0)
-	exDates := *reservation.EXDates
-
+	change := &models.StatusChange{ReservationID: reservation.ID}
+	exDates := []sql.NullTime{}
+	if reservation.EXDates != nil {
+		exDates = append(exDates, *reservation.EXDates...)
+	}
+	master := reservation.GCalEventID.String
 	for _, d := range dates {
-
+		change.DeleteDateIDs = append(change.DeleteDateIDs, d.ID)
 		if reservation.RRule.Valid {
 			exDates = append(exDates, sql.NullTime{Time: d.LocalStart.Time, Valid: true})
-			continue
-		} else if d.GcalEventid.Valid {
-			datesToDelete = append(datesToDelete, d)
-			continue
 		}
-	}
-	if len(datesToDelete) > 0 {
-		for _, d := range datesToDelete {
-			if err := a.calendar.DeleteEvent(facility.Facility.GoogleCalendarID, d.GcalEventid.String); err != nil {
-				a.log.Error("Failed to delete event", "id", d.GcalEventid.String, "err", err)
-				return nil, err
-			}
+		if d.GcalEventid.Valid && d.GcalEventid.String != "" && d.GcalEventid.String != master {
+			change.Ops = append(change.Ops, calendars.DeleteOp(reservation.ID, calendarID, d.GcalEventid.String))
 		}
 	}
-	if len(exDates) > 0 {
-		if err := a.calendar.AddExdatesToMaster(ctx, facility.Facility.GoogleCalendarID, reservation.GCalEventID.String, reservation.RRule.String, utils.NullDatesArrayToTimes(exDates)); err != nil {
-			a.log.Error("Failed to delete event", "id", reservation.GCalEventID.String, "err", err)
-			return nil, err
+	if reservation.RRule.Valid {
+		change.Exdates = &exDates
+		if master != "" {
+			change.Ops = append(change.Ops, calendars.ExdatesOp(reservation.ID, calendarID, master, reservation.RRule.String, utils.NullDatesArrayToTimes(exDates)))
 		}
 	}
-	err = a.reservationStore.DeleteDates(ctx, req.Msg.GetId())
-	if err != nil {
+	if err := a.outbox.Commit(ctx, change); err != nil {
 		return nil, err
 	}
+	a.refreshEquipmentFees(ctx, reservation.ID)
 	return connect.NewResponse(&service.DeleteReservationDatesResponse{}), nil
 }
 
 func (a *ReservationHandler) CreateReservationFee(ctx context.Context, req *connect.Request[service.CreateReservationFeeRequest]) (*connect.Response[service.CreateReservationFeeResponse], error) {
 	fees := models.ToReservationFees(req.Msg.GetFee())
+	checked := make(map[int64]bool)
+	for _, fee := range fees {
+		if checked[fee.ReservationID] {
+			continue
+		}
+		if err := a.requireReservation(ctx, fee.ReservationID); err != nil {
+			return nil, err
+		}
+		checked[fee.ReservationID] = true
+	}
 	for i := range fees {
 		_ = a.reservationStore.CreateFee(ctx, fees[i])
 	}
@@ -729,16 +1242,35 @@ func (a *ReservationHandler) CreateReservationFee(ctx context.Context, req *conn
 }
 
 func (a *ReservationHandler) UpdateReservationFee(ctx context.Context, req *connect.Request[service.UpdateReservationFeeRequest]) (*connect.Response[service.UpdateReservationFeeResponse], error) {
-	// fee := models.ToReservationFee(req.Msg.GetFee())
-	// err := a.reservationStore.Update(ctx, fee)
-	// if err != nil {
-	// 	return nil, err
-	// }
+	fee := models.ToReservationFee(req.Msg.GetFee())
+	existing, err := a.reservationStore.GetFee(ctx, fee.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("fee %d not found", fee.ID))
+	}
+	if err := a.requireReservation(ctx, existing.ReservationID); err != nil {
+		return nil, err
+	}
+	if err := a.reservationStore.UpdateFee(ctx, *fee); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&service.UpdateReservationFeeResponse{}), nil
 }
 
 func (a *ReservationHandler) DeleteReservationFee(ctx context.Context, req *connect.Request[service.DeleteReservationFeeRequest]) (*connect.Response[service.DeleteReservationFeeResponse], error) {
-	err := a.reservationStore.DeleteFees(ctx, req.Msg.GetId())
+	fee, err := a.reservationStore.GetFee(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if fee == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("fee %d not found", req.Msg.GetId()))
+	}
+	if err := a.requireReservation(ctx, fee.ReservationID); err != nil {
+		return nil, err
+	}
+	err = a.reservationStore.DeleteFees(ctx, req.Msg.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -778,58 +1310,28 @@ func (a *ReservationHandler) CostReducer(ctx context.Context, req *connect.Reque
 }
 
 func reducer(ctx context.Context, category *models.Category, reservation *models.FullReservation, price *stripe.Price) (string, error) {
-
-	var total time.Duration
-	for _, date := range reservation.Dates {
-		if date.Approved == models.ReservationDateApprovedDenied || date.Approved == models.ReservationDateApprovedCanceled {
-			continue
-		}
-		start := date.LocalStart.Time
-		end := date.LocalEnd.Time
-		if end.Before(start) {
-			return "", fmt.Errorf("end before start for date id %d", date.ID)
-		}
-		total += end.Sub(start)
+	lines, err := costLines(reservation, price)
+	if err != nil {
+		return "", err
 	}
-	totalHours := int64(total / time.Hour)
-	if totalHours == 0 {
-		totalHours = 1
+	var sum int64
+	for _, line := range lines {
+		sum += line.amountCents()
 	}
-
-	fees := 0.0
-	for _, fee := range reservation.Fees {
-		fees += utils.PGNumericToFloat64(fee.AdditionalFees)
-	}
-
-	pricePerHourCents := price.UnitAmount
-
-	feeCents := int64(math.Round(fees * 100))
-	costCents := int64(math.Round(float64(pricePerHourCents) * float64(totalHours)))
-	totalCents := max(costCents+feeCents, 0)
+	totalCents := max(sum, 0)
 	result := fmt.Sprintf("%.2f", float64(totalCents)/100.0)
 	slog.Debug(
 		"Cost Reducer",
 		slog.Int64(
 			"reservation_id", reservation.Reservation.ID,
 		),
-		slog.Any(
-			"total duration", total,
-		),
 		slog.Int64(
-			"price_per_hour_cents", pricePerHourCents,
+			"price_per_hour_cents", price.UnitAmount,
 		),
 		slog.Int64(
-			"total_hours", totalHours,
-		),
-		slog.Float64(
-			"fees", fees,
-		),
-		slog.Int64(
-			"fee_cents", feeCents,
-		),
-		slog.Int64(
-			"cost_cents", costCents,
+			"total_hours", lines[0].quantity,
 		),
+		slog.Int("fee_lines", len(lines)-1),
 		slog.Int64(
 			"total_cents", totalCents,
 		),
@@ -838,8 +1340,45 @@ func reducer(ctx context.Context, category *models.Category, reservation *models
 	return result, nil
 }
 
+// costLine is one line of what the reducer adds up.
+type costLine struct {
+	description string
+	quantity    int64
+	unitCents   int64
+}
+
+func (l costLine) amountCents() int64 {
+	return l.quantity * l.unitCents
+}
+
+// costLines itemizes a reservation's cost: its billable hours at the price,
+// then each fee. A negative fee is a discount.
+func costLines(reservation *models.FullReservation, price *stripe.Price) ([]costLine, error) {
+	totalHours, err := billableHours(reservation.Dates)
+	if err != nil {
+		return nil, err
+	}
+	lines := []costLine{{
+		description: "Facility use (hours)",
+		quantity:    totalHours,
+		unitCents:   price.UnitAmount,
+	}}
+	for _, fee := range reservation.Fees {
+		name := fee.FeesType.String
+		if name == "" {
+			name = "Additional fee"
+		}
+		lines = append(lines, costLine{
+			description: name,
+			quantity:    1,
+			unitCents:   int64(math.Round(utils.PGNumericToFloat64(fee.AdditionalFees) * 100)),
+		})
+	}
+	return lines, nil
+}
+
 func (a *ReservationHandler) GetAllPending(ctx context.Context, req *connect.Request[service.GetAllReservationsRequest]) (*connect.Response[service.AllPendingResponse], error) {
-	reservations, err := a.reservationStore.GetAll(ctx)
+	reservations, err := a.scopedReservations(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -885,7 +1424,7 @@ type entry struct {
 }
 
 func (a *ReservationHandler) AllSortedReservations(ctx context.Context, req *connect.Request[service.GetAllReservationsRequest]) (*connect.Response[service.AllSortedResponse], error) {
-	reservations, err := a.reservationStore.GetAll(ctx)
+	reservations, err := a.scopedReservations(ctx)
 	if err != nil {
 		a.log.Error("error getting reservations", "err", err)
 		return nil, err
@@ -1027,14 +1566,14 @@ func buildPublishPlan(res models.Reservation, occs []models.ReservationDate, app
 		}
 	}
 	singles := make([]calendar.OccSpec, 0, len(occs))
-	for i, occ := range occs {
-		singles[i] = calendar.OccSpec{
+	for _, occ := range occs {
+		singles = append(singles, calendar.OccSpec{
 			Start:       occ.LocalStart.Time,
 			End:         occ.LocalEnd.Time,
 			RefID:       occ.ID,
 			Summary:     res.EventName,
 			Description: res.Details.String,
-		}
+		})
 	}
 	return &calendar.PublishPlan{
 		Mode:    "singles",
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"api/internal/auth"
+	"api/internal/lib/utils"
+	"api/internal/models"
+	"api/internal/ports"
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+)
+
+var (
+	errNotAdmin         = errors.New("admin access required")
+	errBuildingNotAdmin = errors.New("not an admin for this building")
+)
+
+// adminScope is the set of buildings the caller is allowed to manage.
+// Site admins (role ADMIN) are not limited to any building.
+type adminScope struct {
+	all       bool
+	buildings map[int64]bool
+}
+
+func (s *adminScope) allows(buildingID int64) bool {
+	return s.all || s.buildings[buildingID]
+}
+
+// callerUser returns the authenticated user making the request.
+func callerUser(ctx context.Context) (*models.Users, error) {
+	authCTX, ok := ctx.Value(utils.CtxKey("user")).(*auth.AuthCTX)
+	if !ok || authCTX == nil || authCTX.User == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+	return authCTX.User, nil
+}
+
+// callerScope resolves the admin scope for the authenticated user. Callers that
+// are neither site admins nor assigned to any building get PermissionDenied.
+func callerScope(ctx context.Context, userStore ports.UserStore) (*adminScope, error) {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == models.UserRoleADMIN {
+		return &adminScope{all: true}, nil
+	}
+	ids, err := userStore.GetAdminBuildingIDs(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, connect.NewError(connect.CodePermissionDenied, errNotAdmin)
+	}
+	scope := &adminScope{buildings: make(map[int64]bool, len(ids))}
+	for _, id := range ids {
+		scope.buildings[id] = true
+	}
+	return scope, nil
+}
+
+// requireSiteAdmin rejects building admins for operations that span every building.
+func requireSiteAdmin(ctx context.Context, userStore ports.UserStore) error {
+	scope, err := callerScope(ctx, userStore)
+	if err != nil {
+		return err
+	}
+	if !scope.all {
+		return connect.NewError(connect.CodePermissionDenied, errNotAdmin)
+	}
+	return nil
+}
+
+// requireBuilding checks that the caller may manage the given building.
+func requireBuilding(ctx context.Context, userStore ports.UserStore, buildingID int64) error {
+	scope, err := callerScope(ctx, userStore)
+	if err != nil {
+		return err
+	}
+	if !scope.allows(buildingID) {
+		return connect.NewError(connect.CodePermissionDenied, errBuildingNotAdmin)
+	}
+	return nil
+}
+
+// requireFacility checks that the caller may manage the building the facility belongs to.
+func requireFacility(ctx context.Context, userStore ports.UserStore, facilityStore ports.FacilityStore, facilityID int64) error {
+	scope, err := callerScope(ctx, userStore)
+	if err != nil {
+		return err
+	}
+	if scope.all {
+		return nil
+	}
+	facility, err := facilityStore.Get(ctx, facilityID)
+	if err != nil {
+		return err
+	}
+	if facility == nil {
+		return connect.NewError(connect.CodeNotFound, errors.New("facility not found"))
+	}
+	if !scope.allows(facility.Facility.BuildingID) {
+		return connect.NewError(connect.CodePermissionDenied, errBuildingNotAdmin)
+	}
+	return nil
+}
+
+// filterReservations drops reservations for facilities outside the scope.
+func (s *adminScope) filterReservations(ctx context.Context, facilityStore ports.FacilityStore, reservations []models.FullReservation) ([]models.FullReservation, error) {
+	if s.all {
+		return reservations, nil
+	}
+	allowed, err := s.facilityFilter(ctx, facilityStore)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]models.FullReservation, 0, len(reservations))
+	for _, r := range reservations {
+		if allowed(r.Reservation.FacilityID) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
+
+// facilityFilter reports whether a facility belongs to a building in the scope.
+func (s *adminScope) facilityFilter(ctx context.Context, facilityStore ports.FacilityStore) (func(facilityID int64) bool, error) {
+	if s.all {
+		return func(int64) bool { return true }, nil
+	}
+	facilities, err := facilityStore.GetAllFacilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	buildingByFacility := make(map[int64]int64, len(facilities))
+	for _, f := range facilities {
+		buildingByFacility[f.ID] = f.BuildingID
+	}
+	return func(facilityID int64) bool {
+		b, ok := buildingByFacility[facilityID]
+		return ok && s.allows(b)
+	}, nil
+}
+
+// requireRequesterOrFacility lets the reservation's requester through, and
+// otherwise checks that the caller may manage its facility.
+func requireRequesterOrFacility(ctx context.Context, userStore ports.UserStore, facilityStore ports.FacilityStore, res *models.Reservation) error {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return err
+	}
+	if user.ID == res.UserID {
+		return nil
+	}
+	return requireFacility(ctx, userStore, facilityStore, res.FacilityID)
+}
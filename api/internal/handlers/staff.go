@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"api/internal/config"
+	"api/internal/lib/emails"
+	"api/internal/models"
+	"api/internal/ports"
+	service "api/internal/proto/staff"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+type StaffHandler struct {
+	staffStore       ports.StaffStore
+	reservationStore ports.ReservationStore
+	facilityStore    ports.FacilityStore
+	userStore        ports.UserStore
+	log              *slog.Logger
+	config           *config.Config
+}
+
+func NewStaffHandler(
+	staffStore ports.StaffStore,
+	reservationStore ports.ReservationStore,
+	facilityStore ports.FacilityStore,
+	userStore ports.UserStore,
+	log *slog.Logger,
+	config *config.Config,
+) *StaffHandler {
+	log.With(slog.Group("Core_StaffHandler", slog.String("name", "staff")))
+	return &StaffHandler{
+		staffStore:       staffStore,
+		reservationStore: reservationStore,
+		facilityStore:    facilityStore,
+		userStore:        userStore,
+		log:              log,
+		config:           config,
+	}
+}
+
+func (a *StaffHandler) GetReservationAssignments(ctx context.Context, req *connect.Request[service.GetReservationAssignmentsRequest]) (*connect.Response[service.StaffAssignmentsResponse], error) {
+	res, err := a.reservationStore.Get(ctx, req.Msg.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("reservation %d not found", req.Msg.GetReservationId()))
+	}
+	if err := requireFacility(ctx, a.userStore, a.facilityStore, res.Reservation.FacilityID); err != nil {
+		return nil, err
+	}
+	assignments, err := a.staffStore.GetReservationAssignments(ctx, res.Reservation.ID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.StaffAssignmentsResponse{
+		Assignments: models.StaffAssignmentsToProto(assignments),
+	}), nil
+}
+
+func (a *StaffHandler) GetMyAssignments(ctx context.Context, req *connect.Request[service.GetMyAssignmentsRequest]) (*connect.Response[service.StaffAssignmentsResponse], error) {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := parseDateRange(req.Msg.GetStartDate(), req.Msg.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := a.staffStore.GetUserAssignments(ctx, user.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.StaffAssignmentsResponse{
+		Assignments: models.StaffAssignmentsToProto(assignments),
+	}), nil
+}
+
+func (a *StaffHandler) CreateAssignments(ctx context.Context, req *connect.Request[service.CreateAssignmentsRequest]) (*connect.Response[service.StaffAssignmentsResponse], error) {
+	role := models.StaffRole(req.Msg.GetRole())
+	if !slices.Contains(models.AllStaffRoleValues(), role) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown role %q", req.Msg.GetRole()))
+	}
+	dateIDs := slices.Compact(slices.Sorted(slices.Values(req.Msg.GetReservationDateIds())))
+	if len(dateIDs) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no dates provided"))
+	}
+
+	dates, err := a.reservationStore.GetDatesByID(ctx, dateIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(dates) != len(dateIDs) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("some dates not found"))
+	}
+	resID := dates[0].ReservationID
+	for _, d := range dates {
+		if d.ReservationID != resID {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("dates must belong to the same reservation"))
+		}
+		if d.Approved == models.ReservationDateApprovedDenied || d.Approved == models.ReservationDateApprovedCanceled {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("date %d is %s", d.ID, d.Approved))
+		}
+	}
+	// the store only checks against shifts already saved
+	if first, second, ok := overlappingDates(dates); ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("dates %d and %d overlap", first.ID, second.ID))
+	}
+	res, err := a.reservationStore.Get(ctx, resID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireFacility(ctx, a.userStore, a.facilityStore, res.Reservation.FacilityID); err != nil {
+		return nil, err
+	}
+
+	staff, err := a.userStore.Get(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if staff == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("user %q not found", req.Msg.GetUserId()))
+	}
+	if staff.Role != models.UserRoleSTAFF && staff.Role != models.UserRoleADMIN {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is not a staff member", staff.Name))
+	}
+
+	ids, conflicts, err := a.staffStore.CreateAssignments(ctx, staff.ID, role, models.CheckNullString(req.Msg.GetNotes()), dateIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		problems := make([]string, len(conflicts))
+		for i, c := range conflicts {
+			problems[i] = fmt.Sprintf("%s at %s %s, %s", c.EventName, c.BuildingName, c.FacilityName, formatShift(c))
+		}
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s is already assigned: %s", staff.Name, strings.Join(problems, "; ")))
+	}
+
+	assignments, err := a.staffStore.GetAssignmentsIn(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	a.notify(staff, assignments, false)
+	return connect.NewResponse(&service.StaffAssignmentsResponse{
+		Assignments: models.StaffAssignmentsToProto(assignments),
+	}), nil
+}
+
+func (a *StaffHandler) DeleteAssignment(ctx context.Context, req *connect.Request[service.DeleteAssignmentRequest]) (*connect.Response[service.DeleteAssignmentResponse], error) {
+	assignment, err := a.staffStore.GetAssignment(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if assignment == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("assignment %d not found", req.Msg.GetId()))
+	}
+	if err := requireBuilding(ctx, a.userStore, assignment.BuildingID); err != nil {
+		return nil, err
+	}
+	if err := a.staffStore.DeleteAssignment(ctx, assignment.ID); err != nil {
+		return nil, err
+	}
+	staff, err := a.userStore.Get(ctx, assignment.UserID)
+	if err != nil {
+		a.log.Error("failed to get staff user", "id", assignment.UserID, "err", err)
+	} else if staff != nil {
+		a.notify(staff, []models.StaffAssignment{*assignment}, true)
+	}
+	return connect.NewResponse(&service.DeleteAssignmentResponse{}), nil
+}
+
+// GetStaffHoursReport totals assigned hours for payroll. Building admins must
+// pick one of their buildings; the all-buildings report is for site admins.
+func (a *StaffHandler) GetStaffHoursReport(ctx context.Context, req *connect.Request[service.GetStaffHoursReportRequest]) (*connect.Response[service.StaffHoursReport], error) {
+	buildingID := req.Msg.GetBuildingId()
+	if buildingID == 0 {
+		if err := requireSiteAdmin(ctx, a.userStore); err != nil {
+			return nil, err
+		}
+	} else if err := requireBuilding(ctx, a.userStore, buildingID); err != nil {
+		return nil, err
+	}
+	from, to, err := parseDateRange(req.Msg.GetStartDate(), req.Msg.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+	rows, err := a.staffStore.GetHoursReport(ctx, from, to, buildingID)
+	if err != nil {
+		return nil, err
+	}
+	report := &service.StaffHoursReport{Rows: make([]*service.StaffHours, len(rows))}
+	for i := range rows {
+		report.Rows[i] = rows[i].ToProto()
+		report.TotalHours += rows[i].Hours
+	}
+	return connect.NewResponse(report), nil
+}
+
+// notify emails the staff member the shifts they were given or taken off.
+func (a *StaffHandler) notify(staff *models.Users, assignments []models.StaffAssignment, removed bool) {
+	if len(assignments) == 0 || a.config.AppEnv != config.PROD {
+		return
+	}
+	first := assignments[0]
+	shifts := make([]string, len(assignments))
+	for i, s := range assignments {
+		shifts[i] = formatShift(s)
+	}
+	subject := "New Shift Assignment"
+	if removed {
+		subject = "Shift Assignment Removed"
+	}
+	go emails.Send(&emails.EmailData{
+		To:       staff.Email,
+		Template: "staffAssignment.html",
+		Subject:  subject,
+		Data: map[string]any{
+			"Name":     staff.Name,
+			"Role":     first.Role.String(),
+			"Event":    first.EventName,
+			"Building": first.BuildingName,
+			"Facility": first.FacilityName,
+			"Shifts":   shifts,
+			"Notes":    first.Notes.String,
+			"Removed":  removed,
+		},
+	})
+}
+
+func formatShift(s models.StaffAssignment) string {
+	return fmt.Sprintf("%s - %s", s.LocalStart.Time.Format("Mon Jan 2, 2006 3:04 PM"), s.LocalEnd.Time.Format("3:04 PM"))
+}
+
+// parseDateRange turns optional YYYY-MM-DD bounds into an inclusive start and
+// an exclusive end.
+func parseDateRange(start, end string) (sql.NullTime, sql.NullTime, error) {
+	var from, to sql.NullTime
+	if start != "" {
+		t, err := time.Parse(time.DateOnly, start)
+		if err != nil {
+			return from, to, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		from = sql.NullTime{Time: t, Valid: true}
+	}
+	if end != "" {
+		t, err := time.Parse(time.DateOnly, end)
+		if err != nil {
+			return from, to, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		to = sql.NullTime{Time: t.AddDate(0, 0, 1), Valid: true}
+	}
+	return from, to, nil
+}
+
+// overlappingDates finds two of the dates that overlap in time, if any.
+func overlappingDates(dates []models.ReservationDate) (models.ReservationDate, models.ReservationDate, bool) {
+	sorted := slices.Clone(dates)
+	slices.SortFunc(sorted, func(a, b models.ReservationDate) int {
+		return a.LocalStart.Time.Compare(b.LocalStart.Time)
+	})
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].LocalStart.Time.Before(sorted[i-1].LocalEnd.Time) {
+			return sorted[i-1], sorted[i], true
+		}
+	}
+	return models.ReservationDate{}, models.ReservationDate{}, false
+}
@@ -1,22 +1,27 @@
 package handlers
 
 import (
+	"api/internal/config"
+	"api/internal/lib/utils"
 	"api/internal/models"
 	"api/internal/ports"
 	service "api/internal/proto/users"
 	"connectrpc.com/connect"
 	"context"
+	"fmt"
 	"log/slog"
+	"strings"
 )
 
 type UserHandler struct {
 	userStore ports.UserStore
 	log       *slog.Logger
+	config    *config.Config
 }
 
-func NewUserHandler(userStore ports.UserStore, log *slog.Logger) *UserHandler {
+func NewUserHandler(userStore ports.UserStore, log *slog.Logger, config *config.Config) *UserHandler {
 	log.With(slog.Group("Core_UserHandler", slog.String("name", "user")))
-	return &UserHandler{userStore: userStore, log: log}
+	return &UserHandler{userStore: userStore, log: log, config: config}
 }
 func (a *UserHandler) GetUserByEmail(ctx context.Context, req *connect.Request[service.UserByEmailRequest]) (*connect.Response[service.Users], error) {
 	user, err := a.userStore.GetByEmail(ctx, req.Msg.GetEmail())
@@ -120,3 +125,94 @@ func (a *UserHandler) DeleteNotification(ctx context.Context, req *connect.Reque
 	}
 	return connect.NewResponse(&service.DeleteNotificationResponse{}), nil
 }
+
+func (a *UserHandler) GetBuildingAdmins(ctx context.Context, req *connect.Request[service.GetBuildingAdminsRequest]) (*connect.Response[service.GetBuildingAdminsResponse], error) {
+	buildingID := req.Msg.GetBuildingId()
+	if buildingID == 0 {
+		if err := requireSiteAdmin(ctx, a.userStore); err != nil {
+			return nil, err
+		}
+	} else if err := requireBuilding(ctx, a.userStore, buildingID); err != nil {
+		return nil, err
+	}
+	admins, err := a.userStore.GetBuildingAdmins(ctx)
+	if err != nil {
+		a.log.ErrorContext(ctx, "Error getting building admins", "error", err)
+		return nil, err
+	}
+	if buildingID != 0 {
+		filtered := make([]*models.BuildingAdmin, 0, len(admins))
+		for _, admin := range admins {
+			if admin.BuildingID == buildingID {
+				filtered = append(filtered, admin)
+			}
+		}
+		admins = filtered
+	}
+	return connect.NewResponse(&service.GetBuildingAdminsResponse{
+		Admins: models.BuildingAdminsToProto(admins),
+	}), nil
+}
+
+func (a *UserHandler) CreateBuildingAdmin(ctx context.Context, req *connect.Request[service.CreateBuildingAdminRequest]) (*connect.Response[service.BuildingAdmin], error) {
+	if err := requireSiteAdmin(ctx, a.userStore); err != nil {
+		return nil, err
+	}
+	admin := models.ToBuildingAdmin(req.Msg.GetAdmin())
+	if err := a.userStore.CreateBuildingAdmin(ctx, admin); err != nil {
+		a.log.Error("Error creating building admin", "error", err)
+		return nil, err
+	}
+	return connect.NewResponse(admin.ToProto()), nil
+}
+
+func (a *UserHandler) DeleteBuildingAdmin(ctx context.Context, req *connect.Request[service.DeleteBuildingAdminRequest]) (*connect.Response[service.DeleteBuildingAdminResponse], error) {
+	if err := requireSiteAdmin(ctx, a.userStore); err != nil {
+		return nil, err
+	}
+	if err := a.userStore.DeleteBuildingAdmin(ctx, req.Msg.GetId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&service.DeleteBuildingAdminResponse{}), nil
+}
+
+func (a *UserHandler) GetCalendarFeeds(ctx context.Context, req *connect.Request[service.GetCalendarFeedsRequest]) (*connect.Response[service.CalendarFeeds], error) {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := a.userStore.GetCalendarFeedToken(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		token = utils.GenerateRandomID()
+		if err := a.userStore.SetCalendarFeedToken(ctx, user.ID, token); err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(a.calendarFeeds(token)), nil
+}
+
+// RotateCalendarFeedToken revokes the caller's feed token, breaking any
+// existing subscriptions that use it.
+func (a *UserHandler) RotateCalendarFeedToken(ctx context.Context, req *connect.Request[service.RotateCalendarFeedTokenRequest]) (*connect.Response[service.CalendarFeeds], error) {
+	user, err := callerUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token := utils.GenerateRandomID()
+	if err := a.userStore.SetCalendarFeedToken(ctx, user.ID, token); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(a.calendarFeeds(token)), nil
+}
+
+func (a *UserHandler) calendarFeeds(token string) *service.CalendarFeeds {
+	base := strings.TrimRight(a.config.ApiHost, "/") + "/calendar"
+	return &service.CalendarFeeds{
+		Token:             token,
+		MyReservationsUrl: fmt.Sprintf("%s/user/%s.ics", base, token),
+		FeedBaseUrl:       base,
+	}
+}
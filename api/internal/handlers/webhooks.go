@@ -0,0 +1,331 @@
+package handlers
+
+import (
+	"api/internal/config"
+	"api/internal/lib/emails"
+	"api/internal/models"
+	"api/internal/ports"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/stripe/stripe-go/v83"
+	"github.com/stripe/stripe-go/v83/webhook"
+)
+
+// Stripe events are small; anything larger is not from Stripe.
+const maxWebhookBody = 1 << 16
+
+// reservationMetadata is the metadata key that ties Stripe objects to a
+// reservation.
+const reservationMetadata = "reservation_id"
+
+// WebhookHandler receives payment events from Stripe. Every request must
+// carry a valid Stripe-Signature for STRIPE_WEBHOOK_SECRET, and each event is
+// applied once no matter how often Stripe delivers it.
+type WebhookHandler struct {
+	paymentStore     ports.PaymentStore
+	reservationStore ports.ReservationStore
+	facilityStore    ports.FacilityStore
+	userStore        ports.UserStore
+	invoices         *invoicer
+	sc               *stripe.Client
+	config           *config.Config
+	log              *slog.Logger
+}
+
+func NewWebhookHandler(paymentStore ports.PaymentStore, reservationStore ports.ReservationStore, facilityStore ports.FacilityStore, userStore ports.UserStore, invoices *invoicer, sc *stripe.Client, config *config.Config, log *slog.Logger) *WebhookHandler {
+	return &WebhookHandler{paymentStore: paymentStore, reservationStore: reservationStore, facilityStore: facilityStore, userStore: userStore, invoices: invoices, sc: sc, config: config, log: log}
+}
+
+func (a *WebhookHandler) Stripe(w http.ResponseWriter, r *http.Request) {
+	if a.config.StripeWebhookKey == "" {
+		http.Error(w, "stripe webhooks are not configured", http.StatusServiceUnavailable)
+		return
+	}
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "could not read body", http.StatusRequestEntityTooLarge)
+		return
+	}
+	event, err := webhook.ConstructEventWithOptions(payload, r.Header.Get("Stripe-Signature"), a.config.StripeWebhookKey, webhook.ConstructEventOptions{
+		IgnoreAPIVersionMismatch: true,
+	})
+	if err != nil {
+		a.log.Warn("Rejected stripe webhook", "err", err)
+		http.Error(w, "invalid signature", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	change, err := a.paymentChange(ctx, event)
+	if err != nil {
+		a.log.Error("Failed to process stripe event", "id", event.ID, "type", event.Type, "err", err)
+		http.Error(w, "could not process event", http.StatusInternalServerError)
+		return
+	}
+	if change == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if change.ReservationID == 0 {
+		a.log.Warn("Stripe event has no reservation", "id", event.ID, "type", event.Type)
+	}
+	applied, err := a.paymentStore.RecordStripeEvent(ctx, change)
+	if err != nil {
+		// a non-2xx response makes Stripe deliver the event again
+		a.log.Error("Failed to record stripe event", "id", event.ID, "type", event.Type, "err", err)
+		http.Error(w, "could not record event", http.StatusInternalServerError)
+		return
+	}
+	if !applied {
+		a.log.Debug("Stripe event already processed", "id", event.ID)
+	} else if change.ReservationID != 0 {
+		a.notify(ctx, change)
+		if change.Status == models.PaymentStatusPaid || change.Status == models.PaymentStatusDepositPaid {
+			a.invoices.issueReceipt(ctx, change.ReservationID)
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// paymentChange maps a Stripe event to the payment status it sets and the
+// ledger entries it adds. It returns nil for events that change nothing.
+func (a *WebhookHandler) paymentChange(ctx context.Context, event stripe.Event) (*models.StripeEvent, error) {
+	change := &models.StripeEvent{ID: event.ID, Type: string(event.Type)}
+	var err error
+	switch event.Type {
+	case stripe.EventTypeCheckoutSessionCompleted, stripe.EventTypeCheckoutSessionAsyncPaymentSucceeded:
+		var s stripe.CheckoutSession
+		if err := json.Unmarshal(event.Data.Raw, &s); err != nil {
+			return nil, err
+		}
+		// delayed payment methods complete the session before they pay
+		if s.PaymentStatus != stripe.CheckoutSessionPaymentStatusPaid {
+			return nil, nil
+		}
+		checkoutCharge(change, &s)
+		if id, err := strconv.ParseInt(s.ClientReferenceID, 10, 64); err == nil {
+			change.ReservationID = id
+		} else {
+			change.ReservationID, err = a.reservationFor(ctx, s.Metadata, change.PaymentIntentID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case stripe.EventTypePaymentIntentSucceeded:
+		var pi stripe.PaymentIntent
+		if err := json.Unmarshal(event.Data.Raw, &pi); err != nil {
+			return nil, err
+		}
+		change.Status = paidStatus(pi.Metadata)
+		change.PaymentIntentID = pi.ID
+		change.Entries = append(change.Entries, stripeEntry(models.PaymentEntryKindCharge, pi.AmountReceived, string(pi.Currency), pi.ID, ""))
+		change.ReservationID, err = a.reservationFor(ctx, pi.Metadata, pi.ID)
+	case stripe.EventTypeChargeRefunded:
+		var ch stripe.Charge
+		if err := json.Unmarshal(event.Data.Raw, &ch); err != nil {
+			return nil, err
+		}
+		change.Status = models.PaymentStatusPartiallyRefunded
+		if ch.Refunded {
+			change.Status = models.PaymentStatusRefunded
+		}
+		change.Entries, err = a.refundEntries(ctx, ch.ID)
+		if err != nil {
+			return nil, err
+		}
+		change.ReservationID, err = a.reservationFor(ctx, ch.Metadata, paymentIntentID(ch.PaymentIntent))
+	case stripe.EventTypeChargeDisputeCreated, stripe.EventTypeChargeDisputeClosed:
+		var d stripe.Dispute
+		if err := json.Unmarshal(event.Data.Raw, &d); err != nil {
+			return nil, err
+		}
+		change.ReservationID, err = a.reservationFor(ctx, d.Metadata, paymentIntentID(d.PaymentIntent))
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case event.Type == stripe.EventTypeChargeDisputeCreated:
+			change.Status = models.PaymentStatusDisputed
+		case d.Status == stripe.DisputeStatusLost:
+			change.Status = models.PaymentStatusDisputeLost
+			change.Entries = append(change.Entries, stripeEntry(models.PaymentEntryKindRefund, -d.Amount, string(d.Currency), d.ID, "Dispute lost"))
+		default:
+			change.Status, err = a.disputeWonStatus(ctx, change.ReservationID)
+		}
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// reservationFor finds the reservation from the object's metadata, falling
+// back to the payment intent recorded on it.
+func (a *WebhookHandler) reservationFor(ctx context.Context, metadata map[string]string, paymentIntent string) (int64, error) {
+	if v, ok := metadata[reservationMetadata]; ok {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s metadata %q", reservationMetadata, v)
+		}
+		return id, nil
+	}
+	if paymentIntent == "" {
+		return 0, nil
+	}
+	return a.paymentStore.ReservationByPaymentIntent(ctx, paymentIntent)
+}
+
+// disputeWonStatus is the status a won dispute returns the reservation to,
+// worked out from its ledger: refunded or partially refunded once anything
+// was refunded, otherwise paid or deposit paid by the balance left.
+func (a *WebhookHandler) disputeWonStatus(ctx context.Context, reservationID int64) (models.PaymentStatus, error) {
+	if reservationID == 0 {
+		return "", nil
+	}
+	res, err := a.reservationStore.Get(ctx, reservationID)
+	if err != nil || res == nil {
+		return "", err
+	}
+	entries, err := a.paymentStore.GetPaymentEntries(ctx, reservationID)
+	if err != nil {
+		return "", err
+	}
+	paid := ledgerCents(entries)
+	for _, e := range entries {
+		if e.Kind == models.PaymentEntryKindRefund {
+			if paid <= 0 {
+				return models.PaymentStatusRefunded, nil
+			}
+			return models.PaymentStatusPartiallyRefunded, nil
+		}
+	}
+	total, err := reservationTotalCents(ctx, a.facilityStore, a.sc, res)
+	if err != nil {
+		return "", err
+	}
+	if paid >= total {
+		return models.PaymentStatusPaid, nil
+	}
+	return models.PaymentStatusDepositPaid, nil
+}
+
+// refundEntries lists a charge's refunds. Refunds already in the ledger are
+// skipped when recorded.
+func (a *WebhookHandler) refundEntries(ctx context.Context, chargeID string) ([]models.PaymentEntry, error) {
+	var entries []models.PaymentEntry
+	params := &stripe.RefundListParams{Charge: stripe.String(chargeID)}
+	for refund, err := range a.sc.V1Refunds.List(ctx, params) {
+		if err != nil {
+			return nil, err
+		}
+		if refund.Status == stripe.RefundStatusFailed || refund.Status == stripe.RefundStatusCanceled {
+			continue
+		}
+		entries = append(entries, stripeEntry(models.PaymentEntryKindRefund, -refund.Amount, string(refund.Currency), refund.ID, string(refund.Reason)))
+	}
+	return entries, nil
+}
+
+// checkoutCharge sets the status and ledger charge of a paid checkout
+// session. The charge is keyed by its payment intent, so the webhook and the
+// browser returning from checkout record it once between them.
+func checkoutCharge(change *models.StripeEvent, s *stripe.CheckoutSession) {
+	ref := s.ID
+	if s.PaymentIntent != nil {
+		change.PaymentIntentID = s.PaymentIntent.ID
+		ref = s.PaymentIntent.ID
+	}
+	change.Status = paidStatus(s.Metadata)
+	change.Entries = append(change.Entries, stripeEntry(models.PaymentEntryKindCharge, s.AmountTotal, string(s.Currency), ref, ""))
+}
+
+// sessionFor reports whether a checkout session was started for the
+// reservation: its client reference and metadata may not name another.
+func sessionFor(s *stripe.CheckoutSession, reservationID int64) bool {
+	id := strconv.FormatInt(reservationID, 10)
+	ref, meta := s.ClientReferenceID, s.Metadata[reservationMetadata]
+	if ref == "" && meta == "" {
+		return false
+	}
+	return (ref == "" || ref == id) && (meta == "" || meta == id)
+}
+
+func stripeEntry(kind models.PaymentEntryKind, cents int64, currency, ref, note string) models.PaymentEntry {
+	return models.PaymentEntry{
+		Kind:        kind,
+		AmountCents: cents,
+		Currency:    currency,
+		Provider:    models.PaymentProviderStripe,
+		ProviderRef: sql.NullString{String: ref, Valid: ref != ""},
+		Note:        sql.NullString{String: note, Valid: note != ""},
+	}
+}
+
+func paymentIntentID(pi *stripe.PaymentIntent) string {
+	if pi == nil {
+		return ""
+	}
+	return pi.ID
+}
+
+// notify tells the building's admins about the payment change.
+func (a *WebhookHandler) notify(ctx context.Context, change *models.StripeEvent) {
+	if err := a.notifyAdmins(ctx, change); err != nil {
+		a.log.Error("Failed to notify admins of payment", "reservation", change.ReservationID, "err", err)
+	}
+}
+
+func (a *WebhookHandler) notifyAdmins(ctx context.Context, change *models.StripeEvent) error {
+	res, err := a.reservationStore.Get(ctx, change.ReservationID)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return errors.New("reservation not found")
+	}
+	return notifyPaymentAdmins(ctx, a.facilityStore, a.userStore, a.config, res, change.Status.String())
+}
+
+// notifyPaymentAdmins emails the admins of the reservation's building that
+// its payment status changed.
+func notifyPaymentAdmins(ctx context.Context, facilityStore ports.FacilityStore, userStore ports.UserStore, cfg *config.Config, res *models.FullReservation, status string) error {
+	facility, err := facilityStore.Get(ctx, res.Reservation.FacilityID)
+	if err != nil {
+		return err
+	}
+	if facility == nil || facility.Facility == nil {
+		return errors.New("facility not found")
+	}
+	toEmails, err := userStore.NotificationUsersByBuilding(ctx, facility.Facility.BuildingID)
+	if err != nil {
+		return err
+	}
+	if len(toEmails) == 0 {
+		return nil
+	}
+	emailData := &emails.EmailData{
+		To:       strings.Join(toEmails, ","),
+		Template: "paymentUpdate.html",
+		Subject:  "Payment Update",
+		Data: map[string]any{
+			"Name":   res.Reservation.EventName,
+			"Status": strings.ReplaceAll(status, "_", " "),
+			"URL":    fmt.Sprintf("%s/reservation/%d", cfg.FrontendUrl, res.Reservation.ID),
+		},
+	}
+	if cfg.AppEnv == config.PROD {
+		go emails.Send(emailData)
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+package calendars
+
+import (
+	"api/internal/models"
+	"api/internal/ports"
+	"api/pkg/calendar"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+const (
+	outboxBatch       = 20
+	outboxLease       = 5 * time.Minute
+	outboxMaxAttempts = 10
+	outboxBackoff     = 30 * time.Second
+	outboxMaxBackoff  = time.Hour
+)
+
+// Outbox runs the calendar operations that reservation changes queue in
+// the database. Each operation is retried with exponential backoff until it
+// succeeds or runs out of attempts, and published event ids are written
+// back when it finishes. Event text is rendered from the reservation when
+// an operation runs, so it always matches the latest edit and visibility.
+type Outbox struct {
+	store        ports.CalendarOutboxStore
+	reservations ports.ReservationStore
+	cal          ports.CalendarProvider
+	text         *EventText
+	log          *slog.Logger
+	wake         chan struct{}
+}
+
+func NewOutbox(store ports.CalendarOutboxStore, reservations ports.ReservationStore, cal ports.CalendarProvider, text *EventText, log *slog.Logger) *Outbox {
+	return &Outbox{
+		store:        store,
+		reservations: reservations,
+		cal:          cal,
+		text:         text,
+		log:          log.With("component", "calendar_outbox"),
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// Commit saves a status change with its operations and wakes the worker so
+// they run right away.
+func (o *Outbox) Commit(ctx context.Context, change *models.StatusChange) error {
+	if err := o.store.CommitStatusChange(ctx, change); err != nil {
+		return err
+	}
+	if len(change.Ops) > 0 {
+		o.Notify()
+	}
+	return nil
+}
+
+// Notify wakes the worker without blocking.
+func (o *Outbox) Notify() {
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Wake receives after Notify.
+func (o *Outbox) Wake() <-chan struct{} {
+	return o.wake
+}
+
+// RunDue executes due operations until none are left and returns how many
+// finished.
+func (o *Outbox) RunDue(ctx context.Context) (int, error) {
+	done := 0
+	for {
+		ops, err := o.store.ClaimCalendarOps(ctx, outboxBatch, outboxLease)
+		if err != nil {
+			return done, fmt.Errorf("failed to claim calendar operations: %w", err)
+		}
+		if len(ops) == 0 {
+			return done, nil
+		}
+		sort.Slice(ops, func(i, j int) bool { return ops[i].ID < ops[j].ID })
+		for i := range ops {
+			op := &ops[i]
+			result, err := o.execute(ctx, op)
+			if err != nil {
+				o.retry(ctx, op, err)
+				continue
+			}
+			if err := o.store.CompleteCalendarOp(ctx, op, result); err != nil {
+				return done, fmt.Errorf("failed to complete calendar operation %d: %w", op.ID, err)
+			}
+			done++
+		}
+		select {
+		case <-ctx.Done():
+			return done, ctx.Err()
+		default:
+		}
+	}
+}
+
+func (o *Outbox) retry(ctx context.Context, op *models.CalendarOp, cause error) {
+	status := models.CalendarOpStatusPending
+	delay := outboxBackoff
+	for i := int32(1); i < op.Attempts && delay < outboxMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > outboxMaxBackoff {
+		delay = outboxMaxBackoff
+	}
+	if op.Attempts >= outboxMaxAttempts {
+		status = models.CalendarOpStatusFailed
+		o.log.Error("Calendar operation failed for good", "id", op.ID, "kind", op.Kind, "reservation_id", op.ReservationID.Int64, "attempts", op.Attempts, "error", cause)
+	} else {
+		o.log.Warn("Calendar operation failed, retrying", "id", op.ID, "kind", op.Kind, "attempts", op.Attempts, "retry_in", delay, "error", cause)
+	}
+	if err := o.store.RetryCalendarOp(ctx, op.ID, status, time.Now().Add(delay), cause.Error()); err != nil {
+		o.log.Error("Failed to record calendar operation attempt", "id", op.ID, "error", err)
+	}
+}
+
+func (o *Outbox) execute(ctx context.Context, op *models.CalendarOp) (*models.CalendarOpResult, error) {
+	p := op.Payload
+	switch op.Kind {
+	case models.CalendarOpKindPublish:
+		if p.Plan == nil {
+			return nil, fmt.Errorf("publish operation %d has no plan", op.ID)
+		}
+		plan, res, err := o.openPlan(ctx, op)
+		if err != nil || plan == nil {
+			return nil, err
+		}
+		opts := calendar.PublishOptions{}
+		if p.Options != nil {
+			opts = *p.Options
+		}
+		opts.CalendarID = op.CalendarID
+		// the reservation may have been edited while the operation waited
+		opts.Summary, opts.Description = o.render(res, opts.Tentative)
+		pub, err := o.cal.Publish(ctx, plan, opts)
+		if err != nil {
+			return nil, err
+		}
+		result := &models.CalendarOpResult{DateEventIDs: pub.SingleEventID}
+		if plan.Mode == calendar.ModeSeries && pub.MasterEventID != nil {
+			result.MasterEventID = *pub.MasterEventID
+		}
+		return result, nil
+	case models.CalendarOpKindPatch:
+		if p.Event == nil {
+			return nil, fmt.Errorf("patch operation %d has no event", op.ID)
+		}
+		ev := *p.Event
+		if op.ReservationID.Valid {
+			wrap, err := o.reservations.Get(ctx, op.ReservationID.Int64)
+			if err != nil {
+				return nil, err
+			}
+			if wrap == nil {
+				return nil, nil
+			}
+			res := wrap.Reservation
+			ev.Summary, ev.Description = o.render(&res, res.Approved == models.ReservationApprovedPending)
+		}
+		if ev.Start.IsZero() {
+			// text only: keep the times the event has now
+			current, err := o.cal.GetEvent(ctx, op.CalendarID, ev.ID)
+			if err != nil {
+				return nil, err
+			}
+			ev.Start, ev.End, ev.AllDay = current.Start, current.End, current.AllDay
+		}
+		return nil, o.cal.UpdateEvent(ctx, op.CalendarID, ev)
+	case models.CalendarOpKindDelete:
+		err := o.cal.DeleteEvent(ctx, op.CalendarID, p.EventID)
+		if err != nil && !calendar.IsNotFound(err) {
+			return nil, err
+		}
+		return nil, nil
+	case models.CalendarOpKindExdates:
+		return nil, o.cal.AddExdatesToMaster(ctx, op.CalendarID, p.EventID, p.RRule, p.Exdates)
+	}
+	return nil, fmt.Errorf("unknown calendar operation %q", op.Kind)
+}
+
+// openPlan drops what was published or unapproved since the operation was
+// queued and takes the current text and times of what is left. Holds are
+// only published for requests that are still pending. A nil plan means
+// there is nothing left to publish.
+func (o *Outbox) openPlan(ctx context.Context, op *models.CalendarOp) (*calendar.PublishPlan, *models.Reservation, error) {
+	plan := *op.Payload.Plan
+	tentative := op.Payload.Options != nil && op.Payload.Options.Tentative
+	resStatus, dateStatus := models.ReservationApprovedApproved, models.ReservationDateApprovedApproved
+	if tentative {
+		resStatus, dateStatus = models.ReservationApprovedPending, models.ReservationDateApprovedPending
+	}
+	wrap, err := o.reservations.Get(ctx, op.ReservationID.Int64)
+	if err != nil || wrap == nil {
+		return nil, nil, err
+	}
+	res := &wrap.Reservation
+	if tentative && res.Approved != models.ReservationApprovedPending {
+		return nil, nil, nil
+	}
+	summary, description := o.render(res, tentative)
+	if plan.Mode == calendar.ModeSeries {
+		if res.Approved != resStatus || res.GCalEventID.String != "" {
+			return nil, nil, nil
+		}
+		series := *plan.Series
+		series.Summary, series.Description = summary, description
+		plan.Series = &series
+		return &plan, res, nil
+	}
+
+	ids := make([]int64, len(plan.Singles))
+	for i, s := range plan.Singles {
+		ids[i] = s.RefID
+	}
+	dates, err := o.reservations.GetDatesByID(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	open := make(map[int64]models.ReservationDate, len(dates))
+	for _, d := range dates {
+		if d.Approved == dateStatus && d.GcalEventid.String == "" {
+			open[d.ID] = d
+		}
+	}
+	singles := make([]calendar.OccSpec, 0, len(plan.Singles))
+	for _, s := range plan.Singles {
+		d, ok := open[s.RefID]
+		if !ok {
+			continue
+		}
+		s.Start, s.End = d.LocalStart.Time, d.LocalEnd.Time
+		s.Summary, s.Description = summary, description
+		singles = append(singles, s)
+	}
+	if len(singles) == 0 {
+		return nil, nil, nil
+	}
+	plan.Singles = singles
+	return &plan, res, nil
+}
+
+// render is the text of a reservation's events; holds are marked pending.
+func (o *Outbox) render(res *models.Reservation, tentative bool) (string, string) {
+	summary, description := o.text.Render(*res)
+	if tentative {
+		summary = calendar.PendingPrefix + summary
+	}
+	return summary, description
+}
+
+// PublishOps queues a publish plan. Singles become one operation per date
+// so a failure never strands the dates published before it; a series is one
+// operation whose master id goes to every date in dateIDs.
+func PublishOps(reservationID int64, calendarID string, plan *calendar.PublishPlan, opts calendar.PublishOptions, dateIDs []int64) []models.CalendarOp {
+	opts.CalendarID = ""
+	if plan.Mode == calendar.ModeSeries {
+		return []models.CalendarOp{newOp(reservationID, calendarID, models.CalendarOpKindPublish, models.CalendarOpPayload{
+			Plan:    plan,
+			Options: &opts,
+			DateIDs: dateIDs,
+		})}
+	}
+	ops := make([]models.CalendarOp, 0, len(plan.Singles))
+	for _, single := range plan.Singles {
+		ops = append(ops, newOp(reservationID, calendarID, models.CalendarOpKindPublish, models.CalendarOpPayload{
+			Plan: &calendar.PublishPlan{
+				Mode:    calendar.ModeSingles,
+				Singles: []calendar.OccSpec{single},
+			},
+			Options: &opts,
+		}))
+	}
+	return ops
+}
+
+// PatchOp queues an update of an existing event. An event without a start
+// keeps its current times and only takes the new text.
+func PatchOp(reservationID int64, calendarID string, ev calendar.Event) models.CalendarOp {
+	return newOp(reservationID, calendarID, models.CalendarOpKindPatch, models.CalendarOpPayload{Event: &ev})
+}
+
+// PatchSeriesOp queues new text for a series master. Its times are kept, so
+// no occurrence moves.
+func PatchSeriesOp(reservationID int64, calendarID, masterEventID, summary, description, location string) models.CalendarOp {
+	return PatchOp(reservationID, calendarID, calendar.Event{
+		ID:          masterEventID,
+		Summary:     summary,
+		Description: description,
+		Location:    location,
+	})
+}
+
+// PatchInstanceOp queues an update of one occurrence of a series, found by
+// the start the recurrence gives it. The operation's event id is the
+// instance id to track the occurrence by afterwards.
+func PatchInstanceOp(reservationID int64, calendarID, masterEventID string, originalStart time.Time, ev calendar.Event) models.CalendarOp {
+	ev.ID = calendar.InstanceID(masterEventID, originalStart)
+	return PatchOp(reservationID, calendarID, ev)
+}
+
+// ConfirmOp queues the promotion of a tentative hold to a confirmed event
+// in place. An event without a start keeps its times.
+func ConfirmOp(reservationID int64, calendarID string, ev calendar.Event) models.CalendarOp {
+	ev.Status = calendar.StatusConfirmed
+	return PatchOp(reservationID, calendarID, ev)
+}
+
+// DeleteOp queues the deletion of an event or occurrence.
+func DeleteOp(reservationID int64, calendarID, eventID string) models.CalendarOp {
+	return newOp(reservationID, calendarID, models.CalendarOpKindDelete, models.CalendarOpPayload{EventID: eventID})
+}
+
+// ExdatesOp queues a rewrite of a series master's exclusions.
+func ExdatesOp(reservationID int64, calendarID, masterEventID, rrule string, exdates []time.Time) models.CalendarOp {
+	return newOp(reservationID, calendarID, models.CalendarOpKindExdates, models.CalendarOpPayload{
+		EventID: masterEventID,
+		RRule:   rrule,
+		Exdates: exdates,
+	})
+}
+
+func newOp(reservationID int64, calendarID string, kind models.CalendarOpKind, payload models.CalendarOpPayload) models.CalendarOp {
+	return models.CalendarOp{
+		ReservationID: sql.NullInt64{Int64: reservationID, Valid: reservationID != 0},
+		Kind:          kind,
+		CalendarID:    calendarID,
+		Payload:       payload,
+	}
+}
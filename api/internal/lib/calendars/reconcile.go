@@ -0,0 +1,569 @@
+package calendars
+
+import (
+	"api/internal/models"
+	"api/internal/ports"
+	"api/pkg/calendar"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// DiscrepancyKind is the category of a difference between the database and
+// a facility calendar.
+type DiscrepancyKind string
+
+const (
+	// DiscrepancyMissingEvent is an approved date with no event.
+	DiscrepancyMissingEvent DiscrepancyKind = "missing_event"
+	// DiscrepancyOrphanEvent is an event no approved date accounts for.
+	DiscrepancyOrphanEvent DiscrepancyKind = "orphan_event"
+	// DiscrepancySeriesDrift is a series occurrence that disagrees with the
+	// reservation's dates, usually because EXDATEs were lost.
+	DiscrepancySeriesDrift DiscrepancyKind = "series_drift"
+)
+
+func (k DiscrepancyKind) String() string {
+	return string(k)
+}
+
+// Discrepancy is one difference found on a calendar. Reservation fields are
+// zero for events that match no reservation.
+type Discrepancy struct {
+	Kind              DiscrepancyKind
+	FacilityID        int64
+	ReservationID     int64
+	ReservationDateID int64
+	EventID           string
+	Summary           string
+	Start             time.Time
+	End               time.Time
+	Detail            string
+	Repaired          bool
+}
+
+// Reconciliation is the report for one calendar. Facilities sharing the
+// calendar are reconciled together.
+type Reconciliation struct {
+	FacilityID    int64
+	CalendarID    string
+	Skipped       string
+	Discrepancies []Discrepancy
+	// Errors holds repairs that failed; the report itself is complete.
+	Errors []string
+}
+
+// Count returns how many discrepancies of kind were found.
+func (r *Reconciliation) Count(kind DiscrepancyKind) int {
+	n := 0
+	for _, d := range r.Discrepancies {
+		if d.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// Reconciler diffs reservation dates against the events on facility
+// calendars within the listing window. In apply mode it republishes
+// missing events, deletes orphans and rewrites the EXDATEs of drifted
+// series.
+type Reconciler struct {
+	reservations ports.ReservationStore
+	facilities   ports.FacilityStore
+	cal          ports.CalendarProvider
+	text         *EventText
+	loc          *time.Location
+	log          *slog.Logger
+}
+
+func NewReconciler(reservations ports.ReservationStore, facilities ports.FacilityStore, cal ports.CalendarProvider, text *EventText, loc *time.Location, log *slog.Logger) *Reconciler {
+	return &Reconciler{
+		reservations: reservations,
+		facilities:   facilities,
+		cal:          cal,
+		text:         text,
+		loc:          loc,
+		log:          log.With("component", "calendar_reconcile"),
+	}
+}
+
+// ReconcileFacilities reconciles each distinct calendar of facilities once.
+// Calendars that are also their building's calendar are skipped, since the
+// building sync copies every facility onto them. A calendar that cannot be
+// read does not stop the others.
+func (r *Reconciler) ReconcileFacilities(ctx context.Context, facilities []models.Facility, apply bool) ([]*Reconciliation, error) {
+	out := make([]*Reconciliation, 0, len(facilities))
+	seen := make(map[string]bool, len(facilities))
+	buildingCalendars := make(map[int64]string)
+	var errs []error
+	for _, facility := range facilities {
+		calendarID := facility.GoogleCalendarID
+		if calendarID == "" || seen[calendarID] {
+			continue
+		}
+		seen[calendarID] = true
+
+		buildingCalendar, ok := buildingCalendars[facility.BuildingID]
+		if !ok {
+			building, err := r.facilities.GetBuilding(ctx, facility.BuildingID)
+			if err != nil {
+				return out, fmt.Errorf("failed to get building %d: %w", facility.BuildingID, err)
+			}
+			if building != nil {
+				buildingCalendar = building.GoogleCalendarID.String
+			}
+			buildingCalendars[facility.BuildingID] = buildingCalendar
+		}
+		if calendarID == buildingCalendar {
+			out = append(out, &Reconciliation{
+				FacilityID: facility.ID,
+				CalendarID: calendarID,
+				Skipped:    "facility publishes to its building calendar",
+			})
+			continue
+		}
+
+		rec, err := r.reconcile(ctx, facility.ID, calendarID, apply)
+		if err != nil {
+			r.log.Error("Failed to reconcile facility calendar", "facility_id", facility.ID, "error", err)
+			errs = append(errs, fmt.Errorf("facility %d: %w", facility.ID, err))
+		} else {
+			out = append(out, rec)
+		}
+
+		select {
+		case <-ctx.Done():
+			return out, ctx.Err()
+		default:
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+// seriesCheck collects what the database says about one published series.
+type seriesCheck struct {
+	master        string
+	reservationID int64
+	facilityID    int64
+	// approved, or pending with a tentative hold
+	approved bool
+	booked   map[int64]models.CalendarDate
+	// statuses of the dates that should be excluded
+	statuses map[int64]models.ReservationDateApproved
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, facilityID int64, calendarID string, apply bool) (*Reconciliation, error) {
+	rec := &Reconciliation{FacilityID: facilityID, CalendarID: calendarID}
+	from, to := calendar.ListWindow(time.Now().In(r.loc))
+	dates, err := r.reservations.GetCalendarDates(ctx, calendarID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservation dates: %w", err)
+	}
+	events, err := r.cal.ListEvents(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	listed := make(map[string]calendar.Event, len(events))
+	occurrences := make(map[string]map[int64]calendar.Event)
+	for _, ev := range events {
+		listed[ev.ID] = ev
+		if master, start, ok := calendar.SplitInstanceID(ev.ID); ok {
+			if occurrences[master] == nil {
+				occurrences[master] = make(map[int64]calendar.Event)
+			}
+			occurrences[master][start.Unix()] = ev
+		}
+	}
+
+	known := make(map[string]bool, len(dates))
+	stale := make(map[string]models.CalendarDate)
+	series := make(map[string]*seriesCheck)
+	var missing []models.CalendarDate
+	for _, d := range dates {
+		if d.EventID.Valid && d.SeriesID.Valid && d.EventID.String == d.SeriesID.String {
+			sc, ok := series[d.SeriesID.String]
+			if !ok {
+				sc = &seriesCheck{
+					master:        d.SeriesID.String,
+					reservationID: d.ReservationID,
+					facilityID:    d.FacilityID,
+					approved:      d.ReservationStatus == models.ReservationApprovedApproved || d.ReservationStatus == models.ReservationApprovedPending,
+					booked:        make(map[int64]models.CalendarDate),
+					statuses:      make(map[int64]models.ReservationDateApproved),
+				}
+				series[sc.master] = sc
+			}
+			start := inLocation(d.LocalStart.Time, r.loc)
+			if d.Booked() || d.Held() {
+				sc.booked[start.Unix()] = d
+			} else {
+				sc.statuses[start.Unix()] = d.DateStatus
+			}
+			continue
+		}
+		if !d.EventID.Valid {
+			if d.Booked() {
+				missing = append(missing, d)
+			}
+			continue
+		}
+		if d.Held() {
+			// a tentative hold is neither missing nor an orphan
+			known[d.EventID.String] = true
+			continue
+		}
+		if !d.Booked() {
+			stale[d.EventID.String] = d
+			continue
+		}
+		known[d.EventID.String] = true
+		if _, ok := listed[d.EventID.String]; ok {
+			continue
+		}
+		// moved out of the window, or gone
+		_, err := r.cal.GetEvent(ctx, calendarID, d.EventID.String)
+		if err != nil && !calendar.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get event %s: %w", d.EventID.String, err)
+		}
+		if err != nil {
+			missing = append(missing, d)
+		}
+	}
+
+	var drifted []*seriesCheck
+	for _, sc := range series {
+		known[sc.master] = true
+		if !sc.approved {
+			// every occurrence left on the calendar is an orphan
+			continue
+		}
+		instances := occurrences[sc.master]
+		if len(instances) == 0 && len(sc.booked) > 0 {
+			// no occurrence matched by id: either the series is gone, or
+			// the provider's occurrence ids cannot be matched
+			_, err := r.cal.GetEvent(ctx, calendarID, sc.master)
+			if err != nil && !calendar.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get series %s: %w", sc.master, err)
+			}
+			if err != nil {
+				for _, d := range sc.booked {
+					if d.Booked() {
+						missing = append(missing, d)
+					}
+				}
+			}
+			continue
+		}
+		drift := false
+		for unix, d := range sc.booked {
+			if _, ok := instances[unix]; ok || d.Held() {
+				continue
+			}
+			drift = true
+			rec.Discrepancies = append(rec.Discrepancies, Discrepancy{
+				Kind:              DiscrepancySeriesDrift,
+				FacilityID:        d.FacilityID,
+				ReservationID:     d.ReservationID,
+				ReservationDateID: d.ReservationDateID,
+				EventID:           calendar.InstanceID(sc.master, time.Unix(unix, 0)),
+				Summary:           d.EventName,
+				Start:             inLocation(d.LocalStart.Time, r.loc),
+				End:               inLocation(d.LocalEnd.Time, r.loc),
+				Detail:            "approved date is excluded from the series",
+			})
+		}
+		for unix, ev := range instances {
+			if _, ok := sc.booked[unix]; ok || known[ev.ID] {
+				continue
+			}
+			known[ev.ID] = true
+			drift = true
+			detail := "occurrence has no approved date"
+			if status, ok := sc.statuses[unix]; ok {
+				detail = fmt.Sprintf("occurrence date is %s", status)
+			}
+			rec.Discrepancies = append(rec.Discrepancies, Discrepancy{
+				Kind:          DiscrepancySeriesDrift,
+				FacilityID:    sc.facilityID,
+				ReservationID: sc.reservationID,
+				EventID:       ev.ID,
+				Summary:       ev.Summary,
+				Start:         ev.Start,
+				End:           ev.End,
+				Detail:        detail,
+			})
+		}
+		if drift {
+			drifted = append(drifted, sc)
+		}
+	}
+
+	for _, d := range missing {
+		detail := "approved date has no event"
+		if d.EventID.Valid {
+			detail = "event was deleted from the calendar"
+		}
+		rec.Discrepancies = append(rec.Discrepancies, Discrepancy{
+			Kind:              DiscrepancyMissingEvent,
+			FacilityID:        d.FacilityID,
+			ReservationID:     d.ReservationID,
+			ReservationDateID: d.ReservationDateID,
+			EventID:           d.EventID.String,
+			Summary:           d.EventName,
+			Start:             inLocation(d.LocalStart.Time, r.loc),
+			End:               inLocation(d.LocalEnd.Time, r.loc),
+			Detail:            detail,
+		})
+	}
+
+	for _, ev := range events {
+		if known[ev.ID] {
+			continue
+		}
+		disc := Discrepancy{
+			Kind:       DiscrepancyOrphanEvent,
+			FacilityID: facilityID,
+			EventID:    ev.ID,
+			Summary:    ev.Summary,
+			Start:      ev.Start,
+			End:        ev.End,
+			Detail:     "event matches no reservation",
+		}
+		if d, ok := stale[ev.ID]; ok {
+			disc.FacilityID = d.FacilityID
+			disc.ReservationID = d.ReservationID
+			disc.ReservationDateID = d.ReservationDateID
+			disc.Detail = fmt.Sprintf("reservation is %s and date is %s", d.ReservationStatus, d.DateStatus)
+		} else if master, _, ok := calendar.SplitInstanceID(ev.ID); ok && series[master] != nil {
+			disc.FacilityID = series[master].facilityID
+			disc.ReservationID = series[master].reservationID
+			disc.Detail = "series belongs to a reservation that is not approved"
+		}
+		rec.Discrepancies = append(rec.Discrepancies, disc)
+	}
+
+	sort.SliceStable(rec.Discrepancies, func(i, j int) bool {
+		a, b := rec.Discrepancies[i], rec.Discrepancies[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Start.Before(b.Start)
+	})
+
+	if apply {
+		r.repair(ctx, rec, drifted)
+	}
+	return rec, nil
+}
+
+// repair makes the calendar match the database. Failures are recorded on
+// the report and the remaining repairs still run.
+func (r *Reconciler) repair(ctx context.Context, rec *Reconciliation, drifted []*seriesCheck) {
+	fail := func(d Discrepancy, err error) {
+		r.log.Error("Failed to repair calendar discrepancy", "calendar_id", rec.CalendarID, "kind", d.Kind, "event_id", d.EventID, "error", err)
+		rec.Errors = append(rec.Errors, fmt.Sprintf("%s %s: %v", d.Kind, d.EventID, err))
+	}
+
+	missing := make(map[int64][]int)
+	for i, d := range rec.Discrepancies {
+		switch d.Kind {
+		case DiscrepancyMissingEvent:
+			missing[d.ReservationID] = append(missing[d.ReservationID], i)
+		case DiscrepancyOrphanEvent:
+			err := r.cal.DeleteEvent(ctx, rec.CalendarID, d.EventID)
+			if err != nil && !calendar.IsNotFound(err) {
+				fail(d, err)
+				continue
+			}
+			rec.Discrepancies[i].Repaired = true
+		}
+	}
+
+	for reservationID, idx := range missing {
+		if err := r.republish(ctx, rec, reservationID, idx); err != nil {
+			for _, i := range idx {
+				fail(rec.Discrepancies[i], err)
+			}
+		}
+	}
+
+	for _, sc := range drifted {
+		err := r.rewriteExdates(ctx, rec, sc)
+		for i, d := range rec.Discrepancies {
+			if d.Kind != DiscrepancySeriesDrift || d.ReservationID != sc.reservationID {
+				continue
+			}
+			if err != nil {
+				fail(d, err)
+				continue
+			}
+			rec.Discrepancies[i].Repaired = true
+		}
+	}
+}
+
+// republish publishes the missing dates of a reservation as standalone
+// events and stores their ids on the dates.
+func (r *Reconciler) republish(ctx context.Context, rec *Reconciliation, reservationID int64, idx []int) error {
+	wrap, err := r.reservations.Get(ctx, reservationID)
+	if err != nil {
+		return err
+	}
+	if wrap == nil {
+		return fmt.Errorf("reservation %d no longer exists", reservationID)
+	}
+	reservation := wrap.Reservation
+	location, err := r.location(ctx, reservation.FacilityID)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int64, len(idx))
+	singles := make([]calendar.OccSpec, len(idx))
+	for n, i := range idx {
+		d := rec.Discrepancies[i]
+		ids[n] = d.ReservationDateID
+		singles[n] = calendar.OccSpec{
+			RefID: d.ReservationDateID,
+			Start: d.Start,
+			End:   d.End,
+		}
+	}
+	summary, description := r.text.Render(reservation)
+	pub, err := r.cal.Publish(ctx, &calendar.PublishPlan{
+		Mode:    calendar.ModeSingles,
+		Singles: singles,
+	}, calendar.PublishOptions{
+		CalendarID:  rec.CalendarID,
+		Summary:     summary,
+		Description: description,
+		Location:    location,
+		SendUpdates: calendar.NoUpdates,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish dates: %w", err)
+	}
+
+	dates, err := r.reservations.GetDatesByID(ctx, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int64]models.ReservationDate, len(dates))
+	for _, d := range dates {
+		byID[d.ID] = d
+	}
+	for _, i := range idx {
+		d := &rec.Discrepancies[i]
+		eventID, ok := pub.SingleEventID[d.ReservationDateID]
+		date, found := byID[d.ReservationDateID]
+		if !ok || !found {
+			continue
+		}
+		date.GcalEventid = models.CheckNullString(eventID)
+		if err := r.reservations.UpdateDate(ctx, &date); err != nil {
+			return fmt.Errorf("failed to store event id for date %d: %w", date.ID, err)
+		}
+		d.EventID = eventID
+		d.Repaired = true
+	}
+	return nil
+}
+
+// rewriteExdates rebuilds a series' EXDATEs from its dates: every start
+// that is not an approved date is excluded, along with occurrences that no
+// longer have a date at all. The result is stored on the reservation so
+// later date changes start from it.
+func (r *Reconciler) rewriteExdates(ctx context.Context, rec *Reconciliation, sc *seriesCheck) error {
+	wrap, err := r.reservations.Get(ctx, sc.reservationID)
+	if err != nil {
+		return err
+	}
+	if wrap == nil {
+		return fmt.Errorf("reservation %d no longer exists", sc.reservationID)
+	}
+	reservation := wrap.Reservation
+
+	excluded := make(map[int64]time.Time)
+	if reservation.EXDates != nil {
+		for _, t := range *reservation.EXDates {
+			if t.Valid {
+				start := inLocation(t.Time, r.loc)
+				excluded[start.Unix()] = start
+			}
+		}
+	}
+	booked := make(map[int64]bool)
+	ends := make(map[int64]time.Time)
+	for _, d := range wrap.Dates {
+		start := inLocation(d.LocalStart.Time, r.loc)
+		held := reservation.Approved == models.ReservationApprovedPending && d.Approved == models.ReservationDateApprovedPending
+		if d.Approved == models.ReservationDateApprovedApproved || held {
+			booked[start.Unix()] = true
+			ends[start.Unix()] = inLocation(d.LocalEnd.Time, r.loc)
+			continue
+		}
+		excluded[start.Unix()] = start
+	}
+	for _, d := range rec.Discrepancies {
+		if d.Kind == DiscrepancySeriesDrift && d.ReservationID == sc.reservationID && d.ReservationDateID == 0 {
+			if _, start, ok := calendar.SplitInstanceID(d.EventID); ok {
+				excluded[start.Unix()] = start.In(r.loc)
+			}
+		}
+	}
+
+	exdates := make([]time.Time, 0, len(excluded))
+	for unix, t := range excluded {
+		if !booked[unix] {
+			exdates = append(exdates, t)
+		}
+	}
+	sort.Slice(exdates, func(i, j int) bool { return exdates[i].Before(exdates[j]) })
+
+	var rdates []calendar.RDateSpec
+	if reservation.RDates != nil {
+		for _, t := range *reservation.RDates {
+			if !t.Valid {
+				continue
+			}
+			start := inLocation(t.Time, r.loc)
+			if end, ok := ends[start.Unix()]; ok {
+				rdates = append(rdates, calendar.RDateSpec{Start: start, End: end})
+			}
+		}
+	}
+	if len(rdates) > 0 {
+		err = r.cal.AddRdatesWithOverrides(ctx, rec.CalendarID, sc.master, reservation.RRule.String, exdates, rdates)
+	} else {
+		err = r.cal.AddExdatesToMaster(ctx, rec.CalendarID, sc.master, reservation.RRule.String, exdates)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update series exdates: %w", err)
+	}
+
+	stored := make([]sql.NullTime, len(exdates))
+	for i, t := range exdates {
+		stored[i] = sql.NullTime{Time: t, Valid: true}
+	}
+	return r.reservations.SetExdates(ctx, sc.reservationID, stored)
+}
+
+func (r *Reconciler) location(ctx context.Context, facilityID int64) (string, error) {
+	facility, err := r.facilities.Get(ctx, facilityID)
+	if err != nil {
+		return "", err
+	}
+	if facility == nil || facility.Facility == nil {
+		return "", fmt.Errorf("facility %d no longer exists", facilityID)
+	}
+	if facility.Building != nil {
+		return fmt.Sprintf("%s %s", facility.Building.Name, facility.Facility.Name), nil
+	}
+	return facility.Facility.Name, nil
+}
@@ -0,0 +1,360 @@
+package calendars
+
+import (
+	"api/internal/models"
+	"api/internal/ports"
+	"api/pkg/calendar"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Resolutions for a calendar change under review.
+const (
+	// ResolveApply makes the reservation date match the calendar.
+	ResolveApply = "apply"
+	// ResolveRevert puts the event back the way the reservation has it.
+	ResolveRevert = "revert"
+)
+
+// ReservationSync reflects edits staff make directly on facility calendars
+// back into reservation dates. A moved event that still fits the facility is
+// applied to its date; deletions, overlaps and changed durations wait for an
+// admin. Every detection is recorded as a calendar change.
+type ReservationSync struct {
+	reservations ports.ReservationStore
+	facilities   ports.FacilityStore
+	changes      ports.CalendarSyncStore
+	cal          ports.CalendarProvider
+	text         *EventText
+	loc          *time.Location
+	log          *slog.Logger
+}
+
+func NewReservationSync(reservations ports.ReservationStore, facilities ports.FacilityStore, changes ports.CalendarSyncStore, cal ports.CalendarProvider, text *EventText, loc *time.Location, log *slog.Logger) *ReservationSync {
+	return &ReservationSync{
+		reservations: reservations,
+		facilities:   facilities,
+		changes:      changes,
+		cal:          cal,
+		text:         text,
+		loc:          loc,
+		log:          log.With("component", "reservation_sync"),
+	}
+}
+
+// ReservationSyncResult counts the changes one pass found.
+type ReservationSyncResult struct {
+	Applied int
+	Review  int
+}
+
+// SyncFacility compares the published dates of a facility in the listing
+// window with the events on its calendar.
+func (s *ReservationSync) SyncFacility(ctx context.Context, facility models.Facility) (*ReservationSyncResult, error) {
+	res := &ReservationSyncResult{}
+	calendarID := facility.GoogleCalendarID
+	if calendarID == "" {
+		return res, nil
+	}
+	from, to := calendar.ListWindow(time.Now().In(s.loc))
+	dates, err := s.reservations.GetPublishedDates(ctx, facility.ID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get published dates: %w", err)
+	}
+	if len(dates) == 0 {
+		return res, nil
+	}
+	events, err := s.cal.ListEvents(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	listed := make(map[string]calendar.Event, len(events))
+	seriesListed := make(map[string]bool)
+	for _, ev := range events {
+		listed[ev.ID] = ev
+		if master, _, ok := strings.Cut(ev.ID, "_"); ok {
+			seriesListed[master] = true
+		}
+	}
+	open, err := s.changes.GetCalendarChanges(ctx, models.CalendarChangeStatusReview.String(), facility.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+	underReview := make(map[int64]bool, len(open))
+	for _, c := range open {
+		underReview[c.ReservationDateID] = true
+	}
+
+	seriesExists := make(map[string]bool)
+	for _, d := range dates {
+		start, end := inLocation(d.LocalStart.Time, s.loc), inLocation(d.LocalEnd.Time, s.loc)
+		eventID := d.EventID
+		if d.SeriesID.Valid {
+			master := d.SeriesID.String
+			if !seriesListed[master] {
+				// no occurrence matched by id: either the series is gone, or
+				// the provider's occurrence ids cannot be matched
+				exists, ok := seriesExists[master]
+				if !ok {
+					_, err := s.cal.GetEvent(ctx, calendarID, master)
+					if err != nil && !calendar.IsNotFound(err) {
+						return res, fmt.Errorf("failed to get series %s: %w", master, err)
+					}
+					exists = err == nil
+					seriesExists[master] = exists
+				}
+				if exists {
+					continue
+				}
+			}
+			eventID = calendar.InstanceID(master, start)
+		}
+
+		ev, ok := listed[eventID]
+		if !ok {
+			// moved out of the window, or gone
+			found, err := s.cal.GetEvent(ctx, calendarID, eventID)
+			if err != nil && !calendar.IsNotFound(err) {
+				return res, fmt.Errorf("failed to get event %s: %w", eventID, err)
+			}
+			if err != nil {
+				if err := s.deleted(ctx, d, eventID, res); err != nil {
+					return res, err
+				}
+				continue
+			}
+			ev = *found
+		}
+		if ev.Start.Equal(start) && ev.End.Equal(end) {
+			continue
+		}
+		if err := s.moved(ctx, facility.ID, d, ev, underReview[d.ReservationDateID], res); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// deleted flags a date whose event was deleted or cancelled. A standalone
+// event's id is dropped from the date since it no longer exists.
+func (s *ReservationSync) deleted(ctx context.Context, d models.PublishedDate, eventID string, res *ReservationSyncResult) error {
+	change := &models.CalendarChange{
+		ReservationDateID: d.ReservationDateID,
+		Kind:              models.CalendarChangeKindDeleted,
+		Status:            models.CalendarChangeStatusReview,
+		EventID:           eventID,
+		OldStart:          d.LocalStart,
+		OldEnd:            d.LocalEnd,
+		Note:              sql.NullString{String: "the event was deleted from the facility calendar", Valid: true},
+	}
+	if err := s.changes.SaveCalendarChange(ctx, change); err != nil {
+		return err
+	}
+	if !d.SeriesID.Valid {
+		date, err := s.date(ctx, d.ReservationDateID)
+		if err != nil {
+			return err
+		}
+		date.GcalEventid = sql.NullString{}
+		if err := s.reservations.UpdateDate(ctx, date); err != nil {
+			return err
+		}
+	}
+	s.log.Info("Published event deleted on calendar", "reservation_id", d.ReservationID, "date_id", d.ReservationDateID, "event_id", eventID)
+	res.Review++
+	return nil
+}
+
+// moved applies a move when the new time keeps the duration and overlaps no
+// other booking, and flags it for review otherwise.
+func (s *ReservationSync) moved(ctx context.Context, facilityID int64, d models.PublishedDate, ev calendar.Event, underReview bool, res *ReservationSyncResult) error {
+	change := &models.CalendarChange{
+		ReservationDateID: d.ReservationDateID,
+		Kind:              models.CalendarChangeKindMoved,
+		Status:            models.CalendarChangeStatusReview,
+		EventID:           ev.ID,
+		OldStart:          d.LocalStart,
+		OldEnd:            d.LocalEnd,
+		NewStart:          pgtype.Timestamp{Time: ev.Start, Valid: true},
+		NewEnd:            pgtype.Timestamp{Time: ev.End, Valid: true},
+	}
+	reason, err := s.moveProblem(ctx, facilityID, d, ev)
+	if err != nil {
+		return err
+	}
+	if underReview && reason == "" {
+		reason = "the date already has a change under review"
+	}
+	if reason != "" {
+		change.Note = sql.NullString{String: reason, Valid: true}
+		if err := s.changes.SaveCalendarChange(ctx, change); err != nil {
+			return err
+		}
+		s.log.Info("Published event moved on calendar, needs review", "reservation_id", d.ReservationID, "date_id", d.ReservationDateID, "reason", reason)
+		res.Review++
+		return nil
+	}
+
+	date, err := s.date(ctx, d.ReservationDateID)
+	if err != nil {
+		return err
+	}
+	applyMove(date, ev.ID, ev.Start, ev.End)
+	if err := s.reservations.UpdateDate(ctx, date); err != nil {
+		return err
+	}
+	change.Status = models.CalendarChangeStatusApplied
+	change.ResolvedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	if err := s.changes.SaveCalendarChange(ctx, change); err != nil {
+		return err
+	}
+	s.log.Info("Applied calendar move to reservation date", "reservation_id", d.ReservationID, "date_id", d.ReservationDateID, "start", ev.Start)
+	res.Applied++
+	return nil
+}
+
+// moveProblem says why a move cannot be applied on its own, or "".
+func (s *ReservationSync) moveProblem(ctx context.Context, facilityID int64, d models.PublishedDate, ev calendar.Event) (string, error) {
+	if ev.AllDay {
+		return "the event was made all-day", nil
+	}
+	if ev.End.Sub(ev.Start) != d.LocalEnd.Time.Sub(d.LocalStart.Time) {
+		return "the event's length changed, which may change its fees", nil
+	}
+	booked, err := s.reservations.GetBookedDates(ctx, facilityID, ev.Start, ev.End)
+	if err != nil {
+		return "", err
+	}
+	for _, b := range booked {
+		if b.ReservationDateID != d.ReservationDateID {
+			return fmt.Sprintf("the new time overlaps %q (reservation %d)", b.EventName, b.ReservationID), nil
+		}
+	}
+	return "", nil
+}
+
+// Resolve closes a change under review, applying it to the reservation date
+// or reverting the calendar.
+func (s *ReservationSync) Resolve(ctx context.Context, change *models.CalendarChange, action, userID, note string) error {
+	if change.Status != models.CalendarChangeStatusReview {
+		return fmt.Errorf("change %d is already %s", change.ID, change.Status)
+	}
+	date, err := s.date(ctx, change.ReservationDateID)
+	if err != nil {
+		return err
+	}
+	status := models.CalendarChangeStatusApplied
+	switch action {
+	case ResolveApply:
+		if change.Kind == models.CalendarChangeKindMoved {
+			applyMove(date, change.EventID, change.NewStart.Time, change.NewEnd.Time)
+		} else {
+			date.Approved = models.ReservationDateApprovedCanceled
+			date.GcalEventid = sql.NullString{}
+		}
+	case ResolveRevert:
+		status = models.CalendarChangeStatusReverted
+		if err := s.revert(ctx, change, date); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown action %q, expected %s or %s", action, ResolveApply, ResolveRevert)
+	}
+	if err := s.reservations.UpdateDate(ctx, date); err != nil {
+		return err
+	}
+	ok, err := s.changes.ResolveCalendarChange(ctx, change.ID, status, userID, note)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("change %d was resolved by someone else", change.ID)
+	}
+	return nil
+}
+
+// revert moves the event back to the date's time, or publishes a new event
+// for a deleted one.
+func (s *ReservationSync) revert(ctx context.Context, change *models.CalendarChange, date *models.ReservationDate) error {
+	wrap, err := s.reservations.Get(ctx, change.ReservationID)
+	if err != nil {
+		return err
+	}
+	facility, err := s.facilities.Get(ctx, change.FacilityID)
+	if err != nil {
+		return err
+	}
+	if wrap == nil || facility == nil || facility.Facility == nil {
+		return errors.New("reservation or facility no longer exists")
+	}
+	reservation := wrap.Reservation
+	location := facility.Facility.Name
+	if facility.Building != nil {
+		location = fmt.Sprintf("%s %s", facility.Building.Name, facility.Facility.Name)
+	}
+	summary, description := s.text.Render(reservation)
+	ev := calendar.Event{
+		ID:          change.EventID,
+		Summary:     summary,
+		Description: description,
+		Location:    location,
+		Start:       inLocation(date.LocalStart.Time, s.loc),
+		End:         inLocation(date.LocalEnd.Time, s.loc),
+	}
+	calendarID := facility.Facility.GoogleCalendarID
+	if change.Kind == models.CalendarChangeKindMoved {
+		return s.cal.UpdateEvent(ctx, calendarID, ev)
+	}
+	pub, err := s.cal.Publish(ctx, &calendar.PublishPlan{
+		Mode: calendar.ModeSingles,
+		Singles: []calendar.OccSpec{{
+			RefID: date.ID,
+			Start: ev.Start,
+			End:   ev.End,
+		}},
+	}, calendar.PublishOptions{
+		CalendarID:  calendarID,
+		Summary:     ev.Summary,
+		Description: ev.Description,
+		Location:    ev.Location,
+		SendUpdates: calendar.NoUpdates,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to republish date: %w", err)
+	}
+	date.GcalEventid = models.CheckNullString(pub.SingleEventID[date.ID])
+	return nil
+}
+
+func (s *ReservationSync) date(ctx context.Context, id int64) (*models.ReservationDate, error) {
+	dates, err := s.reservations.GetDatesByID(ctx, []int64{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(dates) == 0 {
+		return nil, fmt.Errorf("reservation date %d not found", id)
+	}
+	return &dates[0], nil
+}
+
+// applyMove sets a date to its event's new time. A moved occurrence is
+// tracked by its own instance id from then on, since that id keeps the
+// original start.
+func applyMove(date *models.ReservationDate, eventID string, start, end time.Time) {
+	date.LocalStart = pgtype.Timestamp{Time: start, Valid: true}
+	date.LocalEnd = pgtype.Timestamp{Time: end, Valid: true}
+	date.GcalEventid = models.CheckNullString(eventID)
+}
+
+// inLocation reads a timestamp column, returned as UTC, as a wall-clock time
+// in loc.
+func inLocation(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
@@ -0,0 +1,100 @@
+package calendars
+
+import (
+	"api/internal/ports"
+	"api/pkg/calendar"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// ProviderLookup resolves the provider override for the building that owns a
+// calendar. An empty name means the default provider.
+type ProviderLookup interface {
+	GetCalendarProvider(ctx context.Context, calendarID string) (string, error)
+}
+
+// Router sends each calendar operation to the provider configured for the
+// building that owns the calendar, falling back to the default provider.
+type Router struct {
+	providers map[string]ports.CalendarProvider
+	fallback  string
+	lookup    ProviderLookup
+	log       *slog.Logger
+}
+
+func NewRouter(fallback string, providers map[string]ports.CalendarProvider, lookup ProviderLookup, log *slog.Logger) (*Router, error) {
+	if _, ok := providers[fallback]; !ok {
+		return nil, fmt.Errorf("calendar provider %q is not configured", fallback)
+	}
+	return &Router{providers: providers, fallback: fallback, lookup: lookup, log: log.With("component", "calendar_router")}, nil
+}
+
+// Has reports whether a provider is configured under name.
+func (r *Router) Has(name string) bool {
+	_, ok := r.providers[name]
+	return ok
+}
+
+func (r *Router) provider(ctx context.Context, calendarID string) ports.CalendarProvider {
+	if calendarID == "" || r.lookup == nil {
+		return r.providers[r.fallback]
+	}
+	name, err := r.lookup.GetCalendarProvider(ctx, calendarID)
+	if err != nil {
+		r.log.Warn("Failed to look up calendar provider, using default", "calendar_id", calendarID, "error", err)
+		return r.providers[r.fallback]
+	}
+	if name == "" {
+		return r.providers[r.fallback]
+	}
+	p, ok := r.providers[name]
+	if !ok {
+		r.log.Warn("Building uses an unconfigured calendar provider, using default", "calendar_id", calendarID, "provider", name)
+		return r.providers[r.fallback]
+	}
+	return p
+}
+
+func (r *Router) Publish(ctx context.Context, plan *calendar.PublishPlan, opts calendar.PublishOptions) (*calendar.PublishResult, error) {
+	return r.provider(ctx, opts.CalendarID).Publish(ctx, plan, opts)
+}
+
+func (r *Router) ListEvents(ctx context.Context, calendarID string) ([]calendar.Event, error) {
+	return r.provider(ctx, calendarID).ListEvents(ctx, calendarID)
+}
+
+func (r *Router) ListEventsBetween(ctx context.Context, calendarID string, from, to time.Time) ([]calendar.Event, error) {
+	return r.provider(ctx, calendarID).ListEventsBetween(ctx, calendarID, from, to)
+}
+
+func (r *Router) GetEvent(ctx context.Context, calendarID string, eventID string) (*calendar.Event, error) {
+	return r.provider(ctx, calendarID).GetEvent(ctx, calendarID, eventID)
+}
+
+func (r *Router) DeleteEvent(ctx context.Context, calendarID string, eventID string) error {
+	return r.provider(ctx, calendarID).DeleteEvent(ctx, calendarID, eventID)
+}
+
+func (r *Router) UpdateEvent(ctx context.Context, calendarID string, ev calendar.Event) error {
+	return r.provider(ctx, calendarID).UpdateEvent(ctx, calendarID, ev)
+}
+
+// ListChanges forwards to providers with incremental listing and returns
+// calendar.ErrChangesUnsupported for the rest.
+func (r *Router) ListChanges(ctx context.Context, calendarID, syncToken string) (*calendar.Changes, error) {
+	lister, ok := r.provider(ctx, calendarID).(ports.CalendarChangeLister)
+	if !ok {
+		return nil, calendar.ErrChangesUnsupported
+	}
+	return lister.ListChanges(ctx, calendarID, syncToken)
+}
+
+func (r *Router) AddExdatesToMaster(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time) error {
+	return r.provider(ctx, calendarID).AddExdatesToMaster(ctx, calendarID, masterEventID, rrule, exdates)
+}
+
+func (r *Router) AddRdatesWithOverrides(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time, adds []calendar.RDateSpec) error {
+	return r.provider(ctx, calendarID).AddRdatesWithOverrides(ctx, calendarID, masterEventID, rrule, exdates, adds)
+}
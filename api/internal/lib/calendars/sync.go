@@ -0,0 +1,452 @@
+package calendars
+
+import (
+	"api/internal/models"
+	"api/internal/ports"
+	"api/pkg/calendar"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoBuildingCalendar is returned when syncing a building without a calendar.
+var ErrNoBuildingCalendar = errors.New("building has no calendar")
+
+// SyncMode picks how much work a building sync does.
+type SyncMode int
+
+const (
+	// SyncIncremental applies the changes since each facility calendar's
+	// sync token, listing a calendar in full when it has no usable token.
+	SyncIncremental SyncMode = iota
+	// SyncFull lists every facility calendar and reconciles the building
+	// calendar against the mapping, without trusting sync tokens.
+	SyncFull
+	// SyncRebuild deletes every event on the building calendar and the
+	// mapping, then copies everything again. Use it to recover from hand
+	// edits to the building calendar.
+	SyncRebuild
+)
+
+// SyncResult counts the building calendar events a sync touched.
+type SyncResult struct {
+	Inserted int
+	Updated  int
+	Deleted  int
+}
+
+// BuildingSync copies the events of every facility calendar onto its
+// building's calendar, tagging each summary with the facility name. A
+// mapping of facility event to building event lets it insert, patch and
+// delete only what changed since the last run.
+type BuildingSync struct {
+	store      ports.CalendarSyncStore
+	facilities ports.FacilityStore
+	cal        ports.CalendarProvider
+	// fullEvery bounds how long incremental syncs run before a full listing
+	// catches events that drifted into the listing window.
+	fullEvery time.Duration
+	log       *slog.Logger
+	locks     sync.Map
+}
+
+func NewBuildingSync(store ports.CalendarSyncStore, facilities ports.FacilityStore, cal ports.CalendarProvider, fullEvery time.Duration, log *slog.Logger) *BuildingSync {
+	if fullEvery <= 0 {
+		fullEvery = 24 * time.Hour
+	}
+	return &BuildingSync{store: store, facilities: facilities, cal: cal, fullEvery: fullEvery, log: log.With("component", "building_sync")}
+}
+
+// lock serializes syncs of one building so the worker and an admin resync
+// never copy the same event twice.
+func (s *BuildingSync) lock(buildingID int64) func() {
+	mu, _ := s.locks.LoadOrStore(buildingID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// SyncBuilding syncs every facility calendar of a building. A failing
+// facility does not stop the others; their errors are joined.
+func (s *BuildingSync) SyncBuilding(ctx context.Context, buildingID int64, mode SyncMode) (*SyncResult, error) {
+	building, err := s.facilities.GetByBuilding(ctx, buildingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get facilities for building %d: %w", buildingID, err)
+	}
+	if building == nil {
+		return nil, fmt.Errorf("building %d not found", buildingID)
+	}
+	if !building.GoogleCalendarID.Valid || building.GoogleCalendarID.String == "" {
+		return nil, ErrNoBuildingCalendar
+	}
+	target := building.GoogleCalendarID.String
+
+	defer s.lock(buildingID)()
+
+	res := &SyncResult{}
+	if mode == SyncRebuild {
+		if err := s.clear(ctx, buildingID, target, res); err != nil {
+			return res, fmt.Errorf("failed to clear building calendar: %w", err)
+		}
+	}
+
+	states, err := s.store.GetSyncStates(ctx, buildingID)
+	if err != nil {
+		return res, err
+	}
+
+	var errs []error
+	synced := make(map[string]bool, len(building.Facilities))
+	for _, facility := range building.Facilities {
+		source := facility.GoogleCalendarID
+		if source == "" || source == target || synced[source] {
+			continue
+		}
+		synced[source] = true
+		if err := s.syncFacility(ctx, buildingID, facility, target, mode, res); err != nil {
+			s.log.Error("Failed to sync facility calendar", "building_id", buildingID, "facility_id", facility.ID, "error", err)
+			errs = append(errs, fmt.Errorf("facility %d: %w", facility.ID, err))
+		}
+	}
+
+	// facilities that left the building or switched calendars
+	for _, state := range states {
+		if synced[state.SourceCalendarID] {
+			continue
+		}
+		if err := s.dropSource(ctx, buildingID, state.SourceCalendarID, target, res); err != nil {
+			errs = append(errs, fmt.Errorf("calendar %s: %w", state.SourceCalendarID, err))
+		}
+	}
+	return res, errors.Join(errs...)
+}
+
+// facilitySync is one facility calendar's pass over the building calendar.
+type facilitySync struct {
+	*BuildingSync
+	buildingID int64
+	facility   models.Facility
+	target     string
+	mapped     map[string]models.CalendarSyncEvent
+	inserts    []calendar.Event
+	res        *SyncResult
+}
+
+func (s *BuildingSync) syncFacility(ctx context.Context, buildingID int64, facility models.Facility, target string, mode SyncMode, res *SyncResult) error {
+	source := facility.GoogleCalendarID
+	state, err := s.store.GetSyncState(ctx, buildingID, source)
+	if err != nil {
+		return err
+	}
+	if state != nil && state.TargetCalendarID != target {
+		// the building calendar changed; copies on the old one are left there
+		if err := s.store.ClearSync(ctx, buildingID, source); err != nil {
+			return err
+		}
+		state = nil
+	}
+
+	mapping, err := s.store.GetSyncEvents(ctx, buildingID, source)
+	if err != nil {
+		return err
+	}
+	fs := &facilitySync{
+		BuildingSync: s,
+		buildingID:   buildingID,
+		facility:     facility,
+		target:       target,
+		mapped:       make(map[string]models.CalendarSyncEvent, len(mapping)),
+		res:          res,
+	}
+	for _, m := range mapping {
+		fs.mapped[m.SourceEventID] = m
+	}
+
+	next := models.CalendarSyncState{BuildingID: buildingID, SourceCalendarID: source, TargetCalendarID: target}
+	incremental := mode == SyncIncremental && state != nil && state.SyncToken.Valid &&
+		state.FullSyncedAt.Valid && time.Since(state.FullSyncedAt.Time) < s.fullEvery
+	if incremental {
+		next.FullSyncedAt = state.FullSyncedAt
+		changes, err := s.listChanges(ctx, source, state.SyncToken.String)
+		switch {
+		case errors.Is(err, calendar.ErrSyncTokenExpired):
+			s.log.Info("Sync token expired, listing facility calendar in full", "building_id", buildingID, "facility_id", facility.ID)
+		case err != nil:
+			return err
+		default:
+			if err := fs.applyChanges(ctx, changes); err != nil {
+				return err
+			}
+			next.SyncToken = sql.NullString{String: changes.SyncToken, Valid: changes.SyncToken != ""}
+			return s.store.SaveSyncState(ctx, &next)
+		}
+	}
+
+	token, err := fs.reconcile(ctx)
+	if err != nil {
+		return err
+	}
+	next.SyncToken = sql.NullString{String: token, Valid: token != ""}
+	next.FullSyncedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	return s.store.SaveSyncState(ctx, &next)
+}
+
+func (s *BuildingSync) listChanges(ctx context.Context, calendarID, syncToken string) (*calendar.Changes, error) {
+	lister, ok := s.cal.(ports.CalendarChangeLister)
+	if !ok {
+		return nil, calendar.ErrChangesUnsupported
+	}
+	return lister.ListChanges(ctx, calendarID, syncToken)
+}
+
+// applyChanges copies the events changed since the last sync token.
+func (fs *facilitySync) applyChanges(ctx context.Context, changes *calendar.Changes) error {
+	from, to := calendar.ListWindow(time.Now())
+	for _, ev := range changes.Events {
+		switch {
+		case ev.End.Before(from):
+			// past events keep the copy they had
+		case !ev.Start.Before(to):
+			if m, ok := fs.mapped[ev.ID]; ok {
+				if err := fs.remove(ctx, m); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := fs.put(ctx, ev); err != nil {
+				return err
+			}
+		}
+	}
+	for _, id := range changes.Deleted {
+		// a deleted series master takes its occurrences with it
+		for sourceID, m := range fs.mapped {
+			if sourceID != id && !strings.HasPrefix(sourceID, id+"_") {
+				continue
+			}
+			if err := fs.remove(ctx, m); err != nil {
+				return err
+			}
+		}
+	}
+	return fs.flush(ctx)
+}
+
+// reconcile lists the facility calendar in full and makes the building
+// calendar match it. It returns a new sync token when the provider has one.
+func (fs *facilitySync) reconcile(ctx context.Context) (string, error) {
+	var (
+		events []calendar.Event
+		token  string
+	)
+	changes, err := fs.listChanges(ctx, fs.facility.GoogleCalendarID, "")
+	switch {
+	case err == nil:
+		events, token = changes.Events, changes.SyncToken
+	case errors.Is(err, calendar.ErrChangesUnsupported):
+		if events, err = fs.cal.ListEvents(ctx, fs.facility.GoogleCalendarID); err != nil {
+			return "", err
+		}
+	default:
+		return "", err
+	}
+
+	from, to := calendar.ListWindow(time.Now())
+	listed := make(map[string]bool, len(events))
+	for _, ev := range events {
+		if ev.End.Before(from) || !ev.Start.Before(to) {
+			continue
+		}
+		listed[ev.ID] = true
+		if err := fs.put(ctx, ev); err != nil {
+			return "", err
+		}
+	}
+	for sourceID, m := range fs.mapped {
+		// copies of events that fell out of the window behind us are kept
+		if listed[sourceID] || m.EndsAt.Before(from) {
+			continue
+		}
+		if err := fs.remove(ctx, m); err != nil {
+			return "", err
+		}
+	}
+	return token, fs.flush(ctx)
+}
+
+// put patches the building copy of ev when it changed, or queues an insert
+// when there is none.
+func (fs *facilitySync) put(ctx context.Context, ev calendar.Event) error {
+	copied := calendar.Event{
+		ID:          ev.ID,
+		Summary:     fmt.Sprintf("%s [%s]", ev.Summary, fs.facility.Name),
+		Description: ev.Description,
+		Location:    ev.Location,
+		Start:       ev.Start,
+		End:         ev.End,
+		AllDay:      ev.AllDay,
+	}
+	m, ok := fs.mapped[ev.ID]
+	if !ok {
+		fs.inserts = append(fs.inserts, copied)
+		return nil
+	}
+	fp := fingerprint(copied)
+	if m.Fingerprint == fp {
+		return nil
+	}
+	copied.ID = m.TargetEventID
+	if err := fs.cal.UpdateEvent(ctx, fs.target, copied); err != nil {
+		if !calendar.IsNotFound(err) {
+			return fmt.Errorf("failed to update event %s: %w", m.TargetEventID, err)
+		}
+		// the copy was deleted by hand; make a new one
+		copied.ID = ev.ID
+		fs.inserts = append(fs.inserts, copied)
+		return nil
+	}
+	m.Fingerprint, m.EndsAt = fp, ev.End
+	if err := fs.store.SaveSyncEvent(ctx, &m); err != nil {
+		return err
+	}
+	fs.mapped[ev.ID] = m
+	fs.res.Updated++
+	return nil
+}
+
+// flush inserts the queued copies in one publish and maps each to its source.
+func (fs *facilitySync) flush(ctx context.Context) error {
+	if len(fs.inserts) == 0 {
+		return nil
+	}
+	singles := make([]calendar.OccSpec, len(fs.inserts))
+	for i, ev := range fs.inserts {
+		singles[i] = calendar.OccSpec{
+			RefID:       int64(i + 1),
+			Start:       ev.Start,
+			End:         ev.End,
+			Summary:     ev.Summary,
+			Description: ev.Description,
+			Location:    ev.Location,
+			AllDay:      ev.AllDay,
+		}
+	}
+	result, pubErr := fs.cal.Publish(ctx, &calendar.PublishPlan{
+		Mode:    calendar.ModeSingles,
+		Singles: singles,
+	}, calendar.PublishOptions{
+		CalendarID:  fs.target,
+		SendUpdates: calendar.NoUpdates,
+	})
+	// map whatever was created, even when the publish stopped part way
+	if result != nil {
+		for i, ev := range fs.inserts {
+			targetID, ok := result.SingleEventID[int64(i+1)]
+			if !ok {
+				continue
+			}
+			m := models.CalendarSyncEvent{
+				BuildingID:       fs.buildingID,
+				SourceCalendarID: fs.facility.GoogleCalendarID,
+				SourceEventID:    ev.ID,
+				TargetEventID:    targetID,
+				Fingerprint:      fingerprint(ev),
+				EndsAt:           ev.End,
+			}
+			if err := fs.store.SaveSyncEvent(ctx, &m); err != nil {
+				return err
+			}
+			fs.mapped[ev.ID] = m
+			fs.res.Inserted++
+		}
+	}
+	fs.inserts = nil
+	if pubErr != nil {
+		return fmt.Errorf("failed to insert events: %w", pubErr)
+	}
+	return nil
+}
+
+// remove deletes the building copy and its mapping.
+func (fs *facilitySync) remove(ctx context.Context, m models.CalendarSyncEvent) error {
+	if err := fs.cal.DeleteEvent(ctx, fs.target, m.TargetEventID); err != nil && !calendar.IsNotFound(err) {
+		return fmt.Errorf("failed to delete event %s: %w", m.TargetEventID, err)
+	}
+	if err := fs.store.DeleteSyncEvent(ctx, m.ID); err != nil {
+		return err
+	}
+	delete(fs.mapped, m.SourceEventID)
+	fs.res.Deleted++
+	return nil
+}
+
+// dropSource deletes the copies made from a calendar that no longer belongs
+// to the building and forgets its mapping.
+func (s *BuildingSync) dropSource(ctx context.Context, buildingID int64, source, target string, res *SyncResult) error {
+	mapping, err := s.store.GetSyncEvents(ctx, buildingID, source)
+	if err != nil {
+		return err
+	}
+	for _, m := range mapping {
+		if err := s.cal.DeleteEvent(ctx, target, m.TargetEventID); err != nil && !calendar.IsNotFound(err) {
+			return fmt.Errorf("failed to delete event %s: %w", m.TargetEventID, err)
+		}
+		res.Deleted++
+	}
+	return s.store.ClearSync(ctx, buildingID, source)
+}
+
+// clear empties the building calendar, mapped copies outside the listing
+// window included, and forgets the mapping.
+func (s *BuildingSync) clear(ctx context.Context, buildingID int64, target string, res *SyncResult) error {
+	states, err := s.store.GetSyncStates(ctx, buildingID)
+	if err != nil {
+		return err
+	}
+	deleted := make(map[string]bool)
+	for _, state := range states {
+		mapping, err := s.store.GetSyncEvents(ctx, buildingID, state.SourceCalendarID)
+		if err != nil {
+			return err
+		}
+		for _, m := range mapping {
+			if err := s.cal.DeleteEvent(ctx, target, m.TargetEventID); err != nil && !calendar.IsNotFound(err) {
+				s.log.Warn("Failed to delete event from building calendar", "calendar_id", target, "event_id", m.TargetEventID, "error", err)
+				continue
+			}
+			deleted[m.TargetEventID] = true
+			res.Deleted++
+		}
+	}
+
+	events, err := s.cal.ListEvents(ctx, target)
+	if err != nil {
+		return err
+	}
+	for _, ev := range events {
+		if deleted[ev.ID] {
+			continue
+		}
+		if err := s.cal.DeleteEvent(ctx, target, ev.ID); err != nil && !calendar.IsNotFound(err) {
+			s.log.Warn("Failed to delete event from building calendar", "calendar_id", target, "event_id", ev.ID, "error", err)
+			continue
+		}
+		res.Deleted++
+	}
+	return s.store.ClearSync(ctx, buildingID, "")
+}
+
+// fingerprint hashes the fields copied to the building calendar.
+func fingerprint(ev calendar.Event) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%s\x00%t",
+		ev.Summary, ev.Description, ev.Location,
+		ev.Start.Format(time.RFC3339), ev.End.Format(time.RFC3339), ev.AllDay)
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
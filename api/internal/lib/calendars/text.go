@@ -0,0 +1,89 @@
+package calendars
+
+import (
+	"api/internal/models"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// PrivateSummary is all a private reservation shows on a calendar.
+const PrivateSummary = "Reserved"
+
+// EventFields are what the summary and description templates can use.
+// Details and Requester are empty unless the reservation is public.
+type EventFields struct {
+	// Title is the public title, or the event name when there is none.
+	Title     string
+	EventName string
+	Details   string
+	Requester string
+}
+
+// EventText renders what a reservation shows on published calendars,
+// honoring its visibility.
+type EventText struct {
+	summary     *template.Template
+	description *template.Template
+}
+
+// NewEventText parses the summary and description templates and checks
+// that they render.
+func NewEventText(summary, description string) (*EventText, error) {
+	s, err := template.New("summary").Option("missingkey=error").Parse(summary)
+	if err != nil {
+		return nil, fmt.Errorf("invalid summary template: %w", err)
+	}
+	d, err := template.New("description").Option("missingkey=error").Parse(description)
+	if err != nil {
+		return nil, fmt.Errorf("invalid description template: %w", err)
+	}
+	t := &EventText{summary: s, description: d}
+	sample := EventFields{Title: "Title", EventName: "Event", Details: "Details", Requester: "Requester"}
+	if _, err := t.execute(t.summary, sample); err != nil {
+		return nil, fmt.Errorf("invalid summary template: %w", err)
+	}
+	if _, err := t.execute(t.description, sample); err != nil {
+		return nil, fmt.Errorf("invalid description template: %w", err)
+	}
+	return t, nil
+}
+
+// Fields are the template fields of a reservation.
+func Fields(res models.Reservation) EventFields {
+	f := EventFields{Title: res.EventName, EventName: res.EventName}
+	if res.PublicTitle.Valid && res.PublicTitle.String != "" {
+		f.Title = res.PublicTitle.String
+	}
+	if res.Visibility == models.EventVisibilityPublic || res.Visibility == "" {
+		f.Details = res.Details.String
+		f.Requester = res.Name
+	}
+	return f
+}
+
+// Render returns the summary and description of a reservation's events.
+// Private reservations only show that the time is reserved.
+func (t *EventText) Render(res models.Reservation) (string, string) {
+	if res.Visibility == models.EventVisibilityPrivate {
+		return PrivateSummary, ""
+	}
+	f := Fields(res)
+	summary, err := t.execute(t.summary, f)
+	if err != nil || summary == "" {
+		summary = f.Title
+	}
+	description, err := t.execute(t.description, f)
+	if err != nil {
+		description = f.Details
+	}
+	return summary, description
+}
+
+func (t *EventText) execute(tmpl *template.Template, f EventFields) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, f); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
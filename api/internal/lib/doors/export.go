@@ -0,0 +1,145 @@
+package doors
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// timeLayout is the local wall-clock format used in exports. Schedules carry
+// no zone because reservation times are stored as building-local times.
+const timeLayout = "2006-01-02T15:04:05"
+
+// Exporter writes a schedule in one format. Vendor-specific adapters for
+// access-control systems implement this and call Register.
+type Exporter interface {
+	Format() string
+	ContentType() string
+	Extension() string
+	Export(w io.Writer, s *Schedule) error
+}
+
+var (
+	mu        sync.RWMutex
+	exporters = map[string]Exporter{}
+)
+
+func init() {
+	Register(CSVExporter{})
+	Register(JSONExporter{})
+}
+
+// Register adds an exporter, replacing any with the same format name.
+func Register(e Exporter) {
+	mu.Lock()
+	defer mu.Unlock()
+	exporters[e.Format()] = e
+}
+
+func Lookup(format string) (Exporter, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	e, ok := exporters[format]
+	return e, ok
+}
+
+// Formats lists the registered format names.
+func Formats() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(exporters))
+	for name := range exporters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type CSVExporter struct{}
+
+func (CSVExporter) Format() string      { return "csv" }
+func (CSVExporter) ContentType() string { return "text/csv" }
+func (CSVExporter) Extension() string   { return "csv" }
+
+func (CSVExporter) Export(w io.Writer, s *Schedule) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"building", "facility", "unlock_at", "lock_at", "events", "reservation_ids", "doors"}); err != nil {
+		return err
+	}
+	for _, win := range s.Windows {
+		ids := make([]string, len(win.ReservationIDs))
+		for i, id := range win.ReservationIDs {
+			ids[i] = strconv.FormatInt(id, 10)
+		}
+		record := []string{
+			s.BuildingName,
+			win.FacilityName,
+			win.UnlockAt.Format(timeLayout),
+			win.LockAt.Format(timeLayout),
+			strings.Join(win.Events, "; "),
+			strings.Join(ids, " "),
+			strings.Join(win.Doors, "; "),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+type JSONExporter struct{}
+
+func (JSONExporter) Format() string      { return "json" }
+func (JSONExporter) ContentType() string { return "application/json" }
+func (JSONExporter) Extension() string   { return "json" }
+
+type jsonWindow struct {
+	FacilityID     int64    `json:"facility_id"`
+	Facility       string   `json:"facility"`
+	UnlockAt       string   `json:"unlock_at"`
+	LockAt         string   `json:"lock_at"`
+	Events         []string `json:"events"`
+	ReservationIDs []int64  `json:"reservation_ids"`
+	Doors          []string `json:"doors"`
+}
+
+type jsonSchedule struct {
+	BuildingID    int64        `json:"building_id"`
+	Building      string       `json:"building"`
+	From          string       `json:"from"`
+	To            string       `json:"to"`
+	BufferBefore  int64        `json:"buffer_before_minutes"`
+	BufferAfter   int64        `json:"buffer_after_minutes"`
+	UnlockWindows []jsonWindow `json:"unlock_windows"`
+}
+
+func (JSONExporter) Export(w io.Writer, s *Schedule) error {
+	out := jsonSchedule{
+		BuildingID:    s.BuildingID,
+		Building:      s.BuildingName,
+		From:          s.From.Format(timeLayout),
+		To:            s.To.Format(timeLayout),
+		BufferBefore:  int64(s.Before.Minutes()),
+		BufferAfter:   int64(s.After.Minutes()),
+		UnlockWindows: make([]jsonWindow, len(s.Windows)),
+	}
+	for i, win := range s.Windows {
+		out.UnlockWindows[i] = jsonWindow{
+			FacilityID:     win.FacilityID,
+			Facility:       win.FacilityName,
+			UnlockAt:       win.UnlockAt.Format(timeLayout),
+			LockAt:         win.LockAt.Format(timeLayout),
+			Events:         win.Events,
+			ReservationIDs: win.ReservationIDs,
+			Doors:          win.Doors,
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -0,0 +1,86 @@
+// Package doors builds door-unlock schedules from approved bookings for
+// building access-control systems.
+package doors
+
+import (
+	"api/internal/models"
+	"slices"
+	"sort"
+	"time"
+)
+
+// Window is one span a facility's doors should be unlocked. Bookings whose
+// buffered times touch or overlap are merged into a single window.
+type Window struct {
+	FacilityID     int64
+	FacilityName   string
+	UnlockAt       time.Time
+	LockAt         time.Time
+	Events         []string
+	ReservationIDs []int64
+	Doors          []string
+}
+
+type Schedule struct {
+	BuildingID   int64
+	BuildingName string
+	From         time.Time
+	To           time.Time
+	Before       time.Duration
+	After        time.Duration
+	Windows      []Window
+}
+
+// Build applies the buffers to each date and merges overlapping windows per
+// facility. Times stay in the building's local wall clock.
+func Build(dates []models.DoorAccessDate, before, after time.Duration) []Window {
+	sorted := slices.Clone(dates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].FacilityID != sorted[j].FacilityID {
+			return sorted[i].FacilityID < sorted[j].FacilityID
+		}
+		return sorted[i].LocalStart.Time.Before(sorted[j].LocalStart.Time)
+	})
+
+	var windows []Window
+	for _, d := range sorted {
+		unlock := d.LocalStart.Time.Add(-before)
+		lock := d.LocalEnd.Time.Add(after)
+		if n := len(windows); n > 0 {
+			last := &windows[n-1]
+			if last.FacilityID == d.FacilityID && !unlock.After(last.LockAt) {
+				if lock.After(last.LockAt) {
+					last.LockAt = lock
+				}
+				last.add(d)
+				continue
+			}
+		}
+		w := Window{
+			FacilityID:   d.FacilityID,
+			FacilityName: d.FacilityName,
+			UnlockAt:     unlock,
+			LockAt:       lock,
+		}
+		w.add(d)
+		windows = append(windows, w)
+	}
+
+	sort.SliceStable(windows, func(i, j int) bool {
+		if !windows[i].UnlockAt.Equal(windows[j].UnlockAt) {
+			return windows[i].UnlockAt.Before(windows[j].UnlockAt)
+		}
+		return windows[i].FacilityName < windows[j].FacilityName
+	})
+	return windows
+}
+
+func (w *Window) add(d models.DoorAccessDate) {
+	if !slices.Contains(w.ReservationIDs, d.ReservationID) {
+		w.ReservationIDs = append(w.ReservationIDs, d.ReservationID)
+		w.Events = append(w.Events, d.EventName)
+	}
+	if d.DoorsDetails.Valid && d.DoorsDetails.String != "" && !slices.Contains(w.Doors, d.DoorsDetails.String) {
+		w.Doors = append(w.Doors, d.DoorsDetails.String)
+	}
+}
@@ -3,10 +3,14 @@ package emails
 import (
 	"bytes"
 	"embed"
+	"encoding/base64"
 	"fmt"
 	"html/template"
+	"mime"
+	"mime/multipart"
 	"net/mail"
 	"net/smtp"
+	"net/textproto"
 	"os"
 	"strings"
 )
@@ -15,10 +19,18 @@ import (
 var emailTemplates embed.FS
 
 type EmailData struct {
-	To       string
-	Subject  string
-	Template string
-	Data     map[string]any
+	To          string
+	Subject     string
+	Template    string
+	Data        map[string]any
+	Attachments []Attachment
+}
+
+// Attachment is a file sent along with an email, such as an invoice PDF.
+type Attachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
 }
 
 func Send(email *EmailData) {
@@ -55,6 +67,9 @@ func Send(email *EmailData) {
 	subject := "Subject: " + email.Subject + "!\n"
 	header := "From: " + from.Name + "<" + from.Address + ">\n"
 	msg := []byte(header + subject + mime + "\n" + body.String())
+	if len(email.Attachments) > 0 {
+		msg = []byte(header + subject + withAttachments(body.String(), email.Attachments))
+	}
 	recipients := strings.Split(email.To, ",")
 	for i, recipient := range recipients {
 		recipients[i] = strings.TrimSpace(recipient)
@@ -65,3 +80,29 @@ func Send(email *EmailData) {
 		fmt.Printf("Failed to send email: %v\n", err)
 	}
 }
+
+// withAttachments builds a multipart/mixed MIME body: the HTML, then each
+// attachment base64 encoded.
+func withAttachments(html string, attachments []Attachment) string {
+	var b strings.Builder
+	w := multipart.NewWriter(&b)
+	b.WriteString("MIME-version: 1.0\nContent-Type: multipart/mixed; boundary=\"" + w.Boundary() + "\"\n\n")
+
+	part, _ := w.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/html; charset=\"UTF-8\""}})
+	_, _ = part.Write([]byte(html))
+	for _, a := range attachments {
+		part, _ := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {a.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Name})},
+		})
+		encoded := base64.StdEncoding.EncodeToString(a.Data)
+		for len(encoded) > 76 {
+			_, _ = part.Write([]byte(encoded[:76] + "\r\n"))
+			encoded = encoded[76:]
+		}
+		_, _ = part.Write([]byte(encoded))
+	}
+	_ = w.Close()
+	return b.String()
+}
@@ -0,0 +1,203 @@
+// Package feeds turns reservation dates into iCalendar subscription feeds.
+package feeds
+
+import (
+	"api/internal/lib/calendars"
+	"api/internal/models"
+	"api/pkg/calendar"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/teambition/rrule-go"
+)
+
+// Options controls how events are rendered.
+type Options struct {
+	Loc *time.Location
+	TZ  string
+	// Domain makes UIDs globally unique, e.g. the API host name.
+	Domain string
+	// Private adds the requester and booking details to each event.
+	Private bool
+	// ReservationURL, when set, is linked from private events as
+	// ReservationURL + "/" + reservation id.
+	ReservationURL string
+}
+
+// Events groups dates by reservation. A recurring reservation becomes one
+// series bounded by its first and last listed dates: rule occurrences without
+// a listed date are excluded and listed dates off the rule are added as their
+// own events. Everything else gets one event per date.
+func Events(dates []models.FeedDate, opts Options) []calendar.ICSEvent {
+	type groupKey struct {
+		reservationID int64
+		confirmed     bool
+	}
+	groups := map[groupKey][]models.FeedDate{}
+	var order []groupKey
+	for _, d := range dates {
+		k := groupKey{d.ReservationID, d.Confirmed}
+		if _, ok := groups[k]; !ok {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], d)
+	}
+	events := make([]calendar.ICSEvent, 0, len(dates))
+	for _, k := range order {
+		events = append(events, reservationEvents(groups[k], opts)...)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	return events
+}
+
+func reservationEvents(dates []models.FeedDate, opts Options) []calendar.ICSEvent {
+	sort.Slice(dates, func(i, j int) bool { return dates[i].LocalStart.Time.Before(dates[j].LocalStart.Time) })
+	first := dates[0]
+	if len(dates) > 1 && first.RRule.Valid {
+		if ev, rest, err := series(dates, opts); err == nil {
+			out := []calendar.ICSEvent{ev}
+			for _, d := range rest {
+				out = append(out, single(d, opts))
+			}
+			return out
+		}
+	}
+	out := make([]calendar.ICSEvent, 0, len(dates))
+	for _, d := range dates {
+		out = append(out, single(d, opts))
+	}
+	return out
+}
+
+// series builds the master event for dates, which must be sorted. It returns
+// the dates the rule does not produce.
+func series(dates []models.FeedDate, opts Options) (calendar.ICSEvent, []models.FeedDate, error) {
+	first, last := dates[0], dates[len(dates)-1]
+	start := wall(first.LocalStart, opts.Loc)
+	dur := wall(first.LocalEnd, opts.Loc).Sub(start)
+
+	opt, err := rrule.StrToROptionInLocation(ruleLine(first.RRule.String), opts.Loc)
+	if err != nil {
+		return calendar.ICSEvent{}, nil, err
+	}
+	// bound the series by what is listed so denied or removed dates past the
+	// last one never show up
+	opt.Dtstart = start
+	opt.Count = 0
+	opt.Until = wall(last.LocalStart, opts.Loc)
+	rule, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return calendar.ICSEvent{}, nil, err
+	}
+
+	listed := make(map[int64]models.FeedDate, len(dates))
+	for _, d := range dates {
+		s := wall(d.LocalStart, opts.Loc)
+		if wall(d.LocalEnd, opts.Loc).Sub(s) == dur {
+			listed[s.Unix()] = d
+		}
+	}
+	var exdates []string
+	for _, s := range rule.Between(start, opt.Until, true) {
+		if _, ok := listed[s.Unix()]; ok {
+			delete(listed, s.Unix())
+			continue
+		}
+		exdates = append(exdates, s.In(opts.Loc).Format("20060102T150405"))
+	}
+	// the first date anchors the series, so it is never left over
+	var rest []models.FeedDate
+	for _, d := range dates[1:] {
+		s := wall(d.LocalStart, opts.Loc)
+		if wall(d.LocalEnd, opts.Loc).Sub(s) != dur {
+			rest = append(rest, d)
+		} else if l, ok := listed[s.Unix()]; ok && l.ReservationDateID == d.ReservationDateID {
+			rest = append(rest, d)
+		}
+	}
+
+	opt.Dtstart = time.Time{}
+	recurrence := []string{"RRULE:" + opt.RRuleString()}
+	if len(exdates) > 0 {
+		recurrence = append(recurrence, fmt.Sprintf("EXDATE;TZID=%s:%s", opts.TZ, strings.Join(exdates, ",")))
+	}
+	ev := event(first, opts)
+	ev.UID = uid("reservation", first.ReservationID, first.Confirmed, opts.Domain)
+	ev.Recurrence = recurrence
+	return ev, rest, nil
+}
+
+func single(d models.FeedDate, opts Options) calendar.ICSEvent {
+	ev := event(d, opts)
+	ev.UID = uid("reservation-date", d.ReservationDateID, d.Confirmed, opts.Domain)
+	return ev
+}
+
+func event(d models.FeedDate, opts Options) calendar.ICSEvent {
+	ev := calendar.ICSEvent{
+		Summary:  d.EventName,
+		Location: fmt.Sprintf("%s, %s", d.FacilityName, d.BuildingName),
+		Start:    wall(d.LocalStart, opts.Loc),
+		End:      wall(d.LocalEnd, opts.Loc),
+		Status:   "CONFIRMED",
+	}
+	if !d.Confirmed {
+		ev.Status = "TENTATIVE"
+	}
+	if opts.Private {
+		var desc []string
+		if d.Name != "" {
+			desc = append(desc, "Contact: "+d.Name)
+		}
+		if d.Details.Valid && d.Details.String != "" {
+			desc = append(desc, d.Details.String)
+		}
+		if opts.ReservationURL != "" {
+			desc = append(desc, fmt.Sprintf("%s/%d", opts.ReservationURL, d.ReservationID))
+		}
+		ev.Description = strings.Join(desc, "\n\n")
+	} else {
+		ev.Summary = publicSummary(d)
+	}
+	return ev
+}
+
+// publicSummary is what a feed without a token shows for an event.
+func publicSummary(d models.FeedDate) string {
+	switch {
+	case d.Visibility == models.EventVisibilityPrivate:
+		return calendars.PrivateSummary
+	case d.PublicTitle.Valid && d.PublicTitle.String != "":
+		return d.PublicTitle.String
+	}
+	return d.EventName
+}
+
+// uid gives pending dates their own UIDs since they are published as a
+// separate series from the approved dates of the same reservation.
+func uid(kind string, id int64, confirmed bool, domain string) string {
+	if confirmed {
+		return fmt.Sprintf("%s-%d@%s", kind, id, domain)
+	}
+	return fmt.Sprintf("%s-%d-pending@%s", kind, id, domain)
+}
+
+// ruleLine picks the RRULE out of a stored rule, which may carry a DTSTART.
+func ruleLine(stored string) string {
+	for _, line := range strings.Split(stored, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(strings.ToUpper(line), "RRULE:") {
+			return line[len("RRULE:"):]
+		}
+	}
+	return strings.TrimSpace(stored)
+}
+
+// wall reads a timestamp column as a wall-clock time in loc.
+func wall(ts pgtype.Timestamp, loc *time.Location) time.Time {
+	t := ts.Time
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
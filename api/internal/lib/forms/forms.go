@@ -0,0 +1,207 @@
+package forms
+
+import (
+	"api/internal/models"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxConditionDepth stops condition chains (and accidental cycles) from recursing forever
+const maxConditionDepth = 10
+
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid intake answers: " + strings.Join(e.Problems, "; ")
+}
+
+// Merge combines facility and category fields into one form. A facility field
+// replaces a category field with the same key.
+func Merge(fields []models.FormField) []models.FormField {
+	byKey := make(map[string]models.FormField, len(fields))
+	for _, f := range fields {
+		existing, ok := byKey[f.Key]
+		if ok && existing.FacilityID.Valid && !f.FacilityID.Valid {
+			continue
+		}
+		byKey[f.Key] = f
+	}
+	merged := make([]models.FormField, 0, len(byKey))
+	for _, f := range byKey {
+		merged = append(merged, f)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].SortOrder != merged[j].SortOrder {
+			return merged[i].SortOrder < merged[j].SortOrder
+		}
+		return merged[i].ID < merged[j].ID
+	})
+	return merged
+}
+
+// CheckDefinition validates a field definition before it is saved.
+func CheckDefinition(f *models.FormField) error {
+	if f.Key == "" || f.Label == "" {
+		return fmt.Errorf("key and label are required")
+	}
+	if !f.FacilityID.Valid && !f.CategoryID.Valid {
+		return fmt.Errorf("field must belong to a facility or a category")
+	}
+	if !slices.Contains(models.AllFormFieldTypeValues(), f.Type) {
+		return fmt.Errorf("unknown field type %q", f.Type)
+	}
+	if f.Type == models.FormFieldTypeSelect && len(f.Options) == 0 {
+		return fmt.Errorf("select fields need at least one option")
+	}
+	if f.Condition.Field == f.Key {
+		return fmt.Errorf("field cannot depend on itself")
+	}
+	return nil
+}
+
+// Validate checks answers against the form and returns the normalized answers.
+// Answers for fields whose condition is not met are dropped, and unknown keys
+// are rejected. A file answer must be a path fileExists accepts.
+func Validate(fields []models.FormField, answers models.JSONMap, fileExists func(path string) bool) (models.JSONMap, error) {
+	byKey := make(map[string]models.FormField, len(fields))
+	for _, f := range fields {
+		byKey[f.Key] = f
+	}
+	var problems []string
+	for key := range answers {
+		if _, ok := byKey[key]; !ok {
+			problems = append(problems, fmt.Sprintf("%s: unknown field", key))
+		}
+	}
+
+	clean := models.JSONMap{}
+	for _, f := range fields {
+		if !active(f, byKey, answers, 0) {
+			continue
+		}
+		raw, present := answers[f.Key]
+		if !present || raw == nil {
+			if f.Required {
+				problems = append(problems, fmt.Sprintf("%s: required", f.Key))
+			}
+			continue
+		}
+		value, err := normalize(f, raw, fileExists)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", f.Key, err))
+			continue
+		}
+		if f.Required && isEmpty(value) {
+			problems = append(problems, fmt.Sprintf("%s: required", f.Key))
+			continue
+		}
+		clean[f.Key] = value
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, &ValidationError{Problems: problems}
+	}
+	return clean, nil
+}
+
+func active(f models.FormField, byKey map[string]models.FormField, answers models.JSONMap, depth int) bool {
+	if f.Condition.Field == "" {
+		return true
+	}
+	if depth >= maxConditionDepth {
+		return false
+	}
+	parent, ok := byKey[f.Condition.Field]
+	if !ok || !active(parent, byKey, answers, depth+1) {
+		return false
+	}
+	return stringify(answers[f.Condition.Field]) == f.Condition.Equals
+}
+
+func normalize(f models.FormField, raw any, fileExists func(string) bool) (any, error) {
+	switch f.Type {
+	case models.FormFieldTypeText:
+		s, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string")
+		}
+		return strings.TrimSpace(s), nil
+	case models.FormFieldTypeFile:
+		s, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string")
+		}
+		s = strings.TrimSpace(s)
+		if s != "" && !fileExists(s) {
+			return nil, fmt.Errorf("no uploaded file %q", s)
+		}
+		return s, nil
+	case models.FormFieldTypeNumber:
+		switch v := raw.(type) {
+		case float64:
+			return v, nil
+		case json.Number:
+			return v.Float64()
+		case string:
+			n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, fmt.Errorf("expected a number")
+			}
+			return n, nil
+		}
+		return nil, fmt.Errorf("expected a number")
+	case models.FormFieldTypeSelect:
+		s, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string")
+		}
+		if s != "" && !slices.Contains(f.Options, s) {
+			return nil, fmt.Errorf("%q is not one of the options", s)
+		}
+		return s, nil
+	case models.FormFieldTypeCheckbox:
+		switch v := raw.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("expected true or false")
+			}
+			return b, nil
+		}
+		return nil, fmt.Errorf("expected true or false")
+	}
+	return nil, fmt.Errorf("unknown field type %q", f.Type)
+}
+
+// isEmpty treats blank strings and unchecked boxes as missing for required fields.
+func isEmpty(v any) bool {
+	switch t := v.(type) {
+	case string:
+		return t == ""
+	case bool:
+		return !t
+	}
+	return false
+}
+
+func stringify(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
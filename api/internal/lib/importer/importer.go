@@ -0,0 +1,392 @@
+// Package importer loads existing bookings from ICS or CSV files as approved
+// reservations, typically when a building is onboarded.
+package importer
+
+import (
+	"api/internal/lib/calendars"
+	"api/internal/models"
+	"api/internal/ports"
+	"api/pkg/calendar"
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/teambition/rrule-go"
+)
+
+// Row statuses in a Report.
+const (
+	StatusReady    = "ready"
+	StatusCreated  = "created"
+	StatusConflict = "conflict"
+	StatusError    = "error"
+)
+
+// Options apply to a whole import. FacilityID, UserID and CategoryID are
+// used for bookings that do not name their own.
+type Options struct {
+	DryRun     bool
+	FacilityID int64
+	UserID     string
+	CategoryID int64
+	// Publish adds created reservations to their facility's calendar.
+	Publish bool
+	// Allow limits which buildings may be imported into; nil allows all.
+	Allow func(buildingID int64) bool
+}
+
+type RowResult struct {
+	Line          int
+	EventName     string
+	FacilityID    int64
+	FacilityName  string
+	UserID        string
+	Occurrences   int
+	FirstStart    time.Time
+	Status        string
+	Problems      []string
+	Warnings      []string
+	ReservationID int64
+}
+
+type Report struct {
+	Rows    []RowResult
+	Created int
+	Skipped int
+}
+
+type Importer struct {
+	reservationStore ports.ReservationStore
+	facilityStore    ports.FacilityStore
+	userStore        ports.UserStore
+	calendar         ports.CalendarProvider
+	text             *calendars.EventText
+	loc              *time.Location
+	log              *slog.Logger
+}
+
+// New builds an Importer. cal and text may be nil when nothing is published.
+func New(reservationStore ports.ReservationStore, facilityStore ports.FacilityStore, userStore ports.UserStore, cal ports.CalendarProvider, text *calendars.EventText, loc *time.Location, log *slog.Logger) *Importer {
+	return &Importer{
+		reservationStore: reservationStore,
+		facilityStore:    facilityStore,
+		userStore:        userStore,
+		calendar:         cal,
+		text:             text,
+		loc:              loc,
+		log:              log.With("component", "importer"),
+	}
+}
+
+// planned is a booking that resolved cleanly and may be created.
+type planned struct {
+	booking  Booking
+	facility *models.Facility
+	user     *models.Users
+	category int64
+	occ      []Occurrence
+}
+
+// Run checks every booking against existing reservations and against the
+// other bookings in the file. Unless DryRun is set, bookings without
+// problems are created as approved reservations; the rest are skipped.
+func (im *Importer) Run(ctx context.Context, bookings []Booking, opts Options) (*Report, error) {
+	facilities, err := im.facilityStore.GetAllFacilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := im.facilityStore.GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var fallbackUser *models.Users
+	if opts.UserID != "" {
+		if fallbackUser, err = im.userStore.Get(ctx, opts.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &Report{Rows: make([]RowResult, 0, len(bookings))}
+	// occurrences accepted so far in this run, per facility
+	taken := map[int64][]Occurrence{}
+	for _, b := range bookings {
+		row := RowResult{Line: b.Line, EventName: b.EventName}
+		p := im.resolve(ctx, b, opts, facilities, categories, fallbackUser, &row)
+		if p != nil {
+			im.checkConflicts(ctx, p, taken[p.facility.ID], &row)
+		}
+		switch {
+		case len(row.Problems) > 0 && row.Status == "":
+			row.Status = StatusError
+		case row.Status == "":
+			row.Status = StatusReady
+			taken[p.facility.ID] = append(taken[p.facility.ID], p.occ...)
+		}
+		if row.Status == StatusReady && !opts.DryRun {
+			id, err := im.create(ctx, p, opts)
+			if err != nil {
+				im.log.Error("Failed to import booking", "line", b.Line, "err", err)
+				row.Status = StatusError
+				row.Problems = append(row.Problems, "create failed: "+err.Error())
+			} else {
+				row.Status = StatusCreated
+				row.ReservationID = id
+				report.Created++
+			}
+		}
+		if row.Status == StatusError || row.Status == StatusConflict {
+			report.Skipped++
+		}
+		report.Rows = append(report.Rows, row)
+	}
+	return report, nil
+}
+
+func (im *Importer) resolve(ctx context.Context, b Booking, opts Options, facilities []*models.Facility, categories []models.Category, fallbackUser *models.Users, row *RowResult) *planned {
+	if strings.TrimSpace(b.EventName) == "" {
+		row.Problems = append(row.Problems, "missing event name")
+	}
+
+	facility, problem := matchFacility(b.Facility, opts.FacilityID, facilities)
+	if problem != "" && opts.FacilityID != 0 && strings.TrimSpace(b.Facility) != "" {
+		// exported calendars often carry free-form locations
+		row.Warnings = append(row.Warnings, problem+", using the default facility")
+		facility, problem = matchFacility("", opts.FacilityID, facilities)
+	}
+	if problem != "" {
+		row.Problems = append(row.Problems, problem)
+	} else {
+		row.FacilityID = facility.ID
+		row.FacilityName = facility.Name
+		if opts.Allow != nil && !opts.Allow(facility.BuildingID) {
+			row.Problems = append(row.Problems, "not an admin for this facility's building")
+		}
+	}
+
+	user := fallbackUser
+	if b.Email != "" {
+		found, err := im.userStore.GetByEmail(ctx, b.Email)
+		if err != nil {
+			row.Problems = append(row.Problems, "look up user: "+err.Error())
+		} else if found != nil {
+			user = found
+		} else {
+			row.Warnings = append(row.Warnings, fmt.Sprintf("no user with email %s, using the default user", b.Email))
+		}
+	}
+	if user == nil {
+		row.Problems = append(row.Problems, "no user to book for")
+	} else {
+		row.UserID = user.ID
+	}
+
+	category, problem := matchCategory(b.Category, opts.CategoryID, categories)
+	if problem != "" {
+		row.Problems = append(row.Problems, problem)
+	}
+
+	occ, err := b.Occurrences(im.loc)
+	if err != nil {
+		row.Problems = append(row.Problems, err.Error())
+	} else if len(occ) == 0 {
+		row.Problems = append(row.Problems, "no occurrences")
+	} else {
+		row.Occurrences = len(occ)
+		row.FirstStart = occ[0].Start
+	}
+
+	if len(row.Problems) > 0 {
+		return nil
+	}
+	return &planned{booking: b, facility: facility, user: user, category: category, occ: occ}
+}
+
+// checkConflicts flags occurrences overlapping pending or approved dates in
+// the facility, or bookings accepted earlier in the same file.
+func (im *Importer) checkConflicts(ctx context.Context, p *planned, taken []Occurrence, row *RowResult) {
+	from, to := p.occ[0].Start, p.occ[len(p.occ)-1].End
+	booked, err := im.reservationStore.GetBookedDates(ctx, p.facility.ID, from, to)
+	if err != nil {
+		row.Problems = append(row.Problems, "check conflicts: "+err.Error())
+		return
+	}
+	for _, o := range p.occ {
+		for _, d := range booked {
+			start, end := wall(d.LocalStart, im.loc), wall(d.LocalEnd, im.loc)
+			if o.Start.Before(end) && start.Before(o.End) {
+				row.Problems = append(row.Problems, fmt.Sprintf("%s overlaps reservation %d (%s)", o.Start.Format("2006-01-02 15:04"), d.ReservationID, d.EventName))
+			}
+		}
+		for _, t := range taken {
+			if o.Start.Before(t.End) && t.Start.Before(o.End) {
+				row.Problems = append(row.Problems, fmt.Sprintf("%s overlaps another booking in this import", o.Start.Format("2006-01-02 15:04")))
+			}
+		}
+	}
+	if len(row.Problems) > 0 {
+		row.Status = StatusConflict
+	}
+}
+
+func (im *Importer) create(ctx context.Context, p *planned, opts Options) (int64, error) {
+	b := p.booking
+	name := b.Name
+	if name == "" {
+		name = p.user.Name
+	}
+	res := &models.Reservation{
+		UserID:     p.user.ID,
+		EventName:  b.EventName,
+		FacilityID: p.facility.ID,
+		Approved:   models.ReservationApprovedApproved,
+		Details:    models.CheckNullString(b.Details),
+		Name:       name,
+		Phone:      models.CheckNullString(b.Phone),
+		CategoryID: p.category,
+	}
+	if b.RRule != "" {
+		// stored like rules from the booking form, with their DTSTART
+		opt, err := rrule.StrToROptionInLocation(b.RRule, im.loc)
+		if err != nil {
+			return 0, err
+		}
+		opt.Dtstart = b.Start
+		rule, err := rrule.NewRRule(*opt)
+		if err != nil {
+			return 0, err
+		}
+		res.RRule = models.CheckNullString(rule.String())
+	}
+	rdates := append([]time.Time{}, b.RDates...)
+	for _, m := range b.Moved {
+		rdates = append(rdates, m.Start)
+	}
+	res.RDates = models.DatesArrayToNullDates(rdates)
+	res.EXDates = models.DatesArrayToNullDates(b.ExDates)
+
+	id, err := im.reservationStore.Create(ctx, res)
+	if err != nil {
+		return 0, err
+	}
+	dates := make([]models.ReservationDate, 0, len(p.occ))
+	for _, o := range p.occ {
+		dates = append(dates, models.ReservationDate{
+			ReservationID: id,
+			Approved:      models.ReservationDateApprovedApproved,
+			LocalStart:    pgtype.Timestamp{Time: o.Start, Valid: true},
+			LocalEnd:      pgtype.Timestamp{Time: o.End, Valid: true},
+		})
+	}
+	if err := im.reservationStore.CreateDates(ctx, dates); err != nil {
+		if delErr := im.reservationStore.Delete(ctx, id); delErr != nil {
+			im.log.Error("Failed to remove partly imported reservation", "id", id, "err", delErr)
+		}
+		return 0, err
+	}
+	if opts.Publish && im.calendar != nil && p.facility.GoogleCalendarID != "" {
+		if err := im.publish(ctx, id, p); err != nil {
+			// the reservation stands; the calendar can be repaired later
+			im.log.Error("Failed to publish imported reservation", "id", id, "err", err)
+		}
+	}
+	return id, nil
+}
+
+// publish adds each date as its own event, which keeps EXDATEs and moved
+// occurrences exact on every provider.
+func (im *Importer) publish(ctx context.Context, id int64, p *planned) error {
+	full, err := im.reservationStore.Get(ctx, id)
+	if err != nil || full == nil {
+		return fmt.Errorf("reload reservation: %w", err)
+	}
+	singles := make([]calendar.OccSpec, 0, len(full.Dates))
+	for _, d := range full.Dates {
+		singles = append(singles, calendar.OccSpec{
+			Start: d.LocalStart.Time,
+			End:   d.LocalEnd.Time,
+			RefID: d.ID,
+		})
+	}
+	summary, description := im.text.Render(full.Reservation)
+	res, err := im.calendar.Publish(ctx, &calendar.PublishPlan{
+		Mode:    calendar.ModeSingles,
+		Singles: singles,
+	}, calendar.PublishOptions{
+		CalendarID:  p.facility.GoogleCalendarID,
+		Summary:     summary,
+		Description: description,
+		SendUpdates: calendar.NoUpdates,
+	})
+	if err != nil {
+		return err
+	}
+	for i := range full.Dates {
+		d := &full.Dates[i]
+		if eventID, ok := res.SingleEventID[d.ID]; ok {
+			d.GcalEventid = models.CheckNullString(eventID)
+			if err := im.reservationStore.UpdateDate(ctx, d); err != nil {
+				im.log.Error("Failed to store event id for imported date", "date_id", d.ID, "err", err)
+			}
+		}
+	}
+	return nil
+}
+
+// matchFacility accepts a facility id or a case-insensitive name.
+func matchFacility(ref string, fallback int64, facilities []*models.Facility) (*models.Facility, string) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		if fallback == 0 {
+			return nil, "no facility given and no default facility"
+		}
+		ref = strconv.FormatInt(fallback, 10)
+	}
+	if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		for _, f := range facilities {
+			if f.ID == id {
+				return f, ""
+			}
+		}
+		return nil, fmt.Sprintf("facility %d not found", id)
+	}
+	var match *models.Facility
+	for _, f := range facilities {
+		if strings.EqualFold(strings.TrimSpace(f.Name), ref) {
+			if match != nil {
+				return nil, fmt.Sprintf("facility name %q is ambiguous, use its id", ref)
+			}
+			match = f
+		}
+	}
+	if match == nil {
+		return nil, fmt.Sprintf("no facility named %q", ref)
+	}
+	return match, ""
+}
+
+// matchCategory accepts a pricing category id or a case-insensitive name.
+func matchCategory(ref string, fallback int64, categories []models.Category) (int64, string) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		if fallback == 0 {
+			return 0, "no category given and no default category"
+		}
+		ref = strconv.FormatInt(fallback, 10)
+	}
+	for _, c := range categories {
+		if strconv.FormatInt(c.ID, 10) == ref || strings.EqualFold(c.Name, ref) {
+			return c.ID, ""
+		}
+	}
+	return 0, fmt.Sprintf("no category %q", ref)
+}
+
+// wall reads a timestamp column as a wall-clock time in loc.
+func wall(ts pgtype.Timestamp, loc *time.Location) time.Time {
+	t := ts.Time
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
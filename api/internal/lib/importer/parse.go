@@ -0,0 +1,280 @@
+package importer
+
+import (
+	"api/pkg/calendar"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// maxOccurrences matches the limit on reservations made through the app.
+const maxOccurrences = 500
+
+// Booking is one reservation read from an import file. Times are wall-clock
+// times in the building's location. Facility, Email and Category are matched
+// against the database and may be left empty to use the import defaults.
+type Booking struct {
+	// Line is the CSV line, or the event's position in an ICS file.
+	Line      int
+	EventName string
+	Facility  string
+	Email     string
+	Name      string
+	Phone     string
+	Details   string
+	Category  string
+	Start     time.Time
+	End       time.Time
+	// RRule is the bare rule, without DTSTART or an "RRULE:" prefix.
+	RRule   string
+	RDates  []time.Time
+	ExDates []time.Time
+	// Moved holds occurrences an ICS override moved away from the rule.
+	Moved []Occurrence
+}
+
+type Occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Occurrences expands the booking. Rules without an end stop a year after
+// the first occurrence.
+func (b Booking) Occurrences(loc *time.Location) ([]Occurrence, error) {
+	dur := b.End.Sub(b.Start)
+	if dur <= 0 {
+		return nil, errors.New("end must be after start")
+	}
+	if b.RRule == "" && len(b.RDates) == 0 {
+		return append([]Occurrence{{Start: b.Start, End: b.End}}, b.Moved...), nil
+	}
+	var set rrule.Set
+	limit := b.Start.AddDate(1, 0, 0)
+	if b.RRule != "" {
+		opt, err := rrule.StrToROptionInLocation(b.RRule, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule %q: %w", b.RRule, err)
+		}
+		opt.Dtstart = b.Start
+		rule, err := rrule.NewRRule(*opt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule %q: %w", b.RRule, err)
+		}
+		set.RRule(rule)
+		if !opt.Until.IsZero() || opt.Count > 0 {
+			limit = time.Time{}
+		}
+	}
+	// DTSTART is always the first occurrence, even off the rule
+	set.RDate(b.Start)
+	for _, t := range b.RDates {
+		set.RDate(t)
+	}
+	for _, t := range b.ExDates {
+		set.ExDate(t)
+	}
+
+	var occ []Occurrence
+	var last time.Time
+	next := set.Iterator()
+	for {
+		s, ok := next()
+		if !ok || (!limit.IsZero() && s.After(limit)) {
+			break
+		}
+		if s.Equal(last) {
+			continue
+		}
+		last = s
+		if len(occ) >= maxOccurrences {
+			return nil, fmt.Errorf("more than %d occurrences", maxOccurrences)
+		}
+		s = s.In(loc)
+		occ = append(occ, Occurrence{Start: s, End: s.Add(dur)})
+	}
+	occ = append(occ, b.Moved...)
+	sort.Slice(occ, func(i, j int) bool { return occ[i].Start.Before(occ[j].Start) })
+	return occ, nil
+}
+
+// ParseICS reads one booking per VEVENT. Overrides are folded into their
+// series: a moved occurrence is excluded from the rule and added back at its
+// new time, a cancelled one is only excluded. Cancelled events are skipped.
+func ParseICS(r io.Reader, loc *time.Location, tz string) ([]Booking, error) {
+	events, err := calendar.ParseICS(r, loc)
+	if err != nil {
+		return nil, err
+	}
+	var bookings []Booking
+	index := map[string]int{}
+	var overrides []calendar.ICSEvent
+	for i, ev := range events {
+		if ev.IsOverride() {
+			overrides = append(overrides, ev)
+			continue
+		}
+		if ev.Status == "CANCELLED" {
+			continue
+		}
+		b := Booking{
+			Line:      i + 1,
+			EventName: ev.Summary,
+			Facility:  ev.Location,
+			Details:   ev.Description,
+			Start:     ev.Start,
+			End:       ev.End,
+		}
+		if len(ev.Recurrence) > 0 {
+			if err := b.setRecurrence(ev.Recurrence, loc, tz); err != nil {
+				return nil, fmt.Errorf("event %d (%s): %w", i+1, ev.Summary, err)
+			}
+		}
+		if ev.UID != "" {
+			index[ev.UID] = len(bookings)
+		}
+		bookings = append(bookings, b)
+	}
+	for _, ov := range overrides {
+		i, ok := index[ov.UID]
+		if !ok {
+			continue
+		}
+		b := &bookings[i]
+		b.ExDates = append(b.ExDates, ov.RecurrenceID)
+		if ov.Status != "CANCELLED" {
+			b.Moved = append(b.Moved, Occurrence{Start: ov.Start, End: ov.End})
+		}
+	}
+	return bookings, nil
+}
+
+func (b *Booking) setRecurrence(lines []string, loc *time.Location, tz string) error {
+	all := append([]string{fmt.Sprintf("DTSTART;TZID=%s:%s", tz, b.Start.Format("20060102T150405"))}, lines...)
+	set, err := rrule.StrSliceToRRuleSetInLoc(all, loc)
+	if err != nil {
+		return err
+	}
+	if rule := set.GetRRule(); rule != nil {
+		b.RRule = rule.OrigOptions.RRuleString()
+	}
+	for _, t := range set.GetRDate() {
+		b.RDates = append(b.RDates, t.In(loc))
+	}
+	for _, t := range set.GetExDate() {
+		b.ExDates = append(b.ExDates, t.In(loc))
+	}
+	return nil
+}
+
+// CSV columns, matched case-insensitively. event_name, start and end are
+// required; exdates and rdates are separated by semicolons.
+var csvColumns = []string{"event_name", "facility", "email", "name", "phone", "details", "category", "start", "end", "rrule", "exdates", "rdates"}
+
+var csvTimeLayouts = []string{
+	"2006-01-02T15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"1/2/2006 15:04",
+	"1/2/2006 3:04 PM",
+	"1/2/2006 3:04PM",
+}
+
+// ParseCSV reads one booking per row after a header row naming the columns.
+func ParseCSV(r io.Reader, loc *time.Location) ([]Booking, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	col := map[string]int{}
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		name = strings.ReplaceAll(name, " ", "_")
+		col[name] = i
+	}
+	for _, required := range []string{"event_name", "start", "end"} {
+		if _, ok := col[required]; !ok {
+			return nil, fmt.Errorf("missing %q column (columns: %s)", required, strings.Join(csvColumns, ", "))
+		}
+	}
+
+	var bookings []Booking
+	line := 1
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		get := func(name string) string {
+			if i, ok := col[name]; ok && i < len(rec) {
+				return strings.TrimSpace(rec[i])
+			}
+			return ""
+		}
+		if strings.Join(rec, "") == "" {
+			continue
+		}
+		b := Booking{
+			Line:      line,
+			EventName: get("event_name"),
+			Facility:  get("facility"),
+			Email:     get("email"),
+			Name:      get("name"),
+			Phone:     get("phone"),
+			Details:   get("details"),
+			Category:  get("category"),
+			RRule:     strings.TrimPrefix(strings.TrimPrefix(get("rrule"), "RRULE:"), "rrule:"),
+		}
+		if b.Start, err = parseCSVTime(get("start"), loc); err != nil {
+			return nil, fmt.Errorf("line %d: start: %w", line, err)
+		}
+		if b.End, err = parseCSVTime(get("end"), loc); err != nil {
+			return nil, fmt.Errorf("line %d: end: %w", line, err)
+		}
+		if b.ExDates, err = parseCSVTimes(get("exdates"), loc); err != nil {
+			return nil, fmt.Errorf("line %d: exdates: %w", line, err)
+		}
+		if b.RDates, err = parseCSVTimes(get("rdates"), loc); err != nil {
+			return nil, fmt.Errorf("line %d: rdates: %w", line, err)
+		}
+		bookings = append(bookings, b)
+	}
+	return bookings, nil
+}
+
+func parseCSVTime(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range csvTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time %q", s)
+}
+
+func parseCSVTimes(s string, loc *time.Location) ([]time.Time, error) {
+	var out []time.Time
+	for _, part := range strings.Split(s, ";") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		t, err := parseCSVTime(part, loc)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
@@ -0,0 +1,282 @@
+// Package invoices lays out invoice and receipt PDFs.
+package invoices
+
+import (
+	"api/internal/models"
+	"api/pkg/pdf"
+	"image"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Document is everything printed on an invoice or receipt.
+type Document struct {
+	Title        string // INVOICE or RECEIPT
+	Number       string
+	Issued       time.Time
+	DueDate      string // printed as "Balance due by" when set
+	Organization Organization
+	BillTo       []string
+	Details      []string // what was reserved
+	Lines        []Line
+	Payments     []Payment // listed on receipts
+	TotalCents   int64
+	PaidCents    int64
+}
+
+// Organization is the branding at the top and bottom of every document.
+type Organization struct {
+	Name        string
+	URL         string
+	Email       string
+	Description string
+	Color       pdf.Color
+	Logo        image.Image // optional
+}
+
+type Line struct {
+	Description string
+	Quantity    int64
+	UnitCents   int64
+	AmountCents int64
+}
+
+type Payment struct {
+	Date        time.Time
+	Description string
+	AmountCents int64
+}
+
+const (
+	left      = 50.0
+	right     = pdf.PageWidth - 50
+	bottom    = pdf.PageHeight - 70
+	rowHeight = 20.0
+)
+
+var rule = pdf.Color{R: 220, G: 220, B: 220}
+
+type renderer struct {
+	out  *pdf.Document
+	page *pdf.Page
+	doc  *Document
+	y    float64
+}
+
+// Render lays the document out on as many pages as its lines need.
+func Render(doc *Document) ([]byte, error) {
+	r := &renderer{out: pdf.New(), doc: doc}
+	r.newPage()
+	if err := r.header(); err != nil {
+		return nil, err
+	}
+	r.parties()
+	r.lines()
+	r.totals()
+	if len(doc.Payments) > 0 {
+		r.payments()
+	}
+	if doc.Organization.Description != "" {
+		r.note(doc.Organization.Description)
+	}
+	return r.out.Bytes(), nil
+}
+
+func (r *renderer) newPage() {
+	r.page = r.out.AddPage()
+	r.page.Rect(0, 0, pdf.PageWidth, 8, r.doc.Organization.Color)
+	r.y = 50
+	org := r.doc.Organization
+	footer := strings.Join(nonEmpty(org.Name, org.URL, org.Email), "  ·  ")
+	r.page.Line(left, pdf.PageHeight-50, right, pdf.PageHeight-50, 0.5, rule)
+	r.page.Text(left, pdf.PageHeight-36, pdf.Helvetica, 8, pdf.Gray, footer)
+	r.page.TextRight(right, pdf.PageHeight-36, pdf.Helvetica, 8, pdf.Gray, r.doc.Number)
+}
+
+// room starts a new page when h more points would not fit, running then
+// to redraw what continues there.
+func (r *renderer) room(h float64, then func()) {
+	if r.y+h <= bottom {
+		return
+	}
+	r.newPage()
+	if then != nil {
+		then()
+	}
+}
+
+func (r *renderer) header() error {
+	org := r.doc.Organization
+	y := 30.0
+	if org.Logo != nil && !org.Logo.Bounds().Empty() {
+		b := org.Logo.Bounds()
+		w, h := fit(float64(b.Dx()), float64(b.Dy()), 160, 56)
+		if err := r.page.Image(org.Logo, left, y, w, h); err != nil {
+			return err
+		}
+		y += h + 8
+	}
+	y += 16
+	r.page.Text(left, y, pdf.HelveticaBold, 14, pdf.Black, org.Name)
+	for _, s := range nonEmpty(org.URL, org.Email) {
+		y += 13
+		r.page.Text(left, y, pdf.Helvetica, 9, pdf.Gray, s)
+	}
+
+	ry := 58.0
+	r.page.TextRight(right, ry, pdf.HelveticaBold, 26, org.Color, r.doc.Title)
+	ry += 20
+	r.page.TextRight(right, ry, pdf.Helvetica, 10, pdf.Black, "No. "+r.doc.Number)
+	ry += 14
+	r.page.TextRight(right, ry, pdf.Helvetica, 10, pdf.Black, "Issued "+r.doc.Issued.Format("January 2, 2006"))
+	if r.doc.DueDate != "" {
+		ry += 14
+		r.page.TextRight(right, ry, pdf.HelveticaBold, 10, pdf.Black, "Balance due by "+r.doc.DueDate)
+	}
+	r.y = max(y, ry) + 30
+	return nil
+}
+
+func (r *renderer) parties() {
+	const column = 320.0
+	r.page.Text(left, r.y, pdf.HelveticaBold, 9, pdf.Gray, "BILL TO")
+	r.page.Text(column, r.y, pdf.HelveticaBold, 9, pdf.Gray, "RESERVATION")
+	ly, ry := r.y, r.y
+	for _, s := range r.doc.BillTo {
+		ly += 14
+		r.page.Text(left, ly, pdf.Helvetica, 10, pdf.Black, pdf.Truncate(pdf.Helvetica, 10, s, column-left-20))
+	}
+	for _, s := range r.doc.Details {
+		ry += 14
+		r.page.Text(column, ry, pdf.Helvetica, 10, pdf.Black, pdf.Truncate(pdf.Helvetica, 10, s, right-column))
+	}
+	r.y = max(ly, ry) + 30
+}
+
+// Amount columns are right aligned at these x positions.
+const (
+	qtyColumn    = 380.0
+	unitColumn   = 470.0
+	amountColumn = right
+)
+
+func (r *renderer) tableHeader(labels ...string) {
+	r.page.Rect(left, r.y, right-left, rowHeight, r.doc.Organization.Color)
+	base := r.y + 14
+	r.page.Text(left+8, base, pdf.HelveticaBold, 9, pdf.White, labels[0])
+	columns := []float64{qtyColumn, unitColumn, amountColumn}
+	for i, label := range labels[1:] {
+		x := columns[len(columns)-len(labels)+1+i]
+		if x == right {
+			x -= 8
+		}
+		r.page.TextRight(x, base, pdf.HelveticaBold, 9, pdf.White, label)
+	}
+	r.y += rowHeight
+}
+
+func (r *renderer) row(description string, cells ...string) {
+	base := r.y + 14
+	r.page.Text(left+8, base, pdf.Helvetica, 10, pdf.Black, pdf.Truncate(pdf.Helvetica, 10, description, qtyColumn-left-60))
+	columns := []float64{qtyColumn, unitColumn, amountColumn - 8}
+	for i, cell := range cells {
+		r.page.TextRight(columns[len(columns)-len(cells)+i], base, pdf.Helvetica, 10, pdf.Black, cell)
+	}
+	r.y += rowHeight
+	r.page.Line(left, r.y, right, r.y, 0.5, rule)
+}
+
+func (r *renderer) lines() {
+	header := func() { r.tableHeader("DESCRIPTION", "QTY", "UNIT PRICE", "AMOUNT") }
+	r.room(2*rowHeight, nil)
+	header()
+	for _, line := range r.doc.Lines {
+		r.room(rowHeight, header)
+		r.row(line.Description, strconv.FormatInt(line.Quantity, 10), money(line.UnitCents), money(line.AmountCents))
+	}
+	r.y += 10
+}
+
+func (r *renderer) totals() {
+	r.room(3*18+10, nil)
+	rows := []struct {
+		label string
+		cents int64
+		font  pdf.Font
+	}{
+		{"Total", r.doc.TotalCents, pdf.Helvetica},
+		{"Paid", r.doc.PaidCents, pdf.Helvetica},
+		{"Balance due", r.doc.TotalCents - r.doc.PaidCents, pdf.HelveticaBold},
+	}
+	for _, t := range rows {
+		r.y += 18
+		r.page.TextRight(unitColumn, r.y, t.font, 11, pdf.Black, t.label)
+		r.page.TextRight(amountColumn-8, r.y, t.font, 11, pdf.Black, money(t.cents))
+	}
+	r.y += 30
+}
+
+func (r *renderer) payments() {
+	header := func() { r.tableHeader("PAYMENTS", "DATE", "AMOUNT") }
+	r.room(2*rowHeight, nil)
+	header()
+	for _, p := range r.doc.Payments {
+		r.room(rowHeight, header)
+		r.row(p.Description, p.Date.Format("Jan 2, 2006"), money(p.AmountCents))
+	}
+	r.y += 20
+}
+
+// note wraps text across the page width below everything else.
+func (r *renderer) note(text string) {
+	for _, line := range wrap(text, pdf.Helvetica, 9, right-left) {
+		r.room(12, nil)
+		r.y += 12
+		r.page.Text(left, r.y, pdf.Helvetica, 9, pdf.Gray, line)
+	}
+}
+
+func wrap(text string, font pdf.Font, size, width float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			next := word
+			if line != "" {
+				next = line + " " + word
+			}
+			if line != "" && pdf.Width(font, size, next) > width {
+				lines = append(lines, line)
+				next = word
+			}
+			line = next
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// fit scales w by h to fit inside maxW by maxH, keeping its shape.
+func fit(w, h, maxW, maxH float64) (float64, float64) {
+	scale := min(maxW/w, maxH/h, 1)
+	return w * scale, h * scale
+}
+
+func money(cents int64) string {
+	s := models.CentsToString(cents)
+	if strings.HasPrefix(s, "-") {
+		return "-$" + s[1:]
+	}
+	return "$" + s
+}
+
+func nonEmpty(values ...string) []string {
+	out := values[:0:0]
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -2,7 +2,6 @@ package utils
 
 import (
 	"database/sql"
-	"math/big"
 	"strconv"
 	"time"
 
@@ -161,37 +160,38 @@ func PgTimeToTime(ti pgtype.Time) time.Time {
 	return t.(time.Time)
 }
 
+// PgNumericToString formats the numeric with its decimal places, "" when null.
 func PgNumericToString(num pgtype.Numeric) string {
-	var s string
-	if num.Valid {
-		s = num.Int.String()
+	if !num.Valid {
+		return ""
 	}
+	v, err := num.Value()
+	if err != nil {
+		return ""
+	}
+	s, _ := v.(string)
 	return s
 }
 
+// StringToPgNumeric parses a decimal string such as "12.50". Empty or
+// malformed input gives a null numeric.
 func StringToPgNumeric(s string) pgtype.Numeric {
 	var num pgtype.Numeric
 	if s == "" {
-		num.Int = big.NewInt(0)
-		num.Valid = false
 		return num
 	}
-	// parse string to int64
-	i, err := strconv.ParseInt(s, 10, 64)
-	if err != nil {
+	if err := num.Scan(s); err != nil {
 		return pgtype.Numeric{}
 	}
-	bigInt := big.NewInt(i)
-	num.Int = bigInt
-	num.Valid = true
 	return num
 }
+
 func PGNumericToFloat64(num pgtype.Numeric) float64 {
-	var f float64
-	if num.Valid {
-		f, _ = num.Int.Float64()
+	f, err := num.Float64Value()
+	if err != nil || !f.Valid {
+		return 0
 	}
-	return f
+	return f.Float64
 }
 
 func StringPtrToString(s *string) string {
@@ -0,0 +1,60 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// BalanceCollector reminds requesters of balances coming due and flags the
+// ones past due.
+type BalanceCollector interface {
+	CollectBalances(ctx context.Context, lead time.Duration) (reminded int, overdue int, err error)
+}
+
+// BalanceReminders emails payment links for balances due within Lead and
+// flags overdue reservations for their building's admins.
+type BalanceReminders struct {
+	Payments BalanceCollector
+	Lead     time.Duration
+	Interval time.Duration
+	Logger   *slog.Logger
+}
+
+func (br *BalanceReminders) Name() string { return "BalanceReminders" }
+
+func (br *BalanceReminders) Run(ctx context.Context) {
+	interval := br.Interval
+	if interval <= 0 {
+		interval = 6 * time.Hour
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	br.run(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			br.Logger.Info("Exiting", "name", br.Name())
+			return
+		case <-ticker.C:
+			br.run(ctx)
+		}
+	}
+}
+
+func (br *BalanceReminders) run(ctx context.Context) {
+	lead := br.Lead
+	if lead <= 0 {
+		lead = 7 * 24 * time.Hour
+	}
+	reminded, overdue, err := br.Payments.CollectBalances(ctx, lead)
+	if err != nil {
+		br.Logger.Error("Balance reminders failed", "error", err)
+		return
+	}
+	if reminded > 0 || overdue > 0 {
+		br.Logger.Info("Collected balances", "reminded", reminded, "overdue", overdue)
+	}
+}
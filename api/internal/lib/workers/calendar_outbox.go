@@ -0,0 +1,52 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"api/internal/lib/calendars"
+)
+
+// CalendarOutbox runs queued calendar operations. It wakes as soon as a
+// reservation change queues work, and on every tick to pick up retries.
+type CalendarOutbox struct {
+	Outbox   *calendars.Outbox
+	Interval time.Duration
+	Logger   *slog.Logger
+}
+
+func (co *CalendarOutbox) Name() string { return "CalendarOutbox" }
+
+func (co *CalendarOutbox) Run(ctx context.Context) {
+	interval := co.Interval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	co.run(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			co.Logger.Info("Exiting", "name", co.Name())
+			return
+		case <-ticker.C:
+			co.run(ctx)
+		case <-co.Outbox.Wake():
+			co.run(ctx)
+		}
+	}
+}
+
+func (co *CalendarOutbox) run(ctx context.Context) {
+	done, err := co.Outbox.RunDue(ctx)
+	if err != nil {
+		co.Logger.Error("Calendar outbox run failed", "error", err)
+	}
+	if done > 0 {
+		co.Logger.Debug("Ran calendar operations", "count", done)
+	}
+}
@@ -0,0 +1,72 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"api/internal/lib/calendars"
+	"api/internal/models"
+	"api/internal/ports"
+)
+
+// CalendarReconcile reports facility calendars that disagree with the
+// database. It never repairs anything; admins do that through the
+// ReconcileCalendars RPC after reviewing the report.
+type CalendarReconcile struct {
+	FacilityStore ports.FacilityStore
+	Reconciler    *calendars.Reconciler
+	Interval      time.Duration
+	Logger        *slog.Logger
+}
+
+func (cr *CalendarReconcile) Name() string { return "CalendarReconcile" }
+
+func (cr *CalendarReconcile) Run(ctx context.Context) {
+	interval := cr.Interval
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			cr.Logger.Info("Exiting", "name", cr.Name())
+			return
+		case <-ticker.C:
+			if err := cr.report(ctx); err != nil {
+				cr.Logger.Error("Calendar reconciliation failed", "error", err)
+			}
+		}
+	}
+}
+
+func (cr *CalendarReconcile) report(ctx context.Context) error {
+	facilities, err := cr.FacilityStore.GetAllFacilities(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get facilities: %w", err)
+	}
+	list := make([]models.Facility, 0, len(facilities))
+	for _, facility := range facilities {
+		list = append(list, *facility)
+	}
+
+	reports, err := cr.Reconciler.ReconcileFacilities(ctx, list, false)
+	for _, rec := range reports {
+		if len(rec.Discrepancies) == 0 {
+			continue
+		}
+		cr.Logger.Warn("Facility calendar is out of sync",
+			"facility_id", rec.FacilityID,
+			"calendar_id", rec.CalendarID,
+			"missing_events", rec.Count(calendars.DiscrepancyMissingEvent),
+			"orphan_events", rec.Count(calendars.DiscrepancyOrphanEvent),
+			"series_drift", rec.Count(calendars.DiscrepancySeriesDrift),
+		)
+	}
+	return err
+}
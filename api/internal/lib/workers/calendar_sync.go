@@ -2,19 +2,21 @@ package workers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"sync"
 	"time"
 
+	"api/internal/lib/calendars"
 	"api/internal/ports"
-	"api/pkg/calendar"
-	gcal "google.golang.org/api/calendar/v3"
 )
 
+// CalendarSync keeps each building calendar in step with its facility
+// calendars. Runs are incremental; Sync falls back to a full listing when a
+// sync token is missing, expired or old.
 type CalendarSync struct {
 	FacilityStore ports.FacilityStore
-	Calendar      *calendar.Calendar
+	Sync          *calendars.BuildingSync
 	Interval      time.Duration
 	Logger        *slog.Logger
 }
@@ -66,17 +68,21 @@ func (cs *CalendarSync) syncCalendars(ctx context.Context) error {
 			continue
 		}
 
-		if err := cs.syncBuildingCalendar(ctx, building.ID, building.Name, building.GoogleCalendarID.String); err != nil {
+		res, err := cs.Sync.SyncBuilding(ctx, building.ID, calendars.SyncIncremental)
+		if err != nil && !errors.Is(err, calendars.ErrNoBuildingCalendar) {
 			cs.Logger.Error("Failed to sync building calendar",
 				"building_id", building.ID,
 				"building_name", building.Name,
 				"error", err,
 			)
 			errorCount++
-		} else {
+		} else if res != nil {
 			cs.Logger.Info("Successfully synced building calendar",
 				"building_id", building.ID,
 				"building_name", building.Name,
+				"inserted", res.Inserted,
+				"updated", res.Updated,
+				"deleted", res.Deleted,
 			)
 		}
 
@@ -94,139 +100,3 @@ func (cs *CalendarSync) syncCalendars(ctx context.Context) error {
 
 	return nil
 }
-
-func (cs *CalendarSync) syncBuildingCalendar(ctx context.Context, buildingID int64, buildingName string, buildingCalendarID string) error {
-	// Get all facilities for this building
-	buildingWithFacilities, err := cs.FacilityStore.GetByBuilding(ctx, buildingID)
-	if err != nil {
-		return fmt.Errorf("failed to get facilities for building %d: %w", buildingID, err)
-	}
-
-	// Clear existing events from building calendar
-	if err := cs.clearBuildingCalendar(ctx, buildingCalendarID); err != nil {
-		return fmt.Errorf("failed to clear building calendar: %w", err)
-	}
-
-	cs.Logger.Debug("Cleared building calendar", "building_id", buildingID, "facility_count", len(buildingWithFacilities.Facilities))
-
-	// Collect events from all facility calendars concurrently
-	type facilityEvents struct {
-		facilityName string
-		events       []*gcal.Event
-		err          error
-	}
-
-	eventsChan := make(chan facilityEvents, len(buildingWithFacilities.Facilities))
-	var wg sync.WaitGroup
-
-	for _, facility := range buildingWithFacilities.Facilities {
-		// Skip facilities without a calendar ID
-		if facility.GoogleCalendarID == "" {
-			cs.Logger.Debug("Skipping facility without calendar ID",
-				"facility_id", facility.ID,
-				"facility_name", facility.Name,
-			)
-			continue
-		}
-
-		wg.Add(1)
-		go func(facilityID int64, facilityName string, calendarID string) {
-			defer wg.Done()
-
-			events, err := cs.Calendar.ListEventsWithContext(ctx, calendarID)
-			if err != nil {
-				cs.Logger.Error("Failed to list events for facility",
-					"facility_id", facilityID,
-					"facility_name", facilityName,
-					"error", err,
-				)
-				eventsChan <- facilityEvents{facilityName: facilityName, err: err}
-				return
-			}
-
-			// Modify event summaries to include facility name
-			var facilityEvts []*gcal.Event
-			if events != nil && events.Items != nil {
-				for _, event := range events.Items {
-					// Create a copy of the event with modified summary
-					modifiedEvent := &gcal.Event{
-						Summary:     fmt.Sprintf("%s [%s]", event.Summary, facilityName),
-						Description: event.Description,
-						Location:    event.Location,
-						Start:       event.Start,
-						End:         event.End,
-					}
-					facilityEvts = append(facilityEvts, modifiedEvent)
-				}
-			}
-
-			eventsChan <- facilityEvents{facilityName: facilityName, events: facilityEvts}
-		}(facility.ID, facility.Name, facility.GoogleCalendarID)
-	}
-
-	// Close channel when all goroutines complete
-	go func() {
-		wg.Wait()
-		close(eventsChan)
-	}()
-
-	// Collect all events
-	var allEvents []*gcal.Event
-	for fe := range eventsChan {
-		if fe.err != nil {
-			// Log error but continue processing other facilities
-			continue
-		}
-		allEvents = append(allEvents, fe.events...)
-	}
-
-	cs.Logger.Debug("Collected events from facilities",
-		"building_id", buildingID,
-		"event_count", len(allEvents),
-	)
-
-	// Add all events to building calendar
-	errorCount := 0
-	for _, event := range allEvents {
-		if _, err := cs.Calendar.InsertEventWithContext(ctx, buildingCalendarID, event); err != nil {
-			cs.Logger.Error("Failed to insert event into building calendar",
-				"building_id", buildingID,
-				"event_summary", event.Summary,
-				"error", err,
-			)
-			errorCount++
-		}
-	}
-
-	if errorCount > 0 {
-		return fmt.Errorf("failed to insert %d events", errorCount)
-	}
-
-	return nil
-}
-
-func (cs *CalendarSync) clearBuildingCalendar(ctx context.Context, calendarID string) error {
-	// List all events in the building calendar
-	events, err := cs.Calendar.ListEventsWithContext(ctx, calendarID)
-	if err != nil {
-		return fmt.Errorf("failed to list events: %w", err)
-	}
-
-	if events == nil || events.Items == nil {
-		return nil
-	}
-
-	// Delete all events
-	for _, event := range events.Items {
-		if err := cs.Calendar.DeleteEventWithContext(ctx, calendarID, event.Id); err != nil {
-			cs.Logger.Warn("Failed to delete event from building calendar",
-				"calendar_id", calendarID,
-				"event_id", event.Id,
-				"error", err,
-			)
-			// Continue even if one delete fails
-		}
-	}
-
-	return nil
-}
@@ -0,0 +1,85 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"api/internal/lib/calendars"
+	"api/internal/ports"
+)
+
+// ReservationCalendarSync watches facility calendars for published events
+// that staff moved or deleted by hand, and reflects them into reservation
+// dates or flags them for review.
+type ReservationCalendarSync struct {
+	FacilityStore ports.FacilityStore
+	Sync          *calendars.ReservationSync
+	Interval      time.Duration
+	Logger        *slog.Logger
+}
+
+func (rs *ReservationCalendarSync) Name() string { return "ReservationCalendarSync" }
+
+func (rs *ReservationCalendarSync) Run(ctx context.Context) {
+	interval := rs.Interval
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			rs.Logger.Info("Exiting", "name", rs.Name())
+			return
+		case <-ticker.C:
+			if err := rs.syncFacilities(ctx); err != nil {
+				rs.Logger.Error("Reservation calendar sync failed", "error", err)
+			}
+		}
+	}
+}
+
+func (rs *ReservationCalendarSync) syncFacilities(ctx context.Context) error {
+	facilities, err := rs.FacilityStore.GetAllFacilities(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get facilities: %w", err)
+	}
+
+	errorCount := 0
+	for _, facility := range facilities {
+		if facility.GoogleCalendarID == "" {
+			continue
+		}
+		res, err := rs.Sync.SyncFacility(ctx, *facility)
+		if err != nil {
+			rs.Logger.Error("Failed to check facility calendar",
+				"facility_id", facility.ID,
+				"facility_name", facility.Name,
+				"error", err,
+			)
+			errorCount++
+		} else if res.Applied > 0 || res.Review > 0 {
+			rs.Logger.Info("Found calendar edits to reservations",
+				"facility_id", facility.ID,
+				"applied", res.Applied,
+				"review", res.Review,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+
+	if errorCount > 0 {
+		return fmt.Errorf("sync completed with %d facility errors", errorCount)
+	}
+	return nil
+}
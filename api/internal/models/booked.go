@@ -0,0 +1,12 @@
+package models
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// BookedDate is a pending or approved reservation date holding a facility.
+type BookedDate struct {
+	ReservationDateID int64            `db:"reservation_date_id" json:"reservation_date_id"`
+	ReservationID     int64            `db:"reservation_id" json:"reservation_id"`
+	EventName         string           `db:"event_name" json:"event_name"`
+	LocalStart        pgtype.Timestamp `db:"local_start" json:"local_start"`
+	LocalEnd          pgtype.Timestamp `db:"local_end" json:"local_end"`
+}
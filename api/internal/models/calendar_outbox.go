@@ -0,0 +1,148 @@
+package models
+
+import (
+	"api/pkg/calendar"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type CalendarOpKind string
+
+const (
+	CalendarOpKindPublish CalendarOpKind = "publish"
+	CalendarOpKindPatch   CalendarOpKind = "patch"
+	CalendarOpKindDelete  CalendarOpKind = "delete"
+	CalendarOpKindExdates CalendarOpKind = "exdates"
+)
+
+func (e CalendarOpKind) String() string {
+	return string(e)
+}
+
+func (e *CalendarOpKind) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CalendarOpKind(s)
+	case string:
+		*e = CalendarOpKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CalendarOpKind: %T", src)
+	}
+	return nil
+}
+
+func (e CalendarOpKind) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+type CalendarOpStatus string
+
+const (
+	CalendarOpStatusPending CalendarOpStatus = "pending"
+	CalendarOpStatusDone    CalendarOpStatus = "done"
+	CalendarOpStatusFailed  CalendarOpStatus = "failed"
+)
+
+func (e CalendarOpStatus) String() string {
+	return string(e)
+}
+
+func (e *CalendarOpStatus) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CalendarOpStatus(s)
+	case string:
+		*e = CalendarOpStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CalendarOpStatus: %T", src)
+	}
+	return nil
+}
+
+func (e CalendarOpStatus) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllCalendarOpStatusValues() []CalendarOpStatus {
+	return []CalendarOpStatus{
+		CalendarOpStatusPending,
+		CalendarOpStatusDone,
+		CalendarOpStatusFailed,
+	}
+}
+
+// CalendarOpPayload is what an operation needs to run. Which fields are set
+// depends on the kind.
+type CalendarOpPayload struct {
+	// publish
+	Plan    *calendar.PublishPlan    `json:"plan,omitempty"`
+	Options *calendar.PublishOptions `json:"options,omitempty"`
+	// DateIDs are the dates that take the published event ids.
+	DateIDs []int64 `json:"date_ids,omitempty"`
+	// patch
+	Event *calendar.Event `json:"event,omitempty"`
+	// delete and exdates
+	EventID string      `json:"event_id,omitempty"`
+	RRule   string      `json:"rrule,omitempty"`
+	Exdates []time.Time `json:"exdates,omitempty"`
+}
+
+func (p *CalendarOpPayload) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		return json.Unmarshal(s, p)
+	case string:
+		return json.Unmarshal([]byte(s), p)
+	default:
+		return fmt.Errorf("unsupported scan type for CalendarOpPayload: %T", src)
+	}
+}
+
+func (p CalendarOpPayload) Value() (driver.Value, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// CalendarOp is a queued calendar operation. Operations of one reservation
+// run in order; RunAt is pushed back after each failed attempt.
+type CalendarOp struct {
+	ID            int64             `db:"id" json:"id"`
+	ReservationID sql.NullInt64     `db:"reservation_id" json:"reservation_id"`
+	Kind          CalendarOpKind    `db:"kind" json:"kind"`
+	Status        CalendarOpStatus  `db:"status" json:"status"`
+	CalendarID    string            `db:"calendar_id" json:"calendar_id"`
+	Payload       CalendarOpPayload `db:"payload" json:"payload"`
+	Attempts      int32             `db:"attempts" json:"attempts"`
+	LastError     sql.NullString    `db:"last_error" json:"last_error"`
+	RunAt         time.Time         `db:"run_at" json:"run_at"`
+	CreatedAt     sql.NullTime      `db:"created_at" json:"created_at"`
+	DoneAt        sql.NullTime      `db:"done_at" json:"done_at"`
+}
+
+// CalendarOpResult is what a finished publish hands back to the database.
+type CalendarOpResult struct {
+	// MasterEventID is set on the reservation and every date in DateIDs.
+	MasterEventID string
+	// DateEventIDs maps each published date to its own event.
+	DateEventIDs map[int64]string
+}
+
+// StatusChange is everything a reservation status change writes. It is
+// saved in one transaction together with the calendar operations it needs,
+// so a calendar outage never leaves the two half-updated.
+type StatusChange struct {
+	ReservationID int64
+	// Reservation is updated when set.
+	Reservation   *Reservation
+	Dates         []ReservationDate
+	DeleteDateIDs []int64
+	// Exdates replaces the reservation's excluded starts when set.
+	Exdates *[]sql.NullTime
+	Ops     []CalendarOp
+}
@@ -0,0 +1,197 @@
+package models
+
+import (
+	"api/internal/lib/utils"
+	pbReservation "api/internal/proto/reservation"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// CalendarSyncState is where the sync of one facility calendar onto its
+// building calendar left off.
+type CalendarSyncState struct {
+	BuildingID       int64          `db:"building_id" json:"building_id"`
+	SourceCalendarID string         `db:"source_calendar_id" json:"source_calendar_id"`
+	TargetCalendarID string         `db:"target_calendar_id" json:"target_calendar_id"`
+	SyncToken        sql.NullString `db:"sync_token" json:"sync_token"`
+	FullSyncedAt     sql.NullTime   `db:"full_synced_at" json:"full_synced_at"`
+	SyncedAt         sql.NullTime   `db:"synced_at" json:"synced_at"`
+}
+
+// CalendarSyncEvent maps a facility event to its copy on the building
+// calendar. Fingerprint hashes the copied fields so unchanged events are
+// left alone. EndsAt lets copies of past events outlive the listing window.
+type CalendarSyncEvent struct {
+	ID               int64        `db:"id" json:"id"`
+	BuildingID       int64        `db:"building_id" json:"building_id"`
+	SourceCalendarID string       `db:"source_calendar_id" json:"source_calendar_id"`
+	SourceEventID    string       `db:"source_event_id" json:"source_event_id"`
+	TargetEventID    string       `db:"target_event_id" json:"target_event_id"`
+	Fingerprint      string       `db:"fingerprint" json:"fingerprint"`
+	EndsAt           time.Time    `db:"ends_at" json:"ends_at"`
+	UpdatedAt        sql.NullTime `db:"updated_at" json:"updated_at"`
+}
+
+type CalendarChangeKind string
+
+const (
+	CalendarChangeKindMoved   CalendarChangeKind = "moved"
+	CalendarChangeKindDeleted CalendarChangeKind = "deleted"
+)
+
+func (e CalendarChangeKind) String() string {
+	return string(e)
+}
+
+func (e *CalendarChangeKind) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CalendarChangeKind(s)
+	case string:
+		*e = CalendarChangeKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CalendarChangeKind: %T", src)
+	}
+	return nil
+}
+
+func (e CalendarChangeKind) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+type CalendarChangeStatus string
+
+const (
+	CalendarChangeStatusReview   CalendarChangeStatus = "review"
+	CalendarChangeStatusApplied  CalendarChangeStatus = "applied"
+	CalendarChangeStatusReverted CalendarChangeStatus = "reverted"
+)
+
+func (e CalendarChangeStatus) String() string {
+	return string(e)
+}
+
+func (e *CalendarChangeStatus) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CalendarChangeStatus(s)
+	case string:
+		*e = CalendarChangeStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CalendarChangeStatus: %T", src)
+	}
+	return nil
+}
+
+func (e CalendarChangeStatus) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllCalendarChangeStatusValues() []CalendarChangeStatus {
+	return []CalendarChangeStatus{
+		CalendarChangeStatusReview,
+		CalendarChangeStatusApplied,
+		CalendarChangeStatusReverted,
+	}
+}
+
+// CalendarChange is an edit made on a facility calendar to a published
+// event, joined with the booking it belongs to. New times are empty for
+// deletions.
+type CalendarChange struct {
+	ID                int64                `db:"id" json:"id"`
+	ReservationDateID int64                `db:"reservation_date_id" json:"reservation_date_id"`
+	Kind              CalendarChangeKind   `db:"kind" json:"kind"`
+	Status            CalendarChangeStatus `db:"status" json:"status"`
+	EventID           string               `db:"event_id" json:"event_id"`
+	OldStart          pgtype.Timestamp     `db:"old_start" json:"old_start"`
+	OldEnd            pgtype.Timestamp     `db:"old_end" json:"old_end"`
+	NewStart          pgtype.Timestamp     `db:"new_start" json:"new_start"`
+	NewEnd            pgtype.Timestamp     `db:"new_end" json:"new_end"`
+	Note              sql.NullString       `db:"note" json:"note"`
+	DetectedAt        pgtype.Timestamptz   `db:"detected_at" json:"detected_at"`
+	ResolvedAt        pgtype.Timestamptz   `db:"resolved_at" json:"resolved_at"`
+	ResolvedBy        sql.NullString       `db:"resolved_by" json:"resolved_by"`
+	ReservationID     int64                `db:"reservation_id" json:"reservation_id"`
+	EventName         string               `db:"event_name" json:"event_name"`
+	FacilityID        int64                `db:"facility_id" json:"facility_id"`
+	FacilityName      string               `db:"facility_name" json:"facility_name"`
+	BuildingID        int64                `db:"building_id" json:"building_id"`
+	BuildingName      string               `db:"building_name" json:"building_name"`
+}
+
+func (c *CalendarChange) ToProto() *pbReservation.CalendarChange {
+	return &pbReservation.CalendarChange{
+		Id:                c.ID,
+		ReservationDateId: c.ReservationDateID,
+		ReservationId:     c.ReservationID,
+		EventName:         c.EventName,
+		FacilityId:        c.FacilityID,
+		FacilityName:      c.FacilityName,
+		BuildingId:        c.BuildingID,
+		BuildingName:      c.BuildingName,
+		Kind:              c.Kind.String(),
+		Status:            c.Status.String(),
+		EventId:           c.EventID,
+		OldStart:          utils.PgTimestampToString(c.OldStart),
+		OldEnd:            utils.PgTimestampToString(c.OldEnd),
+		NewStart:          utils.PgTimestampToString(c.NewStart),
+		NewEnd:            utils.PgTimestampToString(c.NewEnd),
+		Note:              c.Note.String,
+		DetectedAt:        utils.PgTimestamptzToString(c.DetectedAt),
+		ResolvedAt:        utils.PgTimestamptzToString(c.ResolvedAt),
+		ResolvedBy:        c.ResolvedBy.String,
+	}
+}
+
+// PublishedDate is an approved reservation date with an event on its
+// facility calendar. SeriesID is set when the date is an occurrence of the
+// reservation's published series.
+type PublishedDate struct {
+	ReservationDateID int64            `db:"reservation_date_id" json:"reservation_date_id"`
+	ReservationID     int64            `db:"reservation_id" json:"reservation_id"`
+	EventName         string           `db:"event_name" json:"event_name"`
+	EventID           string           `db:"event_id" json:"event_id"`
+	SeriesID          sql.NullString   `db:"series_id" json:"series_id"`
+	LocalStart        pgtype.Timestamp `db:"local_start" json:"local_start"`
+	LocalEnd          pgtype.Timestamp `db:"local_end" json:"local_end"`
+}
+
+// EventReservation is the reservation behind a published event, with what
+// it allows the public to see.
+type EventReservation struct {
+	EventID     string          `db:"event_id" json:"event_id"`
+	EventName   string          `db:"event_name" json:"event_name"`
+	Visibility  EventVisibility `db:"visibility" json:"visibility"`
+	PublicTitle sql.NullString  `db:"public_title" json:"public_title"`
+}
+
+// CalendarDate is a reservation date of any status on a calendar. SeriesID
+// is the reservation's published series, if it has one.
+type CalendarDate struct {
+	ReservationDateID int64                   `db:"reservation_date_id" json:"reservation_date_id"`
+	ReservationID     int64                   `db:"reservation_id" json:"reservation_id"`
+	FacilityID        int64                   `db:"facility_id" json:"facility_id"`
+	EventName         string                  `db:"event_name" json:"event_name"`
+	ReservationStatus ReservationApproved     `db:"reservation_status" json:"reservation_status"`
+	DateStatus        ReservationDateApproved `db:"date_status" json:"date_status"`
+	EventID           sql.NullString          `db:"event_id" json:"event_id"`
+	SeriesID          sql.NullString          `db:"series_id" json:"series_id"`
+	LocalStart        pgtype.Timestamp        `db:"local_start" json:"local_start"`
+	LocalEnd          pgtype.Timestamp        `db:"local_end" json:"local_end"`
+}
+
+// Booked reports whether the date should have an event on the calendar.
+func (d CalendarDate) Booked() bool {
+	return d.ReservationStatus == ReservationApprovedApproved && d.DateStatus == ReservationDateApprovedApproved
+}
+
+// Held reports whether the date is a request awaiting approval, which may
+// have a tentative hold on the calendar.
+func (d CalendarDate) Held() bool {
+	return d.ReservationStatus == ReservationApprovedPending && d.DateStatus == ReservationDateApprovedPending
+}
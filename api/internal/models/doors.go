@@ -0,0 +1,21 @@
+package models
+
+import (
+	"database/sql"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DoorAccessDate is an approved reservation date that needs the building unlocked.
+type DoorAccessDate struct {
+	ReservationDateID int64            `db:"reservation_date_id" json:"reservation_date_id"`
+	ReservationID     int64            `db:"reservation_id" json:"reservation_id"`
+	EventName         string           `db:"event_name" json:"event_name"`
+	DoorsDetails      sql.NullString   `db:"doors_details" json:"doors_details"`
+	FacilityID        int64            `db:"facility_id" json:"facility_id"`
+	FacilityName      string           `db:"facility_name" json:"facility_name"`
+	BuildingID        int64            `db:"building_id" json:"building_id"`
+	BuildingName      string           `db:"building_name" json:"building_name"`
+	LocalStart        pgtype.Timestamp `db:"local_start" json:"local_start"`
+	LocalEnd          pgtype.Timestamp `db:"local_end" json:"local_end"`
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"api/internal/lib/utils"
+	pbFacilities "api/internal/proto/facilities"
+	pbReservation "api/internal/proto/reservation"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrEquipmentUnavailable is returned when a booking asks for more units than are free.
+var ErrEquipmentUnavailable = errors.New("equipment unavailable")
+
+type EquipmentFeeUnit string
+
+const (
+	EquipmentFeeUnitUnit EquipmentFeeUnit = "unit"
+	EquipmentFeeUnitHour EquipmentFeeUnit = "hour"
+)
+
+func (e EquipmentFeeUnit) String() string {
+	return string(e)
+}
+
+func (e *EquipmentFeeUnit) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EquipmentFeeUnit(s)
+	case string:
+		*e = EquipmentFeeUnit(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EquipmentFeeUnit: %T", src)
+	}
+	return nil
+}
+
+func (e EquipmentFeeUnit) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllEquipmentFeeUnitValues() []EquipmentFeeUnit {
+	return []EquipmentFeeUnit{
+		EquipmentFeeUnitUnit,
+		EquipmentFeeUnitHour,
+	}
+}
+
+type Equipment struct {
+	ID          int64              `db:"id" json:"id"`
+	BuildingID  int64              `db:"building_id" json:"building_id"`
+	Name        string             `db:"name" json:"name"`
+	Description sql.NullString     `db:"description" json:"description"`
+	Quantity    int32              `db:"quantity" json:"quantity"`
+	Fee         pgtype.Numeric     `db:"fee" json:"fee"`
+	FeeUnit     EquipmentFeeUnit   `db:"fee_unit" json:"fee_unit"`
+	Active      bool               `db:"active" json:"active"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+// Charge is the fee for quantity units over the given billable hours.
+func (e *Equipment) Charge(quantity int32, hours int64) float64 {
+	fee := utils.PGNumericToFloat64(e.Fee) * float64(quantity)
+	if e.FeeUnit == EquipmentFeeUnitHour {
+		fee *= float64(hours)
+	}
+	return fee
+}
+
+func (e *Equipment) ToProto() *pbFacilities.Equipment {
+	return &pbFacilities.Equipment{
+		Id:          e.ID,
+		BuildingId:  e.BuildingID,
+		Name:        e.Name,
+		Description: e.Description.String,
+		Quantity:    e.Quantity,
+		Fee:         utils.PgNumericToString(e.Fee),
+		FeeUnit:     e.FeeUnit.String(),
+		Active:      e.Active,
+	}
+}
+
+func EquipmentToProto(equipment []Equipment) []*pbFacilities.Equipment {
+	protoEquipment := make([]*pbFacilities.Equipment, len(equipment))
+	for i := range equipment {
+		protoEquipment[i] = equipment[i].ToProto()
+	}
+	return protoEquipment
+}
+
+func ToEquipment(e *pbFacilities.Equipment) *Equipment {
+	feeUnit := EquipmentFeeUnit(e.FeeUnit)
+	if feeUnit == "" {
+		feeUnit = EquipmentFeeUnitUnit
+	}
+	return &Equipment{
+		ID:          e.Id,
+		BuildingID:  e.BuildingId,
+		Name:        e.Name,
+		Description: CheckNullString(e.Description),
+		Quantity:    e.Quantity,
+		Fee:         utils.StringToPgNumeric(e.Fee),
+		FeeUnit:     feeUnit,
+		Active:      e.Active,
+	}
+}
+
+// ReservationEquipment is an add-on booked with a reservation. Fee is only
+// used when saving and holds the charge for the linked reservation_fees row.
+type ReservationEquipment struct {
+	ID            int64           `db:"id" json:"id"`
+	ReservationID int64           `db:"reservation_id" json:"reservation_id"`
+	EquipmentID   int64           `db:"equipment_id" json:"equipment_id"`
+	Quantity      int32           `db:"quantity" json:"quantity"`
+	FeeID         sql.NullInt64   `db:"fee_id" json:"fee_id"`
+	Name          string          `db:"equipment_name" json:"equipment_name"`
+	Fee           *ReservationFee `db:"-" json:"-"`
+}
+
+func (r *ReservationEquipment) ToProto() *pbReservation.ReservationEquipment {
+	return &pbReservation.ReservationEquipment{
+		Id:            r.ID,
+		ReservationId: r.ReservationID,
+		EquipmentId:   r.EquipmentID,
+		Name:          r.Name,
+		Quantity:      r.Quantity,
+		FeeId:         r.FeeID.Int64,
+	}
+}
+
+func ReservationEquipmentToProto(items []ReservationEquipment) []*pbReservation.ReservationEquipment {
+	protoItems := make([]*pbReservation.ReservationEquipment, len(items))
+	for i := range items {
+		protoItems[i] = items[i].ToProto()
+	}
+	return protoItems
+}
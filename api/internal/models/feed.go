@@ -0,0 +1,38 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// FeedDate is a reservation date published on an iCalendar feed.
+type FeedDate struct {
+	ReservationDateID int64            `db:"reservation_date_id" json:"reservation_date_id"`
+	ReservationID     int64            `db:"reservation_id" json:"reservation_id"`
+	Confirmed         bool             `db:"confirmed" json:"confirmed"`
+	UserID            string           `db:"user_id" json:"user_id"`
+	EventName         string           `db:"event_name" json:"event_name"`
+	Details           sql.NullString   `db:"details" json:"details"`
+	Name              string           `db:"name" json:"name"`
+	RRule             sql.NullString   `db:"rrule" json:"rrule"`
+	Visibility        EventVisibility  `db:"visibility" json:"visibility"`
+	PublicTitle       sql.NullString   `db:"public_title" json:"public_title"`
+	FacilityID        int64            `db:"facility_id" json:"facility_id"`
+	FacilityName      string           `db:"facility_name" json:"facility_name"`
+	BuildingID        int64            `db:"building_id" json:"building_id"`
+	BuildingName      string           `db:"building_name" json:"building_name"`
+	LocalStart        pgtype.Timestamp `db:"local_start" json:"local_start"`
+	LocalEnd          pgtype.Timestamp `db:"local_end" json:"local_end"`
+}
+
+// FeedFilter selects the dates for one feed. Zero fields are ignored.
+// Pending dates are only included when IncludePending is set.
+type FeedFilter struct {
+	FacilityID     int64
+	BuildingID     int64
+	UserID         string
+	From           time.Time
+	IncludePending bool
+}
@@ -0,0 +1,235 @@
+package models
+
+import (
+	pbFacilities "api/internal/proto/facilities"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type FormFieldType string
+
+const (
+	FormFieldTypeText     FormFieldType = "text"
+	FormFieldTypeNumber   FormFieldType = "number"
+	FormFieldTypeSelect   FormFieldType = "select"
+	FormFieldTypeCheckbox FormFieldType = "checkbox"
+	FormFieldTypeFile     FormFieldType = "file"
+)
+
+func (e FormFieldType) String() string {
+	return string(e)
+}
+
+func (e *FormFieldType) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FormFieldType(s)
+	case string:
+		*e = FormFieldType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FormFieldType: %T", src)
+	}
+	return nil
+}
+
+func (e FormFieldType) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllFormFieldTypeValues() []FormFieldType {
+	return []FormFieldType{
+		FormFieldTypeText,
+		FormFieldTypeNumber,
+		FormFieldTypeSelect,
+		FormFieldTypeCheckbox,
+		FormFieldTypeFile,
+	}
+}
+
+// JSONMap is a JSONB object column.
+type JSONMap map[string]any
+
+func (m *JSONMap) Scan(src any) error {
+	var b []byte
+	switch s := src.(type) {
+	case nil:
+		*m = JSONMap{}
+		return nil
+	case []byte:
+		b = s
+	case string:
+		b = []byte(s)
+	default:
+		return fmt.Errorf("unsupported scan type for JSONMap: %T", src)
+	}
+	out := JSONMap{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return err
+	}
+	*m = out
+	return nil
+}
+
+func (m JSONMap) Value() (driver.Value, error) {
+	if m == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// String returns the map encoded as JSON, "{}" when empty.
+func (m JSONMap) String() string {
+	v, err := m.Value()
+	if err != nil {
+		return "{}"
+	}
+	return v.(string)
+}
+
+// ParseJSONMap decodes a JSON object string, treating "" as empty.
+func ParseJSONMap(s string) (JSONMap, error) {
+	m := JSONMap{}
+	if s == "" {
+		return m, nil
+	}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StringList is a JSONB array of strings.
+type StringList []string
+
+func (l *StringList) Scan(src any) error {
+	var b []byte
+	switch s := src.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		b = s
+	case string:
+		b = []byte(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StringList: %T", src)
+	}
+	return json.Unmarshal(b, (*[]string)(l))
+}
+
+func (l StringList) Value() (driver.Value, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal([]string(l))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// FormCondition enables a field only when another field's answer equals Equals.
+type FormCondition struct {
+	Field  string `json:"field"`
+	Equals string `json:"equals"`
+}
+
+func (c *FormCondition) Scan(src any) error {
+	var b []byte
+	switch s := src.(type) {
+	case nil:
+		*c = FormCondition{}
+		return nil
+	case []byte:
+		b = s
+	case string:
+		b = []byte(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FormCondition: %T", src)
+	}
+	return json.Unmarshal(b, c)
+}
+
+func (c FormCondition) Value() (driver.Value, error) {
+	if c.Field == "" {
+		return nil, nil
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+type FormField struct {
+	ID         int64              `db:"id" json:"id"`
+	FacilityID sql.NullInt64      `db:"facility_id" json:"facility_id"`
+	CategoryID sql.NullInt64      `db:"category_id" json:"category_id"`
+	Key        string             `db:"field_key" json:"field_key"`
+	Label      string             `db:"label" json:"label"`
+	Type       FormFieldType      `db:"field_type" json:"field_type"`
+	Required   bool               `db:"required" json:"required"`
+	Options    StringList         `db:"options" json:"options"`
+	Condition  FormCondition      `db:"condition" json:"condition"`
+	HelpText   sql.NullString     `db:"help_text" json:"help_text"`
+	SortOrder  int32              `db:"sort_order" json:"sort_order"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+func (f *FormField) ToProto() *pbFacilities.FormField {
+	var condition *pbFacilities.FormFieldCondition
+	if f.Condition.Field != "" {
+		condition = &pbFacilities.FormFieldCondition{
+			Field:  f.Condition.Field,
+			Equals: f.Condition.Equals,
+		}
+	}
+	return &pbFacilities.FormField{
+		Id:         f.ID,
+		FacilityId: f.FacilityID.Int64,
+		CategoryId: f.CategoryID.Int64,
+		Key:        f.Key,
+		Label:      f.Label,
+		Type:       f.Type.String(),
+		Required:   f.Required,
+		Options:    f.Options,
+		Condition:  condition,
+		HelpText:   f.HelpText.String,
+		SortOrder:  f.SortOrder,
+	}
+}
+
+func FormFieldsToProto(fields []FormField) []*pbFacilities.FormField {
+	protoFields := make([]*pbFacilities.FormField, len(fields))
+	for i := range fields {
+		protoFields[i] = fields[i].ToProto()
+	}
+	return protoFields
+}
+
+func ToFormField(f *pbFacilities.FormField) *FormField {
+	field := &FormField{
+		ID:         f.Id,
+		FacilityID: sql.NullInt64{Int64: f.FacilityId, Valid: f.FacilityId != 0},
+		CategoryID: sql.NullInt64{Int64: f.CategoryId, Valid: f.CategoryId != 0},
+		Key:        f.Key,
+		Label:      f.Label,
+		Type:       FormFieldType(f.Type),
+		Required:   f.Required,
+		Options:    f.Options,
+		HelpText:   CheckNullString(f.HelpText),
+		SortOrder:  f.SortOrder,
+	}
+	if f.Condition != nil {
+		field.Condition = FormCondition{Field: f.Condition.Field, Equals: f.Condition.Equals}
+	}
+	return field
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	pbPayments "api/internal/proto/payments"
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+type InvoiceKind string
+
+const (
+	InvoiceKindInvoice InvoiceKind = "invoice"
+	InvoiceKindReceipt InvoiceKind = "receipt"
+)
+
+func (e *InvoiceKind) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InvoiceKind(s)
+	case string:
+		*e = InvoiceKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InvoiceKind: %T", src)
+	}
+	return nil
+}
+
+func (e InvoiceKind) String() string {
+	return string(e)
+}
+
+func (e InvoiceKind) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllInvoiceKindValues() []InvoiceKind {
+	return []InvoiceKind{
+		InvoiceKindInvoice,
+		InvoiceKindReceipt,
+	}
+}
+
+// Invoice is a numbered invoice or receipt issued for a reservation.
+type Invoice struct {
+	ID            int64       `db:"id" json:"id"`
+	ReservationID int64       `db:"reservation_id" json:"reservation_id"`
+	Kind          InvoiceKind `db:"kind" json:"kind"`
+	Number        int64       `db:"number" json:"number"`
+	TotalCents    int64       `db:"total_cents" json:"total_cents"`
+	PaidCents     int64       `db:"paid_cents" json:"paid_cents"`
+	FilePath      string      `db:"file_path" json:"file_path"`
+	CreatedAt     time.Time   `db:"created_at" json:"created_at"`
+}
+
+// DocumentNumber is the number printed on the document, such as
+// INV-000042 or RCT-000007.
+func (i *Invoice) DocumentNumber() string {
+	prefix := "INV"
+	if i.Kind == InvoiceKindReceipt {
+		prefix = "RCT"
+	}
+	return fmt.Sprintf("%s-%06d", prefix, i.Number)
+}
+
+func (i *Invoice) ToProto(url string) *pbPayments.Invoice {
+	return &pbPayments.Invoice{
+		Id:            i.ID,
+		ReservationId: i.ReservationID,
+		Kind:          i.Kind.String(),
+		Number:        i.DocumentNumber(),
+		Total:         CentsToString(i.TotalCents),
+		Paid:          CentsToString(i.PaidCents),
+		Balance:       CentsToString(i.TotalCents - i.PaidCents),
+		Url:           url,
+		CreatedAt:     i.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// InvoiceLine is one priced line of an invoice.
+type InvoiceLine struct {
+	ID          int64  `db:"id" json:"id"`
+	InvoiceID   int64  `db:"invoice_id" json:"invoice_id"`
+	Position    int32  `db:"position" json:"position"`
+	Description string `db:"description" json:"description"`
+	Quantity    int64  `db:"quantity" json:"quantity"`
+	UnitCents   int64  `db:"unit_cents" json:"unit_cents"`
+	AmountCents int64  `db:"amount_cents" json:"amount_cents"`
+}
@@ -72,6 +72,53 @@ func AllReservationApprovedValues() []ReservationApproved {
 	}
 }
 
+// EventVisibility is how much of a reservation published calendars show.
+type EventVisibility string
+
+const (
+	EventVisibilityPublic      EventVisibility = "public"
+	EventVisibilityHideDetails EventVisibility = "hide_details"
+	EventVisibilityPrivate     EventVisibility = "private"
+)
+
+func (e *EventVisibility) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EventVisibility(s)
+	case string:
+		*e = EventVisibility(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EventVisibility: %T", src)
+	}
+	return nil
+}
+
+func (e EventVisibility) String() string {
+	return string(e)
+}
+
+func (e EventVisibility) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllEventVisibilityValues() []EventVisibility {
+	return []EventVisibility{
+		EventVisibilityPublic,
+		EventVisibilityHideDetails,
+		EventVisibilityPrivate,
+	}
+}
+
+// Valid reports whether e is a known visibility.
+func (e EventVisibility) Valid() bool {
+	for _, v := range AllEventVisibilityValues() {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
 type ReservationDateApproved string
 
 const (
@@ -192,13 +239,24 @@ type Category struct {
 	Description string `db:"description" json:"description"`
 	// Price       float64 `db:"price" json:"price"`
 	// FacilityID  int64   `db:"facility_id" json:"facility_id"`
+	DepositType DepositType `db:"deposit_type" json:"deposit_type"`
+	// DepositAmount is a percentage or dollars, depending on DepositType.
+	DepositAmount  pgtype.Numeric `db:"deposit_amount" json:"deposit_amount"`
+	BalanceDueDays int32          `db:"balance_due_days" json:"balance_due_days"`
 }
 
 func ToCategory(category *pbFacilities.Category) Category {
+	depositType := DepositType(category.DepositType)
+	if depositType == "" {
+		depositType = DepositTypeNone
+	}
 	return Category{
-		ID:          category.Id,
-		Name:        category.Name,
-		Description: category.Description,
+		ID:             category.Id,
+		Name:           category.Name,
+		Description:    category.Description,
+		DepositType:    depositType,
+		DepositAmount:  utils.StringToPgNumeric(category.DepositAmount),
+		BalanceDueDays: category.BalanceDueDays,
 	}
 }
 
@@ -212,9 +270,12 @@ func ToCategories(categories []*pbFacilities.Category) []Category {
 
 func (c *Category) ToProto() *pbFacilities.Category {
 	return &pbFacilities.Category{
-		Id:          c.ID,
-		Name:        c.Name,
-		Description: c.Description,
+		Id:             c.ID,
+		Name:           c.Name,
+		Description:    c.Description,
+		DepositType:    c.DepositType.String(),
+		DepositAmount:  utils.PgNumericToString(c.DepositAmount),
+		BalanceDueDays: c.BalanceDueDays,
 	}
 }
 
@@ -262,6 +323,7 @@ type Building struct {
 	GoogleCalendarID sql.NullString  `db:"google_calendar_id" json:"google_calendar_id"`
 	Latitude         sql.NullFloat64 `db:"latitude" json:"latitude"`
 	Longitude        sql.NullFloat64 `db:"longitude" json:"longitude"`
+	CalendarProvider sql.NullString  `db:"calendar_provider" json:"calendar_provider"`
 }
 
 func (b *Building) ToProto() *pbFacilities.Building {
@@ -273,6 +335,7 @@ func (b *Building) ToProto() *pbFacilities.Building {
 		GoogleCalendarId: b.GoogleCalendarID.String,
 		Latitude:         b.Latitude.Float64,
 		Longitude:        b.Longitude.Float64,
+		CalendarProvider: b.CalendarProvider.String,
 	}
 }
 
@@ -552,36 +615,77 @@ func NotificationsReadableToProto(n []*NotificationReadable) []*pbUsers.Notifica
 	return protoNotifications
 }
 
+type BuildingAdmin struct {
+	ID           int64  `db:"id" json:"id"`
+	BuildingID   int64  `db:"building_id" json:"building_id"`
+	UserID       string `db:"user_id" json:"user_id"`
+	BuildingName string `db:"building_name" json:"building_name"`
+	UserName     string `db:"user_name" json:"user_name"`
+}
+
+func (b *BuildingAdmin) ToProto() *pbUsers.BuildingAdmin {
+	return &pbUsers.BuildingAdmin{
+		Id:           b.ID,
+		BuildingId:   b.BuildingID,
+		UserId:       b.UserID,
+		BuildingName: b.BuildingName,
+		UserName:     b.UserName,
+	}
+}
+func BuildingAdminsToProto(b []*BuildingAdmin) []*pbUsers.BuildingAdmin {
+	protoAdmins := make([]*pbUsers.BuildingAdmin, len(b))
+	for i, admin := range b {
+		protoAdmins[i] = admin.ToProto()
+	}
+	return protoAdmins
+}
+func ToBuildingAdmin(b *pbUsers.BuildingAdmin) *BuildingAdmin {
+	return &BuildingAdmin{
+		ID:         b.Id,
+		BuildingID: b.BuildingId,
+		UserID:     b.UserId,
+	}
+}
+
 type Reservation struct {
-	ID            int64               `db:"id" json:"id"`
-	UserID        string              `db:"user_id" json:"user_id"`
-	EventName     string              `db:"event_name" json:"event_name"`
-	FacilityID    int64               `db:"facility_id" json:"facility_id"`
-	Approved      ReservationApproved `db:"approved" json:"approved"`
-	CreatedAt     pgtype.Timestamptz  `db:"created_at" json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz  `db:"updated_at" json:"updated_at"`
-	Details       sql.NullString      `db:"details" json:"details"`
-	Fees          pgtype.Numeric      `db:"fees" json:"fees"`
-	Insurance     bool                `db:"insurance" json:"insurance"`
-	DoorAccess    bool                `db:"door_access" json:"door_access"`
-	DoorsDetails  sql.NullString      `db:"doors_details" json:"doors_details"`
-	Name          string              `db:"name" json:"name"`
-	TechDetails   sql.NullString      `db:"tech_details" json:"tech_details"`
-	TechSupport   bool                `db:"tech_support" json:"tech_support"`
-	Phone         sql.NullString      `db:"phone" json:"phone"`
-	CategoryID    int64               `db:"category_id" json:"category_id"`
-	TotalHours    sql.NullFloat64     `db:"total_hours" json:"total_hours"`
-	InPerson      bool                `db:"in_person" json:"in_person"`
-	Paid          bool                `db:"paid" json:"paid"`
-	PaymentUrl    sql.NullString      `db:"payment_url" json:"payment_url"`
-	PaymentLinkID sql.NullString      `db:"payment_link_id" json:"payment_link_id"`
-	InsuranceLink sql.NullString      `db:"insurance_link" json:"insurance_link"`
-	CostOverride  pgtype.Numeric      `db:"cost_override" json:"cost_override"`
-	RRule         sql.NullString      `db:"rrule" json:"rrule"`
-	RDates        *[]sql.NullTime     `db:"rdates" json:"rdates"`
-	EXDates       *[]sql.NullTime     `db:"exdates" json:"exdates"`
-	GCalEventID   sql.NullString      `db:"gcal_eventid" json:"gcal_eventid"`
-	PriceID       sql.NullString      `db:"price_id" json:"price_id"`
+	ID             int64               `db:"id" json:"id"`
+	UserID         string              `db:"user_id" json:"user_id"`
+	EventName      string              `db:"event_name" json:"event_name"`
+	FacilityID     int64               `db:"facility_id" json:"facility_id"`
+	Approved       ReservationApproved `db:"approved" json:"approved"`
+	CreatedAt      pgtype.Timestamptz  `db:"created_at" json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz  `db:"updated_at" json:"updated_at"`
+	Details        sql.NullString      `db:"details" json:"details"`
+	Fees           pgtype.Numeric      `db:"fees" json:"fees"`
+	Insurance      bool                `db:"insurance" json:"insurance"`
+	DoorAccess     bool                `db:"door_access" json:"door_access"`
+	DoorsDetails   sql.NullString      `db:"doors_details" json:"doors_details"`
+	Name           string              `db:"name" json:"name"`
+	TechDetails    sql.NullString      `db:"tech_details" json:"tech_details"`
+	TechSupport    bool                `db:"tech_support" json:"tech_support"`
+	Phone          sql.NullString      `db:"phone" json:"phone"`
+	CategoryID     int64               `db:"category_id" json:"category_id"`
+	TotalHours     sql.NullFloat64     `db:"total_hours" json:"total_hours"`
+	InPerson       bool                `db:"in_person" json:"in_person"`
+	Paid           bool                `db:"paid" json:"paid"`
+	PaymentUrl     sql.NullString      `db:"payment_url" json:"payment_url"`
+	PaymentLinkID  sql.NullString      `db:"payment_link_id" json:"payment_link_id"`
+	InsuranceLink  sql.NullString      `db:"insurance_link" json:"insurance_link"`
+	CostOverride   pgtype.Numeric      `db:"cost_override" json:"cost_override"`
+	RRule          sql.NullString      `db:"rrule" json:"rrule"`
+	RDates         *[]sql.NullTime     `db:"rdates" json:"rdates"`
+	EXDates        *[]sql.NullTime     `db:"exdates" json:"exdates"`
+	GCalEventID    sql.NullString      `db:"gcal_eventid" json:"gcal_eventid"`
+	PriceID        sql.NullString      `db:"price_id" json:"price_id"`
+	OrganizationID sql.NullInt64       `db:"organization_id" json:"organization_id"`
+	IntakeAnswers  JSONMap             `db:"intake_answers" json:"intake_answers"`
+	Visibility     EventVisibility     `db:"visibility" json:"visibility"`
+	PublicTitle    sql.NullString      `db:"public_title" json:"public_title"`
+	PaymentStatus  PaymentStatus       `db:"payment_status" json:"payment_status"`
+	PaymentIntent  sql.NullString      `db:"payment_intent_id" json:"payment_intent_id"`
+	// BalanceReminded is when the balance payment link was emailed.
+	BalanceReminded sql.NullTime `db:"balance_reminded_at" json:"balance_reminded_at"`
+	PaymentOverdue  bool         `db:"payment_overdue" json:"payment_overdue"`
 }
 
 func (r *Reservation) ToProto() *pbReservation.Reservation {
@@ -603,35 +707,40 @@ func (r *Reservation) ToProto() *pbReservation.Reservation {
 		}
 	}
 	return &pbReservation.Reservation{
-		Id:            r.ID,
-		UserId:        r.UserID,
-		EventName:     r.EventName,
-		FacilityId:    r.FacilityID,
-		Approved:      r.Approved.String(),
-		CreatedAt:     utils.PgTimestamptzToString(r.CreatedAt),
-		UpdatedAt:     utils.PgTimestamptzToString(r.UpdatedAt),
-		Details:       r.Details.String,
-		Fees:          utils.PgNumericToString(r.Fees),
-		Insurance:     r.Insurance,
-		DoorAccess:    r.DoorAccess,
-		DoorsDetails:  r.DoorsDetails.String,
-		Name:          r.Name,
-		TechDetails:   r.TechDetails.String,
-		TechSupport:   r.TechSupport,
-		Phone:         r.Phone.String,
-		CategoryId:    r.CategoryID,
-		TotalHours:    r.TotalHours.Float64,
-		InPerson:      r.InPerson,
-		Paid:          r.Paid,
-		PaymentUrl:    r.PaymentUrl.String,
-		PaymentLinkId: r.PaymentLinkID.String,
-		InsuranceLink: r.InsuranceLink.String,
-		CostOverride:  utils.PgNumericToString(r.CostOverride),
-		Rrule:         r.RRule.String,
-		Rdates:        rdates,
-		Exdates:       exdates,
-		GcalEventid:   r.GCalEventID.String,
-		PriceId:       r.PriceID.String,
+		Id:             r.ID,
+		UserId:         r.UserID,
+		EventName:      r.EventName,
+		FacilityId:     r.FacilityID,
+		Approved:       r.Approved.String(),
+		CreatedAt:      utils.PgTimestamptzToString(r.CreatedAt),
+		UpdatedAt:      utils.PgTimestamptzToString(r.UpdatedAt),
+		Details:        r.Details.String,
+		Fees:           utils.PgNumericToString(r.Fees),
+		Insurance:      r.Insurance,
+		DoorAccess:     r.DoorAccess,
+		DoorsDetails:   r.DoorsDetails.String,
+		Name:           r.Name,
+		TechDetails:    r.TechDetails.String,
+		TechSupport:    r.TechSupport,
+		Phone:          r.Phone.String,
+		CategoryId:     r.CategoryID,
+		TotalHours:     r.TotalHours.Float64,
+		InPerson:       r.InPerson,
+		Paid:           r.Paid,
+		PaymentUrl:     r.PaymentUrl.String,
+		PaymentLinkId:  r.PaymentLinkID.String,
+		InsuranceLink:  r.InsuranceLink.String,
+		CostOverride:   utils.PgNumericToString(r.CostOverride),
+		Rrule:          r.RRule.String,
+		Rdates:         rdates,
+		Exdates:        exdates,
+		GcalEventid:    r.GCalEventID.String,
+		PriceId:        r.PriceID.String,
+		OrganizationId: r.OrganizationID.Int64,
+		Visibility:     r.Visibility.String(),
+		PublicTitle:    r.PublicTitle.String,
+		PaymentStatus:  r.PaymentStatus.String(),
+		PaymentOverdue: r.PaymentOverdue,
 	}
 }
 
@@ -667,35 +776,38 @@ func ToReservation(reservation *pbReservation.Reservation) *Reservation {
 	rdates := StringArrayToNullDates(reservation.Rdates)
 	exdates := StringArrayToNullDates(reservation.Exdates)
 	return &Reservation{
-		ID:            reservation.Id,
-		UserID:        reservation.UserId,
-		EventName:     reservation.EventName,
-		FacilityID:    reservation.FacilityId,
-		Approved:      ReservationApproved(reservation.Approved),
-		CreatedAt:     utils.StringToPgTimestamptz(reservation.CreatedAt),
-		UpdatedAt:     utils.StringToPgTimestamptz(reservation.UpdatedAt),
-		Details:       CheckNullString(reservation.Details),
-		Fees:          utils.StringToPgNumeric(reservation.Fees),
-		Insurance:     reservation.Insurance,
-		DoorAccess:    reservation.DoorAccess,
-		DoorsDetails:  CheckNullString(reservation.DoorsDetails),
-		Name:          reservation.Name,
-		TechDetails:   CheckNullString(reservation.TechDetails),
-		TechSupport:   reservation.TechSupport,
-		Phone:         CheckNullString(reservation.Phone), //reservation.Phone,
-		CategoryID:    reservation.CategoryId,
-		TotalHours:    CheckNullFloat64(reservation.TotalHours),
-		InPerson:      reservation.InPerson,
-		Paid:          reservation.Paid,
-		PaymentUrl:    CheckNullString(reservation.PaymentUrl),
-		PaymentLinkID: CheckNullString(reservation.PaymentLinkId),
-		InsuranceLink: CheckNullString(reservation.InsuranceLink), //reservation.InsuranceLink,
-		CostOverride:  utils.StringToPgNumeric(reservation.CostOverride),
-		RRule:         CheckNullString(reservation.Rrule), //reservation.Rrule,
-		RDates:        &rdates,
-		EXDates:       &exdates,
-		GCalEventID:   CheckNullString(reservation.GcalEventid),
-		PriceID:       CheckNullString(reservation.PriceId),
+		ID:             reservation.Id,
+		UserID:         reservation.UserId,
+		EventName:      reservation.EventName,
+		FacilityID:     reservation.FacilityId,
+		Approved:       ReservationApproved(reservation.Approved),
+		CreatedAt:      utils.StringToPgTimestamptz(reservation.CreatedAt),
+		UpdatedAt:      utils.StringToPgTimestamptz(reservation.UpdatedAt),
+		Details:        CheckNullString(reservation.Details),
+		Fees:           utils.StringToPgNumeric(reservation.Fees),
+		Insurance:      reservation.Insurance,
+		DoorAccess:     reservation.DoorAccess,
+		DoorsDetails:   CheckNullString(reservation.DoorsDetails),
+		Name:           reservation.Name,
+		TechDetails:    CheckNullString(reservation.TechDetails),
+		TechSupport:    reservation.TechSupport,
+		Phone:          CheckNullString(reservation.Phone), //reservation.Phone,
+		CategoryID:     reservation.CategoryId,
+		TotalHours:     CheckNullFloat64(reservation.TotalHours),
+		InPerson:       reservation.InPerson,
+		Paid:           reservation.Paid,
+		PaymentUrl:     CheckNullString(reservation.PaymentUrl),
+		PaymentLinkID:  CheckNullString(reservation.PaymentLinkId),
+		InsuranceLink:  CheckNullString(reservation.InsuranceLink), //reservation.InsuranceLink,
+		CostOverride:   utils.StringToPgNumeric(reservation.CostOverride),
+		RRule:          CheckNullString(reservation.Rrule), //reservation.Rrule,
+		RDates:         &rdates,
+		EXDates:        &exdates,
+		GCalEventID:    CheckNullString(reservation.GcalEventid),
+		PriceID:        CheckNullString(reservation.PriceId),
+		OrganizationID: sql.NullInt64{Int64: reservation.OrganizationId, Valid: reservation.OrganizationId != 0},
+		Visibility:     EventVisibility(reservation.Visibility),
+		PublicTitle:    CheckNullString(reservation.PublicTitle),
 	}
 }
 
@@ -716,9 +828,10 @@ func (r *FullReservation) ToProto() *pbReservation.FullReservation {
 	}
 
 	return &pbReservation.FullReservation{
-		Reservation: r.Reservation.ToProto(),
-		Dates:       dates,
-		Fees:        fees,
+		Reservation:   r.Reservation.ToProto(),
+		Dates:         dates,
+		Fees:          fees,
+		IntakeAnswers: r.Reservation.IntakeAnswers.String(),
 	}
 }
 
@@ -0,0 +1,181 @@
+package models
+
+import (
+	"api/internal/lib/utils"
+	pbOrganizations "api/internal/proto/organizations"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type OrganizationRole string
+
+const (
+	OrganizationRoleOwner   OrganizationRole = "owner"
+	OrganizationRoleManager OrganizationRole = "manager"
+	OrganizationRoleMember  OrganizationRole = "member"
+)
+
+func (e OrganizationRole) String() string {
+	return string(e)
+}
+
+func (e *OrganizationRole) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationRole(s)
+	case string:
+		*e = OrganizationRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationRole: %T", src)
+	}
+	return nil
+}
+
+func (e OrganizationRole) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+// CanManage reports whether the role may edit the organization and its bookings.
+func (e OrganizationRole) CanManage() bool {
+	return e == OrganizationRoleOwner || e == OrganizationRoleManager
+}
+
+func AllOrganizationRoleValues() []OrganizationRole {
+	return []OrganizationRole{
+		OrganizationRoleOwner,
+		OrganizationRoleManager,
+		OrganizationRoleMember,
+	}
+}
+
+type Organization struct {
+	ID           int64              `db:"id" json:"id"`
+	Name         string             `db:"name" json:"name"`
+	BillingName  sql.NullString     `db:"billing_name" json:"billing_name"`
+	BillingEmail sql.NullString     `db:"billing_email" json:"billing_email"`
+	BillingPhone sql.NullString     `db:"billing_phone" json:"billing_phone"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+func (o *Organization) ToProto() *pbOrganizations.Organization {
+	return &pbOrganizations.Organization{
+		Id:           o.ID,
+		Name:         o.Name,
+		BillingName:  o.BillingName.String,
+		BillingEmail: o.BillingEmail.String,
+		BillingPhone: o.BillingPhone.String,
+		CreatedAt:    utils.PgTimestamptzToString(o.CreatedAt),
+		UpdatedAt:    utils.PgTimestamptzToString(o.UpdatedAt),
+	}
+}
+
+func ToOrganization(o *pbOrganizations.Organization) *Organization {
+	return &Organization{
+		ID:           o.Id,
+		Name:         o.Name,
+		BillingName:  CheckNullString(o.BillingName),
+		BillingEmail: CheckNullString(o.BillingEmail),
+		BillingPhone: CheckNullString(o.BillingPhone),
+	}
+}
+
+type OrganizationMember struct {
+	ID             int64            `db:"id" json:"id"`
+	OrganizationID int64            `db:"organization_id" json:"organization_id"`
+	UserID         string           `db:"user_id" json:"user_id"`
+	Role           OrganizationRole `db:"role" json:"role"`
+	UserName       string           `db:"user_name" json:"user_name"`
+	UserEmail      string           `db:"user_email" json:"user_email"`
+}
+
+func (m *OrganizationMember) ToProto() *pbOrganizations.OrganizationMember {
+	return &pbOrganizations.OrganizationMember{
+		Id:             m.ID,
+		OrganizationId: m.OrganizationID,
+		UserId:         m.UserID,
+		Role:           m.Role.String(),
+		UserName:       m.UserName,
+		UserEmail:      m.UserEmail,
+	}
+}
+
+func OrganizationMembersToProto(m []OrganizationMember) []*pbOrganizations.OrganizationMember {
+	protoMembers := make([]*pbOrganizations.OrganizationMember, len(m))
+	for i := range m {
+		protoMembers[i] = m[i].ToProto()
+	}
+	return protoMembers
+}
+
+// UserOrganization is an organization along with the caller's role in it.
+type UserOrganization struct {
+	Organization
+	Role OrganizationRole `db:"role" json:"role"`
+}
+
+func (u *UserOrganization) ToProto() *pbOrganizations.UserOrganization {
+	return &pbOrganizations.UserOrganization{
+		Organization: u.Organization.ToProto(),
+		Role:         u.Role.String(),
+	}
+}
+
+type OrganizationInsurance struct {
+	ID             int64              `db:"id" json:"id"`
+	OrganizationID int64              `db:"organization_id" json:"organization_id"`
+	FilePath       string             `db:"file_path" json:"file_path"`
+	FileName       string             `db:"file_name" json:"file_name"`
+	ExpiresAt      pgtype.Date        `db:"expires_at" json:"expires_at"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+func (i *OrganizationInsurance) ToProto() *pbOrganizations.OrganizationInsurance {
+	return &pbOrganizations.OrganizationInsurance{
+		Id:             i.ID,
+		OrganizationId: i.OrganizationID,
+		FilePath:       i.FilePath,
+		FileName:       i.FileName,
+		ExpiresAt:      utils.PgDateToString(i.ExpiresAt),
+		CreatedAt:      utils.PgTimestamptzToString(i.CreatedAt),
+	}
+}
+
+func OrganizationInsuranceToProto(files []OrganizationInsurance) []*pbOrganizations.OrganizationInsurance {
+	protoFiles := make([]*pbOrganizations.OrganizationInsurance, len(files))
+	for i := range files {
+		protoFiles[i] = files[i].ToProto()
+	}
+	return protoFiles
+}
+
+type FullOrganization struct {
+	Organization Organization
+	Members      []OrganizationMember
+	Insurance    []OrganizationInsurance
+}
+
+func (f *FullOrganization) ToProto() *pbOrganizations.FullOrganization {
+	return &pbOrganizations.FullOrganization{
+		Organization: f.Organization.ToProto(),
+		Members:      OrganizationMembersToProto(f.Members),
+		Insurance:    OrganizationInsuranceToProto(f.Insurance),
+	}
+}
+
+// CurrentInsurance returns the newest insurance document that has not expired.
+func (f *FullOrganization) CurrentInsurance(now time.Time) *OrganizationInsurance {
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	for i := range f.Insurance {
+		ins := &f.Insurance[i]
+		if !ins.ExpiresAt.Valid || !ins.ExpiresAt.Time.Before(today) {
+			return ins
+		}
+	}
+	return nil
+}
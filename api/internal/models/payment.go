@@ -0,0 +1,323 @@
+package models
+
+import (
+	pbPayments "api/internal/proto/payments"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PaymentStatus is where a reservation's payment stands with Stripe.
+type PaymentStatus string
+
+const (
+	PaymentStatusUnpaid            PaymentStatus = "unpaid"
+	PaymentStatusDepositPaid       PaymentStatus = "deposit_paid"
+	PaymentStatusPending           PaymentStatus = "pending_verification"
+	PaymentStatusPaid              PaymentStatus = "paid"
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded"
+	PaymentStatusRefunded          PaymentStatus = "refunded"
+	PaymentStatusDisputed          PaymentStatus = "disputed"
+	PaymentStatusDisputeLost       PaymentStatus = "dispute_lost"
+)
+
+func (e *PaymentStatus) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentStatus(s)
+	case string:
+		*e = PaymentStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentStatus: %T", src)
+	}
+	return nil
+}
+
+func (e PaymentStatus) String() string {
+	return string(e)
+}
+
+func (e PaymentStatus) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllPaymentStatusValues() []PaymentStatus {
+	return []PaymentStatus{
+		PaymentStatusUnpaid,
+		PaymentStatusDepositPaid,
+		PaymentStatusPending,
+		PaymentStatusPaid,
+		PaymentStatusPartiallyRefunded,
+		PaymentStatusRefunded,
+		PaymentStatusDisputed,
+		PaymentStatusDisputeLost,
+	}
+}
+
+// Paid reports whether the reservation keeps money for a payment in this
+// status.
+func (e PaymentStatus) Paid() bool {
+	return e == PaymentStatusPaid || e == PaymentStatusPartiallyRefunded
+}
+
+// StripeEvent is a webhook event and the payment change it makes. An event
+// is applied at most once, keyed by its Stripe id.
+type StripeEvent struct {
+	ID            string
+	Type          string
+	ReservationID int64
+	Status        PaymentStatus
+	// PaymentIntentID is recorded on the reservation when set.
+	PaymentIntentID string
+	// Entries are added to the ledger; one already recorded for the same
+	// provider reference is skipped.
+	Entries []PaymentEntry
+}
+
+// DepositType is how a category's deposit is figured.
+type DepositType string
+
+const (
+	DepositTypeNone    DepositType = "none"
+	DepositTypePercent DepositType = "percent"
+	DepositTypeFixed   DepositType = "fixed"
+)
+
+func (e *DepositType) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DepositType(s)
+	case string:
+		*e = DepositType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DepositType: %T", src)
+	}
+	return nil
+}
+
+func (e DepositType) String() string {
+	return string(e)
+}
+
+func (e DepositType) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllDepositTypeValues() []DepositType {
+	return []DepositType{
+		DepositTypeNone,
+		DepositTypePercent,
+		DepositTypeFixed,
+	}
+}
+
+// BalanceDue is an approved reservation with money still owed whose balance
+// comes due soon or already has.
+type BalanceDue struct {
+	ReservationID   int64            `db:"reservation_id" json:"reservation_id"`
+	BalanceDueDays  int32            `db:"balance_due_days" json:"balance_due_days"`
+	FirstStart      pgtype.Timestamp `db:"first_start" json:"first_start"`
+	BalanceReminded sql.NullTime     `db:"balance_reminded_at" json:"balance_reminded_at"`
+	PaymentOverdue  bool             `db:"payment_overdue" json:"payment_overdue"`
+}
+
+type PaymentEntryKind string
+
+const (
+	PaymentEntryKindCharge     PaymentEntryKind = "charge"
+	PaymentEntryKindRefund     PaymentEntryKind = "refund"
+	PaymentEntryKindAdjustment PaymentEntryKind = "adjustment"
+	PaymentEntryKindOffline    PaymentEntryKind = "offline"
+)
+
+func (e *PaymentEntryKind) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentEntryKind(s)
+	case string:
+		*e = PaymentEntryKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentEntryKind: %T", src)
+	}
+	return nil
+}
+
+func (e PaymentEntryKind) String() string {
+	return string(e)
+}
+
+func (e PaymentEntryKind) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllPaymentEntryKindValues() []PaymentEntryKind {
+	return []PaymentEntryKind{
+		PaymentEntryKindCharge,
+		PaymentEntryKindRefund,
+		PaymentEntryKindAdjustment,
+		PaymentEntryKindOffline,
+	}
+}
+
+const (
+	PaymentProviderStripe = "stripe"
+	PaymentProviderManual = "manual"
+)
+
+// PaymentEntry is one row of a reservation's payments ledger. AmountCents
+// is what the entry takes off the balance due, so refunds are negative.
+type PaymentEntry struct {
+	ID            int64            `db:"id" json:"id"`
+	ReservationID int64            `db:"reservation_id" json:"reservation_id"`
+	Kind          PaymentEntryKind `db:"kind" json:"kind"`
+	AmountCents   int64            `db:"amount_cents" json:"amount_cents"`
+	Currency      string           `db:"currency" json:"currency"`
+	Provider      string           `db:"provider" json:"provider"`
+	ProviderRef   sql.NullString   `db:"provider_ref" json:"provider_ref"`
+	ActorID       sql.NullString   `db:"actor_id" json:"actor_id"`
+	Note          sql.NullString   `db:"note" json:"note"`
+	CreatedAt     time.Time        `db:"created_at" json:"created_at"`
+}
+
+func (e *PaymentEntry) ToProto() *pbPayments.LedgerEntry {
+	return &pbPayments.LedgerEntry{
+		Id:            e.ID,
+		ReservationId: e.ReservationID,
+		Kind:          e.Kind.String(),
+		Amount:        CentsToString(e.AmountCents),
+		Currency:      e.Currency,
+		Provider:      e.Provider,
+		ProviderRef:   e.ProviderRef.String,
+		ActorId:       e.ActorID.String,
+		Note:          e.Note.String,
+		CreatedAt:     e.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// ManualPaymentMethod is an offline way to pay that an admin verifies.
+type ManualPaymentMethod string
+
+const (
+	ManualPaymentMethodCheck         ManualPaymentMethod = "check"
+	ManualPaymentMethodPurchaseOrder ManualPaymentMethod = "purchase_order"
+	ManualPaymentMethodAccountCode   ManualPaymentMethod = "account_code"
+)
+
+func (e *ManualPaymentMethod) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ManualPaymentMethod(s)
+	case string:
+		*e = ManualPaymentMethod(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ManualPaymentMethod: %T", src)
+	}
+	return nil
+}
+
+func (e ManualPaymentMethod) String() string {
+	return string(e)
+}
+
+func (e ManualPaymentMethod) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllManualPaymentMethodValues() []ManualPaymentMethod {
+	return []ManualPaymentMethod{
+		ManualPaymentMethodCheck,
+		ManualPaymentMethodPurchaseOrder,
+		ManualPaymentMethodAccountCode,
+	}
+}
+
+type PaymentSubmissionStatus string
+
+const (
+	PaymentSubmissionStatusPending  PaymentSubmissionStatus = "pending"
+	PaymentSubmissionStatusVerified PaymentSubmissionStatus = "verified"
+	PaymentSubmissionStatusRejected PaymentSubmissionStatus = "rejected"
+)
+
+func (e *PaymentSubmissionStatus) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentSubmissionStatus(s)
+	case string:
+		*e = PaymentSubmissionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentSubmissionStatus: %T", src)
+	}
+	return nil
+}
+
+func (e PaymentSubmissionStatus) String() string {
+	return string(e)
+}
+
+func (e PaymentSubmissionStatus) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllPaymentSubmissionStatusValues() []PaymentSubmissionStatus {
+	return []PaymentSubmissionStatus{
+		PaymentSubmissionStatusPending,
+		PaymentSubmissionStatusVerified,
+		PaymentSubmissionStatusRejected,
+	}
+}
+
+// PaymentSubmission is a check, purchase order or internal transfer recorded
+// against a reservation. It is only added to the ledger once verified.
+type PaymentSubmission struct {
+	ID            int64                   `db:"id" json:"id"`
+	ReservationID int64                   `db:"reservation_id" json:"reservation_id"`
+	Method        ManualPaymentMethod     `db:"method" json:"method"`
+	Payment       string                  `db:"payment" json:"payment"`
+	AmountCents   int64                   `db:"amount_cents" json:"amount_cents"`
+	Reference     string                  `db:"reference" json:"reference"`
+	Note          sql.NullString          `db:"note" json:"note"`
+	Status        PaymentSubmissionStatus `db:"status" json:"status"`
+	SubmittedBy   sql.NullString          `db:"submitted_by" json:"submitted_by"`
+	ReviewedBy    sql.NullString          `db:"reviewed_by" json:"reviewed_by"`
+	ReviewedAt    sql.NullTime            `db:"reviewed_at" json:"reviewed_at"`
+	ReviewNote    sql.NullString          `db:"review_note" json:"review_note"`
+	PaymentID     sql.NullInt64           `db:"payment_id" json:"payment_id"`
+	CreatedAt     time.Time               `db:"created_at" json:"created_at"`
+	// FacilityID is the reservation's, for scoping admin lists.
+	FacilityID int64 `db:"facility_id" json:"facility_id"`
+}
+
+func (s *PaymentSubmission) ToProto() *pbPayments.PaymentSubmission {
+	res := &pbPayments.PaymentSubmission{
+		Id:            s.ID,
+		ReservationId: s.ReservationID,
+		Method:        s.Method.String(),
+		Payment:       s.Payment,
+		Amount:        CentsToString(s.AmountCents),
+		Reference:     s.Reference,
+		Note:          s.Note.String,
+		Status:        s.Status.String(),
+		SubmittedBy:   s.SubmittedBy.String,
+		ReviewedBy:    s.ReviewedBy.String,
+		ReviewNote:    s.ReviewNote.String,
+		PaymentId:     s.PaymentID.Int64,
+		CreatedAt:     s.CreatedAt.Format(time.RFC3339),
+	}
+	if s.ReviewedAt.Valid {
+		res.ReviewedAt = s.ReviewedAt.Time.Format(time.RFC3339)
+	}
+	return res
+}
+
+// CentsToString formats cents as dollars, such as "-12.50".
+func CentsToString(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
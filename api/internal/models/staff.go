@@ -0,0 +1,115 @@
+package models
+
+import (
+	"api/internal/lib/utils"
+	pbStaff "api/internal/proto/staff"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type StaffRole string
+
+const (
+	StaffRoleCustodian StaffRole = "custodian"
+	StaffRoleTech      StaffRole = "tech"
+	StaffRoleSecurity  StaffRole = "security"
+)
+
+func (e StaffRole) String() string {
+	return string(e)
+}
+
+func (e *StaffRole) Scan(src any) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StaffRole(s)
+	case string:
+		*e = StaffRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StaffRole: %T", src)
+	}
+	return nil
+}
+
+func (e StaffRole) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func AllStaffRoleValues() []StaffRole {
+	return []StaffRole{
+		StaffRoleCustodian,
+		StaffRoleTech,
+		StaffRoleSecurity,
+	}
+}
+
+// StaffAssignment is a shift on one reservation date, joined with the staff
+// member and the booking it covers.
+type StaffAssignment struct {
+	ID                int64              `db:"id" json:"id"`
+	ReservationDateID int64              `db:"reservation_date_id" json:"reservation_date_id"`
+	UserID            string             `db:"user_id" json:"user_id"`
+	Role              StaffRole          `db:"role" json:"role"`
+	Notes             sql.NullString     `db:"notes" json:"notes"`
+	CreatedAt         pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UserName          string             `db:"user_name" json:"user_name"`
+	UserEmail         string             `db:"user_email" json:"user_email"`
+	ReservationID     int64              `db:"reservation_id" json:"reservation_id"`
+	EventName         string             `db:"event_name" json:"event_name"`
+	FacilityID        int64              `db:"facility_id" json:"facility_id"`
+	FacilityName      string             `db:"facility_name" json:"facility_name"`
+	BuildingID        int64              `db:"building_id" json:"building_id"`
+	BuildingName      string             `db:"building_name" json:"building_name"`
+	LocalStart        pgtype.Timestamp   `db:"local_start" json:"local_start"`
+	LocalEnd          pgtype.Timestamp   `db:"local_end" json:"local_end"`
+}
+
+func (s *StaffAssignment) ToProto() *pbStaff.StaffAssignment {
+	return &pbStaff.StaffAssignment{
+		Id:                s.ID,
+		ReservationDateId: s.ReservationDateID,
+		UserId:            s.UserID,
+		Role:              s.Role.String(),
+		Notes:             s.Notes.String,
+		UserName:          s.UserName,
+		UserEmail:         s.UserEmail,
+		ReservationId:     s.ReservationID,
+		EventName:         s.EventName,
+		FacilityId:        s.FacilityID,
+		FacilityName:      s.FacilityName,
+		BuildingId:        s.BuildingID,
+		BuildingName:      s.BuildingName,
+		LocalStart:        utils.PgTimestampToString(s.LocalStart),
+		LocalEnd:          utils.PgTimestampToString(s.LocalEnd),
+		CreatedAt:         utils.PgTimestamptzToString(s.CreatedAt),
+	}
+}
+
+func StaffAssignmentsToProto(assignments []StaffAssignment) []*pbStaff.StaffAssignment {
+	protoAssignments := make([]*pbStaff.StaffAssignment, len(assignments))
+	for i := range assignments {
+		protoAssignments[i] = assignments[i].ToProto()
+	}
+	return protoAssignments
+}
+
+type StaffHours struct {
+	UserID   string    `db:"user_id" json:"user_id"`
+	UserName string    `db:"user_name" json:"user_name"`
+	Role     StaffRole `db:"role" json:"role"`
+	Hours    float64   `db:"hours" json:"hours"`
+	Shifts   int64     `db:"shifts" json:"shifts"`
+}
+
+func (s *StaffHours) ToProto() *pbStaff.StaffHours {
+	return &pbStaff.StaffHours{
+		UserId:   s.UserID,
+		UserName: s.UserName,
+		Role:     s.Role.String(),
+		Hours:    s.Hours,
+		Shifts:   s.Shifts,
+	}
+}
@@ -2,8 +2,11 @@ package ports
 
 import (
 	"api/internal/models"
+	"api/pkg/calendar"
 	"context"
+	"database/sql"
 	"net/http"
+	"time"
 )
 
 type UserStore interface {
@@ -26,6 +29,13 @@ type UserStore interface {
 	EditNotification(context.Context, *models.Notification) error
 	DeleteNotification(context.Context, int64) error
 	NotificationUsersByBuilding(ctx context.Context, buildingID int64) ([]string, error)
+	GetBuildingAdmins(ctx context.Context) ([]*models.BuildingAdmin, error)
+	GetAdminBuildingIDs(ctx context.Context, userID string) ([]int64, error)
+	CreateBuildingAdmin(ctx context.Context, admin *models.BuildingAdmin) error
+	DeleteBuildingAdmin(ctx context.Context, id int64) error
+	GetCalendarFeedToken(ctx context.Context, userID string) (string, error)
+	SetCalendarFeedToken(ctx context.Context, userID, token string) error
+	GetByCalendarFeedToken(ctx context.Context, token string) (*models.Users, error)
 }
 type FacilityStore interface {
 	Get(ctx context.Context, id int64) (*models.FullFacility, error)
@@ -34,6 +44,8 @@ type FacilityStore interface {
 	GetAllFacilities(ctx context.Context) ([]*models.Facility, error)
 	GetByBuilding(ctx context.Context, buildingID int64) (*models.BuildingWithFacilities, error)
 	GetBuilding(ctx context.Context, id int64) (*models.Building, error)
+	GetCalendarProvider(ctx context.Context, calendarID string) (string, error)
+	SetBuildingCalendarProvider(ctx context.Context, buildingID int64, provider sql.NullString) error
 	GetCategories(ctx context.Context) ([]models.Category, error)
 	Create(ctx context.Context, input *models.Facility) error
 	Update(ctx context.Context, input *models.Facility) error
@@ -44,6 +56,16 @@ type FacilityStore interface {
 	GetPricingByFacilityAndCategory(ctx context.Context, faciltyID, categoryID int64) (models.Pricing, error)
 	GetProductPricingWithCategories(ctx context.Context, productID string) ([]models.PricingWithCategory, error)
 	GetPricing(ctx context.Context, pricingID string) (models.Pricing, error)
+	GetFormFields(ctx context.Context, facilityID, categoryID int64) ([]models.FormField, error)
+	GetFormField(ctx context.Context, id int64) (*models.FormField, error)
+	CreateFormField(ctx context.Context, field *models.FormField) (int64, error)
+	UpdateFormField(ctx context.Context, field *models.FormField) error
+	DeleteFormField(ctx context.Context, id int64) error
+	GetEquipment(ctx context.Context, buildingID int64, includeInactive bool) ([]models.Equipment, error)
+	GetEquipmentItem(ctx context.Context, id int64) (*models.Equipment, error)
+	CreateEquipment(ctx context.Context, equipment *models.Equipment) (int64, error)
+	UpdateEquipment(ctx context.Context, equipment *models.Equipment) error
+	DeleteEquipment(ctx context.Context, id int64) error
 }
 
 type ReservationStore interface {
@@ -51,27 +73,130 @@ type ReservationStore interface {
 	GetAll(ctx context.Context) ([]models.FullReservation, error)
 	GetAllIn(ctx context.Context, ids []int64) ([]models.FullReservation, error)
 	GetUserReservations(ctx context.Context, userID string) ([]models.FullReservation, error)
+	GetOrganizationReservations(ctx context.Context, organizationID int64) ([]models.FullReservation, error)
 	Create(ctx context.Context, reservation *models.Reservation) (int64, error)
 	CreateDates(ctx context.Context, dates []models.ReservationDate) error
 	CreateFee(ctx context.Context, fee models.ReservationFee) error
+	UpdateFee(ctx context.Context, fee models.ReservationFee) error
 	Update(ctx context.Context, reservation *models.Reservation) error
 	Delete(ctx context.Context, id int64) error
 	DeleteDates(ctx context.Context, id []int64) error
 	DeleteFees(ctx context.Context, id int64) error
 	UpdateCostOverride(ctx context.Context, id int64, cost string) error
+	UpdateIntakeAnswers(ctx context.Context, id int64, answers models.JSONMap) error
+	GetReservationEquipment(ctx context.Context, reservationID int64) ([]models.ReservationEquipment, error)
+	EquipmentBooked(ctx context.Context, equipmentID, excludeReservationID int64, starts, ends []time.Time) (int32, error)
+	SetReservationEquipment(ctx context.Context, reservationID int64, items []models.ReservationEquipment) error
+	GetDoorAccessDates(ctx context.Context, buildingID int64, from, to time.Time) ([]models.DoorAccessDate, error)
+	GetFeedDates(ctx context.Context, filter models.FeedFilter) ([]models.FeedDate, error)
+	GetPublishedDates(ctx context.Context, facilityID int64, from, to time.Time) ([]models.PublishedDate, error)
+	GetBookedDates(ctx context.Context, facilityID int64, from, to time.Time) ([]models.BookedDate, error)
+	GetCalendarDates(ctx context.Context, calendarID string, from, to time.Time) ([]models.CalendarDate, error)
+	GetEventReservations(ctx context.Context, eventIDs []string) ([]models.EventReservation, error)
+	SetExdates(ctx context.Context, id int64, exdates []sql.NullTime) error
 	UpdateDate(ctx context.Context, date *models.ReservationDate) error
 	GetDates(ctx context.Context, ids []int64) ([]models.ReservationDate, error)
+	GetDatesByID(ctx context.Context, ids []int64) ([]models.ReservationDate, error)
 	GetFees(ctx context.Context, ids []int64) ([]models.ReservationFee, error)
+	GetFee(ctx context.Context, id int64) (*models.ReservationFee, error)
 	GetFutureDates(ctx context.Context) ([]models.ReservationDate, error)
 	Aggregate(ctx context.Context) ([]models.Aggregate, error)
 	UpdatePaymentIntent(ctx context.Context, id int64, paymentID string) error
 }
 
+type OrganizationStore interface {
+	GetAll(ctx context.Context) ([]models.Organization, error)
+	Get(ctx context.Context, id int64) (*models.FullOrganization, error)
+	GetUserOrganizations(ctx context.Context, userID string) ([]models.UserOrganization, error)
+	Create(ctx context.Context, organization *models.Organization, ownerID string) (int64, error)
+	Update(ctx context.Context, organization *models.Organization) error
+	Delete(ctx context.Context, id int64) error
+	AddMember(ctx context.Context, member *models.OrganizationMember) (int64, error)
+	GetMember(ctx context.Context, id int64) (*models.OrganizationMember, error)
+	GetMemberRole(ctx context.Context, organizationID int64, userID string) (models.OrganizationRole, error)
+	CountOwners(ctx context.Context, organizationID int64) (int, error)
+	UpdateMemberRole(ctx context.Context, id int64, role models.OrganizationRole) error
+	RemoveMember(ctx context.Context, id int64) error
+	CreateInsurance(ctx context.Context, insurance *models.OrganizationInsurance) error
+	GetInsurance(ctx context.Context, id int64) (*models.OrganizationInsurance, error)
+	DeleteInsurance(ctx context.Context, id int64) error
+}
+
+type StaffStore interface {
+	GetReservationAssignments(ctx context.Context, reservationID int64) ([]models.StaffAssignment, error)
+	GetUserAssignments(ctx context.Context, userID string, from, to sql.NullTime) ([]models.StaffAssignment, error)
+	GetAssignment(ctx context.Context, id int64) (*models.StaffAssignment, error)
+	GetAssignmentsIn(ctx context.Context, ids []int64) ([]models.StaffAssignment, error)
+	CreateAssignments(ctx context.Context, userID string, role models.StaffRole, notes sql.NullString, reservationDateIDs []int64) ([]int64, []models.StaffAssignment, error)
+	DeleteAssignment(ctx context.Context, id int64) error
+	GetHoursReport(ctx context.Context, from, to sql.NullTime, buildingID int64) ([]models.StaffHours, error)
+}
+
+type CalendarSyncStore interface {
+	GetSyncStates(ctx context.Context, buildingID int64) ([]models.CalendarSyncState, error)
+	GetSyncState(ctx context.Context, buildingID int64, sourceCalendarID string) (*models.CalendarSyncState, error)
+	SaveSyncState(ctx context.Context, state *models.CalendarSyncState) error
+	GetSyncEvents(ctx context.Context, buildingID int64, sourceCalendarID string) ([]models.CalendarSyncEvent, error)
+	SaveSyncEvent(ctx context.Context, ev *models.CalendarSyncEvent) error
+	DeleteSyncEvent(ctx context.Context, id int64) error
+	ClearSync(ctx context.Context, buildingID int64, sourceCalendarID string) error
+	SaveCalendarChange(ctx context.Context, change *models.CalendarChange) error
+	GetCalendarChanges(ctx context.Context, status string, buildingID int64) ([]models.CalendarChange, error)
+	GetCalendarChange(ctx context.Context, id int64) (*models.CalendarChange, error)
+	ResolveCalendarChange(ctx context.Context, id int64, status models.CalendarChangeStatus, userID string, note string) (bool, error)
+}
+
+type CalendarOutboxStore interface {
+	CommitStatusChange(ctx context.Context, change *models.StatusChange) error
+	ClaimCalendarOps(ctx context.Context, limit int, lease time.Duration) ([]models.CalendarOp, error)
+	CompleteCalendarOp(ctx context.Context, op *models.CalendarOp, result *models.CalendarOpResult) error
+	RetryCalendarOp(ctx context.Context, id int64, status models.CalendarOpStatus, runAt time.Time, lastError string) error
+}
+
+type PaymentStore interface {
+	RecordStripeEvent(ctx context.Context, event *models.StripeEvent) (bool, error)
+	UpdatePaymentStatus(ctx context.Context, reservationID int64, status models.PaymentStatus, paymentIntentID string) error
+	ReservationByPaymentIntent(ctx context.Context, paymentIntentID string) (int64, error)
+	CreatePaymentEntry(ctx context.Context, entry *models.PaymentEntry) (*models.PaymentEntry, error)
+	GetPaymentEntries(ctx context.Context, reservationID int64) ([]models.PaymentEntry, error)
+	GetBalancesDue(ctx context.Context, before time.Time) ([]models.BalanceDue, error)
+	MarkBalanceReminded(ctx context.Context, reservationID int64) error
+	SetPaymentOverdue(ctx context.Context, reservationID int64, overdue bool) error
+	CreatePaymentSubmission(ctx context.Context, submission *models.PaymentSubmission) (*models.PaymentSubmission, error)
+	GetPaymentSubmission(ctx context.Context, id int64) (*models.PaymentSubmission, error)
+	GetPaymentSubmissions(ctx context.Context, reservationID int64, status models.PaymentSubmissionStatus) ([]models.PaymentSubmission, error)
+	ReviewPaymentSubmission(ctx context.Context, id int64, status models.PaymentSubmissionStatus, reviewer, note sql.NullString, entry *models.PaymentEntry) (*models.PaymentSubmission, *models.PaymentEntry, error)
+}
+
+type InvoiceStore interface {
+	IssueInvoice(ctx context.Context, invoice *models.Invoice, lines []models.InvoiceLine) (*models.Invoice, bool, error)
+	GetInvoices(ctx context.Context, reservationID int64) ([]models.Invoice, error)
+	GetInvoiceLines(ctx context.Context, invoiceID int64) ([]models.InvoiceLine, error)
+}
+
 type BrandingStore interface {
 	Get(ctx context.Context) (*models.Branding, error)
 	Update(ctx context.Context, branding *models.Branding) error
 }
 
+// CalendarProvider publishes reservations to an external or built-in calendar.
+type CalendarProvider interface {
+	Publish(ctx context.Context, plan *calendar.PublishPlan, opts calendar.PublishOptions) (*calendar.PublishResult, error)
+	ListEvents(ctx context.Context, calendarID string) ([]calendar.Event, error)
+	ListEventsBetween(ctx context.Context, calendarID string, from, to time.Time) ([]calendar.Event, error)
+	GetEvent(ctx context.Context, calendarID string, eventID string) (*calendar.Event, error)
+	DeleteEvent(ctx context.Context, calendarID string, eventID string) error
+	UpdateEvent(ctx context.Context, calendarID string, ev calendar.Event) error
+	AddExdatesToMaster(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time) error
+	AddRdatesWithOverrides(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time, adds []calendar.RDateSpec) error
+}
+
+// CalendarChangeLister is implemented by providers that can list the events
+// changed since a sync token.
+type CalendarChangeLister interface {
+	ListChanges(ctx context.Context, calendarID, syncToken string) (*calendar.Changes, error)
+}
+
 type AuthService interface {
 	AuthMiddleware(next http.Handler) http.Handler
 	GetSessionHandler(w http.ResponseWriter, r *http.Request)
@@ -138,6 +138,7 @@ type Building struct {
 	GoogleCalendarId string                 `protobuf:"bytes,5,opt,name=google_calendar_id,json=googleCalendarId,proto3" json:"google_calendar_id,omitempty"`
 	Latitude         float64                `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
 	Longitude        float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	CalendarProvider string                 `protobuf:"bytes,8,opt,name=calendar_provider,json=calendarProvider,proto3" json:"calendar_provider,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -221,6 +222,13 @@ func (x *Building) GetLongitude() float64 {
 	return 0
 }
 
+func (x *Building) GetCalendarProvider() string {
+	if x != nil {
+		return x.CalendarProvider
+	}
+	return ""
+}
+
 type BuildingWithFacilities struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Building      *Building              `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
@@ -326,12 +334,15 @@ func (x *BuildingWithEvents) GetEvents() []*Event {
 }
 
 type Category struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DepositType    string                 `protobuf:"bytes,4,opt,name=deposit_type,json=depositType,proto3" json:"deposit_type,omitempty"`             // none, percent or fixed
+	DepositAmount  string                 `protobuf:"bytes,5,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`       // percent (0-100) or dollars
+	BalanceDueDays int32                  `protobuf:"varint,6,opt,name=balance_due_days,json=balanceDueDays,proto3" json:"balance_due_days,omitempty"` // balance is due this many days before the first date
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Category) Reset() {
@@ -385,6 +396,27 @@ func (x *Category) GetDescription() string {
 	return ""
 }
 
+func (x *Category) GetDepositType() string {
+	if x != nil {
+		return x.DepositType
+	}
+	return ""
+}
+
+func (x *Category) GetDepositAmount() string {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return ""
+}
+
+func (x *Category) GetBalanceDueDays() int32 {
+	if x != nil {
+		return x.BalanceDueDays
+	}
+	return 0
+}
+
 type Pricing struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -461,22 +493,300 @@ func (x *Pricing) GetUnitLabel() string {
 	return ""
 }
 
+type FormFieldCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`   // key of the field this one depends on
+	Equals        string                 `protobuf:"bytes,2,opt,name=equals,proto3" json:"equals,omitempty"` // answer that enables the field, "true" for checkboxes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormFieldCondition) Reset() {
+	*x = FormFieldCondition{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormFieldCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormFieldCondition) ProtoMessage() {}
+
+func (x *FormFieldCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormFieldCondition.ProtoReflect.Descriptor instead.
+func (*FormFieldCondition) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FormFieldCondition) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FormFieldCondition) GetEquals() string {
+	if x != nil {
+		return x.Equals
+	}
+	return ""
+}
+
+type FormField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FacilityId    int64                  `protobuf:"varint,2,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"` // text | number | select | checkbox | file
+	Required      bool                   `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+	Options       []string               `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"`
+	Condition     *FormFieldCondition    `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
+	HelpText      string                 `protobuf:"bytes,10,opt,name=help_text,json=helpText,proto3" json:"help_text,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,11,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormField) Reset() {
+	*x = FormField{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormField) ProtoMessage() {}
+
+func (x *FormField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormField.ProtoReflect.Descriptor instead.
+func (*FormField) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FormField) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FormField) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *FormField) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *FormField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FormField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *FormField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FormField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *FormField) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *FormField) GetCondition() *FormFieldCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *FormField) GetHelpText() string {
+	if x != nil {
+		return x.HelpText
+	}
+	return ""
+}
+
+func (x *FormField) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type Equipment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuildingId    int64                  `protobuf:"varint,2,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Fee           string                 `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeUnit       string                 `protobuf:"bytes,7,opt,name=fee_unit,json=feeUnit,proto3" json:"fee_unit,omitempty"` // unit | hour
+	Active        bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Equipment) Reset() {
+	*x = Equipment{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Equipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Equipment) ProtoMessage() {}
+
+func (x *Equipment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Equipment.ProtoReflect.Descriptor instead.
+func (*Equipment) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Equipment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Equipment) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *Equipment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Equipment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Equipment) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Equipment) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *Equipment) GetFeeUnit() string {
+	if x != nil {
+		return x.FeeUnit
+	}
+	return ""
+}
+
+func (x *Equipment) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
 type Event struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
 	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"` // RFC 3339, or YYYY-MM-DD for all-day events
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`     // exclusive; the day after the last for all-day events
 	HtmlLink      string                 `protobuf:"bytes,7,opt,name=html_link,json=htmlLink,proto3" json:"html_link,omitempty"`
 	Title         string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Id            string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	AllDay        bool                   `protobuf:"varint,10,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[6]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -488,7 +798,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[6]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,7 +811,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{6}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Event) GetSummary() string {
@@ -553,6 +863,20 @@ func (x *Event) GetTitle() string {
 	return ""
 }
 
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
 type GetPricingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PricingId     string                 `protobuf:"bytes,1,opt,name=pricing_id,json=pricingId,proto3" json:"pricing_id,omitempty"`
@@ -562,7 +886,7 @@ type GetPricingRequest struct {
 
 func (x *GetPricingRequest) Reset() {
 	*x = GetPricingRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[7]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +898,7 @@ func (x *GetPricingRequest) String() string {
 func (*GetPricingRequest) ProtoMessage() {}
 
 func (x *GetPricingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[7]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,7 +911,7 @@ func (x *GetPricingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPricingRequest.ProtoReflect.Descriptor instead.
 func (*GetPricingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{7}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetPricingRequest) GetPricingId() string {
@@ -605,7 +929,7 @@ type GetCategoriesRequest struct {
 
 func (x *GetCategoriesRequest) Reset() {
 	*x = GetCategoriesRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[8]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -617,7 +941,7 @@ func (x *GetCategoriesRequest) String() string {
 func (*GetCategoriesRequest) ProtoMessage() {}
 
 func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[8]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +954,7 @@ func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{8}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{11}
 }
 
 type GetCategoriesResponse struct {
@@ -642,7 +966,7 @@ type GetCategoriesResponse struct {
 
 func (x *GetCategoriesResponse) Reset() {
 	*x = GetCategoriesResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[9]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +978,7 @@ func (x *GetCategoriesResponse) String() string {
 func (*GetCategoriesResponse) ProtoMessage() {}
 
 func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[9]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +991,7 @@ func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{9}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetCategoriesResponse) GetCategories() []*Category {
@@ -689,7 +1013,7 @@ type Coords struct {
 
 func (x *Coords) Reset() {
 	*x = Coords{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[10]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -701,7 +1025,7 @@ func (x *Coords) String() string {
 func (*Coords) ProtoMessage() {}
 
 func (x *Coords) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[10]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +1038,7 @@ func (x *Coords) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Coords.ProtoReflect.Descriptor instead.
 func (*Coords) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{10}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Coords) GetId() int64 {
@@ -753,7 +1077,7 @@ type GetAllCoordsRequest struct {
 
 func (x *GetAllCoordsRequest) Reset() {
 	*x = GetAllCoordsRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[11]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -765,7 +1089,7 @@ func (x *GetAllCoordsRequest) String() string {
 func (*GetAllCoordsRequest) ProtoMessage() {}
 
 func (x *GetAllCoordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[11]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +1102,7 @@ func (x *GetAllCoordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllCoordsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllCoordsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{11}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{14}
 }
 
 type GetAllCoordsResponse struct {
@@ -790,7 +1114,7 @@ type GetAllCoordsResponse struct {
 
 func (x *GetAllCoordsResponse) Reset() {
 	*x = GetAllCoordsResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[12]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -802,7 +1126,7 @@ func (x *GetAllCoordsResponse) String() string {
 func (*GetAllCoordsResponse) ProtoMessage() {}
 
 func (x *GetAllCoordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[12]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +1139,7 @@ func (x *GetAllCoordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllCoordsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllCoordsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{12}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetAllCoordsResponse) GetData() []*Coords {
@@ -834,7 +1158,7 @@ type GetCategoryRequest struct {
 
 func (x *GetCategoryRequest) Reset() {
 	*x = GetCategoryRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[13]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -846,7 +1170,7 @@ func (x *GetCategoryRequest) String() string {
 func (*GetCategoryRequest) ProtoMessage() {}
 
 func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[13]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1183,7 @@ func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
 func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{13}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetCategoryRequest) GetId() int64 {
@@ -870,15 +1194,19 @@ func (x *GetCategoryRequest) GetId() int64 {
 }
 
 type GetEventsByFacilityRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// RFC 3339 or YYYY-MM-DD (an end date is inclusive); empty means one
+	// month back and three ahead
+	Start         string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetEventsByFacilityRequest) Reset() {
 	*x = GetEventsByFacilityRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[14]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -890,7 +1218,7 @@ func (x *GetEventsByFacilityRequest) String() string {
 func (*GetEventsByFacilityRequest) ProtoMessage() {}
 
 func (x *GetEventsByFacilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[14]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,7 +1231,7 @@ func (x *GetEventsByFacilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsByFacilityRequest.ProtoReflect.Descriptor instead.
 func (*GetEventsByFacilityRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{14}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetEventsByFacilityRequest) GetId() int64 {
@@ -913,6 +1241,20 @@ func (x *GetEventsByFacilityRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetEventsByFacilityRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetEventsByFacilityRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
 type GetEventsByFacilityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
@@ -922,7 +1264,7 @@ type GetEventsByFacilityResponse struct {
 
 func (x *GetEventsByFacilityResponse) Reset() {
 	*x = GetEventsByFacilityResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[15]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -934,7 +1276,7 @@ func (x *GetEventsByFacilityResponse) String() string {
 func (*GetEventsByFacilityResponse) ProtoMessage() {}
 
 func (x *GetEventsByFacilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[15]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,7 +1289,7 @@ func (x *GetEventsByFacilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsByFacilityResponse.ProtoReflect.Descriptor instead.
 func (*GetEventsByFacilityResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{15}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetEventsByFacilityResponse) GetEvents() []*Event {
@@ -958,15 +1300,19 @@ func (x *GetEventsByFacilityResponse) GetEvents() []*Event {
 }
 
 type GetEventsByBuildingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// RFC 3339 or YYYY-MM-DD (an end date is inclusive); empty means one
+	// month back and three ahead
+	Start         string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetEventsByBuildingRequest) Reset() {
 	*x = GetEventsByBuildingRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[16]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -978,7 +1324,7 @@ func (x *GetEventsByBuildingRequest) String() string {
 func (*GetEventsByBuildingRequest) ProtoMessage() {}
 
 func (x *GetEventsByBuildingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[16]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,7 +1337,7 @@ func (x *GetEventsByBuildingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsByBuildingRequest.ProtoReflect.Descriptor instead.
 func (*GetEventsByBuildingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{16}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetEventsByBuildingRequest) GetId() int64 {
@@ -1001,6 +1347,20 @@ func (x *GetEventsByBuildingRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetEventsByBuildingRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetEventsByBuildingRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
 type GetEventsByBuildingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
@@ -1010,7 +1370,7 @@ type GetEventsByBuildingResponse struct {
 
 func (x *GetEventsByBuildingResponse) Reset() {
 	*x = GetEventsByBuildingResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[17]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1022,7 +1382,7 @@ func (x *GetEventsByBuildingResponse) String() string {
 func (*GetEventsByBuildingResponse) ProtoMessage() {}
 
 func (x *GetEventsByBuildingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[17]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,7 +1395,7 @@ func (x *GetEventsByBuildingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsByBuildingResponse.ProtoReflect.Descriptor instead.
 func (*GetEventsByBuildingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{17}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetEventsByBuildingResponse) GetEvents() []*Event {
@@ -1046,14 +1406,18 @@ func (x *GetEventsByBuildingResponse) GetEvents() []*Event {
 }
 
 type GetAllEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RFC 3339 or YYYY-MM-DD (an end date is inclusive); empty means one
+	// month back and three ahead
+	Start         string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllEventsRequest) Reset() {
 	*x = GetAllEventsRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[18]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1065,7 +1429,7 @@ func (x *GetAllEventsRequest) String() string {
 func (*GetAllEventsRequest) ProtoMessage() {}
 
 func (x *GetAllEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[18]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,7 +1442,21 @@ func (x *GetAllEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllEventsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllEventsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{18}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAllEventsRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetAllEventsRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
 }
 
 type GetAllEventsResponse struct {
@@ -1090,7 +1468,7 @@ type GetAllEventsResponse struct {
 
 func (x *GetAllEventsResponse) Reset() {
 	*x = GetAllEventsResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[19]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1102,7 +1480,7 @@ func (x *GetAllEventsResponse) String() string {
 func (*GetAllEventsResponse) ProtoMessage() {}
 
 func (x *GetAllEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[19]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,7 +1493,7 @@ func (x *GetAllEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllEventsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllEventsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{19}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetAllEventsResponse) GetData() []*BuildingWithEvents {
@@ -1133,7 +1511,7 @@ type GetAllBuildingsRequest struct {
 
 func (x *GetAllBuildingsRequest) Reset() {
 	*x = GetAllBuildingsRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[20]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1145,7 +1523,7 @@ func (x *GetAllBuildingsRequest) String() string {
 func (*GetAllBuildingsRequest) ProtoMessage() {}
 
 func (x *GetAllBuildingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[20]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1536,7 @@ func (x *GetAllBuildingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllBuildingsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllBuildingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{20}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{23}
 }
 
 type GetAllBuildingsResponse struct {
@@ -1170,7 +1548,7 @@ type GetAllBuildingsResponse struct {
 
 func (x *GetAllBuildingsResponse) Reset() {
 	*x = GetAllBuildingsResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[21]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1182,7 +1560,7 @@ func (x *GetAllBuildingsResponse) String() string {
 func (*GetAllBuildingsResponse) ProtoMessage() {}
 
 func (x *GetAllBuildingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[21]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +1573,7 @@ func (x *GetAllBuildingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllBuildingsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllBuildingsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{21}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetAllBuildingsResponse) GetBuildings() []*Building {
@@ -1213,7 +1591,7 @@ type GetAllFacilitiesRequest struct {
 
 func (x *GetAllFacilitiesRequest) Reset() {
 	*x = GetAllFacilitiesRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[22]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1225,7 +1603,7 @@ func (x *GetAllFacilitiesRequest) String() string {
 func (*GetAllFacilitiesRequest) ProtoMessage() {}
 
 func (x *GetAllFacilitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[22]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,7 +1616,7 @@ func (x *GetAllFacilitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllFacilitiesRequest.ProtoReflect.Descriptor instead.
 func (*GetAllFacilitiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{22}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{25}
 }
 
 type GetFacilityRequest struct {
@@ -1250,7 +1628,7 @@ type GetFacilityRequest struct {
 
 func (x *GetFacilityRequest) Reset() {
 	*x = GetFacilityRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[23]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1262,7 +1640,7 @@ func (x *GetFacilityRequest) String() string {
 func (*GetFacilityRequest) ProtoMessage() {}
 
 func (x *GetFacilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[23]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1275,7 +1653,7 @@ func (x *GetFacilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFacilityRequest.ProtoReflect.Descriptor instead.
 func (*GetFacilityRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{23}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetFacilityRequest) GetId() int64 {
@@ -1294,7 +1672,7 @@ type GetFacilityCategoriesRequest struct {
 
 func (x *GetFacilityCategoriesRequest) Reset() {
 	*x = GetFacilityCategoriesRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[24]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1306,7 +1684,7 @@ func (x *GetFacilityCategoriesRequest) String() string {
 func (*GetFacilityCategoriesRequest) ProtoMessage() {}
 
 func (x *GetFacilityCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[24]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,7 +1697,7 @@ func (x *GetFacilityCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFacilityCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*GetFacilityCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{24}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetFacilityCategoriesRequest) GetId() int64 {
@@ -1338,7 +1716,7 @@ type GetBuildingFacilitiesRequest struct {
 
 func (x *GetBuildingFacilitiesRequest) Reset() {
 	*x = GetBuildingFacilitiesRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[25]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1350,7 +1728,7 @@ func (x *GetBuildingFacilitiesRequest) String() string {
 func (*GetBuildingFacilitiesRequest) ProtoMessage() {}
 
 func (x *GetBuildingFacilitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[25]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,7 +1741,7 @@ func (x *GetBuildingFacilitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuildingFacilitiesRequest.ProtoReflect.Descriptor instead.
 func (*GetBuildingFacilitiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{25}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetBuildingFacilitiesRequest) GetBuildingId() int64 {
@@ -1382,7 +1760,7 @@ type GetAllFacilitiesResponse struct {
 
 func (x *GetAllFacilitiesResponse) Reset() {
 	*x = GetAllFacilitiesResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[26]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1394,7 +1772,7 @@ func (x *GetAllFacilitiesResponse) String() string {
 func (*GetAllFacilitiesResponse) ProtoMessage() {}
 
 func (x *GetAllFacilitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[26]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1407,7 +1785,7 @@ func (x *GetAllFacilitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllFacilitiesResponse.ProtoReflect.Descriptor instead.
 func (*GetAllFacilitiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{26}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetAllFacilitiesResponse) GetBuildings() []*BuildingWithFacilities {
@@ -1426,7 +1804,7 @@ type GetFacilityCategoriesResponse struct {
 
 func (x *GetFacilityCategoriesResponse) Reset() {
 	*x = GetFacilityCategoriesResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[27]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1438,7 +1816,7 @@ func (x *GetFacilityCategoriesResponse) String() string {
 func (*GetFacilityCategoriesResponse) ProtoMessage() {}
 
 func (x *GetFacilityCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[27]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1451,7 +1829,7 @@ func (x *GetFacilityCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFacilityCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*GetFacilityCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{27}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetFacilityCategoriesResponse) GetCategories() []*Category {
@@ -1470,7 +1848,7 @@ type GetBuildingFacilitiesResponse struct {
 
 func (x *GetBuildingFacilitiesResponse) Reset() {
 	*x = GetBuildingFacilitiesResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[28]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1482,7 +1860,7 @@ func (x *GetBuildingFacilitiesResponse) String() string {
 func (*GetBuildingFacilitiesResponse) ProtoMessage() {}
 
 func (x *GetBuildingFacilitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[28]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,7 +1873,7 @@ func (x *GetBuildingFacilitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuildingFacilitiesResponse.ProtoReflect.Descriptor instead.
 func (*GetBuildingFacilitiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{28}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetBuildingFacilitiesResponse) GetBuilding() *BuildingWithFacilities {
@@ -1514,7 +1892,7 @@ type CreateFacilityRequest struct {
 
 func (x *CreateFacilityRequest) Reset() {
 	*x = CreateFacilityRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[29]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1526,7 +1904,7 @@ func (x *CreateFacilityRequest) String() string {
 func (*CreateFacilityRequest) ProtoMessage() {}
 
 func (x *CreateFacilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[29]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,7 +1917,7 @@ func (x *CreateFacilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFacilityRequest.ProtoReflect.Descriptor instead.
 func (*CreateFacilityRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{29}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CreateFacilityRequest) GetFacility() *Facility {
@@ -1558,7 +1936,7 @@ type UpdateFacilityRequest struct {
 
 func (x *UpdateFacilityRequest) Reset() {
 	*x = UpdateFacilityRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[30]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1570,7 +1948,7 @@ func (x *UpdateFacilityRequest) String() string {
 func (*UpdateFacilityRequest) ProtoMessage() {}
 
 func (x *UpdateFacilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[30]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +1961,7 @@ func (x *UpdateFacilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFacilityRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFacilityRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{30}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *UpdateFacilityRequest) GetFacility() *Facility {
@@ -1602,7 +1980,7 @@ type DeleteFacilityRequest struct {
 
 func (x *DeleteFacilityRequest) Reset() {
 	*x = DeleteFacilityRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[31]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1614,7 +1992,7 @@ func (x *DeleteFacilityRequest) String() string {
 func (*DeleteFacilityRequest) ProtoMessage() {}
 
 func (x *DeleteFacilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[31]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,7 +2005,7 @@ func (x *DeleteFacilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFacilityRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFacilityRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{31}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeleteFacilityRequest) GetId() int64 {
@@ -1645,7 +2023,7 @@ type DeleteFacilityResponse struct {
 
 func (x *DeleteFacilityResponse) Reset() {
 	*x = DeleteFacilityResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[32]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1657,7 +2035,7 @@ func (x *DeleteFacilityResponse) String() string {
 func (*DeleteFacilityResponse) ProtoMessage() {}
 
 func (x *DeleteFacilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[32]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1670,7 +2048,7 @@ func (x *DeleteFacilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFacilityResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFacilityResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{32}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{35}
 }
 
 type UpdateFacilityCategoryRequest struct {
@@ -1682,7 +2060,7 @@ type UpdateFacilityCategoryRequest struct {
 
 func (x *UpdateFacilityCategoryRequest) Reset() {
 	*x = UpdateFacilityCategoryRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[33]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1694,7 +2072,7 @@ func (x *UpdateFacilityCategoryRequest) String() string {
 func (*UpdateFacilityCategoryRequest) ProtoMessage() {}
 
 func (x *UpdateFacilityCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[33]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,7 +2085,7 @@ func (x *UpdateFacilityCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFacilityCategoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFacilityCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{33}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UpdateFacilityCategoryRequest) GetCategory() *Category {
@@ -1725,7 +2103,7 @@ type CreateFacilityResponse struct {
 
 func (x *CreateFacilityResponse) Reset() {
 	*x = CreateFacilityResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[34]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1737,7 +2115,7 @@ func (x *CreateFacilityResponse) String() string {
 func (*CreateFacilityResponse) ProtoMessage() {}
 
 func (x *CreateFacilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[34]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1750,7 +2128,7 @@ func (x *CreateFacilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFacilityResponse.ProtoReflect.Descriptor instead.
 func (*CreateFacilityResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{34}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{37}
 }
 
 type UpdateFacilityResponse struct {
@@ -1761,7 +2139,7 @@ type UpdateFacilityResponse struct {
 
 func (x *UpdateFacilityResponse) Reset() {
 	*x = UpdateFacilityResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[35]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1773,7 +2151,7 @@ func (x *UpdateFacilityResponse) String() string {
 func (*UpdateFacilityResponse) ProtoMessage() {}
 
 func (x *UpdateFacilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[35]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,7 +2164,7 @@ func (x *UpdateFacilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFacilityResponse.ProtoReflect.Descriptor instead.
 func (*UpdateFacilityResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{35}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{38}
 }
 
 type PricingWithCategory struct {
@@ -1804,7 +2182,7 @@ type PricingWithCategory struct {
 
 func (x *PricingWithCategory) Reset() {
 	*x = PricingWithCategory{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[36]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1816,7 +2194,7 @@ func (x *PricingWithCategory) String() string {
 func (*PricingWithCategory) ProtoMessage() {}
 
 func (x *PricingWithCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[36]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,7 +2207,7 @@ func (x *PricingWithCategory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PricingWithCategory.ProtoReflect.Descriptor instead.
 func (*PricingWithCategory) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{36}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *PricingWithCategory) GetId() string {
@@ -1893,7 +2271,7 @@ type FullFacility struct {
 
 func (x *FullFacility) Reset() {
 	*x = FullFacility{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[37]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1905,7 +2283,7 @@ func (x *FullFacility) String() string {
 func (*FullFacility) ProtoMessage() {}
 
 func (x *FullFacility) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[37]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +2296,7 @@ func (x *FullFacility) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FullFacility.ProtoReflect.Descriptor instead.
 func (*FullFacility) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{37}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *FullFacility) GetFacility() *Facility {
@@ -1957,7 +2335,7 @@ type GetProductsRequest struct {
 
 func (x *GetProductsRequest) Reset() {
 	*x = GetProductsRequest{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[38]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1969,7 +2347,7 @@ func (x *GetProductsRequest) String() string {
 func (*GetProductsRequest) ProtoMessage() {}
 
 func (x *GetProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[38]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1982,7 +2360,7 @@ func (x *GetProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductsRequest.ProtoReflect.Descriptor instead.
 func (*GetProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{38}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{41}
 }
 
 type ProductWithPricing struct {
@@ -1996,7 +2374,7 @@ type ProductWithPricing struct {
 
 func (x *ProductWithPricing) Reset() {
 	*x = ProductWithPricing{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[39]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2008,7 +2386,7 @@ func (x *ProductWithPricing) String() string {
 func (*ProductWithPricing) ProtoMessage() {}
 
 func (x *ProductWithPricing) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[39]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2021,7 +2399,7 @@ func (x *ProductWithPricing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductWithPricing.ProtoReflect.Descriptor instead.
 func (*ProductWithPricing) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{39}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ProductWithPricing) GetProductId() string {
@@ -2054,7 +2432,7 @@ type GetProductsResponse struct {
 
 func (x *GetProductsResponse) Reset() {
 	*x = GetProductsResponse{}
-	mi := &file_proto_facilities_facilities_proto_msgTypes[40]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2066,7 +2444,7 @@ func (x *GetProductsResponse) String() string {
 func (*GetProductsResponse) ProtoMessage() {}
 
 func (x *GetProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_facilities_facilities_proto_msgTypes[40]
+	mi := &file_proto_facilities_facilities_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2079,7 +2457,7 @@ func (x *GetProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductsResponse.ProtoReflect.Descriptor instead.
 func (*GetProductsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{40}
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetProductsResponse) GetData() []*ProductWithPricing {
@@ -2089,47 +2467,756 @@ func (x *GetProductsResponse) GetData() []*ProductWithPricing {
 	return nil
 }
 
-var File_proto_facilities_facilities_proto protoreflect.FileDescriptor
+// Returns the merged form for a facility and category. Facility fields win
+// over category fields with the same key.
+type GetFormFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FacilityId    int64                  `protobuf:"varint,1,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_facilities_facilities_proto_rawDesc = "" +
-	"\n" +
-	"!proto/facilities/facilities.proto\x12\x0eapi.facilities\"\xa1\x02\n" +
-	"\bFacility\x12\x12\n" +
-	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
-	"\n" +
-	"image_path\x18\x03 \x01(\tR\timagePath\x12\x1e\n" +
-	"\bcapacity\x18\x04 \x01(\x03B\x020\x01R\bcapacity\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12,\n" +
-	"\x12google_calendar_id\x18\a \x01(\tR\x10googleCalendarId\x12#\n" +
-	"\vbuilding_id\x18\b \x01(\x03B\x020\x01R\n" +
-	"buildingId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\t \x01(\tR\tproductId\"\xd3\x01\n" +
-	"\bBuilding\x12\x12\n" +
-	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
-	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1d\n" +
-	"\n" +
-	"image_path\x18\x04 \x01(\tR\timagePath\x12,\n" +
-	"\x12google_calendar_id\x18\x05 \x01(\tR\x10googleCalendarId\x12\x1a\n" +
-	"\blatitude\x18\x06 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\a \x01(\x01R\tlongitude\"\x88\x01\n" +
-	"\x16BuildingWithFacilities\x124\n" +
-	"\bbuilding\x18\x01 \x01(\v2\x18.api.facilities.BuildingR\bbuilding\x128\n" +
-	"\n" +
-	"facilities\x18\x02 \x03(\v2\x18.api.facilities.FacilityR\n" +
-	"facilities\"y\n" +
-	"\x12BuildingWithEvents\x124\n" +
-	"\bbuilding\x18\x01 \x01(\v2\x18.api.facilities.BuildingR\bbuilding\x12-\n" +
-	"\x06events\x18\x02 \x03(\v2\x15.api.facilities.EventR\x06events\"T\n" +
-	"\bCategory\x12\x12\n" +
-	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x92\x01\n" +
+func (x *GetFormFieldsRequest) Reset() {
+	*x = GetFormFieldsRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFormFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFormFieldsRequest) ProtoMessage() {}
+
+func (x *GetFormFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFormFieldsRequest.ProtoReflect.Descriptor instead.
+func (*GetFormFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetFormFieldsRequest) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *GetFormFieldsRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type GetFormFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []*FormField           `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFormFieldsResponse) Reset() {
+	*x = GetFormFieldsResponse{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFormFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFormFieldsResponse) ProtoMessage() {}
+
+func (x *GetFormFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFormFieldsResponse.ProtoReflect.Descriptor instead.
+func (*GetFormFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetFormFieldsResponse) GetFields() []*FormField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type CreateFormFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *FormField             `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFormFieldRequest) Reset() {
+	*x = CreateFormFieldRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFormFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFormFieldRequest) ProtoMessage() {}
+
+func (x *CreateFormFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFormFieldRequest.ProtoReflect.Descriptor instead.
+func (*CreateFormFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateFormFieldRequest) GetField() *FormField {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type UpdateFormFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *FormField             `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFormFieldRequest) Reset() {
+	*x = UpdateFormFieldRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFormFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFormFieldRequest) ProtoMessage() {}
+
+func (x *UpdateFormFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFormFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFormFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateFormFieldRequest) GetField() *FormField {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type DeleteFormFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFormFieldRequest) Reset() {
+	*x = DeleteFormFieldRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFormFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFormFieldRequest) ProtoMessage() {}
+
+func (x *DeleteFormFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFormFieldRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFormFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteFormFieldRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteFormFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFormFieldResponse) Reset() {
+	*x = DeleteFormFieldResponse{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFormFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFormFieldResponse) ProtoMessage() {}
+
+func (x *DeleteFormFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFormFieldResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFormFieldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{49}
+}
+
+type GetEquipmentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId      int64                  `protobuf:"varint,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetEquipmentRequest) Reset() {
+	*x = GetEquipmentRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquipmentRequest) ProtoMessage() {}
+
+func (x *GetEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*GetEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetEquipmentRequest) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *GetEquipmentRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type GetEquipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Equipment     []*Equipment           `protobuf:"bytes,1,rep,name=equipment,proto3" json:"equipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEquipmentResponse) Reset() {
+	*x = GetEquipmentResponse{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquipmentResponse) ProtoMessage() {}
+
+func (x *GetEquipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquipmentResponse.ProtoReflect.Descriptor instead.
+func (*GetEquipmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetEquipmentResponse) GetEquipment() []*Equipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+type CreateEquipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Equipment     *Equipment             `protobuf:"bytes,1,opt,name=equipment,proto3" json:"equipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEquipmentRequest) Reset() {
+	*x = CreateEquipmentRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEquipmentRequest) ProtoMessage() {}
+
+func (x *CreateEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateEquipmentRequest) GetEquipment() *Equipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+type UpdateEquipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Equipment     *Equipment             `protobuf:"bytes,1,opt,name=equipment,proto3" json:"equipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEquipmentRequest) Reset() {
+	*x = UpdateEquipmentRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEquipmentRequest) ProtoMessage() {}
+
+func (x *UpdateEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateEquipmentRequest) GetEquipment() *Equipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+type DeleteEquipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEquipmentRequest) Reset() {
+	*x = DeleteEquipmentRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEquipmentRequest) ProtoMessage() {}
+
+func (x *DeleteEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteEquipmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteEquipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEquipmentResponse) Reset() {
+	*x = DeleteEquipmentResponse{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEquipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEquipmentResponse) ProtoMessage() {}
+
+func (x *DeleteEquipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEquipmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEquipmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{55}
+}
+
+type SetBuildingCalendarProviderRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId int64                  `protobuf:"varint,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	// empty resets the building to the default provider
+	CalendarProvider string `protobuf:"bytes,2,opt,name=calendar_provider,json=calendarProvider,proto3" json:"calendar_provider,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetBuildingCalendarProviderRequest) Reset() {
+	*x = SetBuildingCalendarProviderRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBuildingCalendarProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBuildingCalendarProviderRequest) ProtoMessage() {}
+
+func (x *SetBuildingCalendarProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBuildingCalendarProviderRequest.ProtoReflect.Descriptor instead.
+func (*SetBuildingCalendarProviderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetBuildingCalendarProviderRequest) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *SetBuildingCalendarProviderRequest) GetCalendarProvider() string {
+	if x != nil {
+		return x.CalendarProvider
+	}
+	return ""
+}
+
+type ResyncBuildingCalendarRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId int64                  `protobuf:"varint,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	// lists every facility calendar in full instead of applying changes
+	Full bool `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"`
+	// deletes every event on the building calendar and copies them again
+	Rebuild       bool `protobuf:"varint,3,opt,name=rebuild,proto3" json:"rebuild,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncBuildingCalendarRequest) Reset() {
+	*x = ResyncBuildingCalendarRequest{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncBuildingCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncBuildingCalendarRequest) ProtoMessage() {}
+
+func (x *ResyncBuildingCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncBuildingCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ResyncBuildingCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ResyncBuildingCalendarRequest) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *ResyncBuildingCalendarRequest) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *ResyncBuildingCalendarRequest) GetRebuild() bool {
+	if x != nil {
+		return x.Rebuild
+	}
+	return false
+}
+
+type ResyncBuildingCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inserted      int32                  `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Deleted       int32                  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncBuildingCalendarResponse) Reset() {
+	*x = ResyncBuildingCalendarResponse{}
+	mi := &file_proto_facilities_facilities_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncBuildingCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncBuildingCalendarResponse) ProtoMessage() {}
+
+func (x *ResyncBuildingCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_facilities_facilities_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncBuildingCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ResyncBuildingCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_facilities_facilities_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResyncBuildingCalendarResponse) GetInserted() int32 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *ResyncBuildingCalendarResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ResyncBuildingCalendarResponse) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+var File_proto_facilities_facilities_proto protoreflect.FileDescriptor
+
+const file_proto_facilities_facilities_proto_rawDesc = "" +
+	"\n" +
+	"!proto/facilities/facilities.proto\x12\x0eapi.facilities\"\xa1\x02\n" +
+	"\bFacility\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"image_path\x18\x03 \x01(\tR\timagePath\x12\x1e\n" +
+	"\bcapacity\x18\x04 \x01(\x03B\x020\x01R\bcapacity\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12,\n" +
+	"\x12google_calendar_id\x18\a \x01(\tR\x10googleCalendarId\x12#\n" +
+	"\vbuilding_id\x18\b \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\t \x01(\tR\tproductId\"\x80\x02\n" +
+	"\bBuilding\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1d\n" +
+	"\n" +
+	"image_path\x18\x04 \x01(\tR\timagePath\x12,\n" +
+	"\x12google_calendar_id\x18\x05 \x01(\tR\x10googleCalendarId\x12\x1a\n" +
+	"\blatitude\x18\x06 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\a \x01(\x01R\tlongitude\x12+\n" +
+	"\x11calendar_provider\x18\b \x01(\tR\x10calendarProvider\"\x88\x01\n" +
+	"\x16BuildingWithFacilities\x124\n" +
+	"\bbuilding\x18\x01 \x01(\v2\x18.api.facilities.BuildingR\bbuilding\x128\n" +
+	"\n" +
+	"facilities\x18\x02 \x03(\v2\x18.api.facilities.FacilityR\n" +
+	"facilities\"y\n" +
+	"\x12BuildingWithEvents\x124\n" +
+	"\bbuilding\x18\x01 \x01(\v2\x18.api.facilities.BuildingR\bbuilding\x12-\n" +
+	"\x06events\x18\x02 \x03(\v2\x15.api.facilities.EventR\x06events\"\xc8\x01\n" +
+	"\bCategory\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\fdeposit_type\x18\x04 \x01(\tR\vdepositType\x12%\n" +
+	"\x0edeposit_amount\x18\x05 \x01(\tR\rdepositAmount\x12(\n" +
+	"\x10balance_due_days\x18\x06 \x01(\x05R\x0ebalanceDueDays\"\x92\x01\n" +
 	"\aPricing\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2138,7 +3225,36 @@ const file_proto_facilities_facilities_proto_rawDesc = "" +
 	"\vcategory_id\x18\x04 \x01(\x03B\x020\x01R\n" +
 	"categoryId\x12\x1d\n" +
 	"\n" +
-	"unit_label\x18\x05 \x01(\tR\tunitLabel\"\xba\x01\n" +
+	"unit_label\x18\x05 \x01(\tR\tunitLabel\"B\n" +
+	"\x12FormFieldCondition\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06equals\x18\x02 \x01(\tR\x06equals\"\xd9\x02\n" +
+	"\tFormField\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12#\n" +
+	"\vfacility_id\x18\x02 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12#\n" +
+	"\vcategory_id\x18\x03 \x01(\x03B\x020\x01R\n" +
+	"categoryId\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x05 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\a \x01(\bR\brequired\x12\x18\n" +
+	"\aoptions\x18\b \x03(\tR\aoptions\x12@\n" +
+	"\tcondition\x18\t \x01(\v2\".api.facilities.FormFieldConditionR\tcondition\x12\x1b\n" +
+	"\thelp_text\x18\n" +
+	" \x01(\tR\bhelpText\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\v \x01(\x05R\tsortOrder\"\xdb\x01\n" +
+	"\tEquipment\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12#\n" +
+	"\vbuilding_id\x18\x02 \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x10\n" +
+	"\x03fee\x18\x06 \x01(\tR\x03fee\x12\x19\n" +
+	"\bfee_unit\x18\a \x01(\tR\afeeUnit\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06active\"\xe3\x01\n" +
 	"\x05Event\x12\x18\n" +
 	"\asummary\x18\x01 \x01(\tR\asummary\x12\x1a\n" +
 	"\blocation\x18\x02 \x01(\tR\blocation\x12 \n" +
@@ -2146,7 +3262,10 @@ const file_proto_facilities_facilities_proto_rawDesc = "" +
 	"\x05start\x18\x04 \x01(\tR\x05start\x12\x10\n" +
 	"\x03end\x18\x05 \x01(\tR\x03end\x12\x1b\n" +
 	"\thtml_link\x18\a \x01(\tR\bhtmlLink\x12\x14\n" +
-	"\x05title\x18\b \x01(\tR\x05title\"2\n" +
+	"\x05title\x18\b \x01(\tR\x05title\x12\x0e\n" +
+	"\x02id\x18\t \x01(\tR\x02id\x12\x17\n" +
+	"\aall_day\x18\n" +
+	" \x01(\bR\x06allDay\"2\n" +
 	"\x11GetPricingRequest\x12\x1d\n" +
 	"\n" +
 	"pricing_id\x18\x01 \x01(\tR\tpricingId\"\x16\n" +
@@ -2164,16 +3283,22 @@ const file_proto_facilities_facilities_proto_rawDesc = "" +
 	"\x14GetAllCoordsResponse\x12*\n" +
 	"\x04data\x18\x01 \x03(\v2\x16.api.facilities.coordsR\x04data\"(\n" +
 	"\x12GetCategoryRequest\x12\x12\n" +
-	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"0\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"X\n" +
 	"\x1aGetEventsByFacilityRequest\x12\x12\n" +
-	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"L\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\"L\n" +
 	"\x1bGetEventsByFacilityResponse\x12-\n" +
-	"\x06events\x18\x01 \x03(\v2\x15.api.facilities.EventR\x06events\"0\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.api.facilities.EventR\x06events\"X\n" +
 	"\x1aGetEventsByBuildingRequest\x12\x12\n" +
-	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"L\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\"L\n" +
 	"\x1bGetEventsByBuildingResponse\x12-\n" +
-	"\x06events\x18\x01 \x03(\v2\x15.api.facilities.EventR\x06events\"\x15\n" +
-	"\x13GetAllEventsRequest\"N\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.api.facilities.EventR\x06events\"=\n" +
+	"\x13GetAllEventsRequest\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"N\n" +
 	"\x14GetAllEventsResponse\x126\n" +
 	"\x04data\x18\x01 \x03(\v2\".api.facilities.BuildingWithEventsR\x04data\"\x18\n" +
 	"\x16GetAllBuildingsRequest\"Q\n" +
@@ -2229,7 +3354,47 @@ const file_proto_facilities_facilities_proto_rawDesc = "" +
 	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12=\n" +
 	"\apricing\x18\x03 \x03(\v2#.api.facilities.PricingWithCategoryR\apricing\"M\n" +
 	"\x13GetProductsResponse\x126\n" +
-	"\x04data\x18\x01 \x03(\v2\".api.facilities.ProductWithPricingR\x04data2\xd1\r\n" +
+	"\x04data\x18\x01 \x03(\v2\".api.facilities.ProductWithPricingR\x04data\"`\n" +
+	"\x14GetFormFieldsRequest\x12#\n" +
+	"\vfacility_id\x18\x01 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12#\n" +
+	"\vcategory_id\x18\x02 \x01(\x03B\x020\x01R\n" +
+	"categoryId\"J\n" +
+	"\x15GetFormFieldsResponse\x121\n" +
+	"\x06fields\x18\x01 \x03(\v2\x19.api.facilities.FormFieldR\x06fields\"I\n" +
+	"\x16CreateFormFieldRequest\x12/\n" +
+	"\x05field\x18\x01 \x01(\v2\x19.api.facilities.FormFieldR\x05field\"I\n" +
+	"\x16UpdateFormFieldRequest\x12/\n" +
+	"\x05field\x18\x01 \x01(\v2\x19.api.facilities.FormFieldR\x05field\",\n" +
+	"\x16DeleteFormFieldRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"\x19\n" +
+	"\x17DeleteFormFieldResponse\"e\n" +
+	"\x13GetEquipmentRequest\x12#\n" +
+	"\vbuilding_id\x18\x01 \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\"O\n" +
+	"\x14GetEquipmentResponse\x127\n" +
+	"\tequipment\x18\x01 \x03(\v2\x19.api.facilities.EquipmentR\tequipment\"Q\n" +
+	"\x16CreateEquipmentRequest\x127\n" +
+	"\tequipment\x18\x01 \x01(\v2\x19.api.facilities.EquipmentR\tequipment\"Q\n" +
+	"\x16UpdateEquipmentRequest\x127\n" +
+	"\tequipment\x18\x01 \x01(\v2\x19.api.facilities.EquipmentR\tequipment\",\n" +
+	"\x16DeleteEquipmentRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"\x19\n" +
+	"\x17DeleteEquipmentResponse\"v\n" +
+	"\"SetBuildingCalendarProviderRequest\x12#\n" +
+	"\vbuilding_id\x18\x01 \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12+\n" +
+	"\x11calendar_provider\x18\x02 \x01(\tR\x10calendarProvider\"r\n" +
+	"\x1dResyncBuildingCalendarRequest\x12#\n" +
+	"\vbuilding_id\x18\x01 \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12\x12\n" +
+	"\x04full\x18\x02 \x01(\bR\x04full\x12\x18\n" +
+	"\arebuild\x18\x03 \x01(\bR\arebuild\"p\n" +
+	"\x1eResyncBuildingCalendarResponse\x12\x1a\n" +
+	"\binserted\x18\x01 \x01(\x05R\binserted\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\x05R\adeleted2\x9a\x15\n" +
 	"\x11FacilitiesService\x12j\n" +
 	"\x10GetAllFacilities\x12'.api.facilities.GetAllFacilitiesRequest\x1a(.api.facilities.GetAllFacilitiesResponse\"\x03\x90\x02\x01\x12g\n" +
 	"\x0fGetAllBuildings\x12&.api.facilities.GetAllBuildingsRequest\x1a'.api.facilities.GetAllBuildingsResponse\"\x03\x90\x02\x01\x12T\n" +
@@ -2248,7 +3413,17 @@ const file_proto_facilities_facilities_proto_rawDesc = "" +
 	"\fGetAllCoords\x12#.api.facilities.GetAllCoordsRequest\x1a$.api.facilities.GetAllCoordsResponse\"\x03\x90\x02\x01\x12[\n" +
 	"\vGetProducts\x12\".api.facilities.GetProductsRequest\x1a#.api.facilities.GetProductsResponse\"\x03\x90\x02\x01\x12Y\n" +
 	"\n" +
-	"GetPricing\x12!.api.facilities.GetPricingRequest\x1a#.api.facilities.PricingWithCategory\"\x03\x90\x02\x01B\xaf\x01\n" +
+	"GetPricing\x12!.api.facilities.GetPricingRequest\x1a#.api.facilities.PricingWithCategory\"\x03\x90\x02\x01\x12a\n" +
+	"\rGetFormFields\x12$.api.facilities.GetFormFieldsRequest\x1a%.api.facilities.GetFormFieldsResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\x0fCreateFormField\x12&.api.facilities.CreateFormFieldRequest\x1a\x19.api.facilities.FormField\x12T\n" +
+	"\x0fUpdateFormField\x12&.api.facilities.UpdateFormFieldRequest\x1a\x19.api.facilities.FormField\x12b\n" +
+	"\x0fDeleteFormField\x12&.api.facilities.DeleteFormFieldRequest\x1a'.api.facilities.DeleteFormFieldResponse\x12^\n" +
+	"\fGetEquipment\x12#.api.facilities.GetEquipmentRequest\x1a$.api.facilities.GetEquipmentResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\x0fCreateEquipment\x12&.api.facilities.CreateEquipmentRequest\x1a\x19.api.facilities.Equipment\x12T\n" +
+	"\x0fUpdateEquipment\x12&.api.facilities.UpdateEquipmentRequest\x1a\x19.api.facilities.Equipment\x12b\n" +
+	"\x0fDeleteEquipment\x12&.api.facilities.DeleteEquipmentRequest\x1a'.api.facilities.DeleteEquipmentResponse\x12k\n" +
+	"\x1bSetBuildingCalendarProvider\x122.api.facilities.SetBuildingCalendarProviderRequest\x1a\x18.api.facilities.Building\x12w\n" +
+	"\x16ResyncBuildingCalendar\x12-.api.facilities.ResyncBuildingCalendarRequest\x1a..api.facilities.ResyncBuildingCalendarResponseB\xaf\x01\n" +
 	"\x12com.api.facilitiesB\x0fFacilitiesProtoP\x01Z/api/internal/proto/facilities;facilitiesservice\xa2\x02\x03AFX\xaa\x02\x0eApi.Facilities\xca\x02\x0eApi\\Facilities\xe2\x02\x1aApi\\Facilities\\GPBMetadata\xea\x02\x0fApi::Facilitiesb\x06proto3"
 
 var (
@@ -2263,111 +3438,156 @@ func file_proto_facilities_facilities_proto_rawDescGZIP() []byte {
 	return file_proto_facilities_facilities_proto_rawDescData
 }
 
-var file_proto_facilities_facilities_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_proto_facilities_facilities_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_proto_facilities_facilities_proto_goTypes = []any{
-	(*Facility)(nil),                      // 0: api.facilities.Facility
-	(*Building)(nil),                      // 1: api.facilities.Building
-	(*BuildingWithFacilities)(nil),        // 2: api.facilities.BuildingWithFacilities
-	(*BuildingWithEvents)(nil),            // 3: api.facilities.BuildingWithEvents
-	(*Category)(nil),                      // 4: api.facilities.Category
-	(*Pricing)(nil),                       // 5: api.facilities.Pricing
-	(*Event)(nil),                         // 6: api.facilities.Event
-	(*GetPricingRequest)(nil),             // 7: api.facilities.GetPricingRequest
-	(*GetCategoriesRequest)(nil),          // 8: api.facilities.GetCategoriesRequest
-	(*GetCategoriesResponse)(nil),         // 9: api.facilities.GetCategoriesResponse
-	(*Coords)(nil),                        // 10: api.facilities.coords
-	(*GetAllCoordsRequest)(nil),           // 11: api.facilities.GetAllCoordsRequest
-	(*GetAllCoordsResponse)(nil),          // 12: api.facilities.GetAllCoordsResponse
-	(*GetCategoryRequest)(nil),            // 13: api.facilities.GetCategoryRequest
-	(*GetEventsByFacilityRequest)(nil),    // 14: api.facilities.GetEventsByFacilityRequest
-	(*GetEventsByFacilityResponse)(nil),   // 15: api.facilities.GetEventsByFacilityResponse
-	(*GetEventsByBuildingRequest)(nil),    // 16: api.facilities.GetEventsByBuildingRequest
-	(*GetEventsByBuildingResponse)(nil),   // 17: api.facilities.GetEventsByBuildingResponse
-	(*GetAllEventsRequest)(nil),           // 18: api.facilities.GetAllEventsRequest
-	(*GetAllEventsResponse)(nil),          // 19: api.facilities.GetAllEventsResponse
-	(*GetAllBuildingsRequest)(nil),        // 20: api.facilities.GetAllBuildingsRequest
-	(*GetAllBuildingsResponse)(nil),       // 21: api.facilities.GetAllBuildingsResponse
-	(*GetAllFacilitiesRequest)(nil),       // 22: api.facilities.GetAllFacilitiesRequest
-	(*GetFacilityRequest)(nil),            // 23: api.facilities.GetFacilityRequest
-	(*GetFacilityCategoriesRequest)(nil),  // 24: api.facilities.GetFacilityCategoriesRequest
-	(*GetBuildingFacilitiesRequest)(nil),  // 25: api.facilities.GetBuildingFacilitiesRequest
-	(*GetAllFacilitiesResponse)(nil),      // 26: api.facilities.GetAllFacilitiesResponse
-	(*GetFacilityCategoriesResponse)(nil), // 27: api.facilities.GetFacilityCategoriesResponse
-	(*GetBuildingFacilitiesResponse)(nil), // 28: api.facilities.GetBuildingFacilitiesResponse
-	(*CreateFacilityRequest)(nil),         // 29: api.facilities.CreateFacilityRequest
-	(*UpdateFacilityRequest)(nil),         // 30: api.facilities.UpdateFacilityRequest
-	(*DeleteFacilityRequest)(nil),         // 31: api.facilities.DeleteFacilityRequest
-	(*DeleteFacilityResponse)(nil),        // 32: api.facilities.DeleteFacilityResponse
-	(*UpdateFacilityCategoryRequest)(nil), // 33: api.facilities.UpdateFacilityCategoryRequest
-	(*CreateFacilityResponse)(nil),        // 34: api.facilities.CreateFacilityResponse
-	(*UpdateFacilityResponse)(nil),        // 35: api.facilities.UpdateFacilityResponse
-	(*PricingWithCategory)(nil),           // 36: api.facilities.PricingWithCategory
-	(*FullFacility)(nil),                  // 37: api.facilities.FullFacility
-	(*GetProductsRequest)(nil),            // 38: api.facilities.GetProductsRequest
-	(*ProductWithPricing)(nil),            // 39: api.facilities.ProductWithPricing
-	(*GetProductsResponse)(nil),           // 40: api.facilities.GetProductsResponse
+	(*Facility)(nil),                           // 0: api.facilities.Facility
+	(*Building)(nil),                           // 1: api.facilities.Building
+	(*BuildingWithFacilities)(nil),             // 2: api.facilities.BuildingWithFacilities
+	(*BuildingWithEvents)(nil),                 // 3: api.facilities.BuildingWithEvents
+	(*Category)(nil),                           // 4: api.facilities.Category
+	(*Pricing)(nil),                            // 5: api.facilities.Pricing
+	(*FormFieldCondition)(nil),                 // 6: api.facilities.FormFieldCondition
+	(*FormField)(nil),                          // 7: api.facilities.FormField
+	(*Equipment)(nil),                          // 8: api.facilities.Equipment
+	(*Event)(nil),                              // 9: api.facilities.Event
+	(*GetPricingRequest)(nil),                  // 10: api.facilities.GetPricingRequest
+	(*GetCategoriesRequest)(nil),               // 11: api.facilities.GetCategoriesRequest
+	(*GetCategoriesResponse)(nil),              // 12: api.facilities.GetCategoriesResponse
+	(*Coords)(nil),                             // 13: api.facilities.coords
+	(*GetAllCoordsRequest)(nil),                // 14: api.facilities.GetAllCoordsRequest
+	(*GetAllCoordsResponse)(nil),               // 15: api.facilities.GetAllCoordsResponse
+	(*GetCategoryRequest)(nil),                 // 16: api.facilities.GetCategoryRequest
+	(*GetEventsByFacilityRequest)(nil),         // 17: api.facilities.GetEventsByFacilityRequest
+	(*GetEventsByFacilityResponse)(nil),        // 18: api.facilities.GetEventsByFacilityResponse
+	(*GetEventsByBuildingRequest)(nil),         // 19: api.facilities.GetEventsByBuildingRequest
+	(*GetEventsByBuildingResponse)(nil),        // 20: api.facilities.GetEventsByBuildingResponse
+	(*GetAllEventsRequest)(nil),                // 21: api.facilities.GetAllEventsRequest
+	(*GetAllEventsResponse)(nil),               // 22: api.facilities.GetAllEventsResponse
+	(*GetAllBuildingsRequest)(nil),             // 23: api.facilities.GetAllBuildingsRequest
+	(*GetAllBuildingsResponse)(nil),            // 24: api.facilities.GetAllBuildingsResponse
+	(*GetAllFacilitiesRequest)(nil),            // 25: api.facilities.GetAllFacilitiesRequest
+	(*GetFacilityRequest)(nil),                 // 26: api.facilities.GetFacilityRequest
+	(*GetFacilityCategoriesRequest)(nil),       // 27: api.facilities.GetFacilityCategoriesRequest
+	(*GetBuildingFacilitiesRequest)(nil),       // 28: api.facilities.GetBuildingFacilitiesRequest
+	(*GetAllFacilitiesResponse)(nil),           // 29: api.facilities.GetAllFacilitiesResponse
+	(*GetFacilityCategoriesResponse)(nil),      // 30: api.facilities.GetFacilityCategoriesResponse
+	(*GetBuildingFacilitiesResponse)(nil),      // 31: api.facilities.GetBuildingFacilitiesResponse
+	(*CreateFacilityRequest)(nil),              // 32: api.facilities.CreateFacilityRequest
+	(*UpdateFacilityRequest)(nil),              // 33: api.facilities.UpdateFacilityRequest
+	(*DeleteFacilityRequest)(nil),              // 34: api.facilities.DeleteFacilityRequest
+	(*DeleteFacilityResponse)(nil),             // 35: api.facilities.DeleteFacilityResponse
+	(*UpdateFacilityCategoryRequest)(nil),      // 36: api.facilities.UpdateFacilityCategoryRequest
+	(*CreateFacilityResponse)(nil),             // 37: api.facilities.CreateFacilityResponse
+	(*UpdateFacilityResponse)(nil),             // 38: api.facilities.UpdateFacilityResponse
+	(*PricingWithCategory)(nil),                // 39: api.facilities.PricingWithCategory
+	(*FullFacility)(nil),                       // 40: api.facilities.FullFacility
+	(*GetProductsRequest)(nil),                 // 41: api.facilities.GetProductsRequest
+	(*ProductWithPricing)(nil),                 // 42: api.facilities.ProductWithPricing
+	(*GetProductsResponse)(nil),                // 43: api.facilities.GetProductsResponse
+	(*GetFormFieldsRequest)(nil),               // 44: api.facilities.GetFormFieldsRequest
+	(*GetFormFieldsResponse)(nil),              // 45: api.facilities.GetFormFieldsResponse
+	(*CreateFormFieldRequest)(nil),             // 46: api.facilities.CreateFormFieldRequest
+	(*UpdateFormFieldRequest)(nil),             // 47: api.facilities.UpdateFormFieldRequest
+	(*DeleteFormFieldRequest)(nil),             // 48: api.facilities.DeleteFormFieldRequest
+	(*DeleteFormFieldResponse)(nil),            // 49: api.facilities.DeleteFormFieldResponse
+	(*GetEquipmentRequest)(nil),                // 50: api.facilities.GetEquipmentRequest
+	(*GetEquipmentResponse)(nil),               // 51: api.facilities.GetEquipmentResponse
+	(*CreateEquipmentRequest)(nil),             // 52: api.facilities.CreateEquipmentRequest
+	(*UpdateEquipmentRequest)(nil),             // 53: api.facilities.UpdateEquipmentRequest
+	(*DeleteEquipmentRequest)(nil),             // 54: api.facilities.DeleteEquipmentRequest
+	(*DeleteEquipmentResponse)(nil),            // 55: api.facilities.DeleteEquipmentResponse
+	(*SetBuildingCalendarProviderRequest)(nil), // 56: api.facilities.SetBuildingCalendarProviderRequest
+	(*ResyncBuildingCalendarRequest)(nil),      // 57: api.facilities.ResyncBuildingCalendarRequest
+	(*ResyncBuildingCalendarResponse)(nil),     // 58: api.facilities.ResyncBuildingCalendarResponse
 }
 var file_proto_facilities_facilities_proto_depIdxs = []int32{
 	1,  // 0: api.facilities.BuildingWithFacilities.building:type_name -> api.facilities.Building
 	0,  // 1: api.facilities.BuildingWithFacilities.facilities:type_name -> api.facilities.Facility
 	1,  // 2: api.facilities.BuildingWithEvents.building:type_name -> api.facilities.Building
-	6,  // 3: api.facilities.BuildingWithEvents.events:type_name -> api.facilities.Event
-	4,  // 4: api.facilities.GetCategoriesResponse.categories:type_name -> api.facilities.Category
-	10, // 5: api.facilities.GetAllCoordsResponse.data:type_name -> api.facilities.coords
-	6,  // 6: api.facilities.GetEventsByFacilityResponse.events:type_name -> api.facilities.Event
-	6,  // 7: api.facilities.GetEventsByBuildingResponse.events:type_name -> api.facilities.Event
-	3,  // 8: api.facilities.GetAllEventsResponse.data:type_name -> api.facilities.BuildingWithEvents
-	1,  // 9: api.facilities.GetAllBuildingsResponse.buildings:type_name -> api.facilities.Building
-	2,  // 10: api.facilities.GetAllFacilitiesResponse.buildings:type_name -> api.facilities.BuildingWithFacilities
-	4,  // 11: api.facilities.GetFacilityCategoriesResponse.categories:type_name -> api.facilities.Category
-	2,  // 12: api.facilities.GetBuildingFacilitiesResponse.building:type_name -> api.facilities.BuildingWithFacilities
-	0,  // 13: api.facilities.CreateFacilityRequest.facility:type_name -> api.facilities.Facility
-	0,  // 14: api.facilities.UpdateFacilityRequest.facility:type_name -> api.facilities.Facility
-	4,  // 15: api.facilities.UpdateFacilityCategoryRequest.category:type_name -> api.facilities.Category
-	0,  // 16: api.facilities.FullFacility.facility:type_name -> api.facilities.Facility
-	36, // 17: api.facilities.FullFacility.pricing:type_name -> api.facilities.PricingWithCategory
-	1,  // 18: api.facilities.FullFacility.building:type_name -> api.facilities.Building
-	36, // 19: api.facilities.ProductWithPricing.pricing:type_name -> api.facilities.PricingWithCategory
-	39, // 20: api.facilities.GetProductsResponse.data:type_name -> api.facilities.ProductWithPricing
-	22, // 21: api.facilities.FacilitiesService.GetAllFacilities:input_type -> api.facilities.GetAllFacilitiesRequest
-	20, // 22: api.facilities.FacilitiesService.GetAllBuildings:input_type -> api.facilities.GetAllBuildingsRequest
-	23, // 23: api.facilities.FacilitiesService.GetFacility:input_type -> api.facilities.GetFacilityRequest
-	14, // 24: api.facilities.FacilitiesService.GetEventsByFacility:input_type -> api.facilities.GetEventsByFacilityRequest
-	16, // 25: api.facilities.FacilitiesService.GetEventsByBuilding:input_type -> api.facilities.GetEventsByBuildingRequest
-	18, // 26: api.facilities.FacilitiesService.GetAllEvents:input_type -> api.facilities.GetAllEventsRequest
-	24, // 27: api.facilities.FacilitiesService.GetFacilityCategories:input_type -> api.facilities.GetFacilityCategoriesRequest
-	25, // 28: api.facilities.FacilitiesService.GetBuildingFacilities:input_type -> api.facilities.GetBuildingFacilitiesRequest
-	29, // 29: api.facilities.FacilitiesService.CreateFacility:input_type -> api.facilities.CreateFacilityRequest
-	30, // 30: api.facilities.FacilitiesService.UpdateFacility:input_type -> api.facilities.UpdateFacilityRequest
-	31, // 31: api.facilities.FacilitiesService.DeleteFacility:input_type -> api.facilities.DeleteFacilityRequest
-	33, // 32: api.facilities.FacilitiesService.UpdateFacilityCategory:input_type -> api.facilities.UpdateFacilityCategoryRequest
-	8,  // 33: api.facilities.FacilitiesService.GetCategories:input_type -> api.facilities.GetCategoriesRequest
-	13, // 34: api.facilities.FacilitiesService.GetCategory:input_type -> api.facilities.GetCategoryRequest
-	11, // 35: api.facilities.FacilitiesService.GetAllCoords:input_type -> api.facilities.GetAllCoordsRequest
-	38, // 36: api.facilities.FacilitiesService.GetProducts:input_type -> api.facilities.GetProductsRequest
-	7,  // 37: api.facilities.FacilitiesService.GetPricing:input_type -> api.facilities.GetPricingRequest
-	26, // 38: api.facilities.FacilitiesService.GetAllFacilities:output_type -> api.facilities.GetAllFacilitiesResponse
-	21, // 39: api.facilities.FacilitiesService.GetAllBuildings:output_type -> api.facilities.GetAllBuildingsResponse
-	37, // 40: api.facilities.FacilitiesService.GetFacility:output_type -> api.facilities.FullFacility
-	15, // 41: api.facilities.FacilitiesService.GetEventsByFacility:output_type -> api.facilities.GetEventsByFacilityResponse
-	17, // 42: api.facilities.FacilitiesService.GetEventsByBuilding:output_type -> api.facilities.GetEventsByBuildingResponse
-	19, // 43: api.facilities.FacilitiesService.GetAllEvents:output_type -> api.facilities.GetAllEventsResponse
-	27, // 44: api.facilities.FacilitiesService.GetFacilityCategories:output_type -> api.facilities.GetFacilityCategoriesResponse
-	28, // 45: api.facilities.FacilitiesService.GetBuildingFacilities:output_type -> api.facilities.GetBuildingFacilitiesResponse
-	34, // 46: api.facilities.FacilitiesService.CreateFacility:output_type -> api.facilities.CreateFacilityResponse
-	35, // 47: api.facilities.FacilitiesService.UpdateFacility:output_type -> api.facilities.UpdateFacilityResponse
-	32, // 48: api.facilities.FacilitiesService.DeleteFacility:output_type -> api.facilities.DeleteFacilityResponse
-	4,  // 49: api.facilities.FacilitiesService.UpdateFacilityCategory:output_type -> api.facilities.Category
-	9,  // 50: api.facilities.FacilitiesService.GetCategories:output_type -> api.facilities.GetCategoriesResponse
-	4,  // 51: api.facilities.FacilitiesService.GetCategory:output_type -> api.facilities.Category
-	12, // 52: api.facilities.FacilitiesService.GetAllCoords:output_type -> api.facilities.GetAllCoordsResponse
-	40, // 53: api.facilities.FacilitiesService.GetProducts:output_type -> api.facilities.GetProductsResponse
-	36, // 54: api.facilities.FacilitiesService.GetPricing:output_type -> api.facilities.PricingWithCategory
-	38, // [38:55] is the sub-list for method output_type
-	21, // [21:38] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	9,  // 3: api.facilities.BuildingWithEvents.events:type_name -> api.facilities.Event
+	6,  // 4: api.facilities.FormField.condition:type_name -> api.facilities.FormFieldCondition
+	4,  // 5: api.facilities.GetCategoriesResponse.categories:type_name -> api.facilities.Category
+	13, // 6: api.facilities.GetAllCoordsResponse.data:type_name -> api.facilities.coords
+	9,  // 7: api.facilities.GetEventsByFacilityResponse.events:type_name -> api.facilities.Event
+	9,  // 8: api.facilities.GetEventsByBuildingResponse.events:type_name -> api.facilities.Event
+	3,  // 9: api.facilities.GetAllEventsResponse.data:type_name -> api.facilities.BuildingWithEvents
+	1,  // 10: api.facilities.GetAllBuildingsResponse.buildings:type_name -> api.facilities.Building
+	2,  // 11: api.facilities.GetAllFacilitiesResponse.buildings:type_name -> api.facilities.BuildingWithFacilities
+	4,  // 12: api.facilities.GetFacilityCategoriesResponse.categories:type_name -> api.facilities.Category
+	2,  // 13: api.facilities.GetBuildingFacilitiesResponse.building:type_name -> api.facilities.BuildingWithFacilities
+	0,  // 14: api.facilities.CreateFacilityRequest.facility:type_name -> api.facilities.Facility
+	0,  // 15: api.facilities.UpdateFacilityRequest.facility:type_name -> api.facilities.Facility
+	4,  // 16: api.facilities.UpdateFacilityCategoryRequest.category:type_name -> api.facilities.Category
+	0,  // 17: api.facilities.FullFacility.facility:type_name -> api.facilities.Facility
+	39, // 18: api.facilities.FullFacility.pricing:type_name -> api.facilities.PricingWithCategory
+	1,  // 19: api.facilities.FullFacility.building:type_name -> api.facilities.Building
+	39, // 20: api.facilities.ProductWithPricing.pricing:type_name -> api.facilities.PricingWithCategory
+	42, // 21: api.facilities.GetProductsResponse.data:type_name -> api.facilities.ProductWithPricing
+	7,  // 22: api.facilities.GetFormFieldsResponse.fields:type_name -> api.facilities.FormField
+	7,  // 23: api.facilities.CreateFormFieldRequest.field:type_name -> api.facilities.FormField
+	7,  // 24: api.facilities.UpdateFormFieldRequest.field:type_name -> api.facilities.FormField
+	8,  // 25: api.facilities.GetEquipmentResponse.equipment:type_name -> api.facilities.Equipment
+	8,  // 26: api.facilities.CreateEquipmentRequest.equipment:type_name -> api.facilities.Equipment
+	8,  // 27: api.facilities.UpdateEquipmentRequest.equipment:type_name -> api.facilities.Equipment
+	25, // 28: api.facilities.FacilitiesService.GetAllFacilities:input_type -> api.facilities.GetAllFacilitiesRequest
+	23, // 29: api.facilities.FacilitiesService.GetAllBuildings:input_type -> api.facilities.GetAllBuildingsRequest
+	26, // 30: api.facilities.FacilitiesService.GetFacility:input_type -> api.facilities.GetFacilityRequest
+	17, // 31: api.facilities.FacilitiesService.GetEventsByFacility:input_type -> api.facilities.GetEventsByFacilityRequest
+	19, // 32: api.facilities.FacilitiesService.GetEventsByBuilding:input_type -> api.facilities.GetEventsByBuildingRequest
+	21, // 33: api.facilities.FacilitiesService.GetAllEvents:input_type -> api.facilities.GetAllEventsRequest
+	27, // 34: api.facilities.FacilitiesService.GetFacilityCategories:input_type -> api.facilities.GetFacilityCategoriesRequest
+	28, // 35: api.facilities.FacilitiesService.GetBuildingFacilities:input_type -> api.facilities.GetBuildingFacilitiesRequest
+	32, // 36: api.facilities.FacilitiesService.CreateFacility:input_type -> api.facilities.CreateFacilityRequest
+	33, // 37: api.facilities.FacilitiesService.UpdateFacility:input_type -> api.facilities.UpdateFacilityRequest
+	34, // 38: api.facilities.FacilitiesService.DeleteFacility:input_type -> api.facilities.DeleteFacilityRequest
+	36, // 39: api.facilities.FacilitiesService.UpdateFacilityCategory:input_type -> api.facilities.UpdateFacilityCategoryRequest
+	11, // 40: api.facilities.FacilitiesService.GetCategories:input_type -> api.facilities.GetCategoriesRequest
+	16, // 41: api.facilities.FacilitiesService.GetCategory:input_type -> api.facilities.GetCategoryRequest
+	14, // 42: api.facilities.FacilitiesService.GetAllCoords:input_type -> api.facilities.GetAllCoordsRequest
+	41, // 43: api.facilities.FacilitiesService.GetProducts:input_type -> api.facilities.GetProductsRequest
+	10, // 44: api.facilities.FacilitiesService.GetPricing:input_type -> api.facilities.GetPricingRequest
+	44, // 45: api.facilities.FacilitiesService.GetFormFields:input_type -> api.facilities.GetFormFieldsRequest
+	46, // 46: api.facilities.FacilitiesService.CreateFormField:input_type -> api.facilities.CreateFormFieldRequest
+	47, // 47: api.facilities.FacilitiesService.UpdateFormField:input_type -> api.facilities.UpdateFormFieldRequest
+	48, // 48: api.facilities.FacilitiesService.DeleteFormField:input_type -> api.facilities.DeleteFormFieldRequest
+	50, // 49: api.facilities.FacilitiesService.GetEquipment:input_type -> api.facilities.GetEquipmentRequest
+	52, // 50: api.facilities.FacilitiesService.CreateEquipment:input_type -> api.facilities.CreateEquipmentRequest
+	53, // 51: api.facilities.FacilitiesService.UpdateEquipment:input_type -> api.facilities.UpdateEquipmentRequest
+	54, // 52: api.facilities.FacilitiesService.DeleteEquipment:input_type -> api.facilities.DeleteEquipmentRequest
+	56, // 53: api.facilities.FacilitiesService.SetBuildingCalendarProvider:input_type -> api.facilities.SetBuildingCalendarProviderRequest
+	57, // 54: api.facilities.FacilitiesService.ResyncBuildingCalendar:input_type -> api.facilities.ResyncBuildingCalendarRequest
+	29, // 55: api.facilities.FacilitiesService.GetAllFacilities:output_type -> api.facilities.GetAllFacilitiesResponse
+	24, // 56: api.facilities.FacilitiesService.GetAllBuildings:output_type -> api.facilities.GetAllBuildingsResponse
+	40, // 57: api.facilities.FacilitiesService.GetFacility:output_type -> api.facilities.FullFacility
+	18, // 58: api.facilities.FacilitiesService.GetEventsByFacility:output_type -> api.facilities.GetEventsByFacilityResponse
+	20, // 59: api.facilities.FacilitiesService.GetEventsByBuilding:output_type -> api.facilities.GetEventsByBuildingResponse
+	22, // 60: api.facilities.FacilitiesService.GetAllEvents:output_type -> api.facilities.GetAllEventsResponse
+	30, // 61: api.facilities.FacilitiesService.GetFacilityCategories:output_type -> api.facilities.GetFacilityCategoriesResponse
+	31, // 62: api.facilities.FacilitiesService.GetBuildingFacilities:output_type -> api.facilities.GetBuildingFacilitiesResponse
+	37, // 63: api.facilities.FacilitiesService.CreateFacility:output_type -> api.facilities.CreateFacilityResponse
+	38, // 64: api.facilities.FacilitiesService.UpdateFacility:output_type -> api.facilities.UpdateFacilityResponse
+	35, // 65: api.facilities.FacilitiesService.DeleteFacility:output_type -> api.facilities.DeleteFacilityResponse
+	4,  // 66: api.facilities.FacilitiesService.UpdateFacilityCategory:output_type -> api.facilities.Category
+	12, // 67: api.facilities.FacilitiesService.GetCategories:output_type -> api.facilities.GetCategoriesResponse
+	4,  // 68: api.facilities.FacilitiesService.GetCategory:output_type -> api.facilities.Category
+	15, // 69: api.facilities.FacilitiesService.GetAllCoords:output_type -> api.facilities.GetAllCoordsResponse
+	43, // 70: api.facilities.FacilitiesService.GetProducts:output_type -> api.facilities.GetProductsResponse
+	39, // 71: api.facilities.FacilitiesService.GetPricing:output_type -> api.facilities.PricingWithCategory
+	45, // 72: api.facilities.FacilitiesService.GetFormFields:output_type -> api.facilities.GetFormFieldsResponse
+	7,  // 73: api.facilities.FacilitiesService.CreateFormField:output_type -> api.facilities.FormField
+	7,  // 74: api.facilities.FacilitiesService.UpdateFormField:output_type -> api.facilities.FormField
+	49, // 75: api.facilities.FacilitiesService.DeleteFormField:output_type -> api.facilities.DeleteFormFieldResponse
+	51, // 76: api.facilities.FacilitiesService.GetEquipment:output_type -> api.facilities.GetEquipmentResponse
+	8,  // 77: api.facilities.FacilitiesService.CreateEquipment:output_type -> api.facilities.Equipment
+	8,  // 78: api.facilities.FacilitiesService.UpdateEquipment:output_type -> api.facilities.Equipment
+	55, // 79: api.facilities.FacilitiesService.DeleteEquipment:output_type -> api.facilities.DeleteEquipmentResponse
+	1,  // 80: api.facilities.FacilitiesService.SetBuildingCalendarProvider:output_type -> api.facilities.Building
+	58, // 81: api.facilities.FacilitiesService.ResyncBuildingCalendar:output_type -> api.facilities.ResyncBuildingCalendarResponse
+	55, // [55:82] is the sub-list for method output_type
+	28, // [28:55] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_proto_facilities_facilities_proto_init() }
@@ -2381,7 +3601,7 @@ func file_proto_facilities_facilities_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_facilities_facilities_proto_rawDesc), len(file_proto_facilities_facilities_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   41,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
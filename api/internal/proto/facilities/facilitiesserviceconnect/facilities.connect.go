@@ -84,6 +84,36 @@ const (
 	// FacilitiesServiceGetPricingProcedure is the fully-qualified name of the FacilitiesService's
 	// GetPricing RPC.
 	FacilitiesServiceGetPricingProcedure = "/api.facilities.FacilitiesService/GetPricing"
+	// FacilitiesServiceGetFormFieldsProcedure is the fully-qualified name of the FacilitiesService's
+	// GetFormFields RPC.
+	FacilitiesServiceGetFormFieldsProcedure = "/api.facilities.FacilitiesService/GetFormFields"
+	// FacilitiesServiceCreateFormFieldProcedure is the fully-qualified name of the FacilitiesService's
+	// CreateFormField RPC.
+	FacilitiesServiceCreateFormFieldProcedure = "/api.facilities.FacilitiesService/CreateFormField"
+	// FacilitiesServiceUpdateFormFieldProcedure is the fully-qualified name of the FacilitiesService's
+	// UpdateFormField RPC.
+	FacilitiesServiceUpdateFormFieldProcedure = "/api.facilities.FacilitiesService/UpdateFormField"
+	// FacilitiesServiceDeleteFormFieldProcedure is the fully-qualified name of the FacilitiesService's
+	// DeleteFormField RPC.
+	FacilitiesServiceDeleteFormFieldProcedure = "/api.facilities.FacilitiesService/DeleteFormField"
+	// FacilitiesServiceGetEquipmentProcedure is the fully-qualified name of the FacilitiesService's
+	// GetEquipment RPC.
+	FacilitiesServiceGetEquipmentProcedure = "/api.facilities.FacilitiesService/GetEquipment"
+	// FacilitiesServiceCreateEquipmentProcedure is the fully-qualified name of the FacilitiesService's
+	// CreateEquipment RPC.
+	FacilitiesServiceCreateEquipmentProcedure = "/api.facilities.FacilitiesService/CreateEquipment"
+	// FacilitiesServiceUpdateEquipmentProcedure is the fully-qualified name of the FacilitiesService's
+	// UpdateEquipment RPC.
+	FacilitiesServiceUpdateEquipmentProcedure = "/api.facilities.FacilitiesService/UpdateEquipment"
+	// FacilitiesServiceDeleteEquipmentProcedure is the fully-qualified name of the FacilitiesService's
+	// DeleteEquipment RPC.
+	FacilitiesServiceDeleteEquipmentProcedure = "/api.facilities.FacilitiesService/DeleteEquipment"
+	// FacilitiesServiceSetBuildingCalendarProviderProcedure is the fully-qualified name of the
+	// FacilitiesService's SetBuildingCalendarProvider RPC.
+	FacilitiesServiceSetBuildingCalendarProviderProcedure = "/api.facilities.FacilitiesService/SetBuildingCalendarProvider"
+	// FacilitiesServiceResyncBuildingCalendarProcedure is the fully-qualified name of the
+	// FacilitiesService's ResyncBuildingCalendar RPC.
+	FacilitiesServiceResyncBuildingCalendarProcedure = "/api.facilities.FacilitiesService/ResyncBuildingCalendar"
 )
 
 // FacilitiesServiceClient is a client for the api.facilities.FacilitiesService service.
@@ -105,6 +135,16 @@ type FacilitiesServiceClient interface {
 	GetAllCoords(context.Context, *connect.Request[facilities.GetAllCoordsRequest]) (*connect.Response[facilities.GetAllCoordsResponse], error)
 	GetProducts(context.Context, *connect.Request[facilities.GetProductsRequest]) (*connect.Response[facilities.GetProductsResponse], error)
 	GetPricing(context.Context, *connect.Request[facilities.GetPricingRequest]) (*connect.Response[facilities.PricingWithCategory], error)
+	GetFormFields(context.Context, *connect.Request[facilities.GetFormFieldsRequest]) (*connect.Response[facilities.GetFormFieldsResponse], error)
+	CreateFormField(context.Context, *connect.Request[facilities.CreateFormFieldRequest]) (*connect.Response[facilities.FormField], error)
+	UpdateFormField(context.Context, *connect.Request[facilities.UpdateFormFieldRequest]) (*connect.Response[facilities.FormField], error)
+	DeleteFormField(context.Context, *connect.Request[facilities.DeleteFormFieldRequest]) (*connect.Response[facilities.DeleteFormFieldResponse], error)
+	GetEquipment(context.Context, *connect.Request[facilities.GetEquipmentRequest]) (*connect.Response[facilities.GetEquipmentResponse], error)
+	CreateEquipment(context.Context, *connect.Request[facilities.CreateEquipmentRequest]) (*connect.Response[facilities.Equipment], error)
+	UpdateEquipment(context.Context, *connect.Request[facilities.UpdateEquipmentRequest]) (*connect.Response[facilities.Equipment], error)
+	DeleteEquipment(context.Context, *connect.Request[facilities.DeleteEquipmentRequest]) (*connect.Response[facilities.DeleteEquipmentResponse], error)
+	SetBuildingCalendarProvider(context.Context, *connect.Request[facilities.SetBuildingCalendarProviderRequest]) (*connect.Response[facilities.Building], error)
+	ResyncBuildingCalendar(context.Context, *connect.Request[facilities.ResyncBuildingCalendarRequest]) (*connect.Response[facilities.ResyncBuildingCalendarResponse], error)
 }
 
 // NewFacilitiesServiceClient constructs a client for the api.facilities.FacilitiesService service.
@@ -233,28 +273,100 @@ func NewFacilitiesServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		getFormFields: connect.NewClient[facilities.GetFormFieldsRequest, facilities.GetFormFieldsResponse](
+			httpClient,
+			baseURL+FacilitiesServiceGetFormFieldsProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("GetFormFields")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createFormField: connect.NewClient[facilities.CreateFormFieldRequest, facilities.FormField](
+			httpClient,
+			baseURL+FacilitiesServiceCreateFormFieldProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("CreateFormField")),
+			connect.WithClientOptions(opts...),
+		),
+		updateFormField: connect.NewClient[facilities.UpdateFormFieldRequest, facilities.FormField](
+			httpClient,
+			baseURL+FacilitiesServiceUpdateFormFieldProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("UpdateFormField")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFormField: connect.NewClient[facilities.DeleteFormFieldRequest, facilities.DeleteFormFieldResponse](
+			httpClient,
+			baseURL+FacilitiesServiceDeleteFormFieldProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("DeleteFormField")),
+			connect.WithClientOptions(opts...),
+		),
+		getEquipment: connect.NewClient[facilities.GetEquipmentRequest, facilities.GetEquipmentResponse](
+			httpClient,
+			baseURL+FacilitiesServiceGetEquipmentProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("GetEquipment")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createEquipment: connect.NewClient[facilities.CreateEquipmentRequest, facilities.Equipment](
+			httpClient,
+			baseURL+FacilitiesServiceCreateEquipmentProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("CreateEquipment")),
+			connect.WithClientOptions(opts...),
+		),
+		updateEquipment: connect.NewClient[facilities.UpdateEquipmentRequest, facilities.Equipment](
+			httpClient,
+			baseURL+FacilitiesServiceUpdateEquipmentProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("UpdateEquipment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteEquipment: connect.NewClient[facilities.DeleteEquipmentRequest, facilities.DeleteEquipmentResponse](
+			httpClient,
+			baseURL+FacilitiesServiceDeleteEquipmentProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("DeleteEquipment")),
+			connect.WithClientOptions(opts...),
+		),
+		setBuildingCalendarProvider: connect.NewClient[facilities.SetBuildingCalendarProviderRequest, facilities.Building](
+			httpClient,
+			baseURL+FacilitiesServiceSetBuildingCalendarProviderProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("SetBuildingCalendarProvider")),
+			connect.WithClientOptions(opts...),
+		),
+		resyncBuildingCalendar: connect.NewClient[facilities.ResyncBuildingCalendarRequest, facilities.ResyncBuildingCalendarResponse](
+			httpClient,
+			baseURL+FacilitiesServiceResyncBuildingCalendarProcedure,
+			connect.WithSchema(facilitiesServiceMethods.ByName("ResyncBuildingCalendar")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // facilitiesServiceClient implements FacilitiesServiceClient.
 type facilitiesServiceClient struct {
-	getAllFacilities       *connect.Client[facilities.GetAllFacilitiesRequest, facilities.GetAllFacilitiesResponse]
-	getAllBuildings        *connect.Client[facilities.GetAllBuildingsRequest, facilities.GetAllBuildingsResponse]
-	getFacility            *connect.Client[facilities.GetFacilityRequest, facilities.FullFacility]
-	getEventsByFacility    *connect.Client[facilities.GetEventsByFacilityRequest, facilities.GetEventsByFacilityResponse]
-	getEventsByBuilding    *connect.Client[facilities.GetEventsByBuildingRequest, facilities.GetEventsByBuildingResponse]
-	getAllEvents           *connect.Client[facilities.GetAllEventsRequest, facilities.GetAllEventsResponse]
-	getFacilityCategories  *connect.Client[facilities.GetFacilityCategoriesRequest, facilities.GetFacilityCategoriesResponse]
-	getBuildingFacilities  *connect.Client[facilities.GetBuildingFacilitiesRequest, facilities.GetBuildingFacilitiesResponse]
-	createFacility         *connect.Client[facilities.CreateFacilityRequest, facilities.CreateFacilityResponse]
-	updateFacility         *connect.Client[facilities.UpdateFacilityRequest, facilities.UpdateFacilityResponse]
-	deleteFacility         *connect.Client[facilities.DeleteFacilityRequest, facilities.DeleteFacilityResponse]
-	updateFacilityCategory *connect.Client[facilities.UpdateFacilityCategoryRequest, facilities.Category]
-	getCategories          *connect.Client[facilities.GetCategoriesRequest, facilities.GetCategoriesResponse]
-	getCategory            *connect.Client[facilities.GetCategoryRequest, facilities.Category]
-	getAllCoords           *connect.Client[facilities.GetAllCoordsRequest, facilities.GetAllCoordsResponse]
-	getProducts            *connect.Client[facilities.GetProductsRequest, facilities.GetProductsResponse]
-	getPricing             *connect.Client[facilities.GetPricingRequest, facilities.PricingWithCategory]
+	getAllFacilities            *connect.Client[facilities.GetAllFacilitiesRequest, facilities.GetAllFacilitiesResponse]
+	getAllBuildings             *connect.Client[facilities.GetAllBuildingsRequest, facilities.GetAllBuildingsResponse]
+	getFacility                 *connect.Client[facilities.GetFacilityRequest, facilities.FullFacility]
+	getEventsByFacility         *connect.Client[facilities.GetEventsByFacilityRequest, facilities.GetEventsByFacilityResponse]
+	getEventsByBuilding         *connect.Client[facilities.GetEventsByBuildingRequest, facilities.GetEventsByBuildingResponse]
+	getAllEvents                *connect.Client[facilities.GetAllEventsRequest, facilities.GetAllEventsResponse]
+	getFacilityCategories       *connect.Client[facilities.GetFacilityCategoriesRequest, facilities.GetFacilityCategoriesResponse]
+	getBuildingFacilities       *connect.Client[facilities.GetBuildingFacilitiesRequest, facilities.GetBuildingFacilitiesResponse]
+	createFacility              *connect.Client[facilities.CreateFacilityRequest, facilities.CreateFacilityResponse]
+	updateFacility              *connect.Client[facilities.UpdateFacilityRequest, facilities.UpdateFacilityResponse]
+	deleteFacility              *connect.Client[facilities.DeleteFacilityRequest, facilities.DeleteFacilityResponse]
+	updateFacilityCategory      *connect.Client[facilities.UpdateFacilityCategoryRequest, facilities.Category]
+	getCategories               *connect.Client[facilities.GetCategoriesRequest, facilities.GetCategoriesResponse]
+	getCategory                 *connect.Client[facilities.GetCategoryRequest, facilities.Category]
+	getAllCoords                *connect.Client[facilities.GetAllCoordsRequest, facilities.GetAllCoordsResponse]
+	getProducts                 *connect.Client[facilities.GetProductsRequest, facilities.GetProductsResponse]
+	getPricing                  *connect.Client[facilities.GetPricingRequest, facilities.PricingWithCategory]
+	getFormFields               *connect.Client[facilities.GetFormFieldsRequest, facilities.GetFormFieldsResponse]
+	createFormField             *connect.Client[facilities.CreateFormFieldRequest, facilities.FormField]
+	updateFormField             *connect.Client[facilities.UpdateFormFieldRequest, facilities.FormField]
+	deleteFormField             *connect.Client[facilities.DeleteFormFieldRequest, facilities.DeleteFormFieldResponse]
+	getEquipment                *connect.Client[facilities.GetEquipmentRequest, facilities.GetEquipmentResponse]
+	createEquipment             *connect.Client[facilities.CreateEquipmentRequest, facilities.Equipment]
+	updateEquipment             *connect.Client[facilities.UpdateEquipmentRequest, facilities.Equipment]
+	deleteEquipment             *connect.Client[facilities.DeleteEquipmentRequest, facilities.DeleteEquipmentResponse]
+	setBuildingCalendarProvider *connect.Client[facilities.SetBuildingCalendarProviderRequest, facilities.Building]
+	resyncBuildingCalendar      *connect.Client[facilities.ResyncBuildingCalendarRequest, facilities.ResyncBuildingCalendarResponse]
 }
 
 // GetAllFacilities calls api.facilities.FacilitiesService.GetAllFacilities.
@@ -342,6 +454,56 @@ func (c *facilitiesServiceClient) GetPricing(ctx context.Context, req *connect.R
 	return c.getPricing.CallUnary(ctx, req)
 }
 
+// GetFormFields calls api.facilities.FacilitiesService.GetFormFields.
+func (c *facilitiesServiceClient) GetFormFields(ctx context.Context, req *connect.Request[facilities.GetFormFieldsRequest]) (*connect.Response[facilities.GetFormFieldsResponse], error) {
+	return c.getFormFields.CallUnary(ctx, req)
+}
+
+// CreateFormField calls api.facilities.FacilitiesService.CreateFormField.
+func (c *facilitiesServiceClient) CreateFormField(ctx context.Context, req *connect.Request[facilities.CreateFormFieldRequest]) (*connect.Response[facilities.FormField], error) {
+	return c.createFormField.CallUnary(ctx, req)
+}
+
+// UpdateFormField calls api.facilities.FacilitiesService.UpdateFormField.
+func (c *facilitiesServiceClient) UpdateFormField(ctx context.Context, req *connect.Request[facilities.UpdateFormFieldRequest]) (*connect.Response[facilities.FormField], error) {
+	return c.updateFormField.CallUnary(ctx, req)
+}
+
+// DeleteFormField calls api.facilities.FacilitiesService.DeleteFormField.
+func (c *facilitiesServiceClient) DeleteFormField(ctx context.Context, req *connect.Request[facilities.DeleteFormFieldRequest]) (*connect.Response[facilities.DeleteFormFieldResponse], error) {
+	return c.deleteFormField.CallUnary(ctx, req)
+}
+
+// GetEquipment calls api.facilities.FacilitiesService.GetEquipment.
+func (c *facilitiesServiceClient) GetEquipment(ctx context.Context, req *connect.Request[facilities.GetEquipmentRequest]) (*connect.Response[facilities.GetEquipmentResponse], error) {
+	return c.getEquipment.CallUnary(ctx, req)
+}
+
+// CreateEquipment calls api.facilities.FacilitiesService.CreateEquipment.
+func (c *facilitiesServiceClient) CreateEquipment(ctx context.Context, req *connect.Request[facilities.CreateEquipmentRequest]) (*connect.Response[facilities.Equipment], error) {
+	return c.createEquipment.CallUnary(ctx, req)
+}
+
+// UpdateEquipment calls api.facilities.FacilitiesService.UpdateEquipment.
+func (c *facilitiesServiceClient) UpdateEquipment(ctx context.Context, req *connect.Request[facilities.UpdateEquipmentRequest]) (*connect.Response[facilities.Equipment], error) {
+	return c.updateEquipment.CallUnary(ctx, req)
+}
+
+// DeleteEquipment calls api.facilities.FacilitiesService.DeleteEquipment.
+func (c *facilitiesServiceClient) DeleteEquipment(ctx context.Context, req *connect.Request[facilities.DeleteEquipmentRequest]) (*connect.Response[facilities.DeleteEquipmentResponse], error) {
+	return c.deleteEquipment.CallUnary(ctx, req)
+}
+
+// SetBuildingCalendarProvider calls api.facilities.FacilitiesService.SetBuildingCalendarProvider.
+func (c *facilitiesServiceClient) SetBuildingCalendarProvider(ctx context.Context, req *connect.Request[facilities.SetBuildingCalendarProviderRequest]) (*connect.Response[facilities.Building], error) {
+	return c.setBuildingCalendarProvider.CallUnary(ctx, req)
+}
+
+// ResyncBuildingCalendar calls api.facilities.FacilitiesService.ResyncBuildingCalendar.
+func (c *facilitiesServiceClient) ResyncBuildingCalendar(ctx context.Context, req *connect.Request[facilities.ResyncBuildingCalendarRequest]) (*connect.Response[facilities.ResyncBuildingCalendarResponse], error) {
+	return c.resyncBuildingCalendar.CallUnary(ctx, req)
+}
+
 // FacilitiesServiceHandler is an implementation of the api.facilities.FacilitiesService service.
 type FacilitiesServiceHandler interface {
 	GetAllFacilities(context.Context, *connect.Request[facilities.GetAllFacilitiesRequest]) (*connect.Response[facilities.GetAllFacilitiesResponse], error)
@@ -361,6 +523,16 @@ type FacilitiesServiceHandler interface {
 	GetAllCoords(context.Context, *connect.Request[facilities.GetAllCoordsRequest]) (*connect.Response[facilities.GetAllCoordsResponse], error)
 	GetProducts(context.Context, *connect.Request[facilities.GetProductsRequest]) (*connect.Response[facilities.GetProductsResponse], error)
 	GetPricing(context.Context, *connect.Request[facilities.GetPricingRequest]) (*connect.Response[facilities.PricingWithCategory], error)
+	GetFormFields(context.Context, *connect.Request[facilities.GetFormFieldsRequest]) (*connect.Response[facilities.GetFormFieldsResponse], error)
+	CreateFormField(context.Context, *connect.Request[facilities.CreateFormFieldRequest]) (*connect.Response[facilities.FormField], error)
+	UpdateFormField(context.Context, *connect.Request[facilities.UpdateFormFieldRequest]) (*connect.Response[facilities.FormField], error)
+	DeleteFormField(context.Context, *connect.Request[facilities.DeleteFormFieldRequest]) (*connect.Response[facilities.DeleteFormFieldResponse], error)
+	GetEquipment(context.Context, *connect.Request[facilities.GetEquipmentRequest]) (*connect.Response[facilities.GetEquipmentResponse], error)
+	CreateEquipment(context.Context, *connect.Request[facilities.CreateEquipmentRequest]) (*connect.Response[facilities.Equipment], error)
+	UpdateEquipment(context.Context, *connect.Request[facilities.UpdateEquipmentRequest]) (*connect.Response[facilities.Equipment], error)
+	DeleteEquipment(context.Context, *connect.Request[facilities.DeleteEquipmentRequest]) (*connect.Response[facilities.DeleteEquipmentResponse], error)
+	SetBuildingCalendarProvider(context.Context, *connect.Request[facilities.SetBuildingCalendarProviderRequest]) (*connect.Response[facilities.Building], error)
+	ResyncBuildingCalendar(context.Context, *connect.Request[facilities.ResyncBuildingCalendarRequest]) (*connect.Response[facilities.ResyncBuildingCalendarResponse], error)
 }
 
 // NewFacilitiesServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -485,6 +657,68 @@ func NewFacilitiesServiceHandler(svc FacilitiesServiceHandler, opts ...connect.H
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	facilitiesServiceGetFormFieldsHandler := connect.NewUnaryHandler(
+		FacilitiesServiceGetFormFieldsProcedure,
+		svc.GetFormFields,
+		connect.WithSchema(facilitiesServiceMethods.ByName("GetFormFields")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	facilitiesServiceCreateFormFieldHandler := connect.NewUnaryHandler(
+		FacilitiesServiceCreateFormFieldProcedure,
+		svc.CreateFormField,
+		connect.WithSchema(facilitiesServiceMethods.ByName("CreateFormField")),
+		connect.WithHandlerOptions(opts...),
+	)
+	facilitiesServiceUpdateFormFieldHandler := connect.NewUnaryHandler(
+		FacilitiesServiceUpdateFormFieldProcedure,
+		svc.UpdateFormField,
+		connect.WithSchema(facilitiesServiceMethods.ByName("UpdateFormField")),
+		connect.WithHandlerOptions(opts...),
+	)
+	facilitiesServiceDeleteFormFieldHandler := connect.NewUnaryHandler(
+		FacilitiesServiceDeleteFormFieldProcedure,
+		svc.DeleteFormField,
+		connect.WithSchema(facilitiesServiceMethods.ByName("DeleteFormField")),
+		connect.WithHandlerOptions(opts...),
+	)
+	facilitiesServiceGetEquipmentHandler := connect.NewUnaryHandler(
+		FacilitiesServiceGetEquipmentProcedure,
+		svc.GetEquipment,
+		connect.WithSchema(facilitiesServiceMethods.ByName("GetEquipment")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	facilitiesServiceCreateEquipmentHandler := connect.NewUnaryHandler(
+		FacilitiesServiceCreateEquipmentProcedure,
+		svc.CreateEquipment,
+		connect.WithSchema(facilitiesServiceMethods.ByName("CreateEquipment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	facilitiesServiceUpdateEquipmentHandler := connect.NewUnaryHandler(
+		FacilitiesServiceUpdateEquipmentProcedure,
+		svc.UpdateEquipment,
+		connect.WithSchema(facilitiesServiceMethods.ByName("UpdateEquipment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	facilitiesServiceDeleteEquipmentHandler := connect.NewUnaryHandler(
+		FacilitiesServiceDeleteEquipmentProcedure,
+		svc.DeleteEquipment,
+		connect.WithSchema(facilitiesServiceMethods.ByName("DeleteEquipment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	facilitiesServiceSetBuildingCalendarProviderHandler := connect.NewUnaryHandler(
+		FacilitiesServiceSetBuildingCalendarProviderProcedure,
+		svc.SetBuildingCalendarProvider,
+		connect.WithSchema(facilitiesServiceMethods.ByName("SetBuildingCalendarProvider")),
+		connect.WithHandlerOptions(opts...),
+	)
+	facilitiesServiceResyncBuildingCalendarHandler := connect.NewUnaryHandler(
+		FacilitiesServiceResyncBuildingCalendarProcedure,
+		svc.ResyncBuildingCalendar,
+		connect.WithSchema(facilitiesServiceMethods.ByName("ResyncBuildingCalendar")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.facilities.FacilitiesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FacilitiesServiceGetAllFacilitiesProcedure:
@@ -521,6 +755,26 @@ func NewFacilitiesServiceHandler(svc FacilitiesServiceHandler, opts ...connect.H
 			facilitiesServiceGetProductsHandler.ServeHTTP(w, r)
 		case FacilitiesServiceGetPricingProcedure:
 			facilitiesServiceGetPricingHandler.ServeHTTP(w, r)
+		case FacilitiesServiceGetFormFieldsProcedure:
+			facilitiesServiceGetFormFieldsHandler.ServeHTTP(w, r)
+		case FacilitiesServiceCreateFormFieldProcedure:
+			facilitiesServiceCreateFormFieldHandler.ServeHTTP(w, r)
+		case FacilitiesServiceUpdateFormFieldProcedure:
+			facilitiesServiceUpdateFormFieldHandler.ServeHTTP(w, r)
+		case FacilitiesServiceDeleteFormFieldProcedure:
+			facilitiesServiceDeleteFormFieldHandler.ServeHTTP(w, r)
+		case FacilitiesServiceGetEquipmentProcedure:
+			facilitiesServiceGetEquipmentHandler.ServeHTTP(w, r)
+		case FacilitiesServiceCreateEquipmentProcedure:
+			facilitiesServiceCreateEquipmentHandler.ServeHTTP(w, r)
+		case FacilitiesServiceUpdateEquipmentProcedure:
+			facilitiesServiceUpdateEquipmentHandler.ServeHTTP(w, r)
+		case FacilitiesServiceDeleteEquipmentProcedure:
+			facilitiesServiceDeleteEquipmentHandler.ServeHTTP(w, r)
+		case FacilitiesServiceSetBuildingCalendarProviderProcedure:
+			facilitiesServiceSetBuildingCalendarProviderHandler.ServeHTTP(w, r)
+		case FacilitiesServiceResyncBuildingCalendarProcedure:
+			facilitiesServiceResyncBuildingCalendarHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -597,3 +851,43 @@ func (UnimplementedFacilitiesServiceHandler) GetProducts(context.Context, *conne
 func (UnimplementedFacilitiesServiceHandler) GetPricing(context.Context, *connect.Request[facilities.GetPricingRequest]) (*connect.Response[facilities.PricingWithCategory], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.GetPricing is not implemented"))
 }
+
+func (UnimplementedFacilitiesServiceHandler) GetFormFields(context.Context, *connect.Request[facilities.GetFormFieldsRequest]) (*connect.Response[facilities.GetFormFieldsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.GetFormFields is not implemented"))
+}
+
+func (UnimplementedFacilitiesServiceHandler) CreateFormField(context.Context, *connect.Request[facilities.CreateFormFieldRequest]) (*connect.Response[facilities.FormField], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.CreateFormField is not implemented"))
+}
+
+func (UnimplementedFacilitiesServiceHandler) UpdateFormField(context.Context, *connect.Request[facilities.UpdateFormFieldRequest]) (*connect.Response[facilities.FormField], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.UpdateFormField is not implemented"))
+}
+
+func (UnimplementedFacilitiesServiceHandler) DeleteFormField(context.Context, *connect.Request[facilities.DeleteFormFieldRequest]) (*connect.Response[facilities.DeleteFormFieldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.DeleteFormField is not implemented"))
+}
+
+func (UnimplementedFacilitiesServiceHandler) GetEquipment(context.Context, *connect.Request[facilities.GetEquipmentRequest]) (*connect.Response[facilities.GetEquipmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.GetEquipment is not implemented"))
+}
+
+func (UnimplementedFacilitiesServiceHandler) CreateEquipment(context.Context, *connect.Request[facilities.CreateEquipmentRequest]) (*connect.Response[facilities.Equipment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.CreateEquipment is not implemented"))
+}
+
+func (UnimplementedFacilitiesServiceHandler) UpdateEquipment(context.Context, *connect.Request[facilities.UpdateEquipmentRequest]) (*connect.Response[facilities.Equipment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.UpdateEquipment is not implemented"))
+}
+
+func (UnimplementedFacilitiesServiceHandler) DeleteEquipment(context.Context, *connect.Request[facilities.DeleteEquipmentRequest]) (*connect.Response[facilities.DeleteEquipmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.DeleteEquipment is not implemented"))
+}
+
+func (UnimplementedFacilitiesServiceHandler) SetBuildingCalendarProvider(context.Context, *connect.Request[facilities.SetBuildingCalendarProviderRequest]) (*connect.Response[facilities.Building], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.SetBuildingCalendarProvider is not implemented"))
+}
+
+func (UnimplementedFacilitiesServiceHandler) ResyncBuildingCalendar(context.Context, *connect.Request[facilities.ResyncBuildingCalendarRequest]) (*connect.Response[facilities.ResyncBuildingCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.facilities.FacilitiesService.ResyncBuildingCalendar is not implemented"))
+}
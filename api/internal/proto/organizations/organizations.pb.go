@@ -0,0 +1,1585 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: proto/organizations/organizations.proto
+
+package organizationsservice
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Organization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BillingName   string                 `protobuf:"bytes,3,opt,name=billing_name,json=billingName,proto3" json:"billing_name,omitempty"`
+	BillingEmail  string                 `protobuf:"bytes,4,opt,name=billing_email,json=billingEmail,proto3" json:"billing_email,omitempty"`
+	BillingPhone  string                 `protobuf:"bytes,5,opt,name=billing_phone,json=billingPhone,proto3" json:"billing_phone,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 string
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Organization) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetBillingName() string {
+	if x != nil {
+		return x.BillingName
+	}
+	return ""
+}
+
+func (x *Organization) GetBillingEmail() string {
+	if x != nil {
+		return x.BillingEmail
+	}
+	return ""
+}
+
+func (x *Organization) GetBillingPhone() string {
+	if x != nil {
+		return x.BillingPhone
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Organization) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type OrganizationMember struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId int64                  `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // owner | manager | member
+	UserName       string                 `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserEmail      string                 `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationMember) Reset() {
+	*x = OrganizationMember{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationMember) ProtoMessage() {}
+
+func (x *OrganizationMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationMember.ProtoReflect.Descriptor instead.
+func (*OrganizationMember) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrganizationMember) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrganizationMember) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *OrganizationMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+type OrganizationInsurance struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId int64                  `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	FilePath       string                 `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	FileName       string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ExpiresAt      string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // YYYY-MM-DD
+	CreatedAt      string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 string
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationInsurance) Reset() {
+	*x = OrganizationInsurance{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationInsurance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationInsurance) ProtoMessage() {}
+
+func (x *OrganizationInsurance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationInsurance.ProtoReflect.Descriptor instead.
+func (*OrganizationInsurance) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrganizationInsurance) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrganizationInsurance) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *OrganizationInsurance) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *OrganizationInsurance) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *OrganizationInsurance) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *OrganizationInsurance) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type FullOrganization struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Organization  *Organization            `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Members       []*OrganizationMember    `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Insurance     []*OrganizationInsurance `protobuf:"bytes,3,rep,name=insurance,proto3" json:"insurance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FullOrganization) Reset() {
+	*x = FullOrganization{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FullOrganization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FullOrganization) ProtoMessage() {}
+
+func (x *FullOrganization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FullOrganization.ProtoReflect.Descriptor instead.
+func (*FullOrganization) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FullOrganization) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *FullOrganization) GetMembers() []*OrganizationMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *FullOrganization) GetInsurance() []*OrganizationInsurance {
+	if x != nil {
+		return x.Insurance
+	}
+	return nil
+}
+
+type UserOrganization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOrganization) Reset() {
+	*x = UserOrganization{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOrganization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOrganization) ProtoMessage() {}
+
+func (x *UserOrganization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOrganization.ProtoReflect.Descriptor instead.
+func (*UserOrganization) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserOrganization) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *UserOrganization) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type OrganizationReservation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId   int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventName       string                 `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	FacilityName    string                 `protobuf:"bytes,3,opt,name=facility_name,json=facilityName,proto3" json:"facility_name,omitempty"`
+	Approved        string                 `protobuf:"bytes,4,opt,name=approved,proto3" json:"approved,omitempty"`
+	ReservationDate string                 `protobuf:"bytes,5,opt,name=reservation_date,json=reservationDate,proto3" json:"reservation_date,omitempty"`
+	UserName        string                 `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Paid            bool                   `protobuf:"varint,7,opt,name=paid,proto3" json:"paid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrganizationReservation) Reset() {
+	*x = OrganizationReservation{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationReservation) ProtoMessage() {}
+
+func (x *OrganizationReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationReservation.ProtoReflect.Descriptor instead.
+func (*OrganizationReservation) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrganizationReservation) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *OrganizationReservation) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *OrganizationReservation) GetFacilityName() string {
+	if x != nil {
+		return x.FacilityName
+	}
+	return ""
+}
+
+func (x *OrganizationReservation) GetApproved() string {
+	if x != nil {
+		return x.Approved
+	}
+	return ""
+}
+
+func (x *OrganizationReservation) GetReservationDate() string {
+	if x != nil {
+		return x.ReservationDate
+	}
+	return ""
+}
+
+func (x *OrganizationReservation) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *OrganizationReservation) GetPaid() bool {
+	if x != nil {
+		return x.Paid
+	}
+	return false
+}
+
+type FacilityUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FacilityId    int64                  `protobuf:"varint,1,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	FacilityName  string                 `protobuf:"bytes,2,opt,name=facility_name,json=facilityName,proto3" json:"facility_name,omitempty"`
+	Hours         float64                `protobuf:"fixed64,3,opt,name=hours,proto3" json:"hours,omitempty"`
+	Spent         string                 `protobuf:"bytes,4,opt,name=spent,proto3" json:"spent,omitempty"`
+	Reservations  int64                  `protobuf:"varint,5,opt,name=reservations,proto3" json:"reservations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacilityUsage) Reset() {
+	*x = FacilityUsage{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacilityUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacilityUsage) ProtoMessage() {}
+
+func (x *FacilityUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacilityUsage.ProtoReflect.Descriptor instead.
+func (*FacilityUsage) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FacilityUsage) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *FacilityUsage) GetFacilityName() string {
+	if x != nil {
+		return x.FacilityName
+	}
+	return ""
+}
+
+func (x *FacilityUsage) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *FacilityUsage) GetSpent() string {
+	if x != nil {
+		return x.Spent
+	}
+	return ""
+}
+
+func (x *FacilityUsage) GetReservations() int64 {
+	if x != nil {
+		return x.Reservations
+	}
+	return 0
+}
+
+type OrganizationReport struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId    int64                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TotalReservations int64                  `protobuf:"varint,2,opt,name=total_reservations,json=totalReservations,proto3" json:"total_reservations,omitempty"`
+	TotalHours        float64                `protobuf:"fixed64,3,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
+	TotalSpent        string                 `protobuf:"bytes,4,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	TotalPaid         string                 `protobuf:"bytes,5,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid,omitempty"`
+	Facilities        []*FacilityUsage       `protobuf:"bytes,6,rep,name=facilities,proto3" json:"facilities,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OrganizationReport) Reset() {
+	*x = OrganizationReport{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationReport) ProtoMessage() {}
+
+func (x *OrganizationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationReport.ProtoReflect.Descriptor instead.
+func (*OrganizationReport) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OrganizationReport) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *OrganizationReport) GetTotalReservations() int64 {
+	if x != nil {
+		return x.TotalReservations
+	}
+	return 0
+}
+
+func (x *OrganizationReport) GetTotalHours() float64 {
+	if x != nil {
+		return x.TotalHours
+	}
+	return 0
+}
+
+func (x *OrganizationReport) GetTotalSpent() string {
+	if x != nil {
+		return x.TotalSpent
+	}
+	return ""
+}
+
+func (x *OrganizationReport) GetTotalPaid() string {
+	if x != nil {
+		return x.TotalPaid
+	}
+	return ""
+}
+
+func (x *OrganizationReport) GetFacilities() []*FacilityUsage {
+	if x != nil {
+		return x.Facilities
+	}
+	return nil
+}
+
+type GetOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	All           bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"` // admins only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationsRequest) Reset() {
+	*x = GetOrganizationsRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationsRequest) ProtoMessage() {}
+
+func (x *GetOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetOrganizationsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type GetOrganizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizations []*UserOrganization    `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationsResponse) Reset() {
+	*x = GetOrganizationsResponse{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationsResponse) ProtoMessage() {}
+
+func (x *GetOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetOrganizationsResponse) GetOrganizations() []*UserOrganization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+type GetOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationRequest) Reset() {
+	*x = GetOrganizationRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetOrganizationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateOrganizationRequest) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type UpdateOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationRequest) Reset() {
+	*x = UpdateOrganizationRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateOrganizationRequest) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type DeleteOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationRequest) Reset() {
+	*x = DeleteOrganizationRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationRequest) ProtoMessage() {}
+
+func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteOrganizationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationResponse) Reset() {
+	*x = DeleteOrganizationResponse{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationResponse) ProtoMessage() {}
+
+func (x *DeleteOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{14}
+}
+
+type AddOrganizationMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int64                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddOrganizationMemberRequest) Reset() {
+	*x = AddOrganizationMemberRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *AddOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddOrganizationMemberRequest) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *AddOrganizationMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddOrganizationMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateOrganizationMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationMemberRequest) Reset() {
+	*x = UpdateOrganizationMemberRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateOrganizationMemberRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RemoveOrganizationMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationMemberRequest) Reset() {
+	*x = RemoveOrganizationMemberRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *RemoveOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveOrganizationMemberRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveOrganizationMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationMemberResponse) Reset() {
+	*x = RemoveOrganizationMemberResponse{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationMemberResponse) ProtoMessage() {}
+
+func (x *RemoveOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{18}
+}
+
+type DeleteOrganizationInsuranceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationInsuranceRequest) Reset() {
+	*x = DeleteOrganizationInsuranceRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationInsuranceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationInsuranceRequest) ProtoMessage() {}
+
+func (x *DeleteOrganizationInsuranceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationInsuranceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationInsuranceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteOrganizationInsuranceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteOrganizationInsuranceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationInsuranceResponse) Reset() {
+	*x = DeleteOrganizationInsuranceResponse{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationInsuranceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationInsuranceResponse) ProtoMessage() {}
+
+func (x *DeleteOrganizationInsuranceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationInsuranceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationInsuranceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{20}
+}
+
+type GetOrganizationReservationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int64                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationReservationsRequest) Reset() {
+	*x = GetOrganizationReservationsRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationReservationsRequest) ProtoMessage() {}
+
+func (x *GetOrganizationReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationReservationsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetOrganizationReservationsRequest) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+type GetOrganizationReservationsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Reservations  []*OrganizationReservation `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationReservationsResponse) Reset() {
+	*x = GetOrganizationReservationsResponse{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationReservationsResponse) ProtoMessage() {}
+
+func (x *GetOrganizationReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationReservationsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetOrganizationReservationsResponse) GetReservations() []*OrganizationReservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+type GetOrganizationReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int64                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	StartDate      string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD, optional
+	EndDate        string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD, optional
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationReportRequest) Reset() {
+	*x = GetOrganizationReportRequest{}
+	mi := &file_proto_organizations_organizations_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationReportRequest) ProtoMessage() {}
+
+func (x *GetOrganizationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organizations_organizations_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organizations_organizations_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetOrganizationReportRequest) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *GetOrganizationReportRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetOrganizationReportRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+var File_proto_organizations_organizations_proto protoreflect.FileDescriptor
+
+const file_proto_organizations_organizations_proto_rawDesc = "" +
+	"\n" +
+	"'proto/organizations/organizations.proto\x12\x11api.organizations\"\xe1\x01\n" +
+	"\fOrganization\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fbilling_name\x18\x03 \x01(\tR\vbillingName\x12#\n" +
+	"\rbilling_email\x18\x04 \x01(\tR\fbillingEmail\x12#\n" +
+	"\rbilling_phone\x18\x05 \x01(\tR\fbillingPhone\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xbe\x01\n" +
+	"\x12OrganizationMember\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12+\n" +
+	"\x0forganization_id\x18\x02 \x01(\x03B\x020\x01R\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1b\n" +
+	"\tuser_name\x18\x05 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x06 \x01(\tR\tuserEmail\"\xd0\x01\n" +
+	"\x15OrganizationInsurance\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12+\n" +
+	"\x0forganization_id\x18\x02 \x01(\x03B\x020\x01R\x0eorganizationId\x12\x1b\n" +
+	"\tfile_path\x18\x03 \x01(\tR\bfilePath\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\xe0\x01\n" +
+	"\x10FullOrganization\x12C\n" +
+	"\forganization\x18\x01 \x01(\v2\x1f.api.organizations.OrganizationR\forganization\x12?\n" +
+	"\amembers\x18\x02 \x03(\v2%.api.organizations.OrganizationMemberR\amembers\x12F\n" +
+	"\tinsurance\x18\x03 \x03(\v2(.api.organizations.OrganizationInsuranceR\tinsurance\"k\n" +
+	"\x10UserOrganization\x12C\n" +
+	"\forganization\x18\x01 \x01(\v2\x1f.api.organizations.OrganizationR\forganization\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x80\x02\n" +
+	"\x17OrganizationReservation\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x02 \x01(\tR\teventName\x12#\n" +
+	"\rfacility_name\x18\x03 \x01(\tR\ffacilityName\x12\x1a\n" +
+	"\bapproved\x18\x04 \x01(\tR\bapproved\x12)\n" +
+	"\x10reservation_date\x18\x05 \x01(\tR\x0freservationDate\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\x12\x12\n" +
+	"\x04paid\x18\a \x01(\bR\x04paid\"\xad\x01\n" +
+	"\rFacilityUsage\x12#\n" +
+	"\vfacility_id\x18\x01 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12#\n" +
+	"\rfacility_name\x18\x02 \x01(\tR\ffacilityName\x12\x14\n" +
+	"\x05hours\x18\x03 \x01(\x01R\x05hours\x12\x14\n" +
+	"\x05spent\x18\x04 \x01(\tR\x05spent\x12&\n" +
+	"\freservations\x18\x05 \x01(\x03B\x020\x01R\freservations\"\x97\x02\n" +
+	"\x12OrganizationReport\x12+\n" +
+	"\x0forganization_id\x18\x01 \x01(\x03B\x020\x01R\x0eorganizationId\x121\n" +
+	"\x12total_reservations\x18\x02 \x01(\x03B\x020\x01R\x11totalReservations\x12\x1f\n" +
+	"\vtotal_hours\x18\x03 \x01(\x01R\n" +
+	"totalHours\x12\x1f\n" +
+	"\vtotal_spent\x18\x04 \x01(\tR\n" +
+	"totalSpent\x12\x1d\n" +
+	"\n" +
+	"total_paid\x18\x05 \x01(\tR\ttotalPaid\x12@\n" +
+	"\n" +
+	"facilities\x18\x06 \x03(\v2 .api.organizations.FacilityUsageR\n" +
+	"facilities\"+\n" +
+	"\x17GetOrganizationsRequest\x12\x10\n" +
+	"\x03all\x18\x01 \x01(\bR\x03all\"e\n" +
+	"\x18GetOrganizationsResponse\x12I\n" +
+	"\rorganizations\x18\x01 \x03(\v2#.api.organizations.UserOrganizationR\rorganizations\",\n" +
+	"\x16GetOrganizationRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"`\n" +
+	"\x19CreateOrganizationRequest\x12C\n" +
+	"\forganization\x18\x01 \x01(\v2\x1f.api.organizations.OrganizationR\forganization\"`\n" +
+	"\x19UpdateOrganizationRequest\x12C\n" +
+	"\forganization\x18\x01 \x01(\v2\x1f.api.organizations.OrganizationR\forganization\"/\n" +
+	"\x19DeleteOrganizationRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"\x1c\n" +
+	"\x1aDeleteOrganizationResponse\"u\n" +
+	"\x1cAddOrganizationMemberRequest\x12+\n" +
+	"\x0forganization_id\x18\x01 \x01(\x03B\x020\x01R\x0eorganizationId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"I\n" +
+	"\x1fUpdateOrganizationMemberRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"5\n" +
+	"\x1fRemoveOrganizationMemberRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"\"\n" +
+	" RemoveOrganizationMemberResponse\"8\n" +
+	"\"DeleteOrganizationInsuranceRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"%\n" +
+	"#DeleteOrganizationInsuranceResponse\"Q\n" +
+	"\"GetOrganizationReservationsRequest\x12+\n" +
+	"\x0forganization_id\x18\x01 \x01(\x03B\x020\x01R\x0eorganizationId\"u\n" +
+	"#GetOrganizationReservationsResponse\x12N\n" +
+	"\freservations\x18\x01 \x03(\v2*.api.organizations.OrganizationReservationR\freservations\"\x85\x01\n" +
+	"\x1cGetOrganizationReportRequest\x12+\n" +
+	"\x0forganization_id\x18\x01 \x01(\x03B\x020\x01R\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate2\xb4\n" +
+	"\n" +
+	"\x14OrganizationsService\x12p\n" +
+	"\x10GetOrganizations\x12*.api.organizations.GetOrganizationsRequest\x1a+.api.organizations.GetOrganizationsResponse\"\x03\x90\x02\x01\x12f\n" +
+	"\x0fGetOrganization\x12).api.organizations.GetOrganizationRequest\x1a#.api.organizations.FullOrganization\"\x03\x90\x02\x01\x12c\n" +
+	"\x12CreateOrganization\x12,.api.organizations.CreateOrganizationRequest\x1a\x1f.api.organizations.Organization\x12c\n" +
+	"\x12UpdateOrganization\x12,.api.organizations.UpdateOrganizationRequest\x1a\x1f.api.organizations.Organization\x12q\n" +
+	"\x12DeleteOrganization\x12,.api.organizations.DeleteOrganizationRequest\x1a-.api.organizations.DeleteOrganizationResponse\x12o\n" +
+	"\x15AddOrganizationMember\x12/.api.organizations.AddOrganizationMemberRequest\x1a%.api.organizations.OrganizationMember\x12u\n" +
+	"\x18UpdateOrganizationMember\x122.api.organizations.UpdateOrganizationMemberRequest\x1a%.api.organizations.OrganizationMember\x12\x83\x01\n" +
+	"\x18RemoveOrganizationMember\x122.api.organizations.RemoveOrganizationMemberRequest\x1a3.api.organizations.RemoveOrganizationMemberResponse\x12\x8c\x01\n" +
+	"\x1bDeleteOrganizationInsurance\x125.api.organizations.DeleteOrganizationInsuranceRequest\x1a6.api.organizations.DeleteOrganizationInsuranceResponse\x12\x91\x01\n" +
+	"\x1bGetOrganizationReservations\x125.api.organizations.GetOrganizationReservationsRequest\x1a6.api.organizations.GetOrganizationReservationsResponse\"\x03\x90\x02\x01\x12t\n" +
+	"\x15GetOrganizationReport\x12/.api.organizations.GetOrganizationReportRequest\x1a%.api.organizations.OrganizationReport\"\x03\x90\x02\x01B\xc7\x01\n" +
+	"\x15com.api.organizationsB\x12OrganizationsProtoP\x01Z5api/internal/proto/organizations;organizationsservice\xa2\x02\x03AOX\xaa\x02\x11Api.Organizations\xca\x02\x11Api\\Organizations\xe2\x02\x1dApi\\Organizations\\GPBMetadata\xea\x02\x12Api::Organizationsb\x06proto3"
+
+var (
+	file_proto_organizations_organizations_proto_rawDescOnce sync.Once
+	file_proto_organizations_organizations_proto_rawDescData []byte
+)
+
+func file_proto_organizations_organizations_proto_rawDescGZIP() []byte {
+	file_proto_organizations_organizations_proto_rawDescOnce.Do(func() {
+		file_proto_organizations_organizations_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_organizations_organizations_proto_rawDesc), len(file_proto_organizations_organizations_proto_rawDesc)))
+	})
+	return file_proto_organizations_organizations_proto_rawDescData
+}
+
+var file_proto_organizations_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_proto_organizations_organizations_proto_goTypes = []any{
+	(*Organization)(nil),                        // 0: api.organizations.Organization
+	(*OrganizationMember)(nil),                  // 1: api.organizations.OrganizationMember
+	(*OrganizationInsurance)(nil),               // 2: api.organizations.OrganizationInsurance
+	(*FullOrganization)(nil),                    // 3: api.organizations.FullOrganization
+	(*UserOrganization)(nil),                    // 4: api.organizations.UserOrganization
+	(*OrganizationReservation)(nil),             // 5: api.organizations.OrganizationReservation
+	(*FacilityUsage)(nil),                       // 6: api.organizations.FacilityUsage
+	(*OrganizationReport)(nil),                  // 7: api.organizations.OrganizationReport
+	(*GetOrganizationsRequest)(nil),             // 8: api.organizations.GetOrganizationsRequest
+	(*GetOrganizationsResponse)(nil),            // 9: api.organizations.GetOrganizationsResponse
+	(*GetOrganizationRequest)(nil),              // 10: api.organizations.GetOrganizationRequest
+	(*CreateOrganizationRequest)(nil),           // 11: api.organizations.CreateOrganizationRequest
+	(*UpdateOrganizationRequest)(nil),           // 12: api.organizations.UpdateOrganizationRequest
+	(*DeleteOrganizationRequest)(nil),           // 13: api.organizations.DeleteOrganizationRequest
+	(*DeleteOrganizationResponse)(nil),          // 14: api.organizations.DeleteOrganizationResponse
+	(*AddOrganizationMemberRequest)(nil),        // 15: api.organizations.AddOrganizationMemberRequest
+	(*UpdateOrganizationMemberRequest)(nil),     // 16: api.organizations.UpdateOrganizationMemberRequest
+	(*RemoveOrganizationMemberRequest)(nil),     // 17: api.organizations.RemoveOrganizationMemberRequest
+	(*RemoveOrganizationMemberResponse)(nil),    // 18: api.organizations.RemoveOrganizationMemberResponse
+	(*DeleteOrganizationInsuranceRequest)(nil),  // 19: api.organizations.DeleteOrganizationInsuranceRequest
+	(*DeleteOrganizationInsuranceResponse)(nil), // 20: api.organizations.DeleteOrganizationInsuranceResponse
+	(*GetOrganizationReservationsRequest)(nil),  // 21: api.organizations.GetOrganizationReservationsRequest
+	(*GetOrganizationReservationsResponse)(nil), // 22: api.organizations.GetOrganizationReservationsResponse
+	(*GetOrganizationReportRequest)(nil),        // 23: api.organizations.GetOrganizationReportRequest
+}
+var file_proto_organizations_organizations_proto_depIdxs = []int32{
+	0,  // 0: api.organizations.FullOrganization.organization:type_name -> api.organizations.Organization
+	1,  // 1: api.organizations.FullOrganization.members:type_name -> api.organizations.OrganizationMember
+	2,  // 2: api.organizations.FullOrganization.insurance:type_name -> api.organizations.OrganizationInsurance
+	0,  // 3: api.organizations.UserOrganization.organization:type_name -> api.organizations.Organization
+	6,  // 4: api.organizations.OrganizationReport.facilities:type_name -> api.organizations.FacilityUsage
+	4,  // 5: api.organizations.GetOrganizationsResponse.organizations:type_name -> api.organizations.UserOrganization
+	0,  // 6: api.organizations.CreateOrganizationRequest.organization:type_name -> api.organizations.Organization
+	0,  // 7: api.organizations.UpdateOrganizationRequest.organization:type_name -> api.organizations.Organization
+	5,  // 8: api.organizations.GetOrganizationReservationsResponse.reservations:type_name -> api.organizations.OrganizationReservation
+	8,  // 9: api.organizations.OrganizationsService.GetOrganizations:input_type -> api.organizations.GetOrganizationsRequest
+	10, // 10: api.organizations.OrganizationsService.GetOrganization:input_type -> api.organizations.GetOrganizationRequest
+	11, // 11: api.organizations.OrganizationsService.CreateOrganization:input_type -> api.organizations.CreateOrganizationRequest
+	12, // 12: api.organizations.OrganizationsService.UpdateOrganization:input_type -> api.organizations.UpdateOrganizationRequest
+	13, // 13: api.organizations.OrganizationsService.DeleteOrganization:input_type -> api.organizations.DeleteOrganizationRequest
+	15, // 14: api.organizations.OrganizationsService.AddOrganizationMember:input_type -> api.organizations.AddOrganizationMemberRequest
+	16, // 15: api.organizations.OrganizationsService.UpdateOrganizationMember:input_type -> api.organizations.UpdateOrganizationMemberRequest
+	17, // 16: api.organizations.OrganizationsService.RemoveOrganizationMember:input_type -> api.organizations.RemoveOrganizationMemberRequest
+	19, // 17: api.organizations.OrganizationsService.DeleteOrganizationInsurance:input_type -> api.organizations.DeleteOrganizationInsuranceRequest
+	21, // 18: api.organizations.OrganizationsService.GetOrganizationReservations:input_type -> api.organizations.GetOrganizationReservationsRequest
+	23, // 19: api.organizations.OrganizationsService.GetOrganizationReport:input_type -> api.organizations.GetOrganizationReportRequest
+	9,  // 20: api.organizations.OrganizationsService.GetOrganizations:output_type -> api.organizations.GetOrganizationsResponse
+	3,  // 21: api.organizations.OrganizationsService.GetOrganization:output_type -> api.organizations.FullOrganization
+	0,  // 22: api.organizations.OrganizationsService.CreateOrganization:output_type -> api.organizations.Organization
+	0,  // 23: api.organizations.OrganizationsService.UpdateOrganization:output_type -> api.organizations.Organization
+	14, // 24: api.organizations.OrganizationsService.DeleteOrganization:output_type -> api.organizations.DeleteOrganizationResponse
+	1,  // 25: api.organizations.OrganizationsService.AddOrganizationMember:output_type -> api.organizations.OrganizationMember
+	1,  // 26: api.organizations.OrganizationsService.UpdateOrganizationMember:output_type -> api.organizations.OrganizationMember
+	18, // 27: api.organizations.OrganizationsService.RemoveOrganizationMember:output_type -> api.organizations.RemoveOrganizationMemberResponse
+	20, // 28: api.organizations.OrganizationsService.DeleteOrganizationInsurance:output_type -> api.organizations.DeleteOrganizationInsuranceResponse
+	22, // 29: api.organizations.OrganizationsService.GetOrganizationReservations:output_type -> api.organizations.GetOrganizationReservationsResponse
+	7,  // 30: api.organizations.OrganizationsService.GetOrganizationReport:output_type -> api.organizations.OrganizationReport
+	20, // [20:31] is the sub-list for method output_type
+	9,  // [9:20] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_proto_organizations_organizations_proto_init() }
+func file_proto_organizations_organizations_proto_init() {
+	if File_proto_organizations_organizations_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_organizations_organizations_proto_rawDesc), len(file_proto_organizations_organizations_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_organizations_organizations_proto_goTypes,
+		DependencyIndexes: file_proto_organizations_organizations_proto_depIdxs,
+		MessageInfos:      file_proto_organizations_organizations_proto_msgTypes,
+	}.Build()
+	File_proto_organizations_organizations_proto = out.File
+	file_proto_organizations_organizations_proto_goTypes = nil
+	file_proto_organizations_organizations_proto_depIdxs = nil
+}
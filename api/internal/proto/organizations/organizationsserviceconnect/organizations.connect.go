@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/organizations/organizations.proto
+
+package organizationsserviceconnect
+
+import (
+	organizations "api/internal/proto/organizations"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OrganizationsServiceName is the fully-qualified name of the OrganizationsService service.
+	OrganizationsServiceName = "api.organizations.OrganizationsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OrganizationsServiceGetOrganizationsProcedure is the fully-qualified name of the
+	// OrganizationsService's GetOrganizations RPC.
+	OrganizationsServiceGetOrganizationsProcedure = "/api.organizations.OrganizationsService/GetOrganizations"
+	// OrganizationsServiceGetOrganizationProcedure is the fully-qualified name of the
+	// OrganizationsService's GetOrganization RPC.
+	OrganizationsServiceGetOrganizationProcedure = "/api.organizations.OrganizationsService/GetOrganization"
+	// OrganizationsServiceCreateOrganizationProcedure is the fully-qualified name of the
+	// OrganizationsService's CreateOrganization RPC.
+	OrganizationsServiceCreateOrganizationProcedure = "/api.organizations.OrganizationsService/CreateOrganization"
+	// OrganizationsServiceUpdateOrganizationProcedure is the fully-qualified name of the
+	// OrganizationsService's UpdateOrganization RPC.
+	OrganizationsServiceUpdateOrganizationProcedure = "/api.organizations.OrganizationsService/UpdateOrganization"
+	// OrganizationsServiceDeleteOrganizationProcedure is the fully-qualified name of the
+	// OrganizationsService's DeleteOrganization RPC.
+	OrganizationsServiceDeleteOrganizationProcedure = "/api.organizations.OrganizationsService/DeleteOrganization"
+	// OrganizationsServiceAddOrganizationMemberProcedure is the fully-qualified name of the
+	// OrganizationsService's AddOrganizationMember RPC.
+	OrganizationsServiceAddOrganizationMemberProcedure = "/api.organizations.OrganizationsService/AddOrganizationMember"
+	// OrganizationsServiceUpdateOrganizationMemberProcedure is the fully-qualified name of the
+	// OrganizationsService's UpdateOrganizationMember RPC.
+	OrganizationsServiceUpdateOrganizationMemberProcedure = "/api.organizations.OrganizationsService/UpdateOrganizationMember"
+	// OrganizationsServiceRemoveOrganizationMemberProcedure is the fully-qualified name of the
+	// OrganizationsService's RemoveOrganizationMember RPC.
+	OrganizationsServiceRemoveOrganizationMemberProcedure = "/api.organizations.OrganizationsService/RemoveOrganizationMember"
+	// OrganizationsServiceDeleteOrganizationInsuranceProcedure is the fully-qualified name of the
+	// OrganizationsService's DeleteOrganizationInsurance RPC.
+	OrganizationsServiceDeleteOrganizationInsuranceProcedure = "/api.organizations.OrganizationsService/DeleteOrganizationInsurance"
+	// OrganizationsServiceGetOrganizationReservationsProcedure is the fully-qualified name of the
+	// OrganizationsService's GetOrganizationReservations RPC.
+	OrganizationsServiceGetOrganizationReservationsProcedure = "/api.organizations.OrganizationsService/GetOrganizationReservations"
+	// OrganizationsServiceGetOrganizationReportProcedure is the fully-qualified name of the
+	// OrganizationsService's GetOrganizationReport RPC.
+	OrganizationsServiceGetOrganizationReportProcedure = "/api.organizations.OrganizationsService/GetOrganizationReport"
+)
+
+// OrganizationsServiceClient is a client for the api.organizations.OrganizationsService service.
+type OrganizationsServiceClient interface {
+	GetOrganizations(context.Context, *connect.Request[organizations.GetOrganizationsRequest]) (*connect.Response[organizations.GetOrganizationsResponse], error)
+	GetOrganization(context.Context, *connect.Request[organizations.GetOrganizationRequest]) (*connect.Response[organizations.FullOrganization], error)
+	CreateOrganization(context.Context, *connect.Request[organizations.CreateOrganizationRequest]) (*connect.Response[organizations.Organization], error)
+	UpdateOrganization(context.Context, *connect.Request[organizations.UpdateOrganizationRequest]) (*connect.Response[organizations.Organization], error)
+	DeleteOrganization(context.Context, *connect.Request[organizations.DeleteOrganizationRequest]) (*connect.Response[organizations.DeleteOrganizationResponse], error)
+	AddOrganizationMember(context.Context, *connect.Request[organizations.AddOrganizationMemberRequest]) (*connect.Response[organizations.OrganizationMember], error)
+	UpdateOrganizationMember(context.Context, *connect.Request[organizations.UpdateOrganizationMemberRequest]) (*connect.Response[organizations.OrganizationMember], error)
+	RemoveOrganizationMember(context.Context, *connect.Request[organizations.RemoveOrganizationMemberRequest]) (*connect.Response[organizations.RemoveOrganizationMemberResponse], error)
+	DeleteOrganizationInsurance(context.Context, *connect.Request[organizations.DeleteOrganizationInsuranceRequest]) (*connect.Response[organizations.DeleteOrganizationInsuranceResponse], error)
+	GetOrganizationReservations(context.Context, *connect.Request[organizations.GetOrganizationReservationsRequest]) (*connect.Response[organizations.GetOrganizationReservationsResponse], error)
+	GetOrganizationReport(context.Context, *connect.Request[organizations.GetOrganizationReportRequest]) (*connect.Response[organizations.OrganizationReport], error)
+}
+
+// NewOrganizationsServiceClient constructs a client for the api.organizations.OrganizationsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOrganizationsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OrganizationsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	organizationsServiceMethods := organizations.File_proto_organizations_organizations_proto.Services().ByName("OrganizationsService").Methods()
+	return &organizationsServiceClient{
+		getOrganizations: connect.NewClient[organizations.GetOrganizationsRequest, organizations.GetOrganizationsResponse](
+			httpClient,
+			baseURL+OrganizationsServiceGetOrganizationsProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizations")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getOrganization: connect.NewClient[organizations.GetOrganizationRequest, organizations.FullOrganization](
+			httpClient,
+			baseURL+OrganizationsServiceGetOrganizationProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("GetOrganization")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createOrganization: connect.NewClient[organizations.CreateOrganizationRequest, organizations.Organization](
+			httpClient,
+			baseURL+OrganizationsServiceCreateOrganizationProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("CreateOrganization")),
+			connect.WithClientOptions(opts...),
+		),
+		updateOrganization: connect.NewClient[organizations.UpdateOrganizationRequest, organizations.Organization](
+			httpClient,
+			baseURL+OrganizationsServiceUpdateOrganizationProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("UpdateOrganization")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOrganization: connect.NewClient[organizations.DeleteOrganizationRequest, organizations.DeleteOrganizationResponse](
+			httpClient,
+			baseURL+OrganizationsServiceDeleteOrganizationProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("DeleteOrganization")),
+			connect.WithClientOptions(opts...),
+		),
+		addOrganizationMember: connect.NewClient[organizations.AddOrganizationMemberRequest, organizations.OrganizationMember](
+			httpClient,
+			baseURL+OrganizationsServiceAddOrganizationMemberProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("AddOrganizationMember")),
+			connect.WithClientOptions(opts...),
+		),
+		updateOrganizationMember: connect.NewClient[organizations.UpdateOrganizationMemberRequest, organizations.OrganizationMember](
+			httpClient,
+			baseURL+OrganizationsServiceUpdateOrganizationMemberProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("UpdateOrganizationMember")),
+			connect.WithClientOptions(opts...),
+		),
+		removeOrganizationMember: connect.NewClient[organizations.RemoveOrganizationMemberRequest, organizations.RemoveOrganizationMemberResponse](
+			httpClient,
+			baseURL+OrganizationsServiceRemoveOrganizationMemberProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("RemoveOrganizationMember")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOrganizationInsurance: connect.NewClient[organizations.DeleteOrganizationInsuranceRequest, organizations.DeleteOrganizationInsuranceResponse](
+			httpClient,
+			baseURL+OrganizationsServiceDeleteOrganizationInsuranceProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("DeleteOrganizationInsurance")),
+			connect.WithClientOptions(opts...),
+		),
+		getOrganizationReservations: connect.NewClient[organizations.GetOrganizationReservationsRequest, organizations.GetOrganizationReservationsResponse](
+			httpClient,
+			baseURL+OrganizationsServiceGetOrganizationReservationsProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizationReservations")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getOrganizationReport: connect.NewClient[organizations.GetOrganizationReportRequest, organizations.OrganizationReport](
+			httpClient,
+			baseURL+OrganizationsServiceGetOrganizationReportProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizationReport")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// organizationsServiceClient implements OrganizationsServiceClient.
+type organizationsServiceClient struct {
+	getOrganizations            *connect.Client[organizations.GetOrganizationsRequest, organizations.GetOrganizationsResponse]
+	getOrganization             *connect.Client[organizations.GetOrganizationRequest, organizations.FullOrganization]
+	createOrganization          *connect.Client[organizations.CreateOrganizationRequest, organizations.Organization]
+	updateOrganization          *connect.Client[organizations.UpdateOrganizationRequest, organizations.Organization]
+	deleteOrganization          *connect.Client[organizations.DeleteOrganizationRequest, organizations.DeleteOrganizationResponse]
+	addOrganizationMember       *connect.Client[organizations.AddOrganizationMemberRequest, organizations.OrganizationMember]
+	updateOrganizationMember    *connect.Client[organizations.UpdateOrganizationMemberRequest, organizations.OrganizationMember]
+	removeOrganizationMember    *connect.Client[organizations.RemoveOrganizationMemberRequest, organizations.RemoveOrganizationMemberResponse]
+	deleteOrganizationInsurance *connect.Client[organizations.DeleteOrganizationInsuranceRequest, organizations.DeleteOrganizationInsuranceResponse]
+	getOrganizationReservations *connect.Client[organizations.GetOrganizationReservationsRequest, organizations.GetOrganizationReservationsResponse]
+	getOrganizationReport       *connect.Client[organizations.GetOrganizationReportRequest, organizations.OrganizationReport]
+}
+
+// GetOrganizations calls api.organizations.OrganizationsService.GetOrganizations.
+func (c *organizationsServiceClient) GetOrganizations(ctx context.Context, req *connect.Request[organizations.GetOrganizationsRequest]) (*connect.Response[organizations.GetOrganizationsResponse], error) {
+	return c.getOrganizations.CallUnary(ctx, req)
+}
+
+// GetOrganization calls api.organizations.OrganizationsService.GetOrganization.
+func (c *organizationsServiceClient) GetOrganization(ctx context.Context, req *connect.Request[organizations.GetOrganizationRequest]) (*connect.Response[organizations.FullOrganization], error) {
+	return c.getOrganization.CallUnary(ctx, req)
+}
+
+// CreateOrganization calls api.organizations.OrganizationsService.CreateOrganization.
+func (c *organizationsServiceClient) CreateOrganization(ctx context.Context, req *connect.Request[organizations.CreateOrganizationRequest]) (*connect.Response[organizations.Organization], error) {
+	return c.createOrganization.CallUnary(ctx, req)
+}
+
+// UpdateOrganization calls api.organizations.OrganizationsService.UpdateOrganization.
+func (c *organizationsServiceClient) UpdateOrganization(ctx context.Context, req *connect.Request[organizations.UpdateOrganizationRequest]) (*connect.Response[organizations.Organization], error) {
+	return c.updateOrganization.CallUnary(ctx, req)
+}
+
+// DeleteOrganization calls api.organizations.OrganizationsService.DeleteOrganization.
+func (c *organizationsServiceClient) DeleteOrganization(ctx context.Context, req *connect.Request[organizations.DeleteOrganizationRequest]) (*connect.Response[organizations.DeleteOrganizationResponse], error) {
+	return c.deleteOrganization.CallUnary(ctx, req)
+}
+
+// AddOrganizationMember calls api.organizations.OrganizationsService.AddOrganizationMember.
+func (c *organizationsServiceClient) AddOrganizationMember(ctx context.Context, req *connect.Request[organizations.AddOrganizationMemberRequest]) (*connect.Response[organizations.OrganizationMember], error) {
+	return c.addOrganizationMember.CallUnary(ctx, req)
+}
+
+// UpdateOrganizationMember calls api.organizations.OrganizationsService.UpdateOrganizationMember.
+func (c *organizationsServiceClient) UpdateOrganizationMember(ctx context.Context, req *connect.Request[organizations.UpdateOrganizationMemberRequest]) (*connect.Response[organizations.OrganizationMember], error) {
+	return c.updateOrganizationMember.CallUnary(ctx, req)
+}
+
+// RemoveOrganizationMember calls api.organizations.OrganizationsService.RemoveOrganizationMember.
+func (c *organizationsServiceClient) RemoveOrganizationMember(ctx context.Context, req *connect.Request[organizations.RemoveOrganizationMemberRequest]) (*connect.Response[organizations.RemoveOrganizationMemberResponse], error) {
+	return c.removeOrganizationMember.CallUnary(ctx, req)
+}
+
+// DeleteOrganizationInsurance calls
+// api.organizations.OrganizationsService.DeleteOrganizationInsurance.
+func (c *organizationsServiceClient) DeleteOrganizationInsurance(ctx context.Context, req *connect.Request[organizations.DeleteOrganizationInsuranceRequest]) (*connect.Response[organizations.DeleteOrganizationInsuranceResponse], error) {
+	return c.deleteOrganizationInsurance.CallUnary(ctx, req)
+}
+
+// GetOrganizationReservations calls
+// api.organizations.OrganizationsService.GetOrganizationReservations.
+func (c *organizationsServiceClient) GetOrganizationReservations(ctx context.Context, req *connect.Request[organizations.GetOrganizationReservationsRequest]) (*connect.Response[organizations.GetOrganizationReservationsResponse], error) {
+	return c.getOrganizationReservations.CallUnary(ctx, req)
+}
+
+// GetOrganizationReport calls api.organizations.OrganizationsService.GetOrganizationReport.
+func (c *organizationsServiceClient) GetOrganizationReport(ctx context.Context, req *connect.Request[organizations.GetOrganizationReportRequest]) (*connect.Response[organizations.OrganizationReport], error) {
+	return c.getOrganizationReport.CallUnary(ctx, req)
+}
+
+// OrganizationsServiceHandler is an implementation of the api.organizations.OrganizationsService
+// service.
+type OrganizationsServiceHandler interface {
+	GetOrganizations(context.Context, *connect.Request[organizations.GetOrganizationsRequest]) (*connect.Response[organizations.GetOrganizationsResponse], error)
+	GetOrganization(context.Context, *connect.Request[organizations.GetOrganizationRequest]) (*connect.Response[organizations.FullOrganization], error)
+	CreateOrganization(context.Context, *connect.Request[organizations.CreateOrganizationRequest]) (*connect.Response[organizations.Organization], error)
+	UpdateOrganization(context.Context, *connect.Request[organizations.UpdateOrganizationRequest]) (*connect.Response[organizations.Organization], error)
+	DeleteOrganization(context.Context, *connect.Request[organizations.DeleteOrganizationRequest]) (*connect.Response[organizations.DeleteOrganizationResponse], error)
+	AddOrganizationMember(context.Context, *connect.Request[organizations.AddOrganizationMemberRequest]) (*connect.Response[organizations.OrganizationMember], error)
+	UpdateOrganizationMember(context.Context, *connect.Request[organizations.UpdateOrganizationMemberRequest]) (*connect.Response[organizations.OrganizationMember], error)
+	RemoveOrganizationMember(context.Context, *connect.Request[organizations.RemoveOrganizationMemberRequest]) (*connect.Response[organizations.RemoveOrganizationMemberResponse], error)
+	DeleteOrganizationInsurance(context.Context, *connect.Request[organizations.DeleteOrganizationInsuranceRequest]) (*connect.Response[organizations.DeleteOrganizationInsuranceResponse], error)
+	GetOrganizationReservations(context.Context, *connect.Request[organizations.GetOrganizationReservationsRequest]) (*connect.Response[organizations.GetOrganizationReservationsResponse], error)
+	GetOrganizationReport(context.Context, *connect.Request[organizations.GetOrganizationReportRequest]) (*connect.Response[organizations.OrganizationReport], error)
+}
+
+// NewOrganizationsServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOrganizationsServiceHandler(svc OrganizationsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	organizationsServiceMethods := organizations.File_proto_organizations_organizations_proto.Services().ByName("OrganizationsService").Methods()
+	organizationsServiceGetOrganizationsHandler := connect.NewUnaryHandler(
+		OrganizationsServiceGetOrganizationsProcedure,
+		svc.GetOrganizations,
+		connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizations")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceGetOrganizationHandler := connect.NewUnaryHandler(
+		OrganizationsServiceGetOrganizationProcedure,
+		svc.GetOrganization,
+		connect.WithSchema(organizationsServiceMethods.ByName("GetOrganization")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceCreateOrganizationHandler := connect.NewUnaryHandler(
+		OrganizationsServiceCreateOrganizationProcedure,
+		svc.CreateOrganization,
+		connect.WithSchema(organizationsServiceMethods.ByName("CreateOrganization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceUpdateOrganizationHandler := connect.NewUnaryHandler(
+		OrganizationsServiceUpdateOrganizationProcedure,
+		svc.UpdateOrganization,
+		connect.WithSchema(organizationsServiceMethods.ByName("UpdateOrganization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceDeleteOrganizationHandler := connect.NewUnaryHandler(
+		OrganizationsServiceDeleteOrganizationProcedure,
+		svc.DeleteOrganization,
+		connect.WithSchema(organizationsServiceMethods.ByName("DeleteOrganization")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceAddOrganizationMemberHandler := connect.NewUnaryHandler(
+		OrganizationsServiceAddOrganizationMemberProcedure,
+		svc.AddOrganizationMember,
+		connect.WithSchema(organizationsServiceMethods.ByName("AddOrganizationMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceUpdateOrganizationMemberHandler := connect.NewUnaryHandler(
+		OrganizationsServiceUpdateOrganizationMemberProcedure,
+		svc.UpdateOrganizationMember,
+		connect.WithSchema(organizationsServiceMethods.ByName("UpdateOrganizationMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceRemoveOrganizationMemberHandler := connect.NewUnaryHandler(
+		OrganizationsServiceRemoveOrganizationMemberProcedure,
+		svc.RemoveOrganizationMember,
+		connect.WithSchema(organizationsServiceMethods.ByName("RemoveOrganizationMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceDeleteOrganizationInsuranceHandler := connect.NewUnaryHandler(
+		OrganizationsServiceDeleteOrganizationInsuranceProcedure,
+		svc.DeleteOrganizationInsurance,
+		connect.WithSchema(organizationsServiceMethods.ByName("DeleteOrganizationInsurance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceGetOrganizationReservationsHandler := connect.NewUnaryHandler(
+		OrganizationsServiceGetOrganizationReservationsProcedure,
+		svc.GetOrganizationReservations,
+		connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizationReservations")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceGetOrganizationReportHandler := connect.NewUnaryHandler(
+		OrganizationsServiceGetOrganizationReportProcedure,
+		svc.GetOrganizationReport,
+		connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizationReport")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.organizations.OrganizationsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OrganizationsServiceGetOrganizationsProcedure:
+			organizationsServiceGetOrganizationsHandler.ServeHTTP(w, r)
+		case OrganizationsServiceGetOrganizationProcedure:
+			organizationsServiceGetOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationsServiceCreateOrganizationProcedure:
+			organizationsServiceCreateOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationsServiceUpdateOrganizationProcedure:
+			organizationsServiceUpdateOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationsServiceDeleteOrganizationProcedure:
+			organizationsServiceDeleteOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationsServiceAddOrganizationMemberProcedure:
+			organizationsServiceAddOrganizationMemberHandler.ServeHTTP(w, r)
+		case OrganizationsServiceUpdateOrganizationMemberProcedure:
+			organizationsServiceUpdateOrganizationMemberHandler.ServeHTTP(w, r)
+		case OrganizationsServiceRemoveOrganizationMemberProcedure:
+			organizationsServiceRemoveOrganizationMemberHandler.ServeHTTP(w, r)
+		case OrganizationsServiceDeleteOrganizationInsuranceProcedure:
+			organizationsServiceDeleteOrganizationInsuranceHandler.ServeHTTP(w, r)
+		case OrganizationsServiceGetOrganizationReservationsProcedure:
+			organizationsServiceGetOrganizationReservationsHandler.ServeHTTP(w, r)
+		case OrganizationsServiceGetOrganizationReportProcedure:
+			organizationsServiceGetOrganizationReportHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOrganizationsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOrganizationsServiceHandler struct{}
+
+func (UnimplementedOrganizationsServiceHandler) GetOrganizations(context.Context, *connect.Request[organizations.GetOrganizationsRequest]) (*connect.Response[organizations.GetOrganizationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.GetOrganizations is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) GetOrganization(context.Context, *connect.Request[organizations.GetOrganizationRequest]) (*connect.Response[organizations.FullOrganization], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.GetOrganization is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) CreateOrganization(context.Context, *connect.Request[organizations.CreateOrganizationRequest]) (*connect.Response[organizations.Organization], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.CreateOrganization is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) UpdateOrganization(context.Context, *connect.Request[organizations.UpdateOrganizationRequest]) (*connect.Response[organizations.Organization], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.UpdateOrganization is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) DeleteOrganization(context.Context, *connect.Request[organizations.DeleteOrganizationRequest]) (*connect.Response[organizations.DeleteOrganizationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.DeleteOrganization is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) AddOrganizationMember(context.Context, *connect.Request[organizations.AddOrganizationMemberRequest]) (*connect.Response[organizations.OrganizationMember], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.AddOrganizationMember is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) UpdateOrganizationMember(context.Context, *connect.Request[organizations.UpdateOrganizationMemberRequest]) (*connect.Response[organizations.OrganizationMember], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.UpdateOrganizationMember is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) RemoveOrganizationMember(context.Context, *connect.Request[organizations.RemoveOrganizationMemberRequest]) (*connect.Response[organizations.RemoveOrganizationMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.RemoveOrganizationMember is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) DeleteOrganizationInsurance(context.Context, *connect.Request[organizations.DeleteOrganizationInsuranceRequest]) (*connect.Response[organizations.DeleteOrganizationInsuranceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.DeleteOrganizationInsurance is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) GetOrganizationReservations(context.Context, *connect.Request[organizations.GetOrganizationReservationsRequest]) (*connect.Response[organizations.GetOrganizationReservationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.GetOrganizationReservations is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) GetOrganizationReport(context.Context, *connect.Request[organizations.GetOrganizationReportRequest]) (*connect.Response[organizations.OrganizationReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.organizations.OrganizationsService.GetOrganizationReport is not implemented"))
+}
@@ -24,6 +24,8 @@ const (
 type CreatePaymentIntentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// skip the category deposit and pay the whole balance now
+	PayInFull     bool `protobuf:"varint,2,opt,name=pay_in_full,json=payInFull,proto3" json:"pay_in_full,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,6 +67,13 @@ func (x *CreatePaymentIntentRequest) GetReservationId() int64 {
 	return 0
 }
 
+func (x *CreatePaymentIntentRequest) GetPayInFull() bool {
+	if x != nil {
+		return x.PayInFull
+	}
+	return false
+}
+
 type CreatePaymentIntentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientSecret  string                 `protobuf:"bytes,1,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
@@ -192,6 +201,8 @@ func (x *GetStripePublicKeyResponse) GetPublicKey() string {
 type CreatePaymentSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // full, deposit or balance
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -233,6 +244,20 @@ func (x *CreatePaymentSessionResponse) GetUrl() string {
 	return ""
 }
 
+func (x *CreatePaymentSessionResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreatePaymentSessionResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
 type ValidatePaymentSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -329,32 +354,1646 @@ func (x *ValidatePaymentSessionResponse) GetValid() bool {
 	return false
 }
 
-var File_proto_payments_payments_proto protoreflect.FileDescriptor
+// LedgerEntry is one movement of money for a reservation. Amounts are in
+// dollars; entries that lower the balance due are positive.
+type LedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReservationId int64                  `protobuf:"varint,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // charge, refund, adjustment or offline
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Provider      string                 `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"` // stripe or manual
+	ProviderRef   string                 `protobuf:"bytes,7,opt,name=provider_ref,json=providerRef,proto3" json:"provider_ref,omitempty"`
+	ActorId       string                 `protobuf:"bytes,8,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Note          string                 `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_payments_payments_proto_rawDesc = "" +
-	"\n" +
-	"\x1dproto/payments/payments.proto\x12\fapi.payments\"G\n" +
-	"\x1aCreatePaymentIntentRequest\x12)\n" +
-	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\"B\n" +
-	"\x1bCreatePaymentIntentResponse\x12#\n" +
-	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\"\x1b\n" +
-	"\x19GetStripePublicKeyRequest\";\n" +
-	"\x1aGetStripePublicKeyResponse\x12\x1d\n" +
-	"\n" +
-	"public_key\x18\x01 \x01(\tR\tpublicKey\"0\n" +
-	"\x1cCreatePaymentSessionResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"i\n" +
-	"\x1dValidatePaymentSessionRequest\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12)\n" +
-	"\x0ereservation_id\x18\x02 \x01(\x03B\x020\x01R\rreservationId\"6\n" +
-	"\x1eValidatePaymentSessionResponse\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid2\xc9\x03\n" +
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_proto_payments_payments_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LedgerEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetProviderRef() string {
+	if x != nil {
+		return x.ProviderRef
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetReservationLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationLedgerRequest) Reset() {
+	*x = GetReservationLedgerRequest{}
+	mi := &file_proto_payments_payments_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationLedgerRequest) ProtoMessage() {}
+
+func (x *GetReservationLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationLedgerRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetReservationLedgerRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+type GetReservationLedgerResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Entries        []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total          string                 `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`                                           // reservation total from the cost reducer
+	Paid           string                 `protobuf:"bytes,3,opt,name=paid,proto3" json:"paid,omitempty"`                                             // sum of the entries
+	Balance        string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`                                       // total minus paid
+	Deposit        string                 `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`                                       // taken up front by the category; 0.00 without one
+	BalanceDueDate string                 `protobuf:"bytes,6,opt,name=balance_due_date,json=balanceDueDate,proto3" json:"balance_due_date,omitempty"` // YYYY-MM-DD
+	Overdue        bool                   `protobuf:"varint,7,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetReservationLedgerResponse) Reset() {
+	*x = GetReservationLedgerResponse{}
+	mi := &file_proto_payments_payments_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationLedgerResponse) ProtoMessage() {}
+
+func (x *GetReservationLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationLedgerResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetReservationLedgerResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetReservationLedgerResponse) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *GetReservationLedgerResponse) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *GetReservationLedgerResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *GetReservationLedgerResponse) GetDeposit() string {
+	if x != nil {
+		return x.Deposit
+	}
+	return ""
+}
+
+func (x *GetReservationLedgerResponse) GetBalanceDueDate() string {
+	if x != nil {
+		return x.BalanceDueDate
+	}
+	return ""
+}
+
+func (x *GetReservationLedgerResponse) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+// RecordManualPaymentRequest records an offline payment, such as a check or
+// cash, or an adjustment to what is owed.
+type RecordManualPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`           // offline or adjustment
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`       // dollars; offline payments must be positive
+	Reference     string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"` // check or receipt number
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordManualPaymentRequest) Reset() {
+	*x = RecordManualPaymentRequest{}
+	mi := &file_proto_payments_payments_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordManualPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordManualPaymentRequest) ProtoMessage() {}
+
+func (x *RecordManualPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordManualPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RecordManualPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RecordManualPaymentRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *RecordManualPaymentRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RecordManualPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecordManualPaymentRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *RecordManualPaymentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RecordManualPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *LedgerEntry           `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordManualPaymentResponse) Reset() {
+	*x = RecordManualPaymentResponse{}
+	mi := &file_proto_payments_payments_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordManualPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordManualPaymentResponse) ProtoMessage() {}
+
+func (x *RecordManualPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordManualPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RecordManualPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RecordManualPaymentResponse) GetEntry() *LedgerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *RecordManualPaymentResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+// RefundPaymentRequest refunds Stripe payments of a reservation, newest
+// payment first.
+type RefundPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // dollars; empty refunds everything refundable
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_proto_payments_payments_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefundPaymentRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *RefundPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RefundPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Refunded      string                 `protobuf:"bytes,2,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	Balance       string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_proto_payments_payments_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RefundPaymentResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *RefundPaymentResponse) GetRefunded() string {
+	if x != nil {
+		return x.Refunded
+	}
+	return ""
+}
+
+func (x *RefundPaymentResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+// Invoice is a numbered invoice or receipt PDF for a reservation. Amounts
+// are in dollars as of when it was issued.
+type Invoice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReservationId int64                  `protobuf:"varint,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`     // invoice or receipt
+	Number        string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"` // such as INV-000042
+	Total         string                 `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	Paid          string                 `protobuf:"bytes,6,opt,name=paid,proto3" json:"paid,omitempty"`
+	Balance       string                 `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	Url           string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"` // PDF download for the requester and admins
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_proto_payments_payments_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Invoice) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invoice) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *Invoice) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Invoice) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Invoice) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *Invoice) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *Invoice) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *Invoice) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type IssueInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // invoice or receipt
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueInvoiceRequest) Reset() {
+	*x = IssueInvoiceRequest{}
+	mi := &file_proto_payments_payments_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueInvoiceRequest) ProtoMessage() {}
+
+func (x *IssueInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*IssueInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *IssueInvoiceRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *IssueInvoiceRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type IssueInvoiceResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Invoice *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	// false when the latest one of the kind still matched and was returned
+	Created       bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueInvoiceResponse) Reset() {
+	*x = IssueInvoiceResponse{}
+	mi := &file_proto_payments_payments_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueInvoiceResponse) ProtoMessage() {}
+
+func (x *IssueInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*IssueInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IssueInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *IssueInvoiceResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type ListInvoicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_proto_payments_payments_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListInvoicesRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+type ListInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*Invoice             `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	mi := &file_proto_payments_payments_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+// PaymentMethod is a way to pay for a reservation. Stripe sends the payer
+// to a checkout; manual methods record a reference an admin verifies later.
+type PaymentMethod struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // stripe, check, purchase_order or account_code
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Manual         bool                   `protobuf:"varint,3,opt,name=manual,proto3" json:"manual,omitempty"`
+	ReferenceLabel string                 `protobuf:"bytes,4,opt,name=reference_label,json=referenceLabel,proto3" json:"reference_label,omitempty"` // what the reference is called, such as "Check number"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaymentMethod) Reset() {
+	*x = PaymentMethod{}
+	mi := &file_proto_payments_payments_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethod) ProtoMessage() {}
+
+func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PaymentMethod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *PaymentMethod) GetReferenceLabel() string {
+	if x != nil {
+		return x.ReferenceLabel
+	}
+	return ""
+}
+
+type ListPaymentMethodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentMethodsRequest) Reset() {
+	*x = ListPaymentMethodsRequest{}
+	mi := &file_proto_payments_payments_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentMethodsRequest) ProtoMessage() {}
+
+func (x *ListPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentMethodsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{20}
+}
+
+type ListPaymentMethodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Methods       []*PaymentMethod       `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentMethodsResponse) Reset() {
+	*x = ListPaymentMethodsResponse{}
+	mi := &file_proto_payments_payments_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentMethodsResponse) ProtoMessage() {}
+
+func (x *ListPaymentMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentMethodsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListPaymentMethodsResponse) GetMethods() []*PaymentMethod {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+// PaymentSubmission is a check, purchase order or internal transfer waiting
+// for, or having had, an admin's review. Only a verified one is on the ledger.
+type PaymentSubmission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReservationId int64                  `protobuf:"varint,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Payment       string                 `protobuf:"bytes,4,opt,name=payment,proto3" json:"payment,omitempty"` // full, deposit or balance
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference     string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // pending, verified or rejected
+	SubmittedBy   string                 `protobuf:"bytes,9,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,10,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    string                 `protobuf:"bytes,11,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ReviewNote    string                 `protobuf:"bytes,12,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	PaymentId     int64                  `protobuf:"varint,13,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"` // ledger entry of a verified submission
+	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentSubmission) Reset() {
+	*x = PaymentSubmission{}
+	mi := &file_proto_payments_payments_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSubmission) ProtoMessage() {}
+
+func (x *PaymentSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSubmission.ProtoReflect.Descriptor instead.
+func (*PaymentSubmission) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PaymentSubmission) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PaymentSubmission) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *PaymentSubmission) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetPayment() string {
+	if x != nil {
+		return x.Payment
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *PaymentSubmission) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *PaymentSubmission) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// StartPaymentRequest pays what a reservation owes now with a method.
+type StartPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// skip the category deposit and pay the whole balance now
+	PayInFull     bool   `protobuf:"varint,3,opt,name=pay_in_full,json=payInFull,proto3" json:"pay_in_full,omitempty"`
+	Reference     string `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"` // required by manual methods
+	Note          string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	Amount        string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"` // dollars; manual methods only, defaults to what is due
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPaymentRequest) Reset() {
+	*x = StartPaymentRequest{}
+	mi := &file_proto_payments_payments_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPaymentRequest) ProtoMessage() {}
+
+func (x *StartPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPaymentRequest.ProtoReflect.Descriptor instead.
+func (*StartPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StartPaymentRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *StartPaymentRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *StartPaymentRequest) GetPayInFull() bool {
+	if x != nil {
+		return x.PayInFull
+	}
+	return false
+}
+
+func (x *StartPaymentRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *StartPaymentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StartPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type StartPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`             // full, deposit or balance
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`               // checkout to redirect to; empty for manual methods
+	Submission    *PaymentSubmission     `protobuf:"bytes,4,opt,name=submission,proto3" json:"submission,omitempty"` // set for manual methods
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPaymentResponse) Reset() {
+	*x = StartPaymentResponse{}
+	mi := &file_proto_payments_payments_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPaymentResponse) ProtoMessage() {}
+
+func (x *StartPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPaymentResponse.ProtoReflect.Descriptor instead.
+func (*StartPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StartPaymentResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StartPaymentResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *StartPaymentResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StartPaymentResponse) GetSubmission() *PaymentSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type ListPaymentSubmissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 lists submissions across the buildings the caller manages
+	ReservationId int64  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // empty for any
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentSubmissionsRequest) Reset() {
+	*x = ListPaymentSubmissionsRequest{}
+	mi := &file_proto_payments_payments_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListPaymentSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPaymentSubmissionsRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *ListPaymentSubmissionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListPaymentSubmissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*PaymentSubmission   `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentSubmissionsResponse) Reset() {
+	*x = ListPaymentSubmissionsResponse{}
+	mi := &file_proto_payments_payments_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListPaymentSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListPaymentSubmissionsResponse) GetSubmissions() []*PaymentSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// ReviewPaymentSubmissionRequest verifies a pending submission, adding it to
+// the ledger, or rejects it.
+type ReviewPaymentSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  int64                  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	Verified      bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewPaymentSubmissionRequest) Reset() {
+	*x = ReviewPaymentSubmissionRequest{}
+	mi := &file_proto_payments_payments_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPaymentSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPaymentSubmissionRequest) ProtoMessage() {}
+
+func (x *ReviewPaymentSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPaymentSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewPaymentSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReviewPaymentSubmissionRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *ReviewPaymentSubmissionRequest) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *ReviewPaymentSubmissionRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReviewPaymentSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *PaymentSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	Entry         *LedgerEntry           `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"` // set when verified
+	Balance       string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewPaymentSubmissionResponse) Reset() {
+	*x = ReviewPaymentSubmissionResponse{}
+	mi := &file_proto_payments_payments_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPaymentSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPaymentSubmissionResponse) ProtoMessage() {}
+
+func (x *ReviewPaymentSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payments_payments_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPaymentSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*ReviewPaymentSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payments_payments_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReviewPaymentSubmissionResponse) GetSubmission() *PaymentSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+func (x *ReviewPaymentSubmissionResponse) GetEntry() *LedgerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *ReviewPaymentSubmissionResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+var File_proto_payments_payments_proto protoreflect.FileDescriptor
+
+const file_proto_payments_payments_proto_rawDesc = "" +
+	"\n" +
+	"\x1dproto/payments/payments.proto\x12\fapi.payments\"g\n" +
+	"\x1aCreatePaymentIntentRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\x12\x1e\n" +
+	"\vpay_in_full\x18\x02 \x01(\bR\tpayInFull\"B\n" +
+	"\x1bCreatePaymentIntentResponse\x12#\n" +
+	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\"\x1b\n" +
+	"\x19GetStripePublicKeyRequest\";\n" +
+	"\x1aGetStripePublicKeyResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey\"\\\n" +
+	"\x1cCreatePaymentSessionResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\"i\n" +
+	"\x1dValidatePaymentSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12)\n" +
+	"\x0ereservation_id\x18\x02 \x01(\x03B\x020\x01R\rreservationId\"6\n" +
+	"\x1eValidatePaymentSessionResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\"\xa1\x02\n" +
+	"\vLedgerEntry\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12)\n" +
+	"\x0ereservation_id\x18\x02 \x01(\x03B\x020\x01R\rreservationId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bprovider\x18\x06 \x01(\tR\bprovider\x12!\n" +
+	"\fprovider_ref\x18\a \x01(\tR\vproviderRef\x12\x19\n" +
+	"\bactor_id\x18\b \x01(\tR\aactorId\x12\x12\n" +
+	"\x04note\x18\t \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"H\n" +
+	"\x1bGetReservationLedgerRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\"\xf5\x01\n" +
+	"\x1cGetReservationLedgerResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.api.payments.LedgerEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\tR\x05total\x12\x12\n" +
+	"\x04paid\x18\x03 \x01(\tR\x04paid\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\tR\abalance\x12\x18\n" +
+	"\adeposit\x18\x05 \x01(\tR\adeposit\x12(\n" +
+	"\x10balance_due_date\x18\x06 \x01(\tR\x0ebalanceDueDate\x12\x18\n" +
+	"\aoverdue\x18\a \x01(\bR\aoverdue\"\xa5\x01\n" +
+	"\x1aRecordManualPaymentRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"h\n" +
+	"\x1bRecordManualPaymentResponse\x12/\n" +
+	"\x05entry\x18\x01 \x01(\v2\x19.api.payments.LedgerEntryR\x05entry\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\"m\n" +
+	"\x14RefundPaymentRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\x82\x01\n" +
+	"\x15RefundPaymentResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.api.payments.LedgerEntryR\aentries\x12\x1a\n" +
+	"\brefunded\x18\x02 \x01(\tR\brefunded\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance\"\xe9\x01\n" +
+	"\aInvoice\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12)\n" +
+	"\x0ereservation_id\x18\x02 \x01(\x03B\x020\x01R\rreservationId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\tR\x06number\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\tR\x05total\x12\x12\n" +
+	"\x04paid\x18\x06 \x01(\tR\x04paid\x12\x18\n" +
+	"\abalance\x18\a \x01(\tR\abalance\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"T\n" +
+	"\x13IssueInvoiceRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\"a\n" +
+	"\x14IssueInvoiceResponse\x12/\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x15.api.payments.InvoiceR\ainvoice\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"@\n" +
+	"\x13ListInvoicesRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\"I\n" +
+	"\x14ListInvoicesResponse\x121\n" +
+	"\binvoices\x18\x01 \x03(\v2\x15.api.payments.InvoiceR\binvoices\"t\n" +
+	"\rPaymentMethod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06manual\x18\x03 \x01(\bR\x06manual\x12'\n" +
+	"\x0freference_label\x18\x04 \x01(\tR\x0ereferenceLabel\"\x1b\n" +
+	"\x19ListPaymentMethodsRequest\"S\n" +
+	"\x1aListPaymentMethodsResponse\x125\n" +
+	"\amethods\x18\x01 \x03(\v2\x1b.api.payments.PaymentMethodR\amethods\"\xae\x03\n" +
+	"\x11PaymentSubmission\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12)\n" +
+	"\x0ereservation_id\x18\x02 \x01(\x03B\x020\x01R\rreservationId\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x18\n" +
+	"\apayment\x18\x04 \x01(\tR\apayment\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12!\n" +
+	"\fsubmitted_by\x18\t \x01(\tR\vsubmittedBy\x12\x1f\n" +
+	"\vreviewed_by\x18\n" +
+	" \x01(\tR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreviewed_at\x18\v \x01(\tR\n" +
+	"reviewedAt\x12\x1f\n" +
+	"\vreview_note\x18\f \x01(\tR\n" +
+	"reviewNote\x12!\n" +
+	"\n" +
+	"payment_id\x18\r \x01(\x03B\x020\x01R\tpaymentId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\"\xc2\x01\n" +
+	"\x13StartPaymentRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x1e\n" +
+	"\vpay_in_full\x18\x03 \x01(\bR\tpayInFull\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\"\x95\x01\n" +
+	"\x14StartPaymentResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\tR\x06amount\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12?\n" +
+	"\n" +
+	"submission\x18\x04 \x01(\v2\x1f.api.payments.PaymentSubmissionR\n" +
+	"submission\"b\n" +
+	"\x1dListPaymentSubmissionsRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"c\n" +
+	"\x1eListPaymentSubmissionsResponse\x12A\n" +
+	"\vsubmissions\x18\x01 \x03(\v2\x1f.api.payments.PaymentSubmissionR\vsubmissions\"y\n" +
+	"\x1eReviewPaymentSubmissionRequest\x12'\n" +
+	"\rsubmission_id\x18\x01 \x01(\x03B\x020\x01R\fsubmissionId\x12\x1a\n" +
+	"\bverified\x18\x02 \x01(\bR\bverified\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xad\x01\n" +
+	"\x1fReviewPaymentSubmissionResponse\x12?\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x1f.api.payments.PaymentSubmissionR\n" +
+	"submission\x12/\n" +
+	"\x05entry\x18\x02 \x01(\v2\x19.api.payments.LedgerEntryR\x05entry\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance2\xd9\n" +
+	"\n" +
 	"\x0fPaymentsService\x12j\n" +
 	"\x13CreatePaymentIntent\x12(.api.payments.CreatePaymentIntentRequest\x1a).api.payments.CreatePaymentIntentResponse\x12g\n" +
 	"\x12GetStripePublicKey\x12'.api.payments.GetStripePublicKeyRequest\x1a(.api.payments.GetStripePublicKeyResponse\x12l\n" +
 	"\x14CreatePaymentSession\x12(.api.payments.CreatePaymentIntentRequest\x1a*.api.payments.CreatePaymentSessionResponse\x12s\n" +
-	"\x16ValidatePaymentSession\x12+.api.payments.ValidatePaymentSessionRequest\x1a,.api.payments.ValidatePaymentSessionResponseB\x9f\x01\n" +
+	"\x16ValidatePaymentSession\x12+.api.payments.ValidatePaymentSessionRequest\x1a,.api.payments.ValidatePaymentSessionResponse\x12m\n" +
+	"\x14GetReservationLedger\x12).api.payments.GetReservationLedgerRequest\x1a*.api.payments.GetReservationLedgerResponse\x12j\n" +
+	"\x13RecordManualPayment\x12(.api.payments.RecordManualPaymentRequest\x1a).api.payments.RecordManualPaymentResponse\x12X\n" +
+	"\rRefundPayment\x12\".api.payments.RefundPaymentRequest\x1a#.api.payments.RefundPaymentResponse\x12U\n" +
+	"\fIssueInvoice\x12!.api.payments.IssueInvoiceRequest\x1a\".api.payments.IssueInvoiceResponse\x12U\n" +
+	"\fListInvoices\x12!.api.payments.ListInvoicesRequest\x1a\".api.payments.ListInvoicesResponse\x12g\n" +
+	"\x12ListPaymentMethods\x12'.api.payments.ListPaymentMethodsRequest\x1a(.api.payments.ListPaymentMethodsResponse\x12U\n" +
+	"\fStartPayment\x12!.api.payments.StartPaymentRequest\x1a\".api.payments.StartPaymentResponse\x12s\n" +
+	"\x16ListPaymentSubmissions\x12+.api.payments.ListPaymentSubmissionsRequest\x1a,.api.payments.ListPaymentSubmissionsResponse\x12v\n" +
+	"\x17ReviewPaymentSubmission\x12,.api.payments.ReviewPaymentSubmissionRequest\x1a-.api.payments.ReviewPaymentSubmissionResponseB\x9f\x01\n" +
 	"\x10com.api.paymentsB\rPaymentsProtoP\x01Z+api/internal/proto/payments;paymentsservice\xa2\x02\x03APX\xaa\x02\fApi.Payments\xca\x02\fApi\\Payments\xe2\x02\x18Api\\Payments\\GPBMetadata\xea\x02\rApi::Paymentsb\x06proto3"
 
 var (
@@ -369,30 +2008,80 @@ func file_proto_payments_payments_proto_rawDescGZIP() []byte {
 	return file_proto_payments_payments_proto_rawDescData
 }
 
-var file_proto_payments_payments_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_payments_payments_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_proto_payments_payments_proto_goTypes = []any{
-	(*CreatePaymentIntentRequest)(nil),     // 0: api.payments.CreatePaymentIntentRequest
-	(*CreatePaymentIntentResponse)(nil),    // 1: api.payments.CreatePaymentIntentResponse
-	(*GetStripePublicKeyRequest)(nil),      // 2: api.payments.GetStripePublicKeyRequest
-	(*GetStripePublicKeyResponse)(nil),     // 3: api.payments.GetStripePublicKeyResponse
-	(*CreatePaymentSessionResponse)(nil),   // 4: api.payments.CreatePaymentSessionResponse
-	(*ValidatePaymentSessionRequest)(nil),  // 5: api.payments.ValidatePaymentSessionRequest
-	(*ValidatePaymentSessionResponse)(nil), // 6: api.payments.ValidatePaymentSessionResponse
+	(*CreatePaymentIntentRequest)(nil),      // 0: api.payments.CreatePaymentIntentRequest
+	(*CreatePaymentIntentResponse)(nil),     // 1: api.payments.CreatePaymentIntentResponse
+	(*GetStripePublicKeyRequest)(nil),       // 2: api.payments.GetStripePublicKeyRequest
+	(*GetStripePublicKeyResponse)(nil),      // 3: api.payments.GetStripePublicKeyResponse
+	(*CreatePaymentSessionResponse)(nil),    // 4: api.payments.CreatePaymentSessionResponse
+	(*ValidatePaymentSessionRequest)(nil),   // 5: api.payments.ValidatePaymentSessionRequest
+	(*ValidatePaymentSessionResponse)(nil),  // 6: api.payments.ValidatePaymentSessionResponse
+	(*LedgerEntry)(nil),                     // 7: api.payments.LedgerEntry
+	(*GetReservationLedgerRequest)(nil),     // 8: api.payments.GetReservationLedgerRequest
+	(*GetReservationLedgerResponse)(nil),    // 9: api.payments.GetReservationLedgerResponse
+	(*RecordManualPaymentRequest)(nil),      // 10: api.payments.RecordManualPaymentRequest
+	(*RecordManualPaymentResponse)(nil),     // 11: api.payments.RecordManualPaymentResponse
+	(*RefundPaymentRequest)(nil),            // 12: api.payments.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil),           // 13: api.payments.RefundPaymentResponse
+	(*Invoice)(nil),                         // 14: api.payments.Invoice
+	(*IssueInvoiceRequest)(nil),             // 15: api.payments.IssueInvoiceRequest
+	(*IssueInvoiceResponse)(nil),            // 16: api.payments.IssueInvoiceResponse
+	(*ListInvoicesRequest)(nil),             // 17: api.payments.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),            // 18: api.payments.ListInvoicesResponse
+	(*PaymentMethod)(nil),                   // 19: api.payments.PaymentMethod
+	(*ListPaymentMethodsRequest)(nil),       // 20: api.payments.ListPaymentMethodsRequest
+	(*ListPaymentMethodsResponse)(nil),      // 21: api.payments.ListPaymentMethodsResponse
+	(*PaymentSubmission)(nil),               // 22: api.payments.PaymentSubmission
+	(*StartPaymentRequest)(nil),             // 23: api.payments.StartPaymentRequest
+	(*StartPaymentResponse)(nil),            // 24: api.payments.StartPaymentResponse
+	(*ListPaymentSubmissionsRequest)(nil),   // 25: api.payments.ListPaymentSubmissionsRequest
+	(*ListPaymentSubmissionsResponse)(nil),  // 26: api.payments.ListPaymentSubmissionsResponse
+	(*ReviewPaymentSubmissionRequest)(nil),  // 27: api.payments.ReviewPaymentSubmissionRequest
+	(*ReviewPaymentSubmissionResponse)(nil), // 28: api.payments.ReviewPaymentSubmissionResponse
 }
 var file_proto_payments_payments_proto_depIdxs = []int32{
-	0, // 0: api.payments.PaymentsService.CreatePaymentIntent:input_type -> api.payments.CreatePaymentIntentRequest
-	2, // 1: api.payments.PaymentsService.GetStripePublicKey:input_type -> api.payments.GetStripePublicKeyRequest
-	0, // 2: api.payments.PaymentsService.CreatePaymentSession:input_type -> api.payments.CreatePaymentIntentRequest
-	5, // 3: api.payments.PaymentsService.ValidatePaymentSession:input_type -> api.payments.ValidatePaymentSessionRequest
-	1, // 4: api.payments.PaymentsService.CreatePaymentIntent:output_type -> api.payments.CreatePaymentIntentResponse
-	3, // 5: api.payments.PaymentsService.GetStripePublicKey:output_type -> api.payments.GetStripePublicKeyResponse
-	4, // 6: api.payments.PaymentsService.CreatePaymentSession:output_type -> api.payments.CreatePaymentSessionResponse
-	6, // 7: api.payments.PaymentsService.ValidatePaymentSession:output_type -> api.payments.ValidatePaymentSessionResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	7,  // 0: api.payments.GetReservationLedgerResponse.entries:type_name -> api.payments.LedgerEntry
+	7,  // 1: api.payments.RecordManualPaymentResponse.entry:type_name -> api.payments.LedgerEntry
+	7,  // 2: api.payments.RefundPaymentResponse.entries:type_name -> api.payments.LedgerEntry
+	14, // 3: api.payments.IssueInvoiceResponse.invoice:type_name -> api.payments.Invoice
+	14, // 4: api.payments.ListInvoicesResponse.invoices:type_name -> api.payments.Invoice
+	19, // 5: api.payments.ListPaymentMethodsResponse.methods:type_name -> api.payments.PaymentMethod
+	22, // 6: api.payments.StartPaymentResponse.submission:type_name -> api.payments.PaymentSubmission
+	22, // 7: api.payments.ListPaymentSubmissionsResponse.submissions:type_name -> api.payments.PaymentSubmission
+	22, // 8: api.payments.ReviewPaymentSubmissionResponse.submission:type_name -> api.payments.PaymentSubmission
+	7,  // 9: api.payments.ReviewPaymentSubmissionResponse.entry:type_name -> api.payments.LedgerEntry
+	0,  // 10: api.payments.PaymentsService.CreatePaymentIntent:input_type -> api.payments.CreatePaymentIntentRequest
+	2,  // 11: api.payments.PaymentsService.GetStripePublicKey:input_type -> api.payments.GetStripePublicKeyRequest
+	0,  // 12: api.payments.PaymentsService.CreatePaymentSession:input_type -> api.payments.CreatePaymentIntentRequest
+	5,  // 13: api.payments.PaymentsService.ValidatePaymentSession:input_type -> api.payments.ValidatePaymentSessionRequest
+	8,  // 14: api.payments.PaymentsService.GetReservationLedger:input_type -> api.payments.GetReservationLedgerRequest
+	10, // 15: api.payments.PaymentsService.RecordManualPayment:input_type -> api.payments.RecordManualPaymentRequest
+	12, // 16: api.payments.PaymentsService.RefundPayment:input_type -> api.payments.RefundPaymentRequest
+	15, // 17: api.payments.PaymentsService.IssueInvoice:input_type -> api.payments.IssueInvoiceRequest
+	17, // 18: api.payments.PaymentsService.ListInvoices:input_type -> api.payments.ListInvoicesRequest
+	20, // 19: api.payments.PaymentsService.ListPaymentMethods:input_type -> api.payments.ListPaymentMethodsRequest
+	23, // 20: api.payments.PaymentsService.StartPayment:input_type -> api.payments.StartPaymentRequest
+	25, // 21: api.payments.PaymentsService.ListPaymentSubmissions:input_type -> api.payments.ListPaymentSubmissionsRequest
+	27, // 22: api.payments.PaymentsService.ReviewPaymentSubmission:input_type -> api.payments.ReviewPaymentSubmissionRequest
+	1,  // 23: api.payments.PaymentsService.CreatePaymentIntent:output_type -> api.payments.CreatePaymentIntentResponse
+	3,  // 24: api.payments.PaymentsService.GetStripePublicKey:output_type -> api.payments.GetStripePublicKeyResponse
+	4,  // 25: api.payments.PaymentsService.CreatePaymentSession:output_type -> api.payments.CreatePaymentSessionResponse
+	6,  // 26: api.payments.PaymentsService.ValidatePaymentSession:output_type -> api.payments.ValidatePaymentSessionResponse
+	9,  // 27: api.payments.PaymentsService.GetReservationLedger:output_type -> api.payments.GetReservationLedgerResponse
+	11, // 28: api.payments.PaymentsService.RecordManualPayment:output_type -> api.payments.RecordManualPaymentResponse
+	13, // 29: api.payments.PaymentsService.RefundPayment:output_type -> api.payments.RefundPaymentResponse
+	16, // 30: api.payments.PaymentsService.IssueInvoice:output_type -> api.payments.IssueInvoiceResponse
+	18, // 31: api.payments.PaymentsService.ListInvoices:output_type -> api.payments.ListInvoicesResponse
+	21, // 32: api.payments.PaymentsService.ListPaymentMethods:output_type -> api.payments.ListPaymentMethodsResponse
+	24, // 33: api.payments.PaymentsService.StartPayment:output_type -> api.payments.StartPaymentResponse
+	26, // 34: api.payments.PaymentsService.ListPaymentSubmissions:output_type -> api.payments.ListPaymentSubmissionsResponse
+	28, // 35: api.payments.PaymentsService.ReviewPaymentSubmission:output_type -> api.payments.ReviewPaymentSubmissionResponse
+	23, // [23:36] is the sub-list for method output_type
+	10, // [10:23] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_proto_payments_payments_proto_init() }
@@ -406,7 +2095,7 @@ func file_proto_payments_payments_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_payments_payments_proto_rawDesc), len(file_proto_payments_payments_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -45,6 +45,33 @@ const (
 	// PaymentsServiceValidatePaymentSessionProcedure is the fully-qualified name of the
 	// PaymentsService's ValidatePaymentSession RPC.
 	PaymentsServiceValidatePaymentSessionProcedure = "/api.payments.PaymentsService/ValidatePaymentSession"
+	// PaymentsServiceGetReservationLedgerProcedure is the fully-qualified name of the PaymentsService's
+	// GetReservationLedger RPC.
+	PaymentsServiceGetReservationLedgerProcedure = "/api.payments.PaymentsService/GetReservationLedger"
+	// PaymentsServiceRecordManualPaymentProcedure is the fully-qualified name of the PaymentsService's
+	// RecordManualPayment RPC.
+	PaymentsServiceRecordManualPaymentProcedure = "/api.payments.PaymentsService/RecordManualPayment"
+	// PaymentsServiceRefundPaymentProcedure is the fully-qualified name of the PaymentsService's
+	// RefundPayment RPC.
+	PaymentsServiceRefundPaymentProcedure = "/api.payments.PaymentsService/RefundPayment"
+	// PaymentsServiceIssueInvoiceProcedure is the fully-qualified name of the PaymentsService's
+	// IssueInvoice RPC.
+	PaymentsServiceIssueInvoiceProcedure = "/api.payments.PaymentsService/IssueInvoice"
+	// PaymentsServiceListInvoicesProcedure is the fully-qualified name of the PaymentsService's
+	// ListInvoices RPC.
+	PaymentsServiceListInvoicesProcedure = "/api.payments.PaymentsService/ListInvoices"
+	// PaymentsServiceListPaymentMethodsProcedure is the fully-qualified name of the PaymentsService's
+	// ListPaymentMethods RPC.
+	PaymentsServiceListPaymentMethodsProcedure = "/api.payments.PaymentsService/ListPaymentMethods"
+	// PaymentsServiceStartPaymentProcedure is the fully-qualified name of the PaymentsService's
+	// StartPayment RPC.
+	PaymentsServiceStartPaymentProcedure = "/api.payments.PaymentsService/StartPayment"
+	// PaymentsServiceListPaymentSubmissionsProcedure is the fully-qualified name of the
+	// PaymentsService's ListPaymentSubmissions RPC.
+	PaymentsServiceListPaymentSubmissionsProcedure = "/api.payments.PaymentsService/ListPaymentSubmissions"
+	// PaymentsServiceReviewPaymentSubmissionProcedure is the fully-qualified name of the
+	// PaymentsService's ReviewPaymentSubmission RPC.
+	PaymentsServiceReviewPaymentSubmissionProcedure = "/api.payments.PaymentsService/ReviewPaymentSubmission"
 )
 
 // PaymentsServiceClient is a client for the api.payments.PaymentsService service.
@@ -53,6 +80,15 @@ type PaymentsServiceClient interface {
 	GetStripePublicKey(context.Context, *connect.Request[payments.GetStripePublicKeyRequest]) (*connect.Response[payments.GetStripePublicKeyResponse], error)
 	CreatePaymentSession(context.Context, *connect.Request[payments.CreatePaymentIntentRequest]) (*connect.Response[payments.CreatePaymentSessionResponse], error)
 	ValidatePaymentSession(context.Context, *connect.Request[payments.ValidatePaymentSessionRequest]) (*connect.Response[payments.ValidatePaymentSessionResponse], error)
+	GetReservationLedger(context.Context, *connect.Request[payments.GetReservationLedgerRequest]) (*connect.Response[payments.GetReservationLedgerResponse], error)
+	RecordManualPayment(context.Context, *connect.Request[payments.RecordManualPaymentRequest]) (*connect.Response[payments.RecordManualPaymentResponse], error)
+	RefundPayment(context.Context, *connect.Request[payments.RefundPaymentRequest]) (*connect.Response[payments.RefundPaymentResponse], error)
+	IssueInvoice(context.Context, *connect.Request[payments.IssueInvoiceRequest]) (*connect.Response[payments.IssueInvoiceResponse], error)
+	ListInvoices(context.Context, *connect.Request[payments.ListInvoicesRequest]) (*connect.Response[payments.ListInvoicesResponse], error)
+	ListPaymentMethods(context.Context, *connect.Request[payments.ListPaymentMethodsRequest]) (*connect.Response[payments.ListPaymentMethodsResponse], error)
+	StartPayment(context.Context, *connect.Request[payments.StartPaymentRequest]) (*connect.Response[payments.StartPaymentResponse], error)
+	ListPaymentSubmissions(context.Context, *connect.Request[payments.ListPaymentSubmissionsRequest]) (*connect.Response[payments.ListPaymentSubmissionsResponse], error)
+	ReviewPaymentSubmission(context.Context, *connect.Request[payments.ReviewPaymentSubmissionRequest]) (*connect.Response[payments.ReviewPaymentSubmissionResponse], error)
 }
 
 // NewPaymentsServiceClient constructs a client for the api.payments.PaymentsService service. By
@@ -90,15 +126,78 @@ func NewPaymentsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(paymentsServiceMethods.ByName("ValidatePaymentSession")),
 			connect.WithClientOptions(opts...),
 		),
+		getReservationLedger: connect.NewClient[payments.GetReservationLedgerRequest, payments.GetReservationLedgerResponse](
+			httpClient,
+			baseURL+PaymentsServiceGetReservationLedgerProcedure,
+			connect.WithSchema(paymentsServiceMethods.ByName("GetReservationLedger")),
+			connect.WithClientOptions(opts...),
+		),
+		recordManualPayment: connect.NewClient[payments.RecordManualPaymentRequest, payments.RecordManualPaymentResponse](
+			httpClient,
+			baseURL+PaymentsServiceRecordManualPaymentProcedure,
+			connect.WithSchema(paymentsServiceMethods.ByName("RecordManualPayment")),
+			connect.WithClientOptions(opts...),
+		),
+		refundPayment: connect.NewClient[payments.RefundPaymentRequest, payments.RefundPaymentResponse](
+			httpClient,
+			baseURL+PaymentsServiceRefundPaymentProcedure,
+			connect.WithSchema(paymentsServiceMethods.ByName("RefundPayment")),
+			connect.WithClientOptions(opts...),
+		),
+		issueInvoice: connect.NewClient[payments.IssueInvoiceRequest, payments.IssueInvoiceResponse](
+			httpClient,
+			baseURL+PaymentsServiceIssueInvoiceProcedure,
+			connect.WithSchema(paymentsServiceMethods.ByName("IssueInvoice")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvoices: connect.NewClient[payments.ListInvoicesRequest, payments.ListInvoicesResponse](
+			httpClient,
+			baseURL+PaymentsServiceListInvoicesProcedure,
+			connect.WithSchema(paymentsServiceMethods.ByName("ListInvoices")),
+			connect.WithClientOptions(opts...),
+		),
+		listPaymentMethods: connect.NewClient[payments.ListPaymentMethodsRequest, payments.ListPaymentMethodsResponse](
+			httpClient,
+			baseURL+PaymentsServiceListPaymentMethodsProcedure,
+			connect.WithSchema(paymentsServiceMethods.ByName("ListPaymentMethods")),
+			connect.WithClientOptions(opts...),
+		),
+		startPayment: connect.NewClient[payments.StartPaymentRequest, payments.StartPaymentResponse](
+			httpClient,
+			baseURL+PaymentsServiceStartPaymentProcedure,
+			connect.WithSchema(paymentsServiceMethods.ByName("StartPayment")),
+			connect.WithClientOptions(opts...),
+		),
+		listPaymentSubmissions: connect.NewClient[payments.ListPaymentSubmissionsRequest, payments.ListPaymentSubmissionsResponse](
+			httpClient,
+			baseURL+PaymentsServiceListPaymentSubmissionsProcedure,
+			connect.WithSchema(paymentsServiceMethods.ByName("ListPaymentSubmissions")),
+			connect.WithClientOptions(opts...),
+		),
+		reviewPaymentSubmission: connect.NewClient[payments.ReviewPaymentSubmissionRequest, payments.ReviewPaymentSubmissionResponse](
+			httpClient,
+			baseURL+PaymentsServiceReviewPaymentSubmissionProcedure,
+			connect.WithSchema(paymentsServiceMethods.ByName("ReviewPaymentSubmission")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // paymentsServiceClient implements PaymentsServiceClient.
 type paymentsServiceClient struct {
-	createPaymentIntent    *connect.Client[payments.CreatePaymentIntentRequest, payments.CreatePaymentIntentResponse]
-	getStripePublicKey     *connect.Client[payments.GetStripePublicKeyRequest, payments.GetStripePublicKeyResponse]
-	createPaymentSession   *connect.Client[payments.CreatePaymentIntentRequest, payments.CreatePaymentSessionResponse]
-	validatePaymentSession *connect.Client[payments.ValidatePaymentSessionRequest, payments.ValidatePaymentSessionResponse]
+	createPaymentIntent     *connect.Client[payments.CreatePaymentIntentRequest, payments.CreatePaymentIntentResponse]
+	getStripePublicKey      *connect.Client[payments.GetStripePublicKeyRequest, payments.GetStripePublicKeyResponse]
+	createPaymentSession    *connect.Client[payments.CreatePaymentIntentRequest, payments.CreatePaymentSessionResponse]
+	validatePaymentSession  *connect.Client[payments.ValidatePaymentSessionRequest, payments.ValidatePaymentSessionResponse]
+	getReservationLedger    *connect.Client[payments.GetReservationLedgerRequest, payments.GetReservationLedgerResponse]
+	recordManualPayment     *connect.Client[payments.RecordManualPaymentRequest, payments.RecordManualPaymentResponse]
+	refundPayment           *connect.Client[payments.RefundPaymentRequest, payments.RefundPaymentResponse]
+	issueInvoice            *connect.Client[payments.IssueInvoiceRequest, payments.IssueInvoiceResponse]
+	listInvoices            *connect.Client[payments.ListInvoicesRequest, payments.ListInvoicesResponse]
+	listPaymentMethods      *connect.Client[payments.ListPaymentMethodsRequest, payments.ListPaymentMethodsResponse]
+	startPayment            *connect.Client[payments.StartPaymentRequest, payments.StartPaymentResponse]
+	listPaymentSubmissions  *connect.Client[payments.ListPaymentSubmissionsRequest, payments.ListPaymentSubmissionsResponse]
+	reviewPaymentSubmission *connect.Client[payments.ReviewPaymentSubmissionRequest, payments.ReviewPaymentSubmissionResponse]
 }
 
 // CreatePaymentIntent calls api.payments.PaymentsService.CreatePaymentIntent.
@@ -121,12 +220,66 @@ func (c *paymentsServiceClient) ValidatePaymentSession(ctx context.Context, req
 	return c.validatePaymentSession.CallUnary(ctx, req)
 }
 
+// GetReservationLedger calls api.payments.PaymentsService.GetReservationLedger.
+func (c *paymentsServiceClient) GetReservationLedger(ctx context.Context, req *connect.Request[payments.GetReservationLedgerRequest]) (*connect.Response[payments.GetReservationLedgerResponse], error) {
+	return c.getReservationLedger.CallUnary(ctx, req)
+}
+
+// RecordManualPayment calls api.payments.PaymentsService.RecordManualPayment.
+func (c *paymentsServiceClient) RecordManualPayment(ctx context.Context, req *connect.Request[payments.RecordManualPaymentRequest]) (*connect.Response[payments.RecordManualPaymentResponse], error) {
+	return c.recordManualPayment.CallUnary(ctx, req)
+}
+
+// RefundPayment calls api.payments.PaymentsService.RefundPayment.
+func (c *paymentsServiceClient) RefundPayment(ctx context.Context, req *connect.Request[payments.RefundPaymentRequest]) (*connect.Response[payments.RefundPaymentResponse], error) {
+	return c.refundPayment.CallUnary(ctx, req)
+}
+
+// IssueInvoice calls api.payments.PaymentsService.IssueInvoice.
+func (c *paymentsServiceClient) IssueInvoice(ctx context.Context, req *connect.Request[payments.IssueInvoiceRequest]) (*connect.Response[payments.IssueInvoiceResponse], error) {
+	return c.issueInvoice.CallUnary(ctx, req)
+}
+
+// ListInvoices calls api.payments.PaymentsService.ListInvoices.
+func (c *paymentsServiceClient) ListInvoices(ctx context.Context, req *connect.Request[payments.ListInvoicesRequest]) (*connect.Response[payments.ListInvoicesResponse], error) {
+	return c.listInvoices.CallUnary(ctx, req)
+}
+
+// ListPaymentMethods calls api.payments.PaymentsService.ListPaymentMethods.
+func (c *paymentsServiceClient) ListPaymentMethods(ctx context.Context, req *connect.Request[payments.ListPaymentMethodsRequest]) (*connect.Response[payments.ListPaymentMethodsResponse], error) {
+	return c.listPaymentMethods.CallUnary(ctx, req)
+}
+
+// StartPayment calls api.payments.PaymentsService.StartPayment.
+func (c *paymentsServiceClient) StartPayment(ctx context.Context, req *connect.Request[payments.StartPaymentRequest]) (*connect.Response[payments.StartPaymentResponse], error) {
+	return c.startPayment.CallUnary(ctx, req)
+}
+
+// ListPaymentSubmissions calls api.payments.PaymentsService.ListPaymentSubmissions.
+func (c *paymentsServiceClient) ListPaymentSubmissions(ctx context.Context, req *connect.Request[payments.ListPaymentSubmissionsRequest]) (*connect.Response[payments.ListPaymentSubmissionsResponse], error) {
+	return c.listPaymentSubmissions.CallUnary(ctx, req)
+}
+
+// ReviewPaymentSubmission calls api.payments.PaymentsService.ReviewPaymentSubmission.
+func (c *paymentsServiceClient) ReviewPaymentSubmission(ctx context.Context, req *connect.Request[payments.ReviewPaymentSubmissionRequest]) (*connect.Response[payments.ReviewPaymentSubmissionResponse], error) {
+	return c.reviewPaymentSubmission.CallUnary(ctx, req)
+}
+
 // PaymentsServiceHandler is an implementation of the api.payments.PaymentsService service.
 type PaymentsServiceHandler interface {
 	CreatePaymentIntent(context.Context, *connect.Request[payments.CreatePaymentIntentRequest]) (*connect.Response[payments.CreatePaymentIntentResponse], error)
 	GetStripePublicKey(context.Context, *connect.Request[payments.GetStripePublicKeyRequest]) (*connect.Response[payments.GetStripePublicKeyResponse], error)
 	CreatePaymentSession(context.Context, *connect.Request[payments.CreatePaymentIntentRequest]) (*connect.Response[payments.CreatePaymentSessionResponse], error)
 	ValidatePaymentSession(context.Context, *connect.Request[payments.ValidatePaymentSessionRequest]) (*connect.Response[payments.ValidatePaymentSessionResponse], error)
+	GetReservationLedger(context.Context, *connect.Request[payments.GetReservationLedgerRequest]) (*connect.Response[payments.GetReservationLedgerResponse], error)
+	RecordManualPayment(context.Context, *connect.Request[payments.RecordManualPaymentRequest]) (*connect.Response[payments.RecordManualPaymentResponse], error)
+	RefundPayment(context.Context, *connect.Request[payments.RefundPaymentRequest]) (*connect.Response[payments.RefundPaymentResponse], error)
+	IssueInvoice(context.Context, *connect.Request[payments.IssueInvoiceRequest]) (*connect.Response[payments.IssueInvoiceResponse], error)
+	ListInvoices(context.Context, *connect.Request[payments.ListInvoicesRequest]) (*connect.Response[payments.ListInvoicesResponse], error)
+	ListPaymentMethods(context.Context, *connect.Request[payments.ListPaymentMethodsRequest]) (*connect.Response[payments.ListPaymentMethodsResponse], error)
+	StartPayment(context.Context, *connect.Request[payments.StartPaymentRequest]) (*connect.Response[payments.StartPaymentResponse], error)
+	ListPaymentSubmissions(context.Context, *connect.Request[payments.ListPaymentSubmissionsRequest]) (*connect.Response[payments.ListPaymentSubmissionsResponse], error)
+	ReviewPaymentSubmission(context.Context, *connect.Request[payments.ReviewPaymentSubmissionRequest]) (*connect.Response[payments.ReviewPaymentSubmissionResponse], error)
 }
 
 // NewPaymentsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -160,6 +313,60 @@ func NewPaymentsServiceHandler(svc PaymentsServiceHandler, opts ...connect.Handl
 		connect.WithSchema(paymentsServiceMethods.ByName("ValidatePaymentSession")),
 		connect.WithHandlerOptions(opts...),
 	)
+	paymentsServiceGetReservationLedgerHandler := connect.NewUnaryHandler(
+		PaymentsServiceGetReservationLedgerProcedure,
+		svc.GetReservationLedger,
+		connect.WithSchema(paymentsServiceMethods.ByName("GetReservationLedger")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceRecordManualPaymentHandler := connect.NewUnaryHandler(
+		PaymentsServiceRecordManualPaymentProcedure,
+		svc.RecordManualPayment,
+		connect.WithSchema(paymentsServiceMethods.ByName("RecordManualPayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceRefundPaymentHandler := connect.NewUnaryHandler(
+		PaymentsServiceRefundPaymentProcedure,
+		svc.RefundPayment,
+		connect.WithSchema(paymentsServiceMethods.ByName("RefundPayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceIssueInvoiceHandler := connect.NewUnaryHandler(
+		PaymentsServiceIssueInvoiceProcedure,
+		svc.IssueInvoice,
+		connect.WithSchema(paymentsServiceMethods.ByName("IssueInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceListInvoicesHandler := connect.NewUnaryHandler(
+		PaymentsServiceListInvoicesProcedure,
+		svc.ListInvoices,
+		connect.WithSchema(paymentsServiceMethods.ByName("ListInvoices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceListPaymentMethodsHandler := connect.NewUnaryHandler(
+		PaymentsServiceListPaymentMethodsProcedure,
+		svc.ListPaymentMethods,
+		connect.WithSchema(paymentsServiceMethods.ByName("ListPaymentMethods")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceStartPaymentHandler := connect.NewUnaryHandler(
+		PaymentsServiceStartPaymentProcedure,
+		svc.StartPayment,
+		connect.WithSchema(paymentsServiceMethods.ByName("StartPayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceListPaymentSubmissionsHandler := connect.NewUnaryHandler(
+		PaymentsServiceListPaymentSubmissionsProcedure,
+		svc.ListPaymentSubmissions,
+		connect.WithSchema(paymentsServiceMethods.ByName("ListPaymentSubmissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceReviewPaymentSubmissionHandler := connect.NewUnaryHandler(
+		PaymentsServiceReviewPaymentSubmissionProcedure,
+		svc.ReviewPaymentSubmission,
+		connect.WithSchema(paymentsServiceMethods.ByName("ReviewPaymentSubmission")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.payments.PaymentsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PaymentsServiceCreatePaymentIntentProcedure:
@@ -170,6 +377,24 @@ func NewPaymentsServiceHandler(svc PaymentsServiceHandler, opts ...connect.Handl
 			paymentsServiceCreatePaymentSessionHandler.ServeHTTP(w, r)
 		case PaymentsServiceValidatePaymentSessionProcedure:
 			paymentsServiceValidatePaymentSessionHandler.ServeHTTP(w, r)
+		case PaymentsServiceGetReservationLedgerProcedure:
+			paymentsServiceGetReservationLedgerHandler.ServeHTTP(w, r)
+		case PaymentsServiceRecordManualPaymentProcedure:
+			paymentsServiceRecordManualPaymentHandler.ServeHTTP(w, r)
+		case PaymentsServiceRefundPaymentProcedure:
+			paymentsServiceRefundPaymentHandler.ServeHTTP(w, r)
+		case PaymentsServiceIssueInvoiceProcedure:
+			paymentsServiceIssueInvoiceHandler.ServeHTTP(w, r)
+		case PaymentsServiceListInvoicesProcedure:
+			paymentsServiceListInvoicesHandler.ServeHTTP(w, r)
+		case PaymentsServiceListPaymentMethodsProcedure:
+			paymentsServiceListPaymentMethodsHandler.ServeHTTP(w, r)
+		case PaymentsServiceStartPaymentProcedure:
+			paymentsServiceStartPaymentHandler.ServeHTTP(w, r)
+		case PaymentsServiceListPaymentSubmissionsProcedure:
+			paymentsServiceListPaymentSubmissionsHandler.ServeHTTP(w, r)
+		case PaymentsServiceReviewPaymentSubmissionProcedure:
+			paymentsServiceReviewPaymentSubmissionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -194,3 +419,39 @@ func (UnimplementedPaymentsServiceHandler) CreatePaymentSession(context.Context,
 func (UnimplementedPaymentsServiceHandler) ValidatePaymentSession(context.Context, *connect.Request[payments.ValidatePaymentSessionRequest]) (*connect.Response[payments.ValidatePaymentSessionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.ValidatePaymentSession is not implemented"))
 }
+
+func (UnimplementedPaymentsServiceHandler) GetReservationLedger(context.Context, *connect.Request[payments.GetReservationLedgerRequest]) (*connect.Response[payments.GetReservationLedgerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.GetReservationLedger is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) RecordManualPayment(context.Context, *connect.Request[payments.RecordManualPaymentRequest]) (*connect.Response[payments.RecordManualPaymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.RecordManualPayment is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) RefundPayment(context.Context, *connect.Request[payments.RefundPaymentRequest]) (*connect.Response[payments.RefundPaymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.RefundPayment is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) IssueInvoice(context.Context, *connect.Request[payments.IssueInvoiceRequest]) (*connect.Response[payments.IssueInvoiceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.IssueInvoice is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) ListInvoices(context.Context, *connect.Request[payments.ListInvoicesRequest]) (*connect.Response[payments.ListInvoicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.ListInvoices is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) ListPaymentMethods(context.Context, *connect.Request[payments.ListPaymentMethodsRequest]) (*connect.Response[payments.ListPaymentMethodsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.ListPaymentMethods is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) StartPayment(context.Context, *connect.Request[payments.StartPaymentRequest]) (*connect.Response[payments.StartPaymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.StartPayment is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) ListPaymentSubmissions(context.Context, *connect.Request[payments.ListPaymentSubmissionsRequest]) (*connect.Response[payments.ListPaymentSubmissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.ListPaymentSubmissions is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) ReviewPaymentSubmission(context.Context, *connect.Request[payments.ReviewPaymentSubmissionRequest]) (*connect.Response[payments.ReviewPaymentSubmissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.payments.PaymentsService.ReviewPaymentSubmission is not implemented"))
+}
@@ -22,38 +22,48 @@ const (
 )
 
 type Reservation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventName     string                 `protobuf:"bytes,3,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
-	FacilityId    int64                  `protobuf:"varint,4,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
-	Approved      string                 `protobuf:"bytes,5,opt,name=approved,proto3" json:"approved,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Details       string                 `protobuf:"bytes,8,opt,name=details,proto3" json:"details,omitempty"`
-	Fees          string                 `protobuf:"bytes,9,opt,name=fees,proto3" json:"fees,omitempty"` // pgtype.Numeric as string
-	Insurance     bool                   `protobuf:"varint,10,opt,name=insurance,proto3" json:"insurance,omitempty"`
-	DoorAccess    bool                   `protobuf:"varint,11,opt,name=door_access,json=doorAccess,proto3" json:"door_access,omitempty"`
-	DoorsDetails  string                 `protobuf:"bytes,12,opt,name=doors_details,json=doorsDetails,proto3" json:"doors_details,omitempty"`
-	Name          string                 `protobuf:"bytes,13,opt,name=name,proto3" json:"name,omitempty"`
-	TechDetails   string                 `protobuf:"bytes,14,opt,name=tech_details,json=techDetails,proto3" json:"tech_details,omitempty"`
-	TechSupport   bool                   `protobuf:"varint,15,opt,name=tech_support,json=techSupport,proto3" json:"tech_support,omitempty"`
-	Phone         string                 `protobuf:"bytes,16,opt,name=phone,proto3" json:"phone,omitempty"`
-	CategoryId    int64                  `protobuf:"varint,17,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	TotalHours    float64                `protobuf:"fixed64,18,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
-	InPerson      bool                   `protobuf:"varint,19,opt,name=in_person,json=inPerson,proto3" json:"in_person,omitempty"`
-	Paid          bool                   `protobuf:"varint,20,opt,name=paid,proto3" json:"paid,omitempty"`
-	PaymentUrl    string                 `protobuf:"bytes,21,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
-	PaymentLinkId string                 `protobuf:"bytes,22,opt,name=payment_link_id,json=paymentLinkId,proto3" json:"payment_link_id,omitempty"`
-	InsuranceLink string                 `protobuf:"bytes,23,opt,name=insurance_link,json=insuranceLink,proto3" json:"insurance_link,omitempty"`
-	CostOverride  string                 `protobuf:"bytes,24,opt,name=cost_override,json=costOverride,proto3" json:"cost_override,omitempty"` // pgtype.Numeric as string
-	Rrule         string                 `protobuf:"bytes,25,opt,name=rrule,proto3" json:"rrule,omitempty"`
-	Rdates        []string               `protobuf:"bytes,26,rep,name=rdates,proto3" json:"rdates,omitempty"`
-	Exdates       []string               `protobuf:"bytes,27,rep,name=exdates,proto3" json:"exdates,omitempty"`
-	GcalEventid   string                 `protobuf:"bytes,28,opt,name=gcal_eventid,json=gcalEventid,proto3" json:"gcal_eventid,omitempty"`
-	PriceId       string                 `protobuf:"bytes,29,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventName      string                 `protobuf:"bytes,3,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	FacilityId     int64                  `protobuf:"varint,4,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	Approved       string                 `protobuf:"bytes,5,opt,name=approved,proto3" json:"approved,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Details        string                 `protobuf:"bytes,8,opt,name=details,proto3" json:"details,omitempty"`
+	Fees           string                 `protobuf:"bytes,9,opt,name=fees,proto3" json:"fees,omitempty"` // pgtype.Numeric as string
+	Insurance      bool                   `protobuf:"varint,10,opt,name=insurance,proto3" json:"insurance,omitempty"`
+	DoorAccess     bool                   `protobuf:"varint,11,opt,name=door_access,json=doorAccess,proto3" json:"door_access,omitempty"`
+	DoorsDetails   string                 `protobuf:"bytes,12,opt,name=doors_details,json=doorsDetails,proto3" json:"doors_details,omitempty"`
+	Name           string                 `protobuf:"bytes,13,opt,name=name,proto3" json:"name,omitempty"`
+	TechDetails    string                 `protobuf:"bytes,14,opt,name=tech_details,json=techDetails,proto3" json:"tech_details,omitempty"`
+	TechSupport    bool                   `protobuf:"varint,15,opt,name=tech_support,json=techSupport,proto3" json:"tech_support,omitempty"`
+	Phone          string                 `protobuf:"bytes,16,opt,name=phone,proto3" json:"phone,omitempty"`
+	CategoryId     int64                  `protobuf:"varint,17,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TotalHours     float64                `protobuf:"fixed64,18,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
+	InPerson       bool                   `protobuf:"varint,19,opt,name=in_person,json=inPerson,proto3" json:"in_person,omitempty"`
+	Paid           bool                   `protobuf:"varint,20,opt,name=paid,proto3" json:"paid,omitempty"`
+	PaymentUrl     string                 `protobuf:"bytes,21,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	PaymentLinkId  string                 `protobuf:"bytes,22,opt,name=payment_link_id,json=paymentLinkId,proto3" json:"payment_link_id,omitempty"`
+	InsuranceLink  string                 `protobuf:"bytes,23,opt,name=insurance_link,json=insuranceLink,proto3" json:"insurance_link,omitempty"`
+	CostOverride   string                 `protobuf:"bytes,24,opt,name=cost_override,json=costOverride,proto3" json:"cost_override,omitempty"` // pgtype.Numeric as string
+	Rrule          string                 `protobuf:"bytes,25,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	Rdates         []string               `protobuf:"bytes,26,rep,name=rdates,proto3" json:"rdates,omitempty"`
+	Exdates        []string               `protobuf:"bytes,27,rep,name=exdates,proto3" json:"exdates,omitempty"`
+	GcalEventid    string                 `protobuf:"bytes,28,opt,name=gcal_eventid,json=gcalEventid,proto3" json:"gcal_eventid,omitempty"`
+	PriceId        string                 `protobuf:"bytes,29,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
+	OrganizationId int64                  `protobuf:"varint,30,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// public, hide_details or private: how much published calendars show
+	Visibility string `protobuf:"bytes,31,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// replaces event_name on published calendars when set
+	PublicTitle string `protobuf:"bytes,32,opt,name=public_title,json=publicTitle,proto3" json:"public_title,omitempty"`
+	// unpaid, paid, partially_refunded, refunded, disputed or dispute_lost;
+	// set from Stripe webhooks
+	PaymentStatus string `protobuf:"bytes,33,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
+	// the balance was not paid by its due date
+	PaymentOverdue bool `protobuf:"varint,34,opt,name=payment_overdue,json=paymentOverdue,proto3" json:"payment_overdue,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Reservation) Reset() {
@@ -289,6 +299,41 @@ func (x *Reservation) GetPriceId() string {
 	return ""
 }
 
+func (x *Reservation) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *Reservation) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Reservation) GetPublicTitle() string {
+	if x != nil {
+		return x.PublicTitle
+	}
+	return ""
+}
+
+func (x *Reservation) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *Reservation) GetPaymentOverdue() bool {
+	if x != nil {
+		return x.PaymentOverdue
+	}
+	return false
+}
+
 type ReservationDate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -561,18 +606,155 @@ func (x *ReservationFee) GetReservationId() int64 {
 	return 0
 }
 
+type ReservationEquipment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReservationId int64                  `protobuf:"varint,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EquipmentId   int64                  `protobuf:"varint,3,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FeeId         int64                  `protobuf:"varint,6,opt,name=fee_id,json=feeId,proto3" json:"fee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationEquipment) Reset() {
+	*x = ReservationEquipment{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationEquipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationEquipment) ProtoMessage() {}
+
+func (x *ReservationEquipment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationEquipment.ProtoReflect.Descriptor instead.
+func (*ReservationEquipment) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReservationEquipment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReservationEquipment) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *ReservationEquipment) GetEquipmentId() int64 {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return 0
+}
+
+func (x *ReservationEquipment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReservationEquipment) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReservationEquipment) GetFeeId() int64 {
+	if x != nil {
+		return x.FeeId
+	}
+	return 0
+}
+
+type EquipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EquipmentId   int64                  `protobuf:"varint,1,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentRequest) Reset() {
+	*x = EquipmentRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentRequest) ProtoMessage() {}
+
+func (x *EquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentRequest.ProtoReflect.Descriptor instead.
+func (*EquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EquipmentRequest) GetEquipmentId() int64 {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return 0
+}
+
+func (x *EquipmentRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 type FullReservation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
 	Dates         []*ReservationDate     `protobuf:"bytes,2,rep,name=dates,proto3" json:"dates,omitempty"`
 	Fees          []*ReservationFee      `protobuf:"bytes,3,rep,name=fees,proto3" json:"fees,omitempty"`
+	IntakeAnswers string                 `protobuf:"bytes,4,opt,name=intake_answers,json=intakeAnswers,proto3" json:"intake_answers,omitempty"` // JSON object keyed by form field key
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FullReservation) Reset() {
 	*x = FullReservation{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[5]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -584,7 +766,7 @@ func (x *FullReservation) String() string {
 func (*FullReservation) ProtoMessage() {}
 
 func (x *FullReservation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[5]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +779,7 @@ func (x *FullReservation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FullReservation.ProtoReflect.Descriptor instead.
 func (*FullReservation) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{5}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FullReservation) GetReservation() *Reservation {
@@ -621,6 +803,13 @@ func (x *FullReservation) GetFees() []*ReservationFee {
 	return nil
 }
 
+func (x *FullReservation) GetIntakeAnswers() string {
+	if x != nil {
+		return x.IntakeAnswers
+	}
+	return ""
+}
+
 type FullResWithFacilityName struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	EventName       string                 `protobuf:"bytes,1,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
@@ -635,7 +824,7 @@ type FullResWithFacilityName struct {
 
 func (x *FullResWithFacilityName) Reset() {
 	*x = FullResWithFacilityName{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[6]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -647,7 +836,7 @@ func (x *FullResWithFacilityName) String() string {
 func (*FullResWithFacilityName) ProtoMessage() {}
 
 func (x *FullResWithFacilityName) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[6]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +849,7 @@ func (x *FullResWithFacilityName) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FullResWithFacilityName.ProtoReflect.Descriptor instead.
 func (*FullResWithFacilityName) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{6}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FullResWithFacilityName) GetEventName() string {
@@ -714,7 +903,7 @@ type AllPendingResponse struct {
 
 func (x *AllPendingResponse) Reset() {
 	*x = AllPendingResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[7]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +915,7 @@ func (x *AllPendingResponse) String() string {
 func (*AllPendingResponse) ProtoMessage() {}
 
 func (x *AllPendingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[7]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +928,7 @@ func (x *AllPendingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllPendingResponse.ProtoReflect.Descriptor instead.
 func (*AllPendingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{7}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AllPendingResponse) GetData() []*FullResWithFacilityName {
@@ -759,7 +948,7 @@ type AllSortedResponse struct {
 
 func (x *AllSortedResponse) Reset() {
 	*x = AllSortedResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[8]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -771,7 +960,7 @@ func (x *AllSortedResponse) String() string {
 func (*AllSortedResponse) ProtoMessage() {}
 
 func (x *AllSortedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[8]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -784,7 +973,7 @@ func (x *AllSortedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllSortedResponse.ProtoReflect.Descriptor instead.
 func (*AllSortedResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{8}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AllSortedResponse) GetPast() []*FullResWithFacilityName {
@@ -811,7 +1000,7 @@ type UpdateReservationStatusRequest struct {
 
 func (x *UpdateReservationStatusRequest) Reset() {
 	*x = UpdateReservationStatusRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[9]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -823,7 +1012,7 @@ func (x *UpdateReservationStatusRequest) String() string {
 func (*UpdateReservationStatusRequest) ProtoMessage() {}
 
 func (x *UpdateReservationStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[9]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,7 +1025,7 @@ func (x *UpdateReservationStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReservationStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateReservationStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{9}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateReservationStatusRequest) GetId() int64 {
@@ -863,7 +1052,7 @@ type UpdateReservationDatesStatusRequest struct {
 
 func (x *UpdateReservationDatesStatusRequest) Reset() {
 	*x = UpdateReservationDatesStatusRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[10]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -875,7 +1064,7 @@ func (x *UpdateReservationDatesStatusRequest) String() string {
 func (*UpdateReservationDatesStatusRequest) ProtoMessage() {}
 
 func (x *UpdateReservationDatesStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[10]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +1077,7 @@ func (x *UpdateReservationDatesStatusRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateReservationDatesStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateReservationDatesStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{10}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateReservationDatesStatusRequest) GetIds() []int64 {
@@ -913,7 +1102,7 @@ type UpdateReservationDatesStatusResponse struct {
 
 func (x *UpdateReservationDatesStatusResponse) Reset() {
 	*x = UpdateReservationDatesStatusResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[11]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -925,7 +1114,7 @@ func (x *UpdateReservationDatesStatusResponse) String() string {
 func (*UpdateReservationDatesStatusResponse) ProtoMessage() {}
 
 func (x *UpdateReservationDatesStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[11]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,7 +1127,7 @@ func (x *UpdateReservationDatesStatusResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UpdateReservationDatesStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateReservationDatesStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{11}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{13}
 }
 
 type AllReservationsResponse struct {
@@ -950,7 +1139,7 @@ type AllReservationsResponse struct {
 
 func (x *AllReservationsResponse) Reset() {
 	*x = AllReservationsResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[12]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -962,7 +1151,7 @@ func (x *AllReservationsResponse) String() string {
 func (*AllReservationsResponse) ProtoMessage() {}
 
 func (x *AllReservationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[12]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1164,7 @@ func (x *AllReservationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllReservationsResponse.ProtoReflect.Descriptor instead.
 func (*AllReservationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{12}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AllReservationsResponse) GetReservations() []*FullReservation {
@@ -994,7 +1183,7 @@ type RequestThisWeekResponse struct {
 
 func (x *RequestThisWeekResponse) Reset() {
 	*x = RequestThisWeekResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[13]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1006,7 +1195,7 @@ func (x *RequestThisWeekResponse) String() string {
 func (*RequestThisWeekResponse) ProtoMessage() {}
 
 func (x *RequestThisWeekResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[13]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1208,7 @@ func (x *RequestThisWeekResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestThisWeekResponse.ProtoReflect.Descriptor instead.
 func (*RequestThisWeekResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{13}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RequestThisWeekResponse) GetReservations() []*FullReservation {
@@ -1038,7 +1227,7 @@ type ApprovedReservationsResponse struct {
 
 func (x *ApprovedReservationsResponse) Reset() {
 	*x = ApprovedReservationsResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[14]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1050,7 +1239,7 @@ func (x *ApprovedReservationsResponse) String() string {
 func (*ApprovedReservationsResponse) ProtoMessage() {}
 
 func (x *ApprovedReservationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[14]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1063,7 +1252,7 @@ func (x *ApprovedReservationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovedReservationsResponse.ProtoReflect.Descriptor instead.
 func (*ApprovedReservationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{14}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ApprovedReservationsResponse) GetReservations() []*FullReservation {
@@ -1082,7 +1271,7 @@ type PendingReservationsResponse struct {
 
 func (x *PendingReservationsResponse) Reset() {
 	*x = PendingReservationsResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[15]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1094,7 +1283,7 @@ func (x *PendingReservationsResponse) String() string {
 func (*PendingReservationsResponse) ProtoMessage() {}
 
 func (x *PendingReservationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[15]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1296,7 @@ func (x *PendingReservationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingReservationsResponse.ProtoReflect.Descriptor instead.
 func (*PendingReservationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{15}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PendingReservationsResponse) GetReservations() []*FullReservation {
@@ -1126,7 +1315,7 @@ type UserReservationsResponse struct {
 
 func (x *UserReservationsResponse) Reset() {
 	*x = UserReservationsResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[16]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1138,7 +1327,7 @@ func (x *UserReservationsResponse) String() string {
 func (*UserReservationsResponse) ProtoMessage() {}
 
 func (x *UserReservationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[16]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,7 +1340,7 @@ func (x *UserReservationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserReservationsResponse.ProtoReflect.Descriptor instead.
 func (*UserReservationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{16}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UserReservationsResponse) GetReservations() []*FullResWithFacilityName {
@@ -1169,7 +1358,7 @@ type GetAllReservationsRequest struct {
 
 func (x *GetAllReservationsRequest) Reset() {
 	*x = GetAllReservationsRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[17]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1181,7 +1370,7 @@ func (x *GetAllReservationsRequest) String() string {
 func (*GetAllReservationsRequest) ProtoMessage() {}
 
 func (x *GetAllReservationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[17]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1194,7 +1383,7 @@ func (x *GetAllReservationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllReservationsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllReservationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{17}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{19}
 }
 
 type GetReservationRequest struct {
@@ -1206,7 +1395,7 @@ type GetReservationRequest struct {
 
 func (x *GetReservationRequest) Reset() {
 	*x = GetReservationRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[18]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1218,7 +1407,7 @@ func (x *GetReservationRequest) String() string {
 func (*GetReservationRequest) ProtoMessage() {}
 
 func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[18]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +1420,7 @@ func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReservationRequest.ProtoReflect.Descriptor instead.
 func (*GetReservationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{18}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetReservationRequest) GetId() int64 {
@@ -1249,7 +1438,7 @@ type RequestCountRequest struct {
 
 func (x *RequestCountRequest) Reset() {
 	*x = RequestCountRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[19]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1261,7 +1450,7 @@ func (x *RequestCountRequest) String() string {
 func (*RequestCountRequest) ProtoMessage() {}
 
 func (x *RequestCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[19]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +1463,7 @@ func (x *RequestCountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestCountRequest.ProtoReflect.Descriptor instead.
 func (*RequestCountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{19}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{21}
 }
 
 type RequestCountResponse struct {
@@ -1286,7 +1475,7 @@ type RequestCountResponse struct {
 
 func (x *RequestCountResponse) Reset() {
 	*x = RequestCountResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[20]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1487,7 @@ func (x *RequestCountResponse) String() string {
 func (*RequestCountResponse) ProtoMessage() {}
 
 func (x *RequestCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[20]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1500,7 @@ func (x *RequestCountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestCountResponse.ProtoReflect.Descriptor instead.
 func (*RequestCountResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{20}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RequestCountResponse) GetCount() int64 {
@@ -1329,7 +1518,7 @@ type GetRequestsThisWeekRequest struct {
 
 func (x *GetRequestsThisWeekRequest) Reset() {
 	*x = GetRequestsThisWeekRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[21]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1341,7 +1530,7 @@ func (x *GetRequestsThisWeekRequest) String() string {
 func (*GetRequestsThisWeekRequest) ProtoMessage() {}
 
 func (x *GetRequestsThisWeekRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[21]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,37 +1543,42 @@ func (x *GetRequestsThisWeekRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequestsThisWeekRequest.ProtoReflect.Descriptor instead.
 func (*GetRequestsThisWeekRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{21}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{23}
 }
 
 type CreateReservationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventName     string                 `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
-	FacilityId    int64                  `protobuf:"varint,3,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
-	Details       string                 `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
-	PricingId     string                 `protobuf:"bytes,5,opt,name=pricing_id,json=pricingId,proto3" json:"pricing_id,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
-	TechSupport   bool                   `protobuf:"varint,8,opt,name=tech_support,json=techSupport,proto3" json:"tech_support,omitempty"`
-	TechDetails   string                 `protobuf:"bytes,9,opt,name=tech_details,json=techDetails,proto3" json:"tech_details,omitempty"`
-	DoorAccess    bool                   `protobuf:"varint,10,opt,name=door_access,json=doorAccess,proto3" json:"door_access,omitempty"`
-	DoorsDetails  string                 `protobuf:"bytes,11,opt,name=doors_details,json=doorsDetails,proto3" json:"doors_details,omitempty"`
-	Occurrences   []*Occurrence          `protobuf:"bytes,12,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
-	StartDate     string                 `protobuf:"bytes,13,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	StartTime     string                 `protobuf:"bytes,14,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndDate       string                 `protobuf:"bytes,15,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	EndTime       string                 `protobuf:"bytes,16,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Pattern       *RecurrencePattern     `protobuf:"bytes,17,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Rdates        []string               `protobuf:"bytes,18,rep,name=rdates,proto3" json:"rdates,omitempty"`
-	Exdates       []string               `protobuf:"bytes,19,rep,name=exdates,proto3" json:"exdates,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventName      string                 `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	FacilityId     int64                  `protobuf:"varint,3,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	Details        string                 `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	PricingId      string                 `protobuf:"bytes,5,opt,name=pricing_id,json=pricingId,proto3" json:"pricing_id,omitempty"`
+	Name           string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Phone          string                 `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
+	TechSupport    bool                   `protobuf:"varint,8,opt,name=tech_support,json=techSupport,proto3" json:"tech_support,omitempty"`
+	TechDetails    string                 `protobuf:"bytes,9,opt,name=tech_details,json=techDetails,proto3" json:"tech_details,omitempty"`
+	DoorAccess     bool                   `protobuf:"varint,10,opt,name=door_access,json=doorAccess,proto3" json:"door_access,omitempty"`
+	DoorsDetails   string                 `protobuf:"bytes,11,opt,name=doors_details,json=doorsDetails,proto3" json:"doors_details,omitempty"`
+	Occurrences    []*Occurrence          `protobuf:"bytes,12,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	StartDate      string                 `protobuf:"bytes,13,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	StartTime      string                 `protobuf:"bytes,14,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndDate        string                 `protobuf:"bytes,15,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	EndTime        string                 `protobuf:"bytes,16,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Pattern        *RecurrencePattern     `protobuf:"bytes,17,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Rdates         []string               `protobuf:"bytes,18,rep,name=rdates,proto3" json:"rdates,omitempty"`
+	Exdates        []string               `protobuf:"bytes,19,rep,name=exdates,proto3" json:"exdates,omitempty"`
+	OrganizationId int64                  `protobuf:"varint,20,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	IntakeAnswers  string                 `protobuf:"bytes,21,opt,name=intake_answers,json=intakeAnswers,proto3" json:"intake_answers,omitempty"` // JSON object keyed by form field key
+	Equipment      []*EquipmentRequest    `protobuf:"bytes,22,rep,name=equipment,proto3" json:"equipment,omitempty"`
+	Visibility     string                 `protobuf:"bytes,23,opt,name=visibility,proto3" json:"visibility,omitempty"` // defaults to private
+	PublicTitle    string                 `protobuf:"bytes,24,opt,name=public_title,json=publicTitle,proto3" json:"public_title,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateReservationRequest) Reset() {
 	*x = CreateReservationRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[22]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1396,7 +1590,7 @@ func (x *CreateReservationRequest) String() string {
 func (*CreateReservationRequest) ProtoMessage() {}
 
 func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[22]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,7 +1603,7 @@ func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
 func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{22}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CreateReservationRequest) GetUserId() string {
@@ -1545,6 +1739,41 @@ func (x *CreateReservationRequest) GetExdates() []string {
 	return nil
 }
 
+func (x *CreateReservationRequest) GetOrganizationId() int64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *CreateReservationRequest) GetIntakeAnswers() string {
+	if x != nil {
+		return x.IntakeAnswers
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetEquipment() []*EquipmentRequest {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetPublicTitle() string {
+	if x != nil {
+		return x.PublicTitle
+	}
+	return ""
+}
+
 type CreateReservationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1554,7 +1783,7 @@ type CreateReservationResponse struct {
 
 func (x *CreateReservationResponse) Reset() {
 	*x = CreateReservationResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[23]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1566,7 +1795,7 @@ func (x *CreateReservationResponse) String() string {
 func (*CreateReservationResponse) ProtoMessage() {}
 
 func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[23]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,7 +1808,7 @@ func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
 func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{23}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateReservationResponse) GetId() int64 {
@@ -1598,7 +1827,7 @@ type UpdateReservationRequest struct {
 
 func (x *UpdateReservationRequest) Reset() {
 	*x = UpdateReservationRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[24]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1610,7 +1839,7 @@ func (x *UpdateReservationRequest) String() string {
 func (*UpdateReservationRequest) ProtoMessage() {}
 
 func (x *UpdateReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[24]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1623,7 +1852,7 @@ func (x *UpdateReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReservationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateReservationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{24}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UpdateReservationRequest) GetReservation() *Reservation {
@@ -1641,7 +1870,7 @@ type UpdateReservationResponse struct {
 
 func (x *UpdateReservationResponse) Reset() {
 	*x = UpdateReservationResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[25]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1653,7 +1882,7 @@ func (x *UpdateReservationResponse) String() string {
 func (*UpdateReservationResponse) ProtoMessage() {}
 
 func (x *UpdateReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[25]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,7 +1895,59 @@ func (x *UpdateReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReservationResponse.ProtoReflect.Descriptor instead.
 func (*UpdateReservationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{25}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{27}
+}
+
+type UpdateIntakeAnswersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	IntakeAnswers string                 `protobuf:"bytes,2,opt,name=intake_answers,json=intakeAnswers,proto3" json:"intake_answers,omitempty"` // JSON object keyed by form field key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIntakeAnswersRequest) Reset() {
+	*x = UpdateIntakeAnswersRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIntakeAnswersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIntakeAnswersRequest) ProtoMessage() {}
+
+func (x *UpdateIntakeAnswersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIntakeAnswersRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIntakeAnswersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateIntakeAnswersRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *UpdateIntakeAnswersRequest) GetIntakeAnswers() string {
+	if x != nil {
+		return x.IntakeAnswers
+	}
+	return ""
 }
 
 type DeleteReservationRequest struct {
@@ -1678,7 +1959,7 @@ type DeleteReservationRequest struct {
 
 func (x *DeleteReservationRequest) Reset() {
 	*x = DeleteReservationRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[26]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1690,7 +1971,7 @@ func (x *DeleteReservationRequest) String() string {
 func (*DeleteReservationRequest) ProtoMessage() {}
 
 func (x *DeleteReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[26]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,7 +1984,7 @@ func (x *DeleteReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReservationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReservationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{26}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteReservationRequest) GetId() int64 {
@@ -1721,7 +2002,7 @@ type DeleteReservationResponse struct {
 
 func (x *DeleteReservationResponse) Reset() {
 	*x = DeleteReservationResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[27]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1733,7 +2014,7 @@ func (x *DeleteReservationResponse) String() string {
 func (*DeleteReservationResponse) ProtoMessage() {}
 
 func (x *DeleteReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[27]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1746,7 +2027,7 @@ func (x *DeleteReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReservationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteReservationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{27}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{30}
 }
 
 type UserReservationsRequest struct {
@@ -1758,7 +2039,7 @@ type UserReservationsRequest struct {
 
 func (x *UserReservationsRequest) Reset() {
 	*x = UserReservationsRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[28]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1770,7 +2051,7 @@ func (x *UserReservationsRequest) String() string {
 func (*UserReservationsRequest) ProtoMessage() {}
 
 func (x *UserReservationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[28]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,7 +2064,7 @@ func (x *UserReservationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserReservationsRequest.ProtoReflect.Descriptor instead.
 func (*UserReservationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{28}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UserReservationsRequest) GetUserId() string {
@@ -1802,7 +2083,7 @@ type CreateReservationDatesRequest struct {
 
 func (x *CreateReservationDatesRequest) Reset() {
 	*x = CreateReservationDatesRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[29]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1814,7 +2095,7 @@ func (x *CreateReservationDatesRequest) String() string {
 func (*CreateReservationDatesRequest) ProtoMessage() {}
 
 func (x *CreateReservationDatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[29]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1827,7 +2108,7 @@ func (x *CreateReservationDatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationDatesRequest.ProtoReflect.Descriptor instead.
 func (*CreateReservationDatesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{29}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CreateReservationDatesRequest) GetDate() []*ReservationDate {
@@ -1845,7 +2126,7 @@ type CreateReservationDatesResponse struct {
 
 func (x *CreateReservationDatesResponse) Reset() {
 	*x = CreateReservationDatesResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[30]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1857,7 +2138,7 @@ func (x *CreateReservationDatesResponse) String() string {
 func (*CreateReservationDatesResponse) ProtoMessage() {}
 
 func (x *CreateReservationDatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[30]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,7 +2151,7 @@ func (x *CreateReservationDatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationDatesResponse.ProtoReflect.Descriptor instead.
 func (*CreateReservationDatesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{30}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{33}
 }
 
 type UpdateReservationDatesResponse struct {
@@ -1881,7 +2162,7 @@ type UpdateReservationDatesResponse struct {
 
 func (x *UpdateReservationDatesResponse) Reset() {
 	*x = UpdateReservationDatesResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[31]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1893,7 +2174,7 @@ func (x *UpdateReservationDatesResponse) String() string {
 func (*UpdateReservationDatesResponse) ProtoMessage() {}
 
 func (x *UpdateReservationDatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[31]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1906,7 +2187,7 @@ func (x *UpdateReservationDatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReservationDatesResponse.ProtoReflect.Descriptor instead.
 func (*UpdateReservationDatesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{31}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{34}
 }
 
 type DeleteReservationDatesResponse struct {
@@ -1917,7 +2198,7 @@ type DeleteReservationDatesResponse struct {
 
 func (x *DeleteReservationDatesResponse) Reset() {
 	*x = DeleteReservationDatesResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[32]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1929,7 +2210,7 @@ func (x *DeleteReservationDatesResponse) String() string {
 func (*DeleteReservationDatesResponse) ProtoMessage() {}
 
 func (x *DeleteReservationDatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[32]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,7 +2223,7 @@ func (x *DeleteReservationDatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReservationDatesResponse.ProtoReflect.Descriptor instead.
 func (*DeleteReservationDatesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{32}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{35}
 }
 
 type CreateReservationFeeResponse struct {
@@ -1953,7 +2234,7 @@ type CreateReservationFeeResponse struct {
 
 func (x *CreateReservationFeeResponse) Reset() {
 	*x = CreateReservationFeeResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[33]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1965,7 +2246,7 @@ func (x *CreateReservationFeeResponse) String() string {
 func (*CreateReservationFeeResponse) ProtoMessage() {}
 
 func (x *CreateReservationFeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[33]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,7 +2259,7 @@ func (x *CreateReservationFeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationFeeResponse.ProtoReflect.Descriptor instead.
 func (*CreateReservationFeeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{33}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{36}
 }
 
 type UpdateReservationFeeResponse struct {
@@ -1989,7 +2270,7 @@ type UpdateReservationFeeResponse struct {
 
 func (x *UpdateReservationFeeResponse) Reset() {
 	*x = UpdateReservationFeeResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[34]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2001,7 +2282,7 @@ func (x *UpdateReservationFeeResponse) String() string {
 func (*UpdateReservationFeeResponse) ProtoMessage() {}
 
 func (x *UpdateReservationFeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[34]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,7 +2295,7 @@ func (x *UpdateReservationFeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReservationFeeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateReservationFeeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{34}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{37}
 }
 
 type DeleteReservationFeeResponse struct {
@@ -2025,7 +2306,7 @@ type DeleteReservationFeeResponse struct {
 
 func (x *DeleteReservationFeeResponse) Reset() {
 	*x = DeleteReservationFeeResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[35]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2037,7 +2318,7 @@ func (x *DeleteReservationFeeResponse) String() string {
 func (*DeleteReservationFeeResponse) ProtoMessage() {}
 
 func (x *DeleteReservationFeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[35]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2050,7 +2331,7 @@ func (x *DeleteReservationFeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReservationFeeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteReservationFeeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{35}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{38}
 }
 
 type UpdateReservationDatesRequest struct {
@@ -2062,7 +2343,7 @@ type UpdateReservationDatesRequest struct {
 
 func (x *UpdateReservationDatesRequest) Reset() {
 	*x = UpdateReservationDatesRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[36]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2074,7 +2355,7 @@ func (x *UpdateReservationDatesRequest) String() string {
 func (*UpdateReservationDatesRequest) ProtoMessage() {}
 
 func (x *UpdateReservationDatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[36]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2087,7 +2368,7 @@ func (x *UpdateReservationDatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReservationDatesRequest.ProtoReflect.Descriptor instead.
 func (*UpdateReservationDatesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{36}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UpdateReservationDatesRequest) GetDate() []*ReservationDate {
@@ -2106,7 +2387,7 @@ type DeleteReservationDatesRequest struct {
 
 func (x *DeleteReservationDatesRequest) Reset() {
 	*x = DeleteReservationDatesRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[37]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2118,7 +2399,7 @@ func (x *DeleteReservationDatesRequest) String() string {
 func (*DeleteReservationDatesRequest) ProtoMessage() {}
 
 func (x *DeleteReservationDatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[37]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,7 +2412,7 @@ func (x *DeleteReservationDatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReservationDatesRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReservationDatesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{37}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DeleteReservationDatesRequest) GetId() []int64 {
@@ -2150,7 +2431,7 @@ type CreateReservationFeeRequest struct {
 
 func (x *CreateReservationFeeRequest) Reset() {
 	*x = CreateReservationFeeRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[38]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2162,7 +2443,7 @@ func (x *CreateReservationFeeRequest) String() string {
 func (*CreateReservationFeeRequest) ProtoMessage() {}
 
 func (x *CreateReservationFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[38]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2175,7 +2456,7 @@ func (x *CreateReservationFeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationFeeRequest.ProtoReflect.Descriptor instead.
 func (*CreateReservationFeeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{38}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CreateReservationFeeRequest) GetFee() []*ReservationFee {
@@ -2194,7 +2475,7 @@ type UpdateReservationFeeRequest struct {
 
 func (x *UpdateReservationFeeRequest) Reset() {
 	*x = UpdateReservationFeeRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[39]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2206,7 +2487,7 @@ func (x *UpdateReservationFeeRequest) String() string {
 func (*UpdateReservationFeeRequest) ProtoMessage() {}
 
 func (x *UpdateReservationFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[39]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2219,7 +2500,7 @@ func (x *UpdateReservationFeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReservationFeeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateReservationFeeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{39}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UpdateReservationFeeRequest) GetFee() *ReservationFee {
@@ -2238,7 +2519,7 @@ type DeleteReservationFeeRequest struct {
 
 func (x *DeleteReservationFeeRequest) Reset() {
 	*x = DeleteReservationFeeRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[40]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2250,7 +2531,7 @@ func (x *DeleteReservationFeeRequest) String() string {
 func (*DeleteReservationFeeRequest) ProtoMessage() {}
 
 func (x *DeleteReservationFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[40]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2263,7 +2544,7 @@ func (x *DeleteReservationFeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReservationFeeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReservationFeeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{40}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DeleteReservationFeeRequest) GetId() int64 {
@@ -2282,7 +2563,7 @@ type CostReducerRequest struct {
 
 func (x *CostReducerRequest) Reset() {
 	*x = CostReducerRequest{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[41]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2294,7 +2575,7 @@ func (x *CostReducerRequest) String() string {
 func (*CostReducerRequest) ProtoMessage() {}
 
 func (x *CostReducerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[41]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2307,7 +2588,7 @@ func (x *CostReducerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CostReducerRequest.ProtoReflect.Descriptor instead.
 func (*CostReducerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{41}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CostReducerRequest) GetId() int64 {
@@ -2326,7 +2607,7 @@ type CostReducerResponse struct {
 
 func (x *CostReducerResponse) Reset() {
 	*x = CostReducerResponse{}
-	mi := &file_proto_reservation_reservation_proto_msgTypes[42]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2338,7 +2619,7 @@ func (x *CostReducerResponse) String() string {
 func (*CostReducerResponse) ProtoMessage() {}
 
 func (x *CostReducerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_reservation_reservation_proto_msgTypes[42]
+	mi := &file_proto_reservation_reservation_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2351,7 +2632,7 @@ func (x *CostReducerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CostReducerResponse.ProtoReflect.Descriptor instead.
 func (*CostReducerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{42}
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CostReducerResponse) GetCost() string {
@@ -2361,88 +2642,1446 @@ func (x *CostReducerResponse) GetCost() string {
 	return ""
 }
 
-var File_proto_reservation_reservation_proto protoreflect.FileDescriptor
+type GetReservationEquipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_reservation_reservation_proto_rawDesc = "" +
-	"\n" +
-	"#proto/reservation/reservation.proto\x12\x0fapi.reservation\"\xec\x06\n" +
-	"\vReservation\x12\x12\n" +
-	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
-	"\n" +
-	"event_name\x18\x03 \x01(\tR\teventName\x12#\n" +
-	"\vfacility_id\x18\x04 \x01(\x03B\x020\x01R\n" +
-	"facilityId\x12\x1a\n" +
-	"\bapproved\x18\x05 \x01(\tR\bapproved\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x18\n" +
-	"\adetails\x18\b \x01(\tR\adetails\x12\x12\n" +
-	"\x04fees\x18\t \x01(\tR\x04fees\x12\x1c\n" +
-	"\tinsurance\x18\n" +
-	" \x01(\bR\tinsurance\x12\x1f\n" +
-	"\vdoor_access\x18\v \x01(\bR\n" +
-	"doorAccess\x12#\n" +
-	"\rdoors_details\x18\f \x01(\tR\fdoorsDetails\x12\x12\n" +
-	"\x04name\x18\r \x01(\tR\x04name\x12!\n" +
-	"\ftech_details\x18\x0e \x01(\tR\vtechDetails\x12!\n" +
-	"\ftech_support\x18\x0f \x01(\bR\vtechSupport\x12\x14\n" +
-	"\x05phone\x18\x10 \x01(\tR\x05phone\x12#\n" +
-	"\vcategory_id\x18\x11 \x01(\x03B\x020\x01R\n" +
-	"categoryId\x12\x1f\n" +
-	"\vtotal_hours\x18\x12 \x01(\x01R\n" +
-	"totalHours\x12\x1b\n" +
-	"\tin_person\x18\x13 \x01(\bR\binPerson\x12\x12\n" +
-	"\x04paid\x18\x14 \x01(\bR\x04paid\x12\x1f\n" +
-	"\vpayment_url\x18\x15 \x01(\tR\n" +
-	"paymentUrl\x12&\n" +
-	"\x0fpayment_link_id\x18\x16 \x01(\tR\rpaymentLinkId\x12%\n" +
-	"\x0einsurance_link\x18\x17 \x01(\tR\rinsuranceLink\x12#\n" +
-	"\rcost_override\x18\x18 \x01(\tR\fcostOverride\x12\x14\n" +
-	"\x05rrule\x18\x19 \x01(\tR\x05rrule\x12\x16\n" +
-	"\x06rdates\x18\x1a \x03(\tR\x06rdates\x12\x18\n" +
-	"\aexdates\x18\x1b \x03(\tR\aexdates\x12!\n" +
-	"\fgcal_eventid\x18\x1c \x01(\tR\vgcalEventid\x12\x19\n" +
-	"\bprice_id\x18\x1d \x01(\tR\apriceId\"\xcd\x01\n" +
-	"\x0fReservationDate\x12\x12\n" +
-	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12)\n" +
-	"\x0ereservation_id\x18\x02 \x01(\x03B\x020\x01R\rreservationId\x12\x1a\n" +
-	"\bapproved\x18\x03 \x01(\tR\bapproved\x12!\n" +
-	"\fgcal_eventid\x18\x04 \x01(\tR\vgcalEventid\x12\x1f\n" +
-	"\vlocal_start\x18\x05 \x01(\tR\n" +
-	"localStart\x12\x1b\n" +
-	"\tlocal_end\x18\x06 \x01(\tR\blocalEnd\"r\n" +
-	"\x11RecurrencePattern\x12\x12\n" +
-	"\x04freq\x18\x01 \x01(\tR\x04freq\x12\x1d\n" +
-	"\n" +
-	"by_weekday\x18\x02 \x03(\tR\tbyWeekday\x12\x14\n" +
-	"\x05until\x18\x03 \x01(\tR\x05until\x12\x14\n" +
-	"\x05count\x18\x04 \x01(\x05R\x05count\"4\n" +
-	"\n" +
-	"Occurrence\x12\x14\n" +
-	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
-	"\x03end\x18\x02 \x01(\tR\x03end\"\x95\x01\n" +
-	"\x0eReservationFee\x12\x12\n" +
-	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12'\n" +
-	"\x0fadditional_fees\x18\x02 \x01(\tR\x0eadditionalFees\x12\x1b\n" +
-	"\tfees_type\x18\x03 \x01(\tR\bfeesType\x12)\n" +
-	"\x0ereservation_id\x18\x04 \x01(\x03B\x020\x01R\rreservationId\"\xbe\x01\n" +
-	"\x0fFullReservation\x12>\n" +
-	"\vreservation\x18\x01 \x01(\v2\x1c.api.reservation.ReservationR\vreservation\x126\n" +
-	"\x05dates\x18\x02 \x03(\v2 .api.reservation.ReservationDateR\x05dates\x123\n" +
-	"\x04fees\x18\x03 \x03(\v2\x1f.api.reservation.ReservationFeeR\x04fees\"\xec\x01\n" +
-	"\x17FullResWithFacilityName\x12\x1d\n" +
-	"\n" +
-	"event_name\x18\x01 \x01(\tR\teventName\x12#\n" +
-	"\rfacility_name\x18\x02 \x01(\tR\ffacilityName\x12)\n" +
-	"\x10reservation_date\x18\x03 \x01(\tR\x0freservationDate\x12\x1a\n" +
-	"\bapproved\x18\x04 \x01(\tR\bapproved\x12\x1b\n" +
-	"\tuser_name\x18\x05 \x01(\tR\buserName\x12)\n" +
-	"\x0ereservation_id\x18\x06 \x01(\x03B\x020\x01R\rreservationId\"R\n" +
-	"\x12AllPendingResponse\x12<\n" +
-	"\x04data\x18\x01 \x03(\v2(.api.reservation.FullResWithFacilityNameR\x04data\"\x93\x01\n" +
-	"\x11AllSortedResponse\x12<\n" +
+func (x *GetReservationEquipmentRequest) Reset() {
+	*x = GetReservationEquipmentRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationEquipmentRequest) ProtoMessage() {}
+
+func (x *GetReservationEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetReservationEquipmentRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+type GetReservationEquipmentResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Equipment     []*ReservationEquipment `protobuf:"bytes,1,rep,name=equipment,proto3" json:"equipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationEquipmentResponse) Reset() {
+	*x = GetReservationEquipmentResponse{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationEquipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationEquipmentResponse) ProtoMessage() {}
+
+func (x *GetReservationEquipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationEquipmentResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationEquipmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetReservationEquipmentResponse) GetEquipment() []*ReservationEquipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+// Replaces the reservation's add-ons and their fees.
+type SetReservationEquipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Equipment     []*EquipmentRequest    `protobuf:"bytes,2,rep,name=equipment,proto3" json:"equipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReservationEquipmentRequest) Reset() {
+	*x = SetReservationEquipmentRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReservationEquipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReservationEquipmentRequest) ProtoMessage() {}
+
+func (x *SetReservationEquipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReservationEquipmentRequest.ProtoReflect.Descriptor instead.
+func (*SetReservationEquipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetReservationEquipmentRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *SetReservationEquipmentRequest) GetEquipment() []*EquipmentRequest {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+// How many units are free across every occurrence. Set reservation_id to
+// ignore that reservation's own bookings when editing.
+type GetEquipmentAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EquipmentId   int64                  `protobuf:"varint,1,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Occurrences   []*Occurrence          `protobuf:"bytes,2,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	ReservationId int64                  `protobuf:"varint,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEquipmentAvailabilityRequest) Reset() {
+	*x = GetEquipmentAvailabilityRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquipmentAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquipmentAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetEquipmentAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquipmentAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetEquipmentAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetEquipmentAvailabilityRequest) GetEquipmentId() int64 {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return 0
+}
+
+func (x *GetEquipmentAvailabilityRequest) GetOccurrences() []*Occurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+func (x *GetEquipmentAvailabilityRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+type GetEquipmentAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     int32                  `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEquipmentAvailabilityResponse) Reset() {
+	*x = GetEquipmentAvailabilityResponse{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquipmentAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquipmentAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetEquipmentAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquipmentAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetEquipmentAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetEquipmentAvailabilityResponse) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+// Door-unlock schedule for a building's approved bookings that need door access.
+// Buffers fall back to the server defaults when unset.
+type ExportDoorScheduleRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId          int64                  `protobuf:"varint,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	StartDate           string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD, defaults to today
+	EndDate             string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD inclusive, defaults to a week after start
+	Format              string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`                        // csv | json, defaults to csv
+	BufferBeforeMinutes *int32                 `protobuf:"varint,5,opt,name=buffer_before_minutes,json=bufferBeforeMinutes,proto3,oneof" json:"buffer_before_minutes,omitempty"`
+	BufferAfterMinutes  *int32                 `protobuf:"varint,6,opt,name=buffer_after_minutes,json=bufferAfterMinutes,proto3,oneof" json:"buffer_after_minutes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExportDoorScheduleRequest) Reset() {
+	*x = ExportDoorScheduleRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDoorScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDoorScheduleRequest) ProtoMessage() {}
+
+func (x *ExportDoorScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDoorScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ExportDoorScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExportDoorScheduleRequest) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *ExportDoorScheduleRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ExportDoorScheduleRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ExportDoorScheduleRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportDoorScheduleRequest) GetBufferBeforeMinutes() int32 {
+	if x != nil && x.BufferBeforeMinutes != nil {
+		return *x.BufferBeforeMinutes
+	}
+	return 0
+}
+
+func (x *ExportDoorScheduleRequest) GetBufferAfterMinutes() int32 {
+	if x != nil && x.BufferAfterMinutes != nil {
+		return *x.BufferAfterMinutes
+	}
+	return 0
+}
+
+type ExportDoorScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDoorScheduleResponse) Reset() {
+	*x = ExportDoorScheduleResponse{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDoorScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDoorScheduleResponse) ProtoMessage() {}
+
+func (x *ExportDoorScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDoorScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ExportDoorScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ExportDoorScheduleResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportDoorScheduleResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportDoorScheduleResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Loads existing bookings as approved reservations. Defaults apply to rows
+// that do not name their own facility, user (by email) or category.
+type ImportReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // ics | csv
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	FacilityId    int64                  `protobuf:"varint,3,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // defaults to the caller
+	CategoryId    int64                  `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // only report what would be created
+	Publish       bool                   `protobuf:"varint,7,opt,name=publish,proto3" json:"publish,omitempty"`             // add created reservations to facility calendars
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportReservationsRequest) Reset() {
+	*x = ImportReservationsRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReservationsRequest) ProtoMessage() {}
+
+func (x *ImportReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ImportReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ImportReservationsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportReservationsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportReservationsRequest) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *ImportReservationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportReservationsRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ImportReservationsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportReservationsRequest) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+type ImportReservationsRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	EventName     string                 `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	FacilityId    int64                  `protobuf:"varint,3,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	FacilityName  string                 `protobuf:"bytes,4,opt,name=facility_name,json=facilityName,proto3" json:"facility_name,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Occurrences   int32                  `protobuf:"varint,6,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	FirstStart    string                 `protobuf:"bytes,7,opt,name=first_start,json=firstStart,proto3" json:"first_start,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // ready | created | conflict | error
+	Problems      []string               `protobuf:"bytes,9,rep,name=problems,proto3" json:"problems,omitempty"`
+	Warnings      []string               `protobuf:"bytes,10,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	ReservationId int64                  `protobuf:"varint,11,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportReservationsRow) Reset() {
+	*x = ImportReservationsRow{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportReservationsRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReservationsRow) ProtoMessage() {}
+
+func (x *ImportReservationsRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReservationsRow.ProtoReflect.Descriptor instead.
+func (*ImportReservationsRow) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ImportReservationsRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportReservationsRow) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *ImportReservationsRow) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *ImportReservationsRow) GetFacilityName() string {
+	if x != nil {
+		return x.FacilityName
+	}
+	return ""
+}
+
+func (x *ImportReservationsRow) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportReservationsRow) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *ImportReservationsRow) GetFirstStart() string {
+	if x != nil {
+		return x.FirstStart
+	}
+	return ""
+}
+
+func (x *ImportReservationsRow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportReservationsRow) GetProblems() []string {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *ImportReservationsRow) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ImportReservationsRow) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+type ImportReservationsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Rows          []*ImportReservationsRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Created       int32                    `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Skipped       int32                    `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportReservationsResponse) Reset() {
+	*x = ImportReservationsResponse{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReservationsResponse) ProtoMessage() {}
+
+func (x *ImportReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ImportReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImportReservationsResponse) GetRows() []*ImportReservationsRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportReservationsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportReservationsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+// An edit made directly on a facility calendar to a published event.
+type CalendarChange struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReservationDateId int64                  `protobuf:"varint,2,opt,name=reservation_date_id,json=reservationDateId,proto3" json:"reservation_date_id,omitempty"`
+	ReservationId     int64                  `protobuf:"varint,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventName         string                 `protobuf:"bytes,4,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	FacilityId        int64                  `protobuf:"varint,5,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	FacilityName      string                 `protobuf:"bytes,6,opt,name=facility_name,json=facilityName,proto3" json:"facility_name,omitempty"`
+	BuildingId        int64                  `protobuf:"varint,7,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	BuildingName      string                 `protobuf:"bytes,8,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
+	Kind              string                 `protobuf:"bytes,9,opt,name=kind,proto3" json:"kind,omitempty"`      // moved | deleted
+	Status            string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // review | applied | reverted
+	EventId           string                 `protobuf:"bytes,11,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	OldStart          string                 `protobuf:"bytes,12,opt,name=old_start,json=oldStart,proto3" json:"old_start,omitempty"`
+	OldEnd            string                 `protobuf:"bytes,13,opt,name=old_end,json=oldEnd,proto3" json:"old_end,omitempty"`
+	NewStart          string                 `protobuf:"bytes,14,opt,name=new_start,json=newStart,proto3" json:"new_start,omitempty"`
+	NewEnd            string                 `protobuf:"bytes,15,opt,name=new_end,json=newEnd,proto3" json:"new_end,omitempty"`
+	Note              string                 `protobuf:"bytes,16,opt,name=note,proto3" json:"note,omitempty"`
+	DetectedAt        string                 `protobuf:"bytes,17,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	ResolvedAt        string                 `protobuf:"bytes,18,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ResolvedBy        string                 `protobuf:"bytes,19,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CalendarChange) Reset() {
+	*x = CalendarChange{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarChange) ProtoMessage() {}
+
+func (x *CalendarChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarChange.ProtoReflect.Descriptor instead.
+func (*CalendarChange) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CalendarChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CalendarChange) GetReservationDateId() int64 {
+	if x != nil {
+		return x.ReservationDateId
+	}
+	return 0
+}
+
+func (x *CalendarChange) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *CalendarChange) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *CalendarChange) GetFacilityName() string {
+	if x != nil {
+		return x.FacilityName
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *CalendarChange) GetBuildingName() string {
+	if x != nil {
+		return x.BuildingName
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetOldStart() string {
+	if x != nil {
+		return x.OldStart
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetOldEnd() string {
+	if x != nil {
+		return x.OldEnd
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetNewStart() string {
+	if x != nil {
+		return x.NewStart
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetNewEnd() string {
+	if x != nil {
+		return x.NewEnd
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetDetectedAt() string {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *CalendarChange) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+type ListCalendarChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                            // empty lists every change
+	BuildingId    int64                  `protobuf:"varint,2,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"` // 0 lists every building the caller manages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarChangesRequest) Reset() {
+	*x = ListCalendarChangesRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarChangesRequest) ProtoMessage() {}
+
+func (x *ListCalendarChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListCalendarChangesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListCalendarChangesRequest) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+type ListCalendarChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*CalendarChange      `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarChangesResponse) Reset() {
+	*x = ListCalendarChangesResponse{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarChangesResponse) ProtoMessage() {}
+
+func (x *ListCalendarChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListCalendarChangesResponse) GetChanges() []*CalendarChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ResolveCalendarChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// apply makes the reservation date match the calendar; revert puts the
+	// event back the way the reservation has it
+	Action        string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCalendarChangeRequest) Reset() {
+	*x = ResolveCalendarChangeRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCalendarChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCalendarChangeRequest) ProtoMessage() {}
+
+func (x *ResolveCalendarChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCalendarChangeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCalendarChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ResolveCalendarChangeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveCalendarChangeRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ResolveCalendarChangeRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReconcileCalendarsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FacilityId int64                  `protobuf:"varint,1,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"` // reconcile one facility's calendar
+	BuildingId int64                  `protobuf:"varint,2,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"` // or every calendar in a building; both 0 means all
+	// apply republishes missing events, deletes orphans and rewrites the
+	// EXDATEs of drifted series; otherwise only the report is returned
+	Apply         bool `protobuf:"varint,3,opt,name=apply,proto3" json:"apply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCalendarsRequest) Reset() {
+	*x = ReconcileCalendarsRequest{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCalendarsRequest) ProtoMessage() {}
+
+func (x *ReconcileCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReconcileCalendarsRequest) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *ReconcileCalendarsRequest) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *ReconcileCalendarsRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type CalendarDiscrepancy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Kind              string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // missing_event | orphan_event | series_drift
+	FacilityId        int64                  `protobuf:"varint,2,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	ReservationId     int64                  `protobuf:"varint,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	ReservationDateId int64                  `protobuf:"varint,4,opt,name=reservation_date_id,json=reservationDateId,proto3" json:"reservation_date_id,omitempty"`
+	EventId           string                 `protobuf:"bytes,5,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Summary           string                 `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	Start             string                 `protobuf:"bytes,7,opt,name=start,proto3" json:"start,omitempty"`
+	End               string                 `protobuf:"bytes,8,opt,name=end,proto3" json:"end,omitempty"`
+	Detail            string                 `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
+	Repaired          bool                   `protobuf:"varint,10,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CalendarDiscrepancy) Reset() {
+	*x = CalendarDiscrepancy{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDiscrepancy) ProtoMessage() {}
+
+func (x *CalendarDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDiscrepancy.ProtoReflect.Descriptor instead.
+func (*CalendarDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CalendarDiscrepancy) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CalendarDiscrepancy) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *CalendarDiscrepancy) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *CalendarDiscrepancy) GetReservationDateId() int64 {
+	if x != nil {
+		return x.ReservationDateId
+	}
+	return 0
+}
+
+func (x *CalendarDiscrepancy) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CalendarDiscrepancy) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *CalendarDiscrepancy) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *CalendarDiscrepancy) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *CalendarDiscrepancy) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *CalendarDiscrepancy) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type CalendarReconciliation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FacilityId    int64                  `protobuf:"varint,1,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	CalendarId    string                 `protobuf:"bytes,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Skipped       string                 `protobuf:"bytes,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	MissingEvents int32                  `protobuf:"varint,4,opt,name=missing_events,json=missingEvents,proto3" json:"missing_events,omitempty"`
+	OrphanEvents  int32                  `protobuf:"varint,5,opt,name=orphan_events,json=orphanEvents,proto3" json:"orphan_events,omitempty"`
+	SeriesDrift   int32                  `protobuf:"varint,6,opt,name=series_drift,json=seriesDrift,proto3" json:"series_drift,omitempty"`
+	Discrepancies []*CalendarDiscrepancy `protobuf:"bytes,7,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	Errors        []string               `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarReconciliation) Reset() {
+	*x = CalendarReconciliation{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarReconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarReconciliation) ProtoMessage() {}
+
+func (x *CalendarReconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarReconciliation.ProtoReflect.Descriptor instead.
+func (*CalendarReconciliation) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CalendarReconciliation) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *CalendarReconciliation) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *CalendarReconciliation) GetSkipped() string {
+	if x != nil {
+		return x.Skipped
+	}
+	return ""
+}
+
+func (x *CalendarReconciliation) GetMissingEvents() int32 {
+	if x != nil {
+		return x.MissingEvents
+	}
+	return 0
+}
+
+func (x *CalendarReconciliation) GetOrphanEvents() int32 {
+	if x != nil {
+		return x.OrphanEvents
+	}
+	return 0
+}
+
+func (x *CalendarReconciliation) GetSeriesDrift() int32 {
+	if x != nil {
+		return x.SeriesDrift
+	}
+	return 0
+}
+
+func (x *CalendarReconciliation) GetDiscrepancies() []*CalendarDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+func (x *CalendarReconciliation) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ReconcileCalendarsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Calendars     []*CalendarReconciliation `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileCalendarsResponse) Reset() {
+	*x = ReconcileCalendarsResponse{}
+	mi := &file_proto_reservation_reservation_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileCalendarsResponse) ProtoMessage() {}
+
+func (x *ReconcileCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_reservation_reservation_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_reservation_reservation_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReconcileCalendarsResponse) GetCalendars() []*CalendarReconciliation {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+var File_proto_reservation_reservation_proto protoreflect.FileDescriptor
+
+const file_proto_reservation_reservation_proto_rawDesc = "" +
+	"\n" +
+	"#proto/reservation/reservation.proto\x12\x0fapi.reservation\"\xac\b\n" +
+	"\vReservation\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x03 \x01(\tR\teventName\x12#\n" +
+	"\vfacility_id\x18\x04 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12\x1a\n" +
+	"\bapproved\x18\x05 \x01(\tR\bapproved\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\adetails\x18\b \x01(\tR\adetails\x12\x12\n" +
+	"\x04fees\x18\t \x01(\tR\x04fees\x12\x1c\n" +
+	"\tinsurance\x18\n" +
+	" \x01(\bR\tinsurance\x12\x1f\n" +
+	"\vdoor_access\x18\v \x01(\bR\n" +
+	"doorAccess\x12#\n" +
+	"\rdoors_details\x18\f \x01(\tR\fdoorsDetails\x12\x12\n" +
+	"\x04name\x18\r \x01(\tR\x04name\x12!\n" +
+	"\ftech_details\x18\x0e \x01(\tR\vtechDetails\x12!\n" +
+	"\ftech_support\x18\x0f \x01(\bR\vtechSupport\x12\x14\n" +
+	"\x05phone\x18\x10 \x01(\tR\x05phone\x12#\n" +
+	"\vcategory_id\x18\x11 \x01(\x03B\x020\x01R\n" +
+	"categoryId\x12\x1f\n" +
+	"\vtotal_hours\x18\x12 \x01(\x01R\n" +
+	"totalHours\x12\x1b\n" +
+	"\tin_person\x18\x13 \x01(\bR\binPerson\x12\x12\n" +
+	"\x04paid\x18\x14 \x01(\bR\x04paid\x12\x1f\n" +
+	"\vpayment_url\x18\x15 \x01(\tR\n" +
+	"paymentUrl\x12&\n" +
+	"\x0fpayment_link_id\x18\x16 \x01(\tR\rpaymentLinkId\x12%\n" +
+	"\x0einsurance_link\x18\x17 \x01(\tR\rinsuranceLink\x12#\n" +
+	"\rcost_override\x18\x18 \x01(\tR\fcostOverride\x12\x14\n" +
+	"\x05rrule\x18\x19 \x01(\tR\x05rrule\x12\x16\n" +
+	"\x06rdates\x18\x1a \x03(\tR\x06rdates\x12\x18\n" +
+	"\aexdates\x18\x1b \x03(\tR\aexdates\x12!\n" +
+	"\fgcal_eventid\x18\x1c \x01(\tR\vgcalEventid\x12\x19\n" +
+	"\bprice_id\x18\x1d \x01(\tR\apriceId\x12+\n" +
+	"\x0forganization_id\x18\x1e \x01(\x03B\x020\x01R\x0eorganizationId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x1f \x01(\tR\n" +
+	"visibility\x12!\n" +
+	"\fpublic_title\x18  \x01(\tR\vpublicTitle\x12%\n" +
+	"\x0epayment_status\x18! \x01(\tR\rpaymentStatus\x12'\n" +
+	"\x0fpayment_overdue\x18\" \x01(\bR\x0epaymentOverdue\"\xcd\x01\n" +
+	"\x0fReservationDate\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12)\n" +
+	"\x0ereservation_id\x18\x02 \x01(\x03B\x020\x01R\rreservationId\x12\x1a\n" +
+	"\bapproved\x18\x03 \x01(\tR\bapproved\x12!\n" +
+	"\fgcal_eventid\x18\x04 \x01(\tR\vgcalEventid\x12\x1f\n" +
+	"\vlocal_start\x18\x05 \x01(\tR\n" +
+	"localStart\x12\x1b\n" +
+	"\tlocal_end\x18\x06 \x01(\tR\blocalEnd\"r\n" +
+	"\x11RecurrencePattern\x12\x12\n" +
+	"\x04freq\x18\x01 \x01(\tR\x04freq\x12\x1d\n" +
+	"\n" +
+	"by_weekday\x18\x02 \x03(\tR\tbyWeekday\x12\x14\n" +
+	"\x05until\x18\x03 \x01(\tR\x05until\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"4\n" +
+	"\n" +
+	"Occurrence\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\x95\x01\n" +
+	"\x0eReservationFee\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12'\n" +
+	"\x0fadditional_fees\x18\x02 \x01(\tR\x0eadditionalFees\x12\x1b\n" +
+	"\tfees_type\x18\x03 \x01(\tR\bfeesType\x12)\n" +
+	"\x0ereservation_id\x18\x04 \x01(\x03B\x020\x01R\rreservationId\"\xc7\x01\n" +
+	"\x14ReservationEquipment\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12)\n" +
+	"\x0ereservation_id\x18\x02 \x01(\x03B\x020\x01R\rreservationId\x12%\n" +
+	"\fequipment_id\x18\x03 \x01(\x03B\x020\x01R\vequipmentId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x19\n" +
+	"\x06fee_id\x18\x06 \x01(\x03B\x020\x01R\x05feeId\"U\n" +
+	"\x10EquipmentRequest\x12%\n" +
+	"\fequipment_id\x18\x01 \x01(\x03B\x020\x01R\vequipmentId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xe5\x01\n" +
+	"\x0fFullReservation\x12>\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1c.api.reservation.ReservationR\vreservation\x126\n" +
+	"\x05dates\x18\x02 \x03(\v2 .api.reservation.ReservationDateR\x05dates\x123\n" +
+	"\x04fees\x18\x03 \x03(\v2\x1f.api.reservation.ReservationFeeR\x04fees\x12%\n" +
+	"\x0eintake_answers\x18\x04 \x01(\tR\rintakeAnswers\"\xec\x01\n" +
+	"\x17FullResWithFacilityName\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x01 \x01(\tR\teventName\x12#\n" +
+	"\rfacility_name\x18\x02 \x01(\tR\ffacilityName\x12)\n" +
+	"\x10reservation_date\x18\x03 \x01(\tR\x0freservationDate\x12\x1a\n" +
+	"\bapproved\x18\x04 \x01(\tR\bapproved\x12\x1b\n" +
+	"\tuser_name\x18\x05 \x01(\tR\buserName\x12)\n" +
+	"\x0ereservation_id\x18\x06 \x01(\x03B\x020\x01R\rreservationId\"R\n" +
+	"\x12AllPendingResponse\x12<\n" +
+	"\x04data\x18\x01 \x03(\v2(.api.reservation.FullResWithFacilityNameR\x04data\"\x93\x01\n" +
+	"\x11AllSortedResponse\x12<\n" +
 	"\x04past\x18\x01 \x03(\v2(.api.reservation.FullResWithFacilityNameR\x04past\x12@\n" +
 	"\x06future\x18\x02 \x03(\v2(.api.reservation.FullResWithFacilityNameR\x06future\"L\n" +
 	"\x1eUpdateReservationStatusRequest\x12\x12\n" +
@@ -2468,7 +4107,7 @@ const file_proto_reservation_reservation_proto_rawDesc = "" +
 	"\x13RequestCountRequest\"0\n" +
 	"\x14RequestCountResponse\x12\x18\n" +
 	"\x05count\x18\x01 \x01(\x03B\x020\x01R\x05count\"\x1c\n" +
-	"\x1aGetRequestsThisWeekRequest\"\x89\x05\n" +
+	"\x1aGetRequestsThisWeekRequest\"\xe1\x06\n" +
 	"\x18CreateReservationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
@@ -2495,12 +4134,22 @@ const file_proto_reservation_reservation_proto_rawDesc = "" +
 	"\bend_time\x18\x10 \x01(\tR\aendTime\x12<\n" +
 	"\apattern\x18\x11 \x01(\v2\".api.reservation.RecurrencePatternR\apattern\x12\x16\n" +
 	"\x06rdates\x18\x12 \x03(\tR\x06rdates\x12\x18\n" +
-	"\aexdates\x18\x13 \x03(\tR\aexdates\"/\n" +
+	"\aexdates\x18\x13 \x03(\tR\aexdates\x12+\n" +
+	"\x0forganization_id\x18\x14 \x01(\x03B\x020\x01R\x0eorganizationId\x12%\n" +
+	"\x0eintake_answers\x18\x15 \x01(\tR\rintakeAnswers\x12?\n" +
+	"\tequipment\x18\x16 \x03(\v2!.api.reservation.EquipmentRequestR\tequipment\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x17 \x01(\tR\n" +
+	"visibility\x12!\n" +
+	"\fpublic_title\x18\x18 \x01(\tR\vpublicTitle\"/\n" +
 	"\x19CreateReservationResponse\x12\x12\n" +
 	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"Z\n" +
 	"\x18UpdateReservationRequest\x12>\n" +
 	"\vreservation\x18\x01 \x01(\v2\x1c.api.reservation.ReservationR\vreservation\"\x1b\n" +
-	"\x19UpdateReservationResponse\".\n" +
+	"\x19UpdateReservationResponse\"n\n" +
+	"\x1aUpdateIntakeAnswersRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\x12%\n" +
+	"\x0eintake_answers\x18\x02 \x01(\tR\rintakeAnswers\".\n" +
 	"\x18DeleteReservationRequest\x12\x12\n" +
 	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"\x1b\n" +
 	"\x19DeleteReservationResponse\"2\n" +
@@ -2527,7 +4176,134 @@ const file_proto_reservation_reservation_proto_rawDesc = "" +
 	"\x12CostReducerRequest\x12\x12\n" +
 	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\")\n" +
 	"\x13CostReducerResponse\x12\x12\n" +
-	"\x04cost\x18\x01 \x01(\tR\x04cost2\xf2\x10\n" +
+	"\x04cost\x18\x01 \x01(\tR\x04cost\"K\n" +
+	"\x1eGetReservationEquipmentRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\"f\n" +
+	"\x1fGetReservationEquipmentResponse\x12C\n" +
+	"\tequipment\x18\x01 \x03(\v2%.api.reservation.ReservationEquipmentR\tequipment\"\x8c\x01\n" +
+	"\x1eSetReservationEquipmentRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\x12?\n" +
+	"\tequipment\x18\x02 \x03(\v2!.api.reservation.EquipmentRequestR\tequipment\"\xb2\x01\n" +
+	"\x1fGetEquipmentAvailabilityRequest\x12%\n" +
+	"\fequipment_id\x18\x01 \x01(\x03B\x020\x01R\vequipmentId\x12=\n" +
+	"\voccurrences\x18\x02 \x03(\v2\x1b.api.reservation.OccurrenceR\voccurrences\x12)\n" +
+	"\x0ereservation_id\x18\x03 \x01(\x03B\x020\x01R\rreservationId\"@\n" +
+	" GetEquipmentAvailabilityResponse\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\x05R\tavailable\"\xb5\x02\n" +
+	"\x19ExportDoorScheduleRequest\x12#\n" +
+	"\vbuilding_id\x18\x01 \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x127\n" +
+	"\x15buffer_before_minutes\x18\x05 \x01(\x05H\x00R\x13bufferBeforeMinutes\x88\x01\x01\x125\n" +
+	"\x14buffer_after_minutes\x18\x06 \x01(\x05H\x01R\x12bufferAfterMinutes\x88\x01\x01B\x18\n" +
+	"\x16_buffer_before_minutesB\x17\n" +
+	"\x15_buffer_after_minutes\"o\n" +
+	"\x1aExportDoorScheduleResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\xdd\x01\n" +
+	"\x19ImportReservationsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12#\n" +
+	"\vfacility_id\x18\x03 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12#\n" +
+	"\vcategory_id\x18\x05 \x01(\x03B\x020\x01R\n" +
+	"categoryId\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\apublish\x18\a \x01(\bR\apublish\"\xeb\x02\n" +
+	"\x15ImportReservationsRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x02 \x01(\tR\teventName\x12#\n" +
+	"\vfacility_id\x18\x03 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12#\n" +
+	"\rfacility_name\x18\x04 \x01(\tR\ffacilityName\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12 \n" +
+	"\voccurrences\x18\x06 \x01(\x05R\voccurrences\x12\x1f\n" +
+	"\vfirst_start\x18\a \x01(\tR\n" +
+	"firstStart\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1a\n" +
+	"\bproblems\x18\t \x03(\tR\bproblems\x12\x1a\n" +
+	"\bwarnings\x18\n" +
+	" \x03(\tR\bwarnings\x12)\n" +
+	"\x0ereservation_id\x18\v \x01(\x03B\x020\x01R\rreservationId\"\x8c\x01\n" +
+	"\x1aImportReservationsResponse\x12:\n" +
+	"\x04rows\x18\x01 \x03(\v2&.api.reservation.ImportReservationsRowR\x04rows\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x05R\askipped\"\xe0\x04\n" +
+	"\x0eCalendarChange\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x122\n" +
+	"\x13reservation_date_id\x18\x02 \x01(\x03B\x020\x01R\x11reservationDateId\x12)\n" +
+	"\x0ereservation_id\x18\x03 \x01(\x03B\x020\x01R\rreservationId\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x04 \x01(\tR\teventName\x12#\n" +
+	"\vfacility_id\x18\x05 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12#\n" +
+	"\rfacility_name\x18\x06 \x01(\tR\ffacilityName\x12#\n" +
+	"\vbuilding_id\x18\a \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12#\n" +
+	"\rbuilding_name\x18\b \x01(\tR\fbuildingName\x12\x12\n" +
+	"\x04kind\x18\t \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x19\n" +
+	"\bevent_id\x18\v \x01(\tR\aeventId\x12\x1b\n" +
+	"\told_start\x18\f \x01(\tR\boldStart\x12\x17\n" +
+	"\aold_end\x18\r \x01(\tR\x06oldEnd\x12\x1b\n" +
+	"\tnew_start\x18\x0e \x01(\tR\bnewStart\x12\x17\n" +
+	"\anew_end\x18\x0f \x01(\tR\x06newEnd\x12\x12\n" +
+	"\x04note\x18\x10 \x01(\tR\x04note\x12\x1f\n" +
+	"\vdetected_at\x18\x11 \x01(\tR\n" +
+	"detectedAt\x12\x1f\n" +
+	"\vresolved_at\x18\x12 \x01(\tR\n" +
+	"resolvedAt\x12\x1f\n" +
+	"\vresolved_by\x18\x13 \x01(\tR\n" +
+	"resolvedBy\"Y\n" +
+	"\x1aListCalendarChangesRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12#\n" +
+	"\vbuilding_id\x18\x02 \x01(\x03B\x020\x01R\n" +
+	"buildingId\"X\n" +
+	"\x1bListCalendarChangesResponse\x129\n" +
+	"\achanges\x18\x01 \x03(\v2\x1f.api.reservation.CalendarChangeR\achanges\"^\n" +
+	"\x1cResolveCalendarChangeRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"{\n" +
+	"\x19ReconcileCalendarsRequest\x12#\n" +
+	"\vfacility_id\x18\x01 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12#\n" +
+	"\vbuilding_id\x18\x02 \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12\x14\n" +
+	"\x05apply\x18\x03 \x01(\bR\x05apply\"\xbe\x02\n" +
+	"\x13CalendarDiscrepancy\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12#\n" +
+	"\vfacility_id\x18\x02 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12)\n" +
+	"\x0ereservation_id\x18\x03 \x01(\x03B\x020\x01R\rreservationId\x122\n" +
+	"\x13reservation_date_id\x18\x04 \x01(\x03B\x020\x01R\x11reservationDateId\x12\x19\n" +
+	"\bevent_id\x18\x05 \x01(\tR\aeventId\x12\x18\n" +
+	"\asummary\x18\x06 \x01(\tR\asummary\x12\x14\n" +
+	"\x05start\x18\a \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\b \x01(\tR\x03end\x12\x16\n" +
+	"\x06detail\x18\t \x01(\tR\x06detail\x12\x1a\n" +
+	"\brepaired\x18\n" +
+	" \x01(\bR\brepaired\"\xcb\x02\n" +
+	"\x16CalendarReconciliation\x12#\n" +
+	"\vfacility_id\x18\x01 \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12\x1f\n" +
+	"\vcalendar_id\x18\x02 \x01(\tR\n" +
+	"calendarId\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\tR\askipped\x12%\n" +
+	"\x0emissing_events\x18\x04 \x01(\x05R\rmissingEvents\x12#\n" +
+	"\rorphan_events\x18\x05 \x01(\x05R\forphanEvents\x12!\n" +
+	"\fseries_drift\x18\x06 \x01(\x05R\vseriesDrift\x12J\n" +
+	"\rdiscrepancies\x18\a \x03(\v2$.api.reservation.CalendarDiscrepancyR\rdiscrepancies\x12\x16\n" +
+	"\x06errors\x18\b \x03(\tR\x06errors\"c\n" +
+	"\x1aReconcileCalendarsResponse\x12E\n" +
+	"\tcalendars\x18\x01 \x03(\v2'.api.reservation.CalendarReconciliationR\tcalendars2\x9d\x19\n" +
 	"\x12ReservationService\x12o\n" +
 	"\x12GetAllReservations\x12*.api.reservation.GetAllReservationsRequest\x1a(.api.reservation.AllReservationsResponse\"\x03\x90\x02\x01\x12_\n" +
 	"\x0eGetReservation\x12&.api.reservation.GetReservationRequest\x1a .api.reservation.FullReservation\"\x03\x90\x02\x01\x12`\n" +
@@ -2535,7 +4311,8 @@ const file_proto_reservation_reservation_proto_rawDesc = "" +
 	"\x13GetRequestsThisWeek\x12+.api.reservation.GetRequestsThisWeekRequest\x1a(.api.reservation.RequestThisWeekResponse\"\x03\x90\x02\x01\x12j\n" +
 	"\x11CreateReservation\x12).api.reservation.CreateReservationRequest\x1a*.api.reservation.CreateReservationResponse\x12j\n" +
 	"\x11UpdateReservation\x12).api.reservation.UpdateReservationRequest\x1a*.api.reservation.UpdateReservationResponse\x12v\n" +
-	"\x17UpdateReservationStatus\x12/.api.reservation.UpdateReservationStatusRequest\x1a*.api.reservation.UpdateReservationResponse\x12j\n" +
+	"\x17UpdateReservationStatus\x12/.api.reservation.UpdateReservationStatusRequest\x1a*.api.reservation.UpdateReservationResponse\x12n\n" +
+	"\x13UpdateIntakeAnswers\x12+.api.reservation.UpdateIntakeAnswersRequest\x1a*.api.reservation.UpdateReservationResponse\x12j\n" +
 	"\x11DeleteReservation\x12).api.reservation.DeleteReservationRequest\x1a*.api.reservation.DeleteReservationResponse\x12l\n" +
 	"\x10UserReservations\x12(.api.reservation.UserReservationsRequest\x1a).api.reservation.UserReservationsResponse\"\x03\x90\x02\x01\x12y\n" +
 	"\x16CreateReservationDates\x12..api.reservation.CreateReservationDatesRequest\x1a/.api.reservation.CreateReservationDatesResponse\x12y\n" +
@@ -2545,9 +4322,17 @@ const file_proto_reservation_reservation_proto_rawDesc = "" +
 	"\x14CreateReservationFee\x12,.api.reservation.CreateReservationFeeRequest\x1a-.api.reservation.CreateReservationFeeResponse\x12s\n" +
 	"\x14UpdateReservationFee\x12,.api.reservation.UpdateReservationFeeRequest\x1a-.api.reservation.UpdateReservationFeeResponse\x12s\n" +
 	"\x14DeleteReservationFee\x12,.api.reservation.DeleteReservationFeeRequest\x1a-.api.reservation.DeleteReservationFeeResponse\x12X\n" +
-	"\vCostReducer\x12#.api.reservation.CostReducerRequest\x1a$.api.reservation.CostReducerResponse\x12e\n" +
+	"\vCostReducer\x12#.api.reservation.CostReducerRequest\x1a$.api.reservation.CostReducerResponse\x12\x81\x01\n" +
+	"\x17GetReservationEquipment\x12/.api.reservation.GetReservationEquipmentRequest\x1a0.api.reservation.GetReservationEquipmentResponse\"\x03\x90\x02\x01\x12|\n" +
+	"\x17SetReservationEquipment\x12/.api.reservation.SetReservationEquipmentRequest\x1a0.api.reservation.GetReservationEquipmentResponse\x12\x84\x01\n" +
+	"\x18GetEquipmentAvailability\x120.api.reservation.GetEquipmentAvailabilityRequest\x1a1.api.reservation.GetEquipmentAvailabilityResponse\"\x03\x90\x02\x01\x12r\n" +
+	"\x12ExportDoorSchedule\x12*.api.reservation.ExportDoorScheduleRequest\x1a+.api.reservation.ExportDoorScheduleResponse\"\x03\x90\x02\x01\x12m\n" +
+	"\x12ImportReservations\x12*.api.reservation.ImportReservationsRequest\x1a+.api.reservation.ImportReservationsResponse\x12e\n" +
 	"\rGetAllPending\x12*.api.reservation.GetAllReservationsRequest\x1a#.api.reservation.AllPendingResponse\"\x03\x90\x02\x01\x12l\n" +
-	"\x15AllSortedReservations\x12*.api.reservation.GetAllReservationsRequest\x1a\".api.reservation.AllSortedResponse\"\x03\x90\x02\x01B\xb7\x01\n" +
+	"\x15AllSortedReservations\x12*.api.reservation.GetAllReservationsRequest\x1a\".api.reservation.AllSortedResponse\"\x03\x90\x02\x01\x12u\n" +
+	"\x13ListCalendarChanges\x12+.api.reservation.ListCalendarChangesRequest\x1a,.api.reservation.ListCalendarChangesResponse\"\x03\x90\x02\x01\x12g\n" +
+	"\x15ResolveCalendarChange\x12-.api.reservation.ResolveCalendarChangeRequest\x1a\x1f.api.reservation.CalendarChange\x12m\n" +
+	"\x12ReconcileCalendars\x12*.api.reservation.ReconcileCalendarsRequest\x1a+.api.reservation.ReconcileCalendarsResponseB\xb7\x01\n" +
 	"\x13com.api.reservationB\x10ReservationProtoP\x01Z1api/internal/proto/reservation;reservationservice\xa2\x02\x03ARX\xaa\x02\x0fApi.Reservation\xca\x02\x0fApi\\Reservation\xe2\x02\x1bApi\\Reservation\\GPBMetadata\xea\x02\x10Api::Reservationb\x06proto3"
 
 var (
@@ -2562,114 +4347,161 @@ func file_proto_reservation_reservation_proto_rawDescGZIP() []byte {
 	return file_proto_reservation_reservation_proto_rawDescData
 }
 
-var file_proto_reservation_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_proto_reservation_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_proto_reservation_reservation_proto_goTypes = []any{
 	(*Reservation)(nil),                          // 0: api.reservation.Reservation
 	(*ReservationDate)(nil),                      // 1: api.reservation.ReservationDate
 	(*RecurrencePattern)(nil),                    // 2: api.reservation.RecurrencePattern
 	(*Occurrence)(nil),                           // 3: api.reservation.Occurrence
 	(*ReservationFee)(nil),                       // 4: api.reservation.ReservationFee
-	(*FullReservation)(nil),                      // 5: api.reservation.FullReservation
-	(*FullResWithFacilityName)(nil),              // 6: api.reservation.FullResWithFacilityName
-	(*AllPendingResponse)(nil),                   // 7: api.reservation.AllPendingResponse
-	(*AllSortedResponse)(nil),                    // 8: api.reservation.AllSortedResponse
-	(*UpdateReservationStatusRequest)(nil),       // 9: api.reservation.UpdateReservationStatusRequest
-	(*UpdateReservationDatesStatusRequest)(nil),  // 10: api.reservation.UpdateReservationDatesStatusRequest
-	(*UpdateReservationDatesStatusResponse)(nil), // 11: api.reservation.UpdateReservationDatesStatusResponse
-	(*AllReservationsResponse)(nil),              // 12: api.reservation.AllReservationsResponse
-	(*RequestThisWeekResponse)(nil),              // 13: api.reservation.RequestThisWeekResponse
-	(*ApprovedReservationsResponse)(nil),         // 14: api.reservation.ApprovedReservationsResponse
-	(*PendingReservationsResponse)(nil),          // 15: api.reservation.PendingReservationsResponse
-	(*UserReservationsResponse)(nil),             // 16: api.reservation.UserReservationsResponse
-	(*GetAllReservationsRequest)(nil),            // 17: api.reservation.GetAllReservationsRequest
-	(*GetReservationRequest)(nil),                // 18: api.reservation.GetReservationRequest
-	(*RequestCountRequest)(nil),                  // 19: api.reservation.RequestCountRequest
-	(*RequestCountResponse)(nil),                 // 20: api.reservation.RequestCountResponse
-	(*GetRequestsThisWeekRequest)(nil),           // 21: api.reservation.GetRequestsThisWeekRequest
-	(*CreateReservationRequest)(nil),             // 22: api.reservation.CreateReservationRequest
-	(*CreateReservationResponse)(nil),            // 23: api.reservation.CreateReservationResponse
-	(*UpdateReservationRequest)(nil),             // 24: api.reservation.UpdateReservationRequest
-	(*UpdateReservationResponse)(nil),            // 25: api.reservation.UpdateReservationResponse
-	(*DeleteReservationRequest)(nil),             // 26: api.reservation.DeleteReservationRequest
-	(*DeleteReservationResponse)(nil),            // 27: api.reservation.DeleteReservationResponse
-	(*UserReservationsRequest)(nil),              // 28: api.reservation.UserReservationsRequest
-	(*CreateReservationDatesRequest)(nil),        // 29: api.reservation.CreateReservationDatesRequest
-	(*CreateReservationDatesResponse)(nil),       // 30: api.reservation.CreateReservationDatesResponse
-	(*UpdateReservationDatesResponse)(nil),       // 31: api.reservation.UpdateReservationDatesResponse
-	(*DeleteReservationDatesResponse)(nil),       // 32: api.reservation.DeleteReservationDatesResponse
-	(*CreateReservationFeeResponse)(nil),         // 33: api.reservation.CreateReservationFeeResponse
-	(*UpdateReservationFeeResponse)(nil),         // 34: api.reservation.UpdateReservationFeeResponse
-	(*DeleteReservationFeeResponse)(nil),         // 35: api.reservation.DeleteReservationFeeResponse
-	(*UpdateReservationDatesRequest)(nil),        // 36: api.reservation.UpdateReservationDatesRequest
-	(*DeleteReservationDatesRequest)(nil),        // 37: api.reservation.DeleteReservationDatesRequest
-	(*CreateReservationFeeRequest)(nil),          // 38: api.reservation.CreateReservationFeeRequest
-	(*UpdateReservationFeeRequest)(nil),          // 39: api.reservation.UpdateReservationFeeRequest
-	(*DeleteReservationFeeRequest)(nil),          // 40: api.reservation.DeleteReservationFeeRequest
-	(*CostReducerRequest)(nil),                   // 41: api.reservation.CostReducerRequest
-	(*CostReducerResponse)(nil),                  // 42: api.reservation.CostReducerResponse
+	(*ReservationEquipment)(nil),                 // 5: api.reservation.ReservationEquipment
+	(*EquipmentRequest)(nil),                     // 6: api.reservation.EquipmentRequest
+	(*FullReservation)(nil),                      // 7: api.reservation.FullReservation
+	(*FullResWithFacilityName)(nil),              // 8: api.reservation.FullResWithFacilityName
+	(*AllPendingResponse)(nil),                   // 9: api.reservation.AllPendingResponse
+	(*AllSortedResponse)(nil),                    // 10: api.reservation.AllSortedResponse
+	(*UpdateReservationStatusRequest)(nil),       // 11: api.reservation.UpdateReservationStatusRequest
+	(*UpdateReservationDatesStatusRequest)(nil),  // 12: api.reservation.UpdateReservationDatesStatusRequest
+	(*UpdateReservationDatesStatusResponse)(nil), // 13: api.reservation.UpdateReservationDatesStatusResponse
+	(*AllReservationsResponse)(nil),              // 14: api.reservation.AllReservationsResponse
+	(*RequestThisWeekResponse)(nil),              // 15: api.reservation.RequestThisWeekResponse
+	(*ApprovedReservationsResponse)(nil),         // 16: api.reservation.ApprovedReservationsResponse
+	(*PendingReservationsResponse)(nil),          // 17: api.reservation.PendingReservationsResponse
+	(*UserReservationsResponse)(nil),             // 18: api.reservation.UserReservationsResponse
+	(*GetAllReservationsRequest)(nil),            // 19: api.reservation.GetAllReservationsRequest
+	(*GetReservationRequest)(nil),                // 20: api.reservation.GetReservationRequest
+	(*RequestCountRequest)(nil),                  // 21: api.reservation.RequestCountRequest
+	(*RequestCountResponse)(nil),                 // 22: api.reservation.RequestCountResponse
+	(*GetRequestsThisWeekRequest)(nil),           // 23: api.reservation.GetRequestsThisWeekRequest
+	(*CreateReservationRequest)(nil),             // 24: api.reservation.CreateReservationRequest
+	(*CreateReservationResponse)(nil),            // 25: api.reservation.CreateReservationResponse
+	(*UpdateReservationRequest)(nil),             // 26: api.reservation.UpdateReservationRequest
+	(*UpdateReservationResponse)(nil),            // 27: api.reservation.UpdateReservationResponse
+	(*UpdateIntakeAnswersRequest)(nil),           // 28: api.reservation.UpdateIntakeAnswersRequest
+	(*DeleteReservationRequest)(nil),             // 29: api.reservation.DeleteReservationRequest
+	(*DeleteReservationResponse)(nil),            // 30: api.reservation.DeleteReservationResponse
+	(*UserReservationsRequest)(nil),              // 31: api.reservation.UserReservationsRequest
+	(*CreateReservationDatesRequest)(nil),        // 32: api.reservation.CreateReservationDatesRequest
+	(*CreateReservationDatesResponse)(nil),       // 33: api.reservation.CreateReservationDatesResponse
+	(*UpdateReservationDatesResponse)(nil),       // 34: api.reservation.UpdateReservationDatesResponse
+	(*DeleteReservationDatesResponse)(nil),       // 35: api.reservation.DeleteReservationDatesResponse
+	(*CreateReservationFeeResponse)(nil),         // 36: api.reservation.CreateReservationFeeResponse
+	(*UpdateReservationFeeResponse)(nil),         // 37: api.reservation.UpdateReservationFeeResponse
+	(*DeleteReservationFeeResponse)(nil),         // 38: api.reservation.DeleteReservationFeeResponse
+	(*UpdateReservationDatesRequest)(nil),        // 39: api.reservation.UpdateReservationDatesRequest
+	(*DeleteReservationDatesRequest)(nil),        // 40: api.reservation.DeleteReservationDatesRequest
+	(*CreateReservationFeeRequest)(nil),          // 41: api.reservation.CreateReservationFeeRequest
+	(*UpdateReservationFeeRequest)(nil),          // 42: api.reservation.UpdateReservationFeeRequest
+	(*DeleteReservationFeeRequest)(nil),          // 43: api.reservation.DeleteReservationFeeRequest
+	(*CostReducerRequest)(nil),                   // 44: api.reservation.CostReducerRequest
+	(*CostReducerResponse)(nil),                  // 45: api.reservation.CostReducerResponse
+	(*GetReservationEquipmentRequest)(nil),       // 46: api.reservation.GetReservationEquipmentRequest
+	(*GetReservationEquipmentResponse)(nil),      // 47: api.reservation.GetReservationEquipmentResponse
+	(*SetReservationEquipmentRequest)(nil),       // 48: api.reservation.SetReservationEquipmentRequest
+	(*GetEquipmentAvailabilityRequest)(nil),      // 49: api.reservation.GetEquipmentAvailabilityRequest
+	(*GetEquipmentAvailabilityResponse)(nil),     // 50: api.reservation.GetEquipmentAvailabilityResponse
+	(*ExportDoorScheduleRequest)(nil),            // 51: api.reservation.ExportDoorScheduleRequest
+	(*ExportDoorScheduleResponse)(nil),           // 52: api.reservation.ExportDoorScheduleResponse
+	(*ImportReservationsRequest)(nil),            // 53: api.reservation.ImportReservationsRequest
+	(*ImportReservationsRow)(nil),                // 54: api.reservation.ImportReservationsRow
+	(*ImportReservationsResponse)(nil),           // 55: api.reservation.ImportReservationsResponse
+	(*CalendarChange)(nil),                       // 56: api.reservation.CalendarChange
+	(*ListCalendarChangesRequest)(nil),           // 57: api.reservation.ListCalendarChangesRequest
+	(*ListCalendarChangesResponse)(nil),          // 58: api.reservation.ListCalendarChangesResponse
+	(*ResolveCalendarChangeRequest)(nil),         // 59: api.reservation.ResolveCalendarChangeRequest
+	(*ReconcileCalendarsRequest)(nil),            // 60: api.reservation.ReconcileCalendarsRequest
+	(*CalendarDiscrepancy)(nil),                  // 61: api.reservation.CalendarDiscrepancy
+	(*CalendarReconciliation)(nil),               // 62: api.reservation.CalendarReconciliation
+	(*ReconcileCalendarsResponse)(nil),           // 63: api.reservation.ReconcileCalendarsResponse
 }
 var file_proto_reservation_reservation_proto_depIdxs = []int32{
 	0,  // 0: api.reservation.FullReservation.reservation:type_name -> api.reservation.Reservation
 	1,  // 1: api.reservation.FullReservation.dates:type_name -> api.reservation.ReservationDate
 	4,  // 2: api.reservation.FullReservation.fees:type_name -> api.reservation.ReservationFee
-	6,  // 3: api.reservation.AllPendingResponse.data:type_name -> api.reservation.FullResWithFacilityName
-	6,  // 4: api.reservation.AllSortedResponse.past:type_name -> api.reservation.FullResWithFacilityName
-	6,  // 5: api.reservation.AllSortedResponse.future:type_name -> api.reservation.FullResWithFacilityName
-	5,  // 6: api.reservation.AllReservationsResponse.reservations:type_name -> api.reservation.FullReservation
-	5,  // 7: api.reservation.RequestThisWeekResponse.reservations:type_name -> api.reservation.FullReservation
-	5,  // 8: api.reservation.ApprovedReservationsResponse.reservations:type_name -> api.reservation.FullReservation
-	5,  // 9: api.reservation.PendingReservationsResponse.reservations:type_name -> api.reservation.FullReservation
-	6,  // 10: api.reservation.UserReservationsResponse.reservations:type_name -> api.reservation.FullResWithFacilityName
+	8,  // 3: api.reservation.AllPendingResponse.data:type_name -> api.reservation.FullResWithFacilityName
+	8,  // 4: api.reservation.AllSortedResponse.past:type_name -> api.reservation.FullResWithFacilityName
+	8,  // 5: api.reservation.AllSortedResponse.future:type_name -> api.reservation.FullResWithFacilityName
+	7,  // 6: api.reservation.AllReservationsResponse.reservations:type_name -> api.reservation.FullReservation
+	7,  // 7: api.reservation.RequestThisWeekResponse.reservations:type_name -> api.reservation.FullReservation
+	7,  // 8: api.reservation.ApprovedReservationsResponse.reservations:type_name -> api.reservation.FullReservation
+	7,  // 9: api.reservation.PendingReservationsResponse.reservations:type_name -> api.reservation.FullReservation
+	8,  // 10: api.reservation.UserReservationsResponse.reservations:type_name -> api.reservation.FullResWithFacilityName
 	3,  // 11: api.reservation.CreateReservationRequest.occurrences:type_name -> api.reservation.Occurrence
 	2,  // 12: api.reservation.CreateReservationRequest.pattern:type_name -> api.reservation.RecurrencePattern
-	0,  // 13: api.reservation.UpdateReservationRequest.reservation:type_name -> api.reservation.Reservation
-	1,  // 14: api.reservation.CreateReservationDatesRequest.date:type_name -> api.reservation.ReservationDate
-	1,  // 15: api.reservation.UpdateReservationDatesRequest.date:type_name -> api.reservation.ReservationDate
-	4,  // 16: api.reservation.CreateReservationFeeRequest.fee:type_name -> api.reservation.ReservationFee
-	4,  // 17: api.reservation.UpdateReservationFeeRequest.fee:type_name -> api.reservation.ReservationFee
-	17, // 18: api.reservation.ReservationService.GetAllReservations:input_type -> api.reservation.GetAllReservationsRequest
-	18, // 19: api.reservation.ReservationService.GetReservation:input_type -> api.reservation.GetReservationRequest
-	19, // 20: api.reservation.ReservationService.RequestCount:input_type -> api.reservation.RequestCountRequest
-	21, // 21: api.reservation.ReservationService.GetRequestsThisWeek:input_type -> api.reservation.GetRequestsThisWeekRequest
-	22, // 22: api.reservation.ReservationService.CreateReservation:input_type -> api.reservation.CreateReservationRequest
-	24, // 23: api.reservation.ReservationService.UpdateReservation:input_type -> api.reservation.UpdateReservationRequest
-	9,  // 24: api.reservation.ReservationService.UpdateReservationStatus:input_type -> api.reservation.UpdateReservationStatusRequest
-	26, // 25: api.reservation.ReservationService.DeleteReservation:input_type -> api.reservation.DeleteReservationRequest
-	28, // 26: api.reservation.ReservationService.UserReservations:input_type -> api.reservation.UserReservationsRequest
-	29, // 27: api.reservation.ReservationService.CreateReservationDates:input_type -> api.reservation.CreateReservationDatesRequest
-	36, // 28: api.reservation.ReservationService.UpdateReservationDates:input_type -> api.reservation.UpdateReservationDatesRequest
-	10, // 29: api.reservation.ReservationService.UpdateReservationDatesStatus:input_type -> api.reservation.UpdateReservationDatesStatusRequest
-	37, // 30: api.reservation.ReservationService.DeleteReservationDates:input_type -> api.reservation.DeleteReservationDatesRequest
-	38, // 31: api.reservation.ReservationService.CreateReservationFee:input_type -> api.reservation.CreateReservationFeeRequest
-	39, // 32: api.reservation.ReservationService.UpdateReservationFee:input_type -> api.reservation.UpdateReservationFeeRequest
-	40, // 33: api.reservation.ReservationService.DeleteReservationFee:input_type -> api.reservation.DeleteReservationFeeRequest
-	41, // 34: api.reservation.ReservationService.CostReducer:input_type -> api.reservation.CostReducerRequest
-	17, // 35: api.reservation.ReservationService.GetAllPending:input_type -> api.reservation.GetAllReservationsRequest
-	17, // 36: api.reservation.ReservationService.AllSortedReservations:input_type -> api.reservation.GetAllReservationsRequest
-	12, // 37: api.reservation.ReservationService.GetAllReservations:output_type -> api.reservation.AllReservationsResponse
-	5,  // 38: api.reservation.ReservationService.GetReservation:output_type -> api.reservation.FullReservation
-	20, // 39: api.reservation.ReservationService.RequestCount:output_type -> api.reservation.RequestCountResponse
-	13, // 40: api.reservation.ReservationService.GetRequestsThisWeek:output_type -> api.reservation.RequestThisWeekResponse
-	23, // 41: api.reservation.ReservationService.CreateReservation:output_type -> api.reservation.CreateReservationResponse
-	25, // 42: api.reservation.ReservationService.UpdateReservation:output_type -> api.reservation.UpdateReservationResponse
-	25, // 43: api.reservation.ReservationService.UpdateReservationStatus:output_type -> api.reservation.UpdateReservationResponse
-	27, // 44: api.reservation.ReservationService.DeleteReservation:output_type -> api.reservation.DeleteReservationResponse
-	16, // 45: api.reservation.ReservationService.UserReservations:output_type -> api.reservation.UserReservationsResponse
-	30, // 46: api.reservation.ReservationService.CreateReservationDates:output_type -> api.reservation.CreateReservationDatesResponse
-	31, // 47: api.reservation.ReservationService.UpdateReservationDates:output_type -> api.reservation.UpdateReservationDatesResponse
-	11, // 48: api.reservation.ReservationService.UpdateReservationDatesStatus:output_type -> api.reservation.UpdateReservationDatesStatusResponse
-	32, // 49: api.reservation.ReservationService.DeleteReservationDates:output_type -> api.reservation.DeleteReservationDatesResponse
-	33, // 50: api.reservation.ReservationService.CreateReservationFee:output_type -> api.reservation.CreateReservationFeeResponse
-	34, // 51: api.reservation.ReservationService.UpdateReservationFee:output_type -> api.reservation.UpdateReservationFeeResponse
-	35, // 52: api.reservation.ReservationService.DeleteReservationFee:output_type -> api.reservation.DeleteReservationFeeResponse
-	42, // 53: api.reservation.ReservationService.CostReducer:output_type -> api.reservation.CostReducerResponse
-	7,  // 54: api.reservation.ReservationService.GetAllPending:output_type -> api.reservation.AllPendingResponse
-	8,  // 55: api.reservation.ReservationService.AllSortedReservations:output_type -> api.reservation.AllSortedResponse
-	37, // [37:56] is the sub-list for method output_type
-	18, // [18:37] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	6,  // 13: api.reservation.CreateReservationRequest.equipment:type_name -> api.reservation.EquipmentRequest
+	0,  // 14: api.reservation.UpdateReservationRequest.reservation:type_name -> api.reservation.Reservation
+	1,  // 15: api.reservation.CreateReservationDatesRequest.date:type_name -> api.reservation.ReservationDate
+	1,  // 16: api.reservation.UpdateReservationDatesRequest.date:type_name -> api.reservation.ReservationDate
+	4,  // 17: api.reservation.CreateReservationFeeRequest.fee:type_name -> api.reservation.ReservationFee
+	4,  // 18: api.reservation.UpdateReservationFeeRequest.fee:type_name -> api.reservation.ReservationFee
+	5,  // 19: api.reservation.GetReservationEquipmentResponse.equipment:type_name -> api.reservation.ReservationEquipment
+	6,  // 20: api.reservation.SetReservationEquipmentRequest.equipment:type_name -> api.reservation.EquipmentRequest
+	3,  // 21: api.reservation.GetEquipmentAvailabilityRequest.occurrences:type_name -> api.reservation.Occurrence
+	54, // 22: api.reservation.ImportReservationsResponse.rows:type_name -> api.reservation.ImportReservationsRow
+	56, // 23: api.reservation.ListCalendarChangesResponse.changes:type_name -> api.reservation.CalendarChange
+	61, // 24: api.reservation.CalendarReconciliation.discrepancies:type_name -> api.reservation.CalendarDiscrepancy
+	62, // 25: api.reservation.ReconcileCalendarsResponse.calendars:type_name -> api.reservation.CalendarReconciliation
+	19, // 26: api.reservation.ReservationService.GetAllReservations:input_type -> api.reservation.GetAllReservationsRequest
+	20, // 27: api.reservation.ReservationService.GetReservation:input_type -> api.reservation.GetReservationRequest
+	21, // 28: api.reservation.ReservationService.RequestCount:input_type -> api.reservation.RequestCountRequest
+	23, // 29: api.reservation.ReservationService.GetRequestsThisWeek:input_type -> api.reservation.GetRequestsThisWeekRequest
+	24, // 30: api.reservation.ReservationService.CreateReservation:input_type -> api.reservation.CreateReservationRequest
+	26, // 31: api.reservation.ReservationService.UpdateReservation:input_type -> api.reservation.UpdateReservationRequest
+	11, // 32: api.reservation.ReservationService.UpdateReservationStatus:input_type -> api.reservation.UpdateReservationStatusRequest
+	28, // 33: api.reservation.ReservationService.UpdateIntakeAnswers:input_type -> api.reservation.UpdateIntakeAnswersRequest
+	29, // 34: api.reservation.ReservationService.DeleteReservation:input_type -> api.reservation.DeleteReservationRequest
+	31, // 35: api.reservation.ReservationService.UserReservations:input_type -> api.reservation.UserReservationsRequest
+	32, // 36: api.reservation.ReservationService.CreateReservationDates:input_type -> api.reservation.CreateReservationDatesRequest
+	39, // 37: api.reservation.ReservationService.UpdateReservationDates:input_type -> api.reservation.UpdateReservationDatesRequest
+	12, // 38: api.reservation.ReservationService.UpdateReservationDatesStatus:input_type -> api.reservation.UpdateReservationDatesStatusRequest
+	40, // 39: api.reservation.ReservationService.DeleteReservationDates:input_type -> api.reservation.DeleteReservationDatesRequest
+	41, // 40: api.reservation.ReservationService.CreateReservationFee:input_type -> api.reservation.CreateReservationFeeRequest
+	42, // 41: api.reservation.ReservationService.UpdateReservationFee:input_type -> api.reservation.UpdateReservationFeeRequest
+	43, // 42: api.reservation.ReservationService.DeleteReservationFee:input_type -> api.reservation.DeleteReservationFeeRequest
+	44, // 43: api.reservation.ReservationService.CostReducer:input_type -> api.reservation.CostReducerRequest
+	46, // 44: api.reservation.ReservationService.GetReservationEquipment:input_type -> api.reservation.GetReservationEquipmentRequest
+	48, // 45: api.reservation.ReservationService.SetReservationEquipment:input_type -> api.reservation.SetReservationEquipmentRequest
+	49, // 46: api.reservation.ReservationService.GetEquipmentAvailability:input_type -> api.reservation.GetEquipmentAvailabilityRequest
+	51, // 47: api.reservation.ReservationService.ExportDoorSchedule:input_type -> api.reservation.ExportDoorScheduleRequest
+	53, // 48: api.reservation.ReservationService.ImportReservations:input_type -> api.reservation.ImportReservationsRequest
+	19, // 49: api.reservation.ReservationService.GetAllPending:input_type -> api.reservation.GetAllReservationsRequest
+	19, // 50: api.reservation.ReservationService.AllSortedReservations:input_type -> api.reservation.GetAllReservationsRequest
+	57, // 51: api.reservation.ReservationService.ListCalendarChanges:input_type -> api.reservation.ListCalendarChangesRequest
+	59, // 52: api.reservation.ReservationService.ResolveCalendarChange:input_type -> api.reservation.ResolveCalendarChangeRequest
+	60, // 53: api.reservation.ReservationService.ReconcileCalendars:input_type -> api.reservation.ReconcileCalendarsRequest
+	14, // 54: api.reservation.ReservationService.GetAllReservations:output_type -> api.reservation.AllReservationsResponse
+	7,  // 55: api.reservation.ReservationService.GetReservation:output_type -> api.reservation.FullReservation
+	22, // 56: api.reservation.ReservationService.RequestCount:output_type -> api.reservation.RequestCountResponse
+	15, // 57: api.reservation.ReservationService.GetRequestsThisWeek:output_type -> api.reservation.RequestThisWeekResponse
+	25, // 58: api.reservation.ReservationService.CreateReservation:output_type -> api.reservation.CreateReservationResponse
+	27, // 59: api.reservation.ReservationService.UpdateReservation:output_type -> api.reservation.UpdateReservationResponse
+	27, // 60: api.reservation.ReservationService.UpdateReservationStatus:output_type -> api.reservation.UpdateReservationResponse
+	27, // 61: api.reservation.ReservationService.UpdateIntakeAnswers:output_type -> api.reservation.UpdateReservationResponse
+	30, // 62: api.reservation.ReservationService.DeleteReservation:output_type -> api.reservation.DeleteReservationResponse
+	18, // 63: api.reservation.ReservationService.UserReservations:output_type -> api.reservation.UserReservationsResponse
+	33, // 64: api.reservation.ReservationService.CreateReservationDates:output_type -> api.reservation.CreateReservationDatesResponse
+	34, // 65: api.reservation.ReservationService.UpdateReservationDates:output_type -> api.reservation.UpdateReservationDatesResponse
+	13, // 66: api.reservation.ReservationService.UpdateReservationDatesStatus:output_type -> api.reservation.UpdateReservationDatesStatusResponse
+	35, // 67: api.reservation.ReservationService.DeleteReservationDates:output_type -> api.reservation.DeleteReservationDatesResponse
+	36, // 68: api.reservation.ReservationService.CreateReservationFee:output_type -> api.reservation.CreateReservationFeeResponse
+	37, // 69: api.reservation.ReservationService.UpdateReservationFee:output_type -> api.reservation.UpdateReservationFeeResponse
+	38, // 70: api.reservation.ReservationService.DeleteReservationFee:output_type -> api.reservation.DeleteReservationFeeResponse
+	45, // 71: api.reservation.ReservationService.CostReducer:output_type -> api.reservation.CostReducerResponse
+	47, // 72: api.reservation.ReservationService.GetReservationEquipment:output_type -> api.reservation.GetReservationEquipmentResponse
+	47, // 73: api.reservation.ReservationService.SetReservationEquipment:output_type -> api.reservation.GetReservationEquipmentResponse
+	50, // 74: api.reservation.ReservationService.GetEquipmentAvailability:output_type -> api.reservation.GetEquipmentAvailabilityResponse
+	52, // 75: api.reservation.ReservationService.ExportDoorSchedule:output_type -> api.reservation.ExportDoorScheduleResponse
+	55, // 76: api.reservation.ReservationService.ImportReservations:output_type -> api.reservation.ImportReservationsResponse
+	9,  // 77: api.reservation.ReservationService.GetAllPending:output_type -> api.reservation.AllPendingResponse
+	10, // 78: api.reservation.ReservationService.AllSortedReservations:output_type -> api.reservation.AllSortedResponse
+	58, // 79: api.reservation.ReservationService.ListCalendarChanges:output_type -> api.reservation.ListCalendarChangesResponse
+	56, // 80: api.reservation.ReservationService.ResolveCalendarChange:output_type -> api.reservation.CalendarChange
+	63, // 81: api.reservation.ReservationService.ReconcileCalendars:output_type -> api.reservation.ReconcileCalendarsResponse
+	54, // [54:82] is the sub-list for method output_type
+	26, // [26:54] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_proto_reservation_reservation_proto_init() }
@@ -2677,13 +4509,14 @@ func file_proto_reservation_reservation_proto_init() {
 	if File_proto_reservation_reservation_proto != nil {
 		return
 	}
+	file_proto_reservation_reservation_proto_msgTypes[51].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_reservation_reservation_proto_rawDesc), len(file_proto_reservation_reservation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   43,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
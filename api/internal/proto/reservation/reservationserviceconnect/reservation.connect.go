@@ -54,6 +54,9 @@ const (
 	// ReservationServiceUpdateReservationStatusProcedure is the fully-qualified name of the
 	// ReservationService's UpdateReservationStatus RPC.
 	ReservationServiceUpdateReservationStatusProcedure = "/api.reservation.ReservationService/UpdateReservationStatus"
+	// ReservationServiceUpdateIntakeAnswersProcedure is the fully-qualified name of the
+	// ReservationService's UpdateIntakeAnswers RPC.
+	ReservationServiceUpdateIntakeAnswersProcedure = "/api.reservation.ReservationService/UpdateIntakeAnswers"
 	// ReservationServiceDeleteReservationProcedure is the fully-qualified name of the
 	// ReservationService's DeleteReservation RPC.
 	ReservationServiceDeleteReservationProcedure = "/api.reservation.ReservationService/DeleteReservation"
@@ -84,12 +87,36 @@ const (
 	// ReservationServiceCostReducerProcedure is the fully-qualified name of the ReservationService's
 	// CostReducer RPC.
 	ReservationServiceCostReducerProcedure = "/api.reservation.ReservationService/CostReducer"
+	// ReservationServiceGetReservationEquipmentProcedure is the fully-qualified name of the
+	// ReservationService's GetReservationEquipment RPC.
+	ReservationServiceGetReservationEquipmentProcedure = "/api.reservation.ReservationService/GetReservationEquipment"
+	// ReservationServiceSetReservationEquipmentProcedure is the fully-qualified name of the
+	// ReservationService's SetReservationEquipment RPC.
+	ReservationServiceSetReservationEquipmentProcedure = "/api.reservation.ReservationService/SetReservationEquipment"
+	// ReservationServiceGetEquipmentAvailabilityProcedure is the fully-qualified name of the
+	// ReservationService's GetEquipmentAvailability RPC.
+	ReservationServiceGetEquipmentAvailabilityProcedure = "/api.reservation.ReservationService/GetEquipmentAvailability"
+	// ReservationServiceExportDoorScheduleProcedure is the fully-qualified name of the
+	// ReservationService's ExportDoorSchedule RPC.
+	ReservationServiceExportDoorScheduleProcedure = "/api.reservation.ReservationService/ExportDoorSchedule"
+	// ReservationServiceImportReservationsProcedure is the fully-qualified name of the
+	// ReservationService's ImportReservations RPC.
+	ReservationServiceImportReservationsProcedure = "/api.reservation.ReservationService/ImportReservations"
 	// ReservationServiceGetAllPendingProcedure is the fully-qualified name of the ReservationService's
 	// GetAllPending RPC.
 	ReservationServiceGetAllPendingProcedure = "/api.reservation.ReservationService/GetAllPending"
 	// ReservationServiceAllSortedReservationsProcedure is the fully-qualified name of the
 	// ReservationService's AllSortedReservations RPC.
 	ReservationServiceAllSortedReservationsProcedure = "/api.reservation.ReservationService/AllSortedReservations"
+	// ReservationServiceListCalendarChangesProcedure is the fully-qualified name of the
+	// ReservationService's ListCalendarChanges RPC.
+	ReservationServiceListCalendarChangesProcedure = "/api.reservation.ReservationService/ListCalendarChanges"
+	// ReservationServiceResolveCalendarChangeProcedure is the fully-qualified name of the
+	// ReservationService's ResolveCalendarChange RPC.
+	ReservationServiceResolveCalendarChangeProcedure = "/api.reservation.ReservationService/ResolveCalendarChange"
+	// ReservationServiceReconcileCalendarsProcedure is the fully-qualified name of the
+	// ReservationService's ReconcileCalendars RPC.
+	ReservationServiceReconcileCalendarsProcedure = "/api.reservation.ReservationService/ReconcileCalendars"
 )
 
 // ReservationServiceClient is a client for the api.reservation.ReservationService service.
@@ -101,6 +128,7 @@ type ReservationServiceClient interface {
 	CreateReservation(context.Context, *connect.Request[reservation.CreateReservationRequest]) (*connect.Response[reservation.CreateReservationResponse], error)
 	UpdateReservation(context.Context, *connect.Request[reservation.UpdateReservationRequest]) (*connect.Response[reservation.UpdateReservationResponse], error)
 	UpdateReservationStatus(context.Context, *connect.Request[reservation.UpdateReservationStatusRequest]) (*connect.Response[reservation.UpdateReservationResponse], error)
+	UpdateIntakeAnswers(context.Context, *connect.Request[reservation.UpdateIntakeAnswersRequest]) (*connect.Response[reservation.UpdateReservationResponse], error)
 	DeleteReservation(context.Context, *connect.Request[reservation.DeleteReservationRequest]) (*connect.Response[reservation.DeleteReservationResponse], error)
 	UserReservations(context.Context, *connect.Request[reservation.UserReservationsRequest]) (*connect.Response[reservation.UserReservationsResponse], error)
 	CreateReservationDates(context.Context, *connect.Request[reservation.CreateReservationDatesRequest]) (*connect.Response[reservation.CreateReservationDatesResponse], error)
@@ -111,8 +139,16 @@ type ReservationServiceClient interface {
 	UpdateReservationFee(context.Context, *connect.Request[reservation.UpdateReservationFeeRequest]) (*connect.Response[reservation.UpdateReservationFeeResponse], error)
 	DeleteReservationFee(context.Context, *connect.Request[reservation.DeleteReservationFeeRequest]) (*connect.Response[reservation.DeleteReservationFeeResponse], error)
 	CostReducer(context.Context, *connect.Request[reservation.CostReducerRequest]) (*connect.Response[reservation.CostReducerResponse], error)
+	GetReservationEquipment(context.Context, *connect.Request[reservation.GetReservationEquipmentRequest]) (*connect.Response[reservation.GetReservationEquipmentResponse], error)
+	SetReservationEquipment(context.Context, *connect.Request[reservation.SetReservationEquipmentRequest]) (*connect.Response[reservation.GetReservationEquipmentResponse], error)
+	GetEquipmentAvailability(context.Context, *connect.Request[reservation.GetEquipmentAvailabilityRequest]) (*connect.Response[reservation.GetEquipmentAvailabilityResponse], error)
+	ExportDoorSchedule(context.Context, *connect.Request[reservation.ExportDoorScheduleRequest]) (*connect.Response[reservation.ExportDoorScheduleResponse], error)
+	ImportReservations(context.Context, *connect.Request[reservation.ImportReservationsRequest]) (*connect.Response[reservation.ImportReservationsResponse], error)
 	GetAllPending(context.Context, *connect.Request[reservation.GetAllReservationsRequest]) (*connect.Response[reservation.AllPendingResponse], error)
 	AllSortedReservations(context.Context, *connect.Request[reservation.GetAllReservationsRequest]) (*connect.Response[reservation.AllSortedResponse], error)
+	ListCalendarChanges(context.Context, *connect.Request[reservation.ListCalendarChangesRequest]) (*connect.Response[reservation.ListCalendarChangesResponse], error)
+	ResolveCalendarChange(context.Context, *connect.Request[reservation.ResolveCalendarChangeRequest]) (*connect.Response[reservation.CalendarChange], error)
+	ReconcileCalendars(context.Context, *connect.Request[reservation.ReconcileCalendarsRequest]) (*connect.Response[reservation.ReconcileCalendarsResponse], error)
 }
 
 // NewReservationServiceClient constructs a client for the api.reservation.ReservationService
@@ -172,6 +208,12 @@ func NewReservationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(reservationServiceMethods.ByName("UpdateReservationStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		updateIntakeAnswers: connect.NewClient[reservation.UpdateIntakeAnswersRequest, reservation.UpdateReservationResponse](
+			httpClient,
+			baseURL+ReservationServiceUpdateIntakeAnswersProcedure,
+			connect.WithSchema(reservationServiceMethods.ByName("UpdateIntakeAnswers")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteReservation: connect.NewClient[reservation.DeleteReservationRequest, reservation.DeleteReservationResponse](
 			httpClient,
 			baseURL+ReservationServiceDeleteReservationProcedure,
@@ -233,6 +275,39 @@ func NewReservationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(reservationServiceMethods.ByName("CostReducer")),
 			connect.WithClientOptions(opts...),
 		),
+		getReservationEquipment: connect.NewClient[reservation.GetReservationEquipmentRequest, reservation.GetReservationEquipmentResponse](
+			httpClient,
+			baseURL+ReservationServiceGetReservationEquipmentProcedure,
+			connect.WithSchema(reservationServiceMethods.ByName("GetReservationEquipment")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setReservationEquipment: connect.NewClient[reservation.SetReservationEquipmentRequest, reservation.GetReservationEquipmentResponse](
+			httpClient,
+			baseURL+ReservationServiceSetReservationEquipmentProcedure,
+			connect.WithSchema(reservationServiceMethods.ByName("SetReservationEquipment")),
+			connect.WithClientOptions(opts...),
+		),
+		getEquipmentAvailability: connect.NewClient[reservation.GetEquipmentAvailabilityRequest, reservation.GetEquipmentAvailabilityResponse](
+			httpClient,
+			baseURL+ReservationServiceGetEquipmentAvailabilityProcedure,
+			connect.WithSchema(reservationServiceMethods.ByName("GetEquipmentAvailability")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		exportDoorSchedule: connect.NewClient[reservation.ExportDoorScheduleRequest, reservation.ExportDoorScheduleResponse](
+			httpClient,
+			baseURL+ReservationServiceExportDoorScheduleProcedure,
+			connect.WithSchema(reservationServiceMethods.ByName("ExportDoorSchedule")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		importReservations: connect.NewClient[reservation.ImportReservationsRequest, reservation.ImportReservationsResponse](
+			httpClient,
+			baseURL+ReservationServiceImportReservationsProcedure,
+			connect.WithSchema(reservationServiceMethods.ByName("ImportReservations")),
+			connect.WithClientOptions(opts...),
+		),
 		getAllPending: connect.NewClient[reservation.GetAllReservationsRequest, reservation.AllPendingResponse](
 			httpClient,
 			baseURL+ReservationServiceGetAllPendingProcedure,
@@ -247,6 +322,25 @@ func NewReservationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		listCalendarChanges: connect.NewClient[reservation.ListCalendarChangesRequest, reservation.ListCalendarChangesResponse](
+			httpClient,
+			baseURL+ReservationServiceListCalendarChangesProcedure,
+			connect.WithSchema(reservationServiceMethods.ByName("ListCalendarChanges")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		resolveCalendarChange: connect.NewClient[reservation.ResolveCalendarChangeRequest, reservation.CalendarChange](
+			httpClient,
+			baseURL+ReservationServiceResolveCalendarChangeProcedure,
+			connect.WithSchema(reservationServiceMethods.ByName("ResolveCalendarChange")),
+			connect.WithClientOptions(opts...),
+		),
+		reconcileCalendars: connect.NewClient[reservation.ReconcileCalendarsRequest, reservation.ReconcileCalendarsResponse](
+			httpClient,
+			baseURL+ReservationServiceReconcileCalendarsProcedure,
+			connect.WithSchema(reservationServiceMethods.ByName("ReconcileCalendars")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -259,6 +353,7 @@ type reservationServiceClient struct {
 	createReservation            *connect.Client[reservation.CreateReservationRequest, reservation.CreateReservationResponse]
 	updateReservation            *connect.Client[reservation.UpdateReservationRequest, reservation.UpdateReservationResponse]
 	updateReservationStatus      *connect.Client[reservation.UpdateReservationStatusRequest, reservation.UpdateReservationResponse]
+	updateIntakeAnswers          *connect.Client[reservation.UpdateIntakeAnswersRequest, reservation.UpdateReservationResponse]
 	deleteReservation            *connect.Client[reservation.DeleteReservationRequest, reservation.DeleteReservationResponse]
 	userReservations             *connect.Client[reservation.UserReservationsRequest, reservation.UserReservationsResponse]
 	createReservationDates       *connect.Client[reservation.CreateReservationDatesRequest, reservation.CreateReservationDatesResponse]
@@ -269,8 +364,16 @@ type reservationServiceClient struct {
 	updateReservationFee         *connect.Client[reservation.UpdateReservationFeeRequest, reservation.UpdateReservationFeeResponse]
 	deleteReservationFee         *connect.Client[reservation.DeleteReservationFeeRequest, reservation.DeleteReservationFeeResponse]
 	costReducer                  *connect.Client[reservation.CostReducerRequest, reservation.CostReducerResponse]
+	getReservationEquipment      *connect.Client[reservation.GetReservationEquipmentRequest, reservation.GetReservationEquipmentResponse]
+	setReservationEquipment      *connect.Client[reservation.SetReservationEquipmentRequest, reservation.GetReservationEquipmentResponse]
+	getEquipmentAvailability     *connect.Client[reservation.GetEquipmentAvailabilityRequest, reservation.GetEquipmentAvailabilityResponse]
+	exportDoorSchedule           *connect.Client[reservation.ExportDoorScheduleRequest, reservation.ExportDoorScheduleResponse]
+	importReservations           *connect.Client[reservation.ImportReservationsRequest, reservation.ImportReservationsResponse]
 	getAllPending                *connect.Client[reservation.GetAllReservationsRequest, reservation.AllPendingResponse]
 	allSortedReservations        *connect.Client[reservation.GetAllReservationsRequest, reservation.AllSortedResponse]
+	listCalendarChanges          *connect.Client[reservation.ListCalendarChangesRequest, reservation.ListCalendarChangesResponse]
+	resolveCalendarChange        *connect.Client[reservation.ResolveCalendarChangeRequest, reservation.CalendarChange]
+	reconcileCalendars           *connect.Client[reservation.ReconcileCalendarsRequest, reservation.ReconcileCalendarsResponse]
 }
 
 // GetAllReservations calls api.reservation.ReservationService.GetAllReservations.
@@ -308,6 +411,11 @@ func (c *reservationServiceClient) UpdateReservationStatus(ctx context.Context,
 	return c.updateReservationStatus.CallUnary(ctx, req)
 }
 
+// UpdateIntakeAnswers calls api.reservation.ReservationService.UpdateIntakeAnswers.
+func (c *reservationServiceClient) UpdateIntakeAnswers(ctx context.Context, req *connect.Request[reservation.UpdateIntakeAnswersRequest]) (*connect.Response[reservation.UpdateReservationResponse], error) {
+	return c.updateIntakeAnswers.CallUnary(ctx, req)
+}
+
 // DeleteReservation calls api.reservation.ReservationService.DeleteReservation.
 func (c *reservationServiceClient) DeleteReservation(ctx context.Context, req *connect.Request[reservation.DeleteReservationRequest]) (*connect.Response[reservation.DeleteReservationResponse], error) {
 	return c.deleteReservation.CallUnary(ctx, req)
@@ -359,6 +467,31 @@ func (c *reservationServiceClient) CostReducer(ctx context.Context, req *connect
 	return c.costReducer.CallUnary(ctx, req)
 }
 
+// GetReservationEquipment calls api.reservation.ReservationService.GetReservationEquipment.
+func (c *reservationServiceClient) GetReservationEquipment(ctx context.Context, req *connect.Request[reservation.GetReservationEquipmentRequest]) (*connect.Response[reservation.GetReservationEquipmentResponse], error) {
+	return c.getReservationEquipment.CallUnary(ctx, req)
+}
+
+// SetReservationEquipment calls api.reservation.ReservationService.SetReservationEquipment.
+func (c *reservationServiceClient) SetReservationEquipment(ctx context.Context, req *connect.Request[reservation.SetReservationEquipmentRequest]) (*connect.Response[reservation.GetReservationEquipmentResponse], error) {
+	return c.setReservationEquipment.CallUnary(ctx, req)
+}
+
+// GetEquipmentAvailability calls api.reservation.ReservationService.GetEquipmentAvailability.
+func (c *reservationServiceClient) GetEquipmentAvailability(ctx context.Context, req *connect.Request[reservation.GetEquipmentAvailabilityRequest]) (*connect.Response[reservation.GetEquipmentAvailabilityResponse], error) {
+	return c.getEquipmentAvailability.CallUnary(ctx, req)
+}
+
+// ExportDoorSchedule calls api.reservation.ReservationService.ExportDoorSchedule.
+func (c *reservationServiceClient) ExportDoorSchedule(ctx context.Context, req *connect.Request[reservation.ExportDoorScheduleRequest]) (*connect.Response[reservation.ExportDoorScheduleResponse], error) {
+	return c.exportDoorSchedule.CallUnary(ctx, req)
+}
+
+// ImportReservations calls api.reservation.ReservationService.ImportReservations.
+func (c *reservationServiceClient) ImportReservations(ctx context.Context, req *connect.Request[reservation.ImportReservationsRequest]) (*connect.Response[reservation.ImportReservationsResponse], error) {
+	return c.importReservations.CallUnary(ctx, req)
+}
+
 // GetAllPending calls api.reservation.ReservationService.GetAllPending.
 func (c *reservationServiceClient) GetAllPending(ctx context.Context, req *connect.Request[reservation.GetAllReservationsRequest]) (*connect.Response[reservation.AllPendingResponse], error) {
 	return c.getAllPending.CallUnary(ctx, req)
@@ -369,6 +502,21 @@ func (c *reservationServiceClient) AllSortedReservations(ctx context.Context, re
 	return c.allSortedReservations.CallUnary(ctx, req)
 }
 
+// ListCalendarChanges calls api.reservation.ReservationService.ListCalendarChanges.
+func (c *reservationServiceClient) ListCalendarChanges(ctx context.Context, req *connect.Request[reservation.ListCalendarChangesRequest]) (*connect.Response[reservation.ListCalendarChangesResponse], error) {
+	return c.listCalendarChanges.CallUnary(ctx, req)
+}
+
+// ResolveCalendarChange calls api.reservation.ReservationService.ResolveCalendarChange.
+func (c *reservationServiceClient) ResolveCalendarChange(ctx context.Context, req *connect.Request[reservation.ResolveCalendarChangeRequest]) (*connect.Response[reservation.CalendarChange], error) {
+	return c.resolveCalendarChange.CallUnary(ctx, req)
+}
+
+// ReconcileCalendars calls api.reservation.ReservationService.ReconcileCalendars.
+func (c *reservationServiceClient) ReconcileCalendars(ctx context.Context, req *connect.Request[reservation.ReconcileCalendarsRequest]) (*connect.Response[reservation.ReconcileCalendarsResponse], error) {
+	return c.reconcileCalendars.CallUnary(ctx, req)
+}
+
 // ReservationServiceHandler is an implementation of the api.reservation.ReservationService service.
 type ReservationServiceHandler interface {
 	GetAllReservations(context.Context, *connect.Request[reservation.GetAllReservationsRequest]) (*connect.Response[reservation.AllReservationsResponse], error)
@@ -378,6 +526,7 @@ type ReservationServiceHandler interface {
 	CreateReservation(context.Context, *connect.Request[reservation.CreateReservationRequest]) (*connect.Response[reservation.CreateReservationResponse], error)
 	UpdateReservation(context.Context, *connect.Request[reservation.UpdateReservationRequest]) (*connect.Response[reservation.UpdateReservationResponse], error)
 	UpdateReservationStatus(context.Context, *connect.Request[reservation.UpdateReservationStatusRequest]) (*connect.Response[reservation.UpdateReservationResponse], error)
+	UpdateIntakeAnswers(context.Context, *connect.Request[reservation.UpdateIntakeAnswersRequest]) (*connect.Response[reservation.UpdateReservationResponse], error)
 	DeleteReservation(context.Context, *connect.Request[reservation.DeleteReservationRequest]) (*connect.Response[reservation.DeleteReservationResponse], error)
 	UserReservations(context.Context, *connect.Request[reservation.UserReservationsRequest]) (*connect.Response[reservation.UserReservationsResponse], error)
 	CreateReservationDates(context.Context, *connect.Request[reservation.CreateReservationDatesRequest]) (*connect.Response[reservation.CreateReservationDatesResponse], error)
@@ -388,8 +537,16 @@ type ReservationServiceHandler interface {
 	UpdateReservationFee(context.Context, *connect.Request[reservation.UpdateReservationFeeRequest]) (*connect.Response[reservation.UpdateReservationFeeResponse], error)
 	DeleteReservationFee(context.Context, *connect.Request[reservation.DeleteReservationFeeRequest]) (*connect.Response[reservation.DeleteReservationFeeResponse], error)
 	CostReducer(context.Context, *connect.Request[reservation.CostReducerRequest]) (*connect.Response[reservation.CostReducerResponse], error)
+	GetReservationEquipment(context.Context, *connect.Request[reservation.GetReservationEquipmentRequest]) (*connect.Response[reservation.GetReservationEquipmentResponse], error)
+	SetReservationEquipment(context.Context, *connect.Request[reservation.SetReservationEquipmentRequest]) (*connect.Response[reservation.GetReservationEquipmentResponse], error)
+	GetEquipmentAvailability(context.Context, *connect.Request[reservation.GetEquipmentAvailabilityRequest]) (*connect.Response[reservation.GetEquipmentAvailabilityResponse], error)
+	ExportDoorSchedule(context.Context, *connect.Request[reservation.ExportDoorScheduleRequest]) (*connect.Response[reservation.ExportDoorScheduleResponse], error)
+	ImportReservations(context.Context, *connect.Request[reservation.ImportReservationsRequest]) (*connect.Response[reservation.ImportReservationsResponse], error)
 	GetAllPending(context.Context, *connect.Request[reservation.GetAllReservationsRequest]) (*connect.Response[reservation.AllPendingResponse], error)
 	AllSortedReservations(context.Context, *connect.Request[reservation.GetAllReservationsRequest]) (*connect.Response[reservation.AllSortedResponse], error)
+	ListCalendarChanges(context.Context, *connect.Request[reservation.ListCalendarChangesRequest]) (*connect.Response[reservation.ListCalendarChangesResponse], error)
+	ResolveCalendarChange(context.Context, *connect.Request[reservation.ResolveCalendarChangeRequest]) (*connect.Response[reservation.CalendarChange], error)
+	ReconcileCalendars(context.Context, *connect.Request[reservation.ReconcileCalendarsRequest]) (*connect.Response[reservation.ReconcileCalendarsResponse], error)
 }
 
 // NewReservationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -445,6 +602,12 @@ func NewReservationServiceHandler(svc ReservationServiceHandler, opts ...connect
 		connect.WithSchema(reservationServiceMethods.ByName("UpdateReservationStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	reservationServiceUpdateIntakeAnswersHandler := connect.NewUnaryHandler(
+		ReservationServiceUpdateIntakeAnswersProcedure,
+		svc.UpdateIntakeAnswers,
+		connect.WithSchema(reservationServiceMethods.ByName("UpdateIntakeAnswers")),
+		connect.WithHandlerOptions(opts...),
+	)
 	reservationServiceDeleteReservationHandler := connect.NewUnaryHandler(
 		ReservationServiceDeleteReservationProcedure,
 		svc.DeleteReservation,
@@ -506,6 +669,39 @@ func NewReservationServiceHandler(svc ReservationServiceHandler, opts ...connect
 		connect.WithSchema(reservationServiceMethods.ByName("CostReducer")),
 		connect.WithHandlerOptions(opts...),
 	)
+	reservationServiceGetReservationEquipmentHandler := connect.NewUnaryHandler(
+		ReservationServiceGetReservationEquipmentProcedure,
+		svc.GetReservationEquipment,
+		connect.WithSchema(reservationServiceMethods.ByName("GetReservationEquipment")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reservationServiceSetReservationEquipmentHandler := connect.NewUnaryHandler(
+		ReservationServiceSetReservationEquipmentProcedure,
+		svc.SetReservationEquipment,
+		connect.WithSchema(reservationServiceMethods.ByName("SetReservationEquipment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reservationServiceGetEquipmentAvailabilityHandler := connect.NewUnaryHandler(
+		ReservationServiceGetEquipmentAvailabilityProcedure,
+		svc.GetEquipmentAvailability,
+		connect.WithSchema(reservationServiceMethods.ByName("GetEquipmentAvailability")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reservationServiceExportDoorScheduleHandler := connect.NewUnaryHandler(
+		ReservationServiceExportDoorScheduleProcedure,
+		svc.ExportDoorSchedule,
+		connect.WithSchema(reservationServiceMethods.ByName("ExportDoorSchedule")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reservationServiceImportReservationsHandler := connect.NewUnaryHandler(
+		ReservationServiceImportReservationsProcedure,
+		svc.ImportReservations,
+		connect.WithSchema(reservationServiceMethods.ByName("ImportReservations")),
+		connect.WithHandlerOptions(opts...),
+	)
 	reservationServiceGetAllPendingHandler := connect.NewUnaryHandler(
 		ReservationServiceGetAllPendingProcedure,
 		svc.GetAllPending,
@@ -520,6 +716,25 @@ func NewReservationServiceHandler(svc ReservationServiceHandler, opts ...connect
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	reservationServiceListCalendarChangesHandler := connect.NewUnaryHandler(
+		ReservationServiceListCalendarChangesProcedure,
+		svc.ListCalendarChanges,
+		connect.WithSchema(reservationServiceMethods.ByName("ListCalendarChanges")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reservationServiceResolveCalendarChangeHandler := connect.NewUnaryHandler(
+		ReservationServiceResolveCalendarChangeProcedure,
+		svc.ResolveCalendarChange,
+		connect.WithSchema(reservationServiceMethods.ByName("ResolveCalendarChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reservationServiceReconcileCalendarsHandler := connect.NewUnaryHandler(
+		ReservationServiceReconcileCalendarsProcedure,
+		svc.ReconcileCalendars,
+		connect.WithSchema(reservationServiceMethods.ByName("ReconcileCalendars")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.reservation.ReservationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ReservationServiceGetAllReservationsProcedure:
@@ -536,6 +751,8 @@ func NewReservationServiceHandler(svc ReservationServiceHandler, opts ...connect
 			reservationServiceUpdateReservationHandler.ServeHTTP(w, r)
 		case ReservationServiceUpdateReservationStatusProcedure:
 			reservationServiceUpdateReservationStatusHandler.ServeHTTP(w, r)
+		case ReservationServiceUpdateIntakeAnswersProcedure:
+			reservationServiceUpdateIntakeAnswersHandler.ServeHTTP(w, r)
 		case ReservationServiceDeleteReservationProcedure:
 			reservationServiceDeleteReservationHandler.ServeHTTP(w, r)
 		case ReservationServiceUserReservationsProcedure:
@@ -556,10 +773,26 @@ func NewReservationServiceHandler(svc ReservationServiceHandler, opts ...connect
 			reservationServiceDeleteReservationFeeHandler.ServeHTTP(w, r)
 		case ReservationServiceCostReducerProcedure:
 			reservationServiceCostReducerHandler.ServeHTTP(w, r)
+		case ReservationServiceGetReservationEquipmentProcedure:
+			reservationServiceGetReservationEquipmentHandler.ServeHTTP(w, r)
+		case ReservationServiceSetReservationEquipmentProcedure:
+			reservationServiceSetReservationEquipmentHandler.ServeHTTP(w, r)
+		case ReservationServiceGetEquipmentAvailabilityProcedure:
+			reservationServiceGetEquipmentAvailabilityHandler.ServeHTTP(w, r)
+		case ReservationServiceExportDoorScheduleProcedure:
+			reservationServiceExportDoorScheduleHandler.ServeHTTP(w, r)
+		case ReservationServiceImportReservationsProcedure:
+			reservationServiceImportReservationsHandler.ServeHTTP(w, r)
 		case ReservationServiceGetAllPendingProcedure:
 			reservationServiceGetAllPendingHandler.ServeHTTP(w, r)
 		case ReservationServiceAllSortedReservationsProcedure:
 			reservationServiceAllSortedReservationsHandler.ServeHTTP(w, r)
+		case ReservationServiceListCalendarChangesProcedure:
+			reservationServiceListCalendarChangesHandler.ServeHTTP(w, r)
+		case ReservationServiceResolveCalendarChangeProcedure:
+			reservationServiceResolveCalendarChangeHandler.ServeHTTP(w, r)
+		case ReservationServiceReconcileCalendarsProcedure:
+			reservationServiceReconcileCalendarsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -597,6 +830,10 @@ func (UnimplementedReservationServiceHandler) UpdateReservationStatus(context.Co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.UpdateReservationStatus is not implemented"))
 }
 
+func (UnimplementedReservationServiceHandler) UpdateIntakeAnswers(context.Context, *connect.Request[reservation.UpdateIntakeAnswersRequest]) (*connect.Response[reservation.UpdateReservationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.UpdateIntakeAnswers is not implemented"))
+}
+
 func (UnimplementedReservationServiceHandler) DeleteReservation(context.Context, *connect.Request[reservation.DeleteReservationRequest]) (*connect.Response[reservation.DeleteReservationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.DeleteReservation is not implemented"))
 }
@@ -637,6 +874,26 @@ func (UnimplementedReservationServiceHandler) CostReducer(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.CostReducer is not implemented"))
 }
 
+func (UnimplementedReservationServiceHandler) GetReservationEquipment(context.Context, *connect.Request[reservation.GetReservationEquipmentRequest]) (*connect.Response[reservation.GetReservationEquipmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.GetReservationEquipment is not implemented"))
+}
+
+func (UnimplementedReservationServiceHandler) SetReservationEquipment(context.Context, *connect.Request[reservation.SetReservationEquipmentRequest]) (*connect.Response[reservation.GetReservationEquipmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.SetReservationEquipment is not implemented"))
+}
+
+func (UnimplementedReservationServiceHandler) GetEquipmentAvailability(context.Context, *connect.Request[reservation.GetEquipmentAvailabilityRequest]) (*connect.Response[reservation.GetEquipmentAvailabilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.GetEquipmentAvailability is not implemented"))
+}
+
+func (UnimplementedReservationServiceHandler) ExportDoorSchedule(context.Context, *connect.Request[reservation.ExportDoorScheduleRequest]) (*connect.Response[reservation.ExportDoorScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.ExportDoorSchedule is not implemented"))
+}
+
+func (UnimplementedReservationServiceHandler) ImportReservations(context.Context, *connect.Request[reservation.ImportReservationsRequest]) (*connect.Response[reservation.ImportReservationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.ImportReservations is not implemented"))
+}
+
 func (UnimplementedReservationServiceHandler) GetAllPending(context.Context, *connect.Request[reservation.GetAllReservationsRequest]) (*connect.Response[reservation.AllPendingResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.GetAllPending is not implemented"))
 }
@@ -644,3 +901,15 @@ func (UnimplementedReservationServiceHandler) GetAllPending(context.Context, *co
 func (UnimplementedReservationServiceHandler) AllSortedReservations(context.Context, *connect.Request[reservation.GetAllReservationsRequest]) (*connect.Response[reservation.AllSortedResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.AllSortedReservations is not implemented"))
 }
+
+func (UnimplementedReservationServiceHandler) ListCalendarChanges(context.Context, *connect.Request[reservation.ListCalendarChangesRequest]) (*connect.Response[reservation.ListCalendarChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.ListCalendarChanges is not implemented"))
+}
+
+func (UnimplementedReservationServiceHandler) ResolveCalendarChange(context.Context, *connect.Request[reservation.ResolveCalendarChangeRequest]) (*connect.Response[reservation.CalendarChange], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.ResolveCalendarChange is not implemented"))
+}
+
+func (UnimplementedReservationServiceHandler) ReconcileCalendars(context.Context, *connect.Request[reservation.ReconcileCalendarsRequest]) (*connect.Response[reservation.ReconcileCalendarsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.reservation.ReservationService.ReconcileCalendars is not implemented"))
+}
@@ -0,0 +1,807 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: proto/staff/staff.proto
+
+package staffservice
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StaffAssignment struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReservationDateId int64                  `protobuf:"varint,2,opt,name=reservation_date_id,json=reservationDateId,proto3" json:"reservation_date_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role              string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // custodian | tech | security
+	Notes             string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	UserName          string                 `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserEmail         string                 `protobuf:"bytes,7,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	ReservationId     int64                  `protobuf:"varint,8,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventName         string                 `protobuf:"bytes,9,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	FacilityId        int64                  `protobuf:"varint,10,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
+	FacilityName      string                 `protobuf:"bytes,11,opt,name=facility_name,json=facilityName,proto3" json:"facility_name,omitempty"`
+	BuildingId        int64                  `protobuf:"varint,12,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	BuildingName      string                 `protobuf:"bytes,13,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
+	LocalStart        string                 `protobuf:"bytes,14,opt,name=local_start,json=localStart,proto3" json:"local_start,omitempty"` // YYYY-MM-DDTHH:MM:SS
+	LocalEnd          string                 `protobuf:"bytes,15,opt,name=local_end,json=localEnd,proto3" json:"local_end,omitempty"`       // YYYY-MM-DDTHH:MM:SS
+	CreatedAt         string                 `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // RFC3339 string
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StaffAssignment) Reset() {
+	*x = StaffAssignment{}
+	mi := &file_proto_staff_staff_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffAssignment) ProtoMessage() {}
+
+func (x *StaffAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffAssignment.ProtoReflect.Descriptor instead.
+func (*StaffAssignment) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StaffAssignment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StaffAssignment) GetReservationDateId() int64 {
+	if x != nil {
+		return x.ReservationDateId
+	}
+	return 0
+}
+
+func (x *StaffAssignment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *StaffAssignment) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetFacilityId() int64 {
+	if x != nil {
+		return x.FacilityId
+	}
+	return 0
+}
+
+func (x *StaffAssignment) GetFacilityName() string {
+	if x != nil {
+		return x.FacilityName
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *StaffAssignment) GetBuildingName() string {
+	if x != nil {
+		return x.BuildingName
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetLocalStart() string {
+	if x != nil {
+		return x.LocalStart
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetLocalEnd() string {
+	if x != nil {
+		return x.LocalEnd
+	}
+	return ""
+}
+
+func (x *StaffAssignment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type StaffHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Hours         float64                `protobuf:"fixed64,4,opt,name=hours,proto3" json:"hours,omitempty"`
+	Shifts        int64                  `protobuf:"varint,5,opt,name=shifts,proto3" json:"shifts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffHours) Reset() {
+	*x = StaffHours{}
+	mi := &file_proto_staff_staff_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffHours) ProtoMessage() {}
+
+func (x *StaffHours) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffHours.ProtoReflect.Descriptor instead.
+func (*StaffHours) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StaffHours) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StaffHours) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *StaffHours) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *StaffHours) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *StaffHours) GetShifts() int64 {
+	if x != nil {
+		return x.Shifts
+	}
+	return 0
+}
+
+type StaffAssignmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*StaffAssignment     `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffAssignmentsResponse) Reset() {
+	*x = StaffAssignmentsResponse{}
+	mi := &file_proto_staff_staff_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffAssignmentsResponse) ProtoMessage() {}
+
+func (x *StaffAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*StaffAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StaffAssignmentsResponse) GetAssignments() []*StaffAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type GetReservationAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId int64                  `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationAssignmentsRequest) Reset() {
+	*x = GetReservationAssignmentsRequest{}
+	mi := &file_proto_staff_staff_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationAssignmentsRequest) ProtoMessage() {}
+
+func (x *GetReservationAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetReservationAssignmentsRequest) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+type GetMyAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD, optional
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD, optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyAssignmentsRequest) Reset() {
+	*x = GetMyAssignmentsRequest{}
+	mi := &file_proto_staff_staff_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyAssignmentsRequest) ProtoMessage() {}
+
+func (x *GetMyAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMyAssignmentsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetMyAssignmentsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// Assigns one staff member to one or more dates. Nothing is saved if any date
+// overlaps a shift the person already has.
+type CreateAssignmentsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ReservationDateIds []int64                `protobuf:"varint,1,rep,packed,name=reservation_date_ids,json=reservationDateIds,proto3" json:"reservation_date_ids,omitempty"`
+	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role               string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Notes              string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentsRequest) Reset() {
+	*x = CreateAssignmentsRequest{}
+	mi := &file_proto_staff_staff_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentsRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAssignmentsRequest) GetReservationDateIds() []int64 {
+	if x != nil {
+		return x.ReservationDateIds
+	}
+	return nil
+}
+
+func (x *CreateAssignmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateAssignmentsRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type DeleteAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentRequest) Reset() {
+	*x = DeleteAssignmentRequest{}
+	mi := &file_proto_staff_staff_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentRequest) ProtoMessage() {}
+
+func (x *DeleteAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteAssignmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentResponse) Reset() {
+	*x = DeleteAssignmentResponse{}
+	mi := &file_proto_staff_staff_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentResponse) ProtoMessage() {}
+
+func (x *DeleteAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{7}
+}
+
+// Totals assigned shift hours per person and role. Leave building_id unset
+// for every building (site admins only).
+type GetStaffHoursReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD, optional
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD, optional
+	BuildingId    int64                  `protobuf:"varint,3,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStaffHoursReportRequest) Reset() {
+	*x = GetStaffHoursReportRequest{}
+	mi := &file_proto_staff_staff_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStaffHoursReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaffHoursReportRequest) ProtoMessage() {}
+
+func (x *GetStaffHoursReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaffHoursReportRequest.ProtoReflect.Descriptor instead.
+func (*GetStaffHoursReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetStaffHoursReportRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetStaffHoursReportRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetStaffHoursReportRequest) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+type StaffHoursReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*StaffHours          `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	TotalHours    float64                `protobuf:"fixed64,2,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffHoursReport) Reset() {
+	*x = StaffHoursReport{}
+	mi := &file_proto_staff_staff_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffHoursReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffHoursReport) ProtoMessage() {}
+
+func (x *StaffHoursReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_staff_staff_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffHoursReport.ProtoReflect.Descriptor instead.
+func (*StaffHoursReport) Descriptor() ([]byte, []int) {
+	return file_proto_staff_staff_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StaffHoursReport) GetRows() []*StaffHours {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *StaffHoursReport) GetTotalHours() float64 {
+	if x != nil {
+		return x.TotalHours
+	}
+	return 0
+}
+
+var File_proto_staff_staff_proto protoreflect.FileDescriptor
+
+const file_proto_staff_staff_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/staff/staff.proto\x12\tapi.staff\"\x93\x04\n" +
+	"\x0fStaffAssignment\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x122\n" +
+	"\x13reservation_date_id\x18\x02 \x01(\x03B\x020\x01R\x11reservationDateId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\a \x01(\tR\tuserEmail\x12)\n" +
+	"\x0ereservation_id\x18\b \x01(\x03B\x020\x01R\rreservationId\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\t \x01(\tR\teventName\x12#\n" +
+	"\vfacility_id\x18\n" +
+	" \x01(\x03B\x020\x01R\n" +
+	"facilityId\x12#\n" +
+	"\rfacility_name\x18\v \x01(\tR\ffacilityName\x12#\n" +
+	"\vbuilding_id\x18\f \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12#\n" +
+	"\rbuilding_name\x18\r \x01(\tR\fbuildingName\x12\x1f\n" +
+	"\vlocal_start\x18\x0e \x01(\tR\n" +
+	"localStart\x12\x1b\n" +
+	"\tlocal_end\x18\x0f \x01(\tR\blocalEnd\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\tR\tcreatedAt\"\x88\x01\n" +
+	"\n" +
+	"StaffHours\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x14\n" +
+	"\x05hours\x18\x04 \x01(\x01R\x05hours\x12\x1a\n" +
+	"\x06shifts\x18\x05 \x01(\x03B\x020\x01R\x06shifts\"X\n" +
+	"\x18StaffAssignmentsResponse\x12<\n" +
+	"\vassignments\x18\x01 \x03(\v2\x1a.api.staff.StaffAssignmentR\vassignments\"M\n" +
+	" GetReservationAssignmentsRequest\x12)\n" +
+	"\x0ereservation_id\x18\x01 \x01(\x03B\x020\x01R\rreservationId\"S\n" +
+	"\x17GetMyAssignmentsRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\"\x93\x01\n" +
+	"\x18CreateAssignmentsRequest\x124\n" +
+	"\x14reservation_date_ids\x18\x01 \x03(\x03B\x020\x01R\x12reservationDateIds\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"-\n" +
+	"\x17DeleteAssignmentRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"\x1a\n" +
+	"\x18DeleteAssignmentResponse\"{\n" +
+	"\x1aGetStaffHoursReportRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12#\n" +
+	"\vbuilding_id\x18\x03 \x01(\x03B\x020\x01R\n" +
+	"buildingId\"^\n" +
+	"\x10StaffHoursReport\x12)\n" +
+	"\x04rows\x18\x01 \x03(\v2\x15.api.staff.StaffHoursR\x04rows\x12\x1f\n" +
+	"\vtotal_hours\x18\x02 \x01(\x01R\n" +
+	"totalHours2\x80\x04\n" +
+	"\fStaffService\x12r\n" +
+	"\x19GetReservationAssignments\x12+.api.staff.GetReservationAssignmentsRequest\x1a#.api.staff.StaffAssignmentsResponse\"\x03\x90\x02\x01\x12`\n" +
+	"\x10GetMyAssignments\x12\".api.staff.GetMyAssignmentsRequest\x1a#.api.staff.StaffAssignmentsResponse\"\x03\x90\x02\x01\x12]\n" +
+	"\x11CreateAssignments\x12#.api.staff.CreateAssignmentsRequest\x1a#.api.staff.StaffAssignmentsResponse\x12[\n" +
+	"\x10DeleteAssignment\x12\".api.staff.DeleteAssignmentRequest\x1a#.api.staff.DeleteAssignmentResponse\x12^\n" +
+	"\x13GetStaffHoursReport\x12%.api.staff.GetStaffHoursReportRequest\x1a\x1b.api.staff.StaffHoursReport\"\x03\x90\x02\x01B\x87\x01\n" +
+	"\rcom.api.staffB\n" +
+	"StaffProtoP\x01Z%api/internal/proto/staff;staffservice\xa2\x02\x03ASX\xaa\x02\tApi.Staff\xca\x02\tApi\\Staff\xe2\x02\x15Api\\Staff\\GPBMetadata\xea\x02\n" +
+	"Api::Staffb\x06proto3"
+
+var (
+	file_proto_staff_staff_proto_rawDescOnce sync.Once
+	file_proto_staff_staff_proto_rawDescData []byte
+)
+
+func file_proto_staff_staff_proto_rawDescGZIP() []byte {
+	file_proto_staff_staff_proto_rawDescOnce.Do(func() {
+		file_proto_staff_staff_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_staff_staff_proto_rawDesc), len(file_proto_staff_staff_proto_rawDesc)))
+	})
+	return file_proto_staff_staff_proto_rawDescData
+}
+
+var file_proto_staff_staff_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_staff_staff_proto_goTypes = []any{
+	(*StaffAssignment)(nil),                  // 0: api.staff.StaffAssignment
+	(*StaffHours)(nil),                       // 1: api.staff.StaffHours
+	(*StaffAssignmentsResponse)(nil),         // 2: api.staff.StaffAssignmentsResponse
+	(*GetReservationAssignmentsRequest)(nil), // 3: api.staff.GetReservationAssignmentsRequest
+	(*GetMyAssignmentsRequest)(nil),          // 4: api.staff.GetMyAssignmentsRequest
+	(*CreateAssignmentsRequest)(nil),         // 5: api.staff.CreateAssignmentsRequest
+	(*DeleteAssignmentRequest)(nil),          // 6: api.staff.DeleteAssignmentRequest
+	(*DeleteAssignmentResponse)(nil),         // 7: api.staff.DeleteAssignmentResponse
+	(*GetStaffHoursReportRequest)(nil),       // 8: api.staff.GetStaffHoursReportRequest
+	(*StaffHoursReport)(nil),                 // 9: api.staff.StaffHoursReport
+}
+var file_proto_staff_staff_proto_depIdxs = []int32{
+	0, // 0: api.staff.StaffAssignmentsResponse.assignments:type_name -> api.staff.StaffAssignment
+	1, // 1: api.staff.StaffHoursReport.rows:type_name -> api.staff.StaffHours
+	3, // 2: api.staff.StaffService.GetReservationAssignments:input_type -> api.staff.GetReservationAssignmentsRequest
+	4, // 3: api.staff.StaffService.GetMyAssignments:input_type -> api.staff.GetMyAssignmentsRequest
+	5, // 4: api.staff.StaffService.CreateAssignments:input_type -> api.staff.CreateAssignmentsRequest
+	6, // 5: api.staff.StaffService.DeleteAssignment:input_type -> api.staff.DeleteAssignmentRequest
+	8, // 6: api.staff.StaffService.GetStaffHoursReport:input_type -> api.staff.GetStaffHoursReportRequest
+	2, // 7: api.staff.StaffService.GetReservationAssignments:output_type -> api.staff.StaffAssignmentsResponse
+	2, // 8: api.staff.StaffService.GetMyAssignments:output_type -> api.staff.StaffAssignmentsResponse
+	2, // 9: api.staff.StaffService.CreateAssignments:output_type -> api.staff.StaffAssignmentsResponse
+	7, // 10: api.staff.StaffService.DeleteAssignment:output_type -> api.staff.DeleteAssignmentResponse
+	9, // 11: api.staff.StaffService.GetStaffHoursReport:output_type -> api.staff.StaffHoursReport
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_staff_staff_proto_init() }
+func file_proto_staff_staff_proto_init() {
+	if File_proto_staff_staff_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_staff_staff_proto_rawDesc), len(file_proto_staff_staff_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_staff_staff_proto_goTypes,
+		DependencyIndexes: file_proto_staff_staff_proto_depIdxs,
+		MessageInfos:      file_proto_staff_staff_proto_msgTypes,
+	}.Build()
+	File_proto_staff_staff_proto = out.File
+	file_proto_staff_staff_proto_goTypes = nil
+	file_proto_staff_staff_proto_depIdxs = nil
+}
@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/staff/staff.proto
+
+package staffserviceconnect
+
+import (
+	staff "api/internal/proto/staff"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StaffServiceName is the fully-qualified name of the StaffService service.
+	StaffServiceName = "api.staff.StaffService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StaffServiceGetReservationAssignmentsProcedure is the fully-qualified name of the StaffService's
+	// GetReservationAssignments RPC.
+	StaffServiceGetReservationAssignmentsProcedure = "/api.staff.StaffService/GetReservationAssignments"
+	// StaffServiceGetMyAssignmentsProcedure is the fully-qualified name of the StaffService's
+	// GetMyAssignments RPC.
+	StaffServiceGetMyAssignmentsProcedure = "/api.staff.StaffService/GetMyAssignments"
+	// StaffServiceCreateAssignmentsProcedure is the fully-qualified name of the StaffService's
+	// CreateAssignments RPC.
+	StaffServiceCreateAssignmentsProcedure = "/api.staff.StaffService/CreateAssignments"
+	// StaffServiceDeleteAssignmentProcedure is the fully-qualified name of the StaffService's
+	// DeleteAssignment RPC.
+	StaffServiceDeleteAssignmentProcedure = "/api.staff.StaffService/DeleteAssignment"
+	// StaffServiceGetStaffHoursReportProcedure is the fully-qualified name of the StaffService's
+	// GetStaffHoursReport RPC.
+	StaffServiceGetStaffHoursReportProcedure = "/api.staff.StaffService/GetStaffHoursReport"
+)
+
+// StaffServiceClient is a client for the api.staff.StaffService service.
+type StaffServiceClient interface {
+	GetReservationAssignments(context.Context, *connect.Request[staff.GetReservationAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error)
+	GetMyAssignments(context.Context, *connect.Request[staff.GetMyAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error)
+	CreateAssignments(context.Context, *connect.Request[staff.CreateAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error)
+	DeleteAssignment(context.Context, *connect.Request[staff.DeleteAssignmentRequest]) (*connect.Response[staff.DeleteAssignmentResponse], error)
+	GetStaffHoursReport(context.Context, *connect.Request[staff.GetStaffHoursReportRequest]) (*connect.Response[staff.StaffHoursReport], error)
+}
+
+// NewStaffServiceClient constructs a client for the api.staff.StaffService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStaffServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StaffServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	staffServiceMethods := staff.File_proto_staff_staff_proto.Services().ByName("StaffService").Methods()
+	return &staffServiceClient{
+		getReservationAssignments: connect.NewClient[staff.GetReservationAssignmentsRequest, staff.StaffAssignmentsResponse](
+			httpClient,
+			baseURL+StaffServiceGetReservationAssignmentsProcedure,
+			connect.WithSchema(staffServiceMethods.ByName("GetReservationAssignments")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getMyAssignments: connect.NewClient[staff.GetMyAssignmentsRequest, staff.StaffAssignmentsResponse](
+			httpClient,
+			baseURL+StaffServiceGetMyAssignmentsProcedure,
+			connect.WithSchema(staffServiceMethods.ByName("GetMyAssignments")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createAssignments: connect.NewClient[staff.CreateAssignmentsRequest, staff.StaffAssignmentsResponse](
+			httpClient,
+			baseURL+StaffServiceCreateAssignmentsProcedure,
+			connect.WithSchema(staffServiceMethods.ByName("CreateAssignments")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAssignment: connect.NewClient[staff.DeleteAssignmentRequest, staff.DeleteAssignmentResponse](
+			httpClient,
+			baseURL+StaffServiceDeleteAssignmentProcedure,
+			connect.WithSchema(staffServiceMethods.ByName("DeleteAssignment")),
+			connect.WithClientOptions(opts...),
+		),
+		getStaffHoursReport: connect.NewClient[staff.GetStaffHoursReportRequest, staff.StaffHoursReport](
+			httpClient,
+			baseURL+StaffServiceGetStaffHoursReportProcedure,
+			connect.WithSchema(staffServiceMethods.ByName("GetStaffHoursReport")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// staffServiceClient implements StaffServiceClient.
+type staffServiceClient struct {
+	getReservationAssignments *connect.Client[staff.GetReservationAssignmentsRequest, staff.StaffAssignmentsResponse]
+	getMyAssignments          *connect.Client[staff.GetMyAssignmentsRequest, staff.StaffAssignmentsResponse]
+	createAssignments         *connect.Client[staff.CreateAssignmentsRequest, staff.StaffAssignmentsResponse]
+	deleteAssignment          *connect.Client[staff.DeleteAssignmentRequest, staff.DeleteAssignmentResponse]
+	getStaffHoursReport       *connect.Client[staff.GetStaffHoursReportRequest, staff.StaffHoursReport]
+}
+
+// GetReservationAssignments calls api.staff.StaffService.GetReservationAssignments.
+func (c *staffServiceClient) GetReservationAssignments(ctx context.Context, req *connect.Request[staff.GetReservationAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error) {
+	return c.getReservationAssignments.CallUnary(ctx, req)
+}
+
+// GetMyAssignments calls api.staff.StaffService.GetMyAssignments.
+func (c *staffServiceClient) GetMyAssignments(ctx context.Context, req *connect.Request[staff.GetMyAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error) {
+	return c.getMyAssignments.CallUnary(ctx, req)
+}
+
+// CreateAssignments calls api.staff.StaffService.CreateAssignments.
+func (c *staffServiceClient) CreateAssignments(ctx context.Context, req *connect.Request[staff.CreateAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error) {
+	return c.createAssignments.CallUnary(ctx, req)
+}
+
+// DeleteAssignment calls api.staff.StaffService.DeleteAssignment.
+func (c *staffServiceClient) DeleteAssignment(ctx context.Context, req *connect.Request[staff.DeleteAssignmentRequest]) (*connect.Response[staff.DeleteAssignmentResponse], error) {
+	return c.deleteAssignment.CallUnary(ctx, req)
+}
+
+// GetStaffHoursReport calls api.staff.StaffService.GetStaffHoursReport.
+func (c *staffServiceClient) GetStaffHoursReport(ctx context.Context, req *connect.Request[staff.GetStaffHoursReportRequest]) (*connect.Response[staff.StaffHoursReport], error) {
+	return c.getStaffHoursReport.CallUnary(ctx, req)
+}
+
+// StaffServiceHandler is an implementation of the api.staff.StaffService service.
+type StaffServiceHandler interface {
+	GetReservationAssignments(context.Context, *connect.Request[staff.GetReservationAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error)
+	GetMyAssignments(context.Context, *connect.Request[staff.GetMyAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error)
+	CreateAssignments(context.Context, *connect.Request[staff.CreateAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error)
+	DeleteAssignment(context.Context, *connect.Request[staff.DeleteAssignmentRequest]) (*connect.Response[staff.DeleteAssignmentResponse], error)
+	GetStaffHoursReport(context.Context, *connect.Request[staff.GetStaffHoursReportRequest]) (*connect.Response[staff.StaffHoursReport], error)
+}
+
+// NewStaffServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStaffServiceHandler(svc StaffServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	staffServiceMethods := staff.File_proto_staff_staff_proto.Services().ByName("StaffService").Methods()
+	staffServiceGetReservationAssignmentsHandler := connect.NewUnaryHandler(
+		StaffServiceGetReservationAssignmentsProcedure,
+		svc.GetReservationAssignments,
+		connect.WithSchema(staffServiceMethods.ByName("GetReservationAssignments")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	staffServiceGetMyAssignmentsHandler := connect.NewUnaryHandler(
+		StaffServiceGetMyAssignmentsProcedure,
+		svc.GetMyAssignments,
+		connect.WithSchema(staffServiceMethods.ByName("GetMyAssignments")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	staffServiceCreateAssignmentsHandler := connect.NewUnaryHandler(
+		StaffServiceCreateAssignmentsProcedure,
+		svc.CreateAssignments,
+		connect.WithSchema(staffServiceMethods.ByName("CreateAssignments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	staffServiceDeleteAssignmentHandler := connect.NewUnaryHandler(
+		StaffServiceDeleteAssignmentProcedure,
+		svc.DeleteAssignment,
+		connect.WithSchema(staffServiceMethods.ByName("DeleteAssignment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	staffServiceGetStaffHoursReportHandler := connect.NewUnaryHandler(
+		StaffServiceGetStaffHoursReportProcedure,
+		svc.GetStaffHoursReport,
+		connect.WithSchema(staffServiceMethods.ByName("GetStaffHoursReport")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.staff.StaffService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StaffServiceGetReservationAssignmentsProcedure:
+			staffServiceGetReservationAssignmentsHandler.ServeHTTP(w, r)
+		case StaffServiceGetMyAssignmentsProcedure:
+			staffServiceGetMyAssignmentsHandler.ServeHTTP(w, r)
+		case StaffServiceCreateAssignmentsProcedure:
+			staffServiceCreateAssignmentsHandler.ServeHTTP(w, r)
+		case StaffServiceDeleteAssignmentProcedure:
+			staffServiceDeleteAssignmentHandler.ServeHTTP(w, r)
+		case StaffServiceGetStaffHoursReportProcedure:
+			staffServiceGetStaffHoursReportHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStaffServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStaffServiceHandler struct{}
+
+func (UnimplementedStaffServiceHandler) GetReservationAssignments(context.Context, *connect.Request[staff.GetReservationAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.staff.StaffService.GetReservationAssignments is not implemented"))
+}
+
+func (UnimplementedStaffServiceHandler) GetMyAssignments(context.Context, *connect.Request[staff.GetMyAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.staff.StaffService.GetMyAssignments is not implemented"))
+}
+
+func (UnimplementedStaffServiceHandler) CreateAssignments(context.Context, *connect.Request[staff.CreateAssignmentsRequest]) (*connect.Response[staff.StaffAssignmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.staff.StaffService.CreateAssignments is not implemented"))
+}
+
+func (UnimplementedStaffServiceHandler) DeleteAssignment(context.Context, *connect.Request[staff.DeleteAssignmentRequest]) (*connect.Response[staff.DeleteAssignmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.staff.StaffService.DeleteAssignment is not implemented"))
+}
+
+func (UnimplementedStaffServiceHandler) GetStaffHoursReport(context.Context, *connect.Request[staff.GetStaffHoursReportRequest]) (*connect.Response[staff.StaffHoursReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.staff.StaffService.GetStaffHoursReport is not implemented"))
+}
@@ -157,6 +157,82 @@ func (x *NotificationsReadable) GetUserName() string {
 	return ""
 }
 
+type BuildingAdmin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuildingId    int64                  `protobuf:"varint,2,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BuildingName  string                 `protobuf:"bytes,4,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
+	UserName      string                 `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildingAdmin) Reset() {
+	*x = BuildingAdmin{}
+	mi := &file_proto_users_users_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildingAdmin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingAdmin) ProtoMessage() {}
+
+func (x *BuildingAdmin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_users_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingAdmin.ProtoReflect.Descriptor instead.
+func (*BuildingAdmin) Descriptor() ([]byte, []int) {
+	return file_proto_users_users_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BuildingAdmin) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BuildingAdmin) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *BuildingAdmin) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BuildingAdmin) GetBuildingName() string {
+	if x != nil {
+		return x.BuildingName
+	}
+	return ""
+}
+
+func (x *BuildingAdmin) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
 type Users struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -175,7 +251,7 @@ type Users struct {
 
 func (x *Users) Reset() {
 	*x = Users{}
-	mi := &file_proto_users_users_proto_msgTypes[2]
+	mi := &file_proto_users_users_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -187,7 +263,7 @@ func (x *Users) String() string {
 func (*Users) ProtoMessage() {}
 
 func (x *Users) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[2]
+	mi := &file_proto_users_users_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +276,7 @@ func (x *Users) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Users.ProtoReflect.Descriptor instead.
 func (*Users) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{2}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Users) GetId() string {
@@ -284,7 +360,7 @@ type VerificationToken struct {
 
 func (x *VerificationToken) Reset() {
 	*x = VerificationToken{}
-	mi := &file_proto_users_users_proto_msgTypes[3]
+	mi := &file_proto_users_users_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -296,7 +372,7 @@ func (x *VerificationToken) String() string {
 func (*VerificationToken) ProtoMessage() {}
 
 func (x *VerificationToken) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[3]
+	mi := &file_proto_users_users_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +385,7 @@ func (x *VerificationToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerificationToken.ProtoReflect.Descriptor instead.
 func (*VerificationToken) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{3}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *VerificationToken) GetIdentifier() string {
@@ -342,7 +418,7 @@ type GetUserNotificationsResponse struct {
 
 func (x *GetUserNotificationsResponse) Reset() {
 	*x = GetUserNotificationsResponse{}
-	mi := &file_proto_users_users_proto_msgTypes[4]
+	mi := &file_proto_users_users_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -354,7 +430,7 @@ func (x *GetUserNotificationsResponse) String() string {
 func (*GetUserNotificationsResponse) ProtoMessage() {}
 
 func (x *GetUserNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[4]
+	mi := &file_proto_users_users_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,7 +443,7 @@ func (x *GetUserNotificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserNotificationsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{4}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetUserNotificationsResponse) GetNotifications() []*NotificationsReadable {
@@ -386,7 +462,7 @@ type GetNotificationsResponse struct {
 
 func (x *GetNotificationsResponse) Reset() {
 	*x = GetNotificationsResponse{}
-	mi := &file_proto_users_users_proto_msgTypes[5]
+	mi := &file_proto_users_users_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +474,7 @@ func (x *GetNotificationsResponse) String() string {
 func (*GetNotificationsResponse) ProtoMessage() {}
 
 func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[5]
+	mi := &file_proto_users_users_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +487,7 @@ func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationsResponse.ProtoReflect.Descriptor instead.
 func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{5}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetNotificationsResponse) GetNotifications() []*Notifications {
@@ -430,7 +506,7 @@ type GetUsersResponse struct {
 
 func (x *GetUsersResponse) Reset() {
 	*x = GetUsersResponse{}
-	mi := &file_proto_users_users_proto_msgTypes[6]
+	mi := &file_proto_users_users_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -442,7 +518,7 @@ func (x *GetUsersResponse) String() string {
 func (*GetUsersResponse) ProtoMessage() {}
 
 func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[6]
+	mi := &file_proto_users_users_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +531,7 @@ func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersResponse.ProtoReflect.Descriptor instead.
 func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{6}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetUsersResponse) GetUsers() []*Users {
@@ -474,7 +550,7 @@ type UserByEmailRequest struct {
 
 func (x *UserByEmailRequest) Reset() {
 	*x = UserByEmailRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[7]
+	mi := &file_proto_users_users_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +562,7 @@ func (x *UserByEmailRequest) String() string {
 func (*UserByEmailRequest) ProtoMessage() {}
 
 func (x *UserByEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[7]
+	mi := &file_proto_users_users_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +575,7 @@ func (x *UserByEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserByEmailRequest.ProtoReflect.Descriptor instead.
 func (*UserByEmailRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{7}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UserByEmailRequest) GetEmail() string {
@@ -518,7 +594,7 @@ type GetUserRequest struct {
 
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[8]
+	mi := &file_proto_users_users_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -530,7 +606,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[8]
+	mi := &file_proto_users_users_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +619,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{8}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUserRequest) GetId() string {
@@ -561,7 +637,7 @@ type GetUsersRequest struct {
 
 func (x *GetUsersRequest) Reset() {
 	*x = GetUsersRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[9]
+	mi := &file_proto_users_users_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +649,7 @@ func (x *GetUsersRequest) String() string {
 func (*GetUsersRequest) ProtoMessage() {}
 
 func (x *GetUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[9]
+	mi := &file_proto_users_users_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +662,7 @@ func (x *GetUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersRequest.ProtoReflect.Descriptor instead.
 func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{9}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{10}
 }
 
 type CreateUserRequest struct {
@@ -598,7 +674,7 @@ type CreateUserRequest struct {
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[10]
+	mi := &file_proto_users_users_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -610,7 +686,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[10]
+	mi := &file_proto_users_users_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +699,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{10}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateUserRequest) GetUser() *Users {
@@ -642,7 +718,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[11]
+	mi := &file_proto_users_users_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +730,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[11]
+	mi := &file_proto_users_users_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +743,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{11}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateUserRequest) GetUser() *Users {
@@ -686,7 +762,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[12]
+	mi := &file_proto_users_users_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -698,7 +774,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[12]
+	mi := &file_proto_users_users_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,7 +787,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{12}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteUserRequest) GetId() string {
@@ -729,7 +805,7 @@ type DeleteUserResponse struct {
 
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
-	mi := &file_proto_users_users_proto_msgTypes[13]
+	mi := &file_proto_users_users_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +817,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[13]
+	mi := &file_proto_users_users_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +830,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{13}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{14}
 }
 
 type GetNotificationsRequest struct {
@@ -765,7 +841,7 @@ type GetNotificationsRequest struct {
 
 func (x *GetNotificationsRequest) Reset() {
 	*x = GetNotificationsRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[14]
+	mi := &file_proto_users_users_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -777,7 +853,7 @@ func (x *GetNotificationsRequest) String() string {
 func (*GetNotificationsRequest) ProtoMessage() {}
 
 func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[14]
+	mi := &file_proto_users_users_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +866,7 @@ func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{14}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{15}
 }
 
 type GetUserNotificationsRequest struct {
@@ -802,7 +878,7 @@ type GetUserNotificationsRequest struct {
 
 func (x *GetUserNotificationsRequest) Reset() {
 	*x = GetUserNotificationsRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[15]
+	mi := &file_proto_users_users_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +890,7 @@ func (x *GetUserNotificationsRequest) String() string {
 func (*GetUserNotificationsRequest) ProtoMessage() {}
 
 func (x *GetUserNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[15]
+	mi := &file_proto_users_users_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +903,7 @@ func (x *GetUserNotificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{15}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetUserNotificationsRequest) GetUserId() string {
@@ -846,7 +922,7 @@ type CreateNotificationRequest struct {
 
 func (x *CreateNotificationRequest) Reset() {
 	*x = CreateNotificationRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[16]
+	mi := &file_proto_users_users_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -858,7 +934,7 @@ func (x *CreateNotificationRequest) String() string {
 func (*CreateNotificationRequest) ProtoMessage() {}
 
 func (x *CreateNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[16]
+	mi := &file_proto_users_users_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +947,7 @@ func (x *CreateNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNotificationRequest.ProtoReflect.Descriptor instead.
 func (*CreateNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{16}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateNotificationRequest) GetNotification() *Notifications {
@@ -890,7 +966,7 @@ type EditNotificationRequest struct {
 
 func (x *EditNotificationRequest) Reset() {
 	*x = EditNotificationRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[17]
+	mi := &file_proto_users_users_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -902,7 +978,7 @@ func (x *EditNotificationRequest) String() string {
 func (*EditNotificationRequest) ProtoMessage() {}
 
 func (x *EditNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[17]
+	mi := &file_proto_users_users_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,7 +991,7 @@ func (x *EditNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditNotificationRequest.ProtoReflect.Descriptor instead.
 func (*EditNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{17}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *EditNotificationRequest) GetNotification() *Notifications {
@@ -934,7 +1010,7 @@ type DeleteNotificationRequest struct {
 
 func (x *DeleteNotificationRequest) Reset() {
 	*x = DeleteNotificationRequest{}
-	mi := &file_proto_users_users_proto_msgTypes[18]
+	mi := &file_proto_users_users_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -946,7 +1022,7 @@ func (x *DeleteNotificationRequest) String() string {
 func (*DeleteNotificationRequest) ProtoMessage() {}
 
 func (x *DeleteNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[18]
+	mi := &file_proto_users_users_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1035,7 @@ func (x *DeleteNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNotificationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{18}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteNotificationRequest) GetId() int64 {
@@ -977,7 +1053,7 @@ type DeleteNotificationResponse struct {
 
 func (x *DeleteNotificationResponse) Reset() {
 	*x = DeleteNotificationResponse{}
-	mi := &file_proto_users_users_proto_msgTypes[19]
+	mi := &file_proto_users_users_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -989,7 +1065,7 @@ func (x *DeleteNotificationResponse) String() string {
 func (*DeleteNotificationResponse) ProtoMessage() {}
 
 func (x *DeleteNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_users_users_proto_msgTypes[19]
+	mi := &file_proto_users_users_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1078,354 @@ func (x *DeleteNotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNotificationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_users_users_proto_rawDescGZIP(), []int{19}
+	return file_proto_users_users_proto_rawDescGZIP(), []int{20}
+}
+
+type GetBuildingAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId    int64                  `protobuf:"varint,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"` // optional, 0 returns all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBuildingAdminsRequest) Reset() {
+	*x = GetBuildingAdminsRequest{}
+	mi := &file_proto_users_users_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBuildingAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildingAdminsRequest) ProtoMessage() {}
+
+func (x *GetBuildingAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_users_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildingAdminsRequest.ProtoReflect.Descriptor instead.
+func (*GetBuildingAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_users_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetBuildingAdminsRequest) GetBuildingId() int64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+type GetBuildingAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*BuildingAdmin       `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBuildingAdminsResponse) Reset() {
+	*x = GetBuildingAdminsResponse{}
+	mi := &file_proto_users_users_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBuildingAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildingAdminsResponse) ProtoMessage() {}
+
+func (x *GetBuildingAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_users_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildingAdminsResponse.ProtoReflect.Descriptor instead.
+func (*GetBuildingAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_users_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetBuildingAdminsResponse) GetAdmins() []*BuildingAdmin {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+type CreateBuildingAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *BuildingAdmin         `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBuildingAdminRequest) Reset() {
+	*x = CreateBuildingAdminRequest{}
+	mi := &file_proto_users_users_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBuildingAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBuildingAdminRequest) ProtoMessage() {}
+
+func (x *CreateBuildingAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_users_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBuildingAdminRequest.ProtoReflect.Descriptor instead.
+func (*CreateBuildingAdminRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_users_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateBuildingAdminRequest) GetAdmin() *BuildingAdmin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+type DeleteBuildingAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBuildingAdminRequest) Reset() {
+	*x = DeleteBuildingAdminRequest{}
+	mi := &file_proto_users_users_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBuildingAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBuildingAdminRequest) ProtoMessage() {}
+
+func (x *DeleteBuildingAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_users_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBuildingAdminRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBuildingAdminRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_users_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteBuildingAdminRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteBuildingAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBuildingAdminResponse) Reset() {
+	*x = DeleteBuildingAdminResponse{}
+	mi := &file_proto_users_users_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBuildingAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBuildingAdminResponse) ProtoMessage() {}
+
+func (x *DeleteBuildingAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_users_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBuildingAdminResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBuildingAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_users_users_proto_rawDescGZIP(), []int{25}
+}
+
+type GetCalendarFeedsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedsRequest) Reset() {
+	*x = GetCalendarFeedsRequest{}
+	mi := &file_proto_users_users_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedsRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_users_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedsRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_users_proto_rawDescGZIP(), []int{26}
+}
+
+type RotateCalendarFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCalendarFeedTokenRequest) Reset() {
+	*x = RotateCalendarFeedTokenRequest{}
+	mi := &file_proto_users_users_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCalendarFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCalendarFeedTokenRequest) ProtoMessage() {}
+
+func (x *RotateCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_users_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_users_users_proto_rawDescGZIP(), []int{27}
+}
+
+type CalendarFeeds struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// the caller's own reservations, pending ones included
+	MyReservationsUrl string `protobuf:"bytes,2,opt,name=my_reservations_url,json=myReservationsUrl,proto3" json:"my_reservations_url,omitempty"`
+	// add ?token= to /calendar/building/{id}.ics or /calendar/facility/{id}.ics
+	// for private details on buildings the caller administers
+	FeedBaseUrl   string `protobuf:"bytes,3,opt,name=feed_base_url,json=feedBaseUrl,proto3" json:"feed_base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeeds) Reset() {
+	*x = CalendarFeeds{}
+	mi := &file_proto_users_users_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeeds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeeds) ProtoMessage() {}
+
+func (x *CalendarFeeds) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_users_users_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeeds.ProtoReflect.Descriptor instead.
+func (*CalendarFeeds) Descriptor() ([]byte, []int) {
+	return file_proto_users_users_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CalendarFeeds) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CalendarFeeds) GetMyReservationsUrl() string {
+	if x != nil {
+		return x.MyReservationsUrl
+	}
+	return ""
+}
+
+func (x *CalendarFeeds) GetFeedBaseUrl() string {
+	if x != nil {
+		return x.FeedBaseUrl
+	}
+	return ""
 }
 
 var File_proto_users_users_proto protoreflect.FileDescriptor
@@ -1021,6 +1444,13 @@ const file_proto_users_users_proto_rawDesc = "" +
 	"buildingId\x12#\n" +
 	"\rbuilding_name\x18\x03 \x01(\tR\fbuildingName\x12\x17\n" +
 	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x05 \x01(\tR\buserName\"\xa3\x01\n" +
+	"\rBuildingAdmin\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\x12#\n" +
+	"\vbuilding_id\x18\x02 \x01(\x03B\x020\x01R\n" +
+	"buildingId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12#\n" +
+	"\rbuilding_name\x18\x04 \x01(\tR\fbuildingName\x12\x1b\n" +
 	"\tuser_name\x18\x05 \x01(\tR\buserName\"\x84\x02\n" +
 	"\x05Users\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -1068,7 +1498,24 @@ const file_proto_users_users_proto_rawDesc = "" +
 	"\fnotification\x18\x01 \x01(\v2\x18.api.users.NotificationsR\fnotification\"/\n" +
 	"\x19DeleteNotificationRequest\x12\x12\n" +
 	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"\x1c\n" +
-	"\x1aDeleteNotificationResponse2\xf5\x06\n" +
+	"\x1aDeleteNotificationResponse\"?\n" +
+	"\x18GetBuildingAdminsRequest\x12#\n" +
+	"\vbuilding_id\x18\x01 \x01(\x03B\x020\x01R\n" +
+	"buildingId\"M\n" +
+	"\x19GetBuildingAdminsResponse\x120\n" +
+	"\x06admins\x18\x01 \x03(\v2\x18.api.users.BuildingAdminR\x06admins\"L\n" +
+	"\x1aCreateBuildingAdminRequest\x12.\n" +
+	"\x05admin\x18\x01 \x01(\v2\x18.api.users.BuildingAdminR\x05admin\"0\n" +
+	"\x1aDeleteBuildingAdminRequest\x12\x12\n" +
+	"\x02id\x18\x01 \x01(\x03B\x020\x01R\x02id\"\x1d\n" +
+	"\x1bDeleteBuildingAdminResponse\"\x19\n" +
+	"\x17GetCalendarFeedsRequest\" \n" +
+	"\x1eRotateCalendarFeedTokenRequest\"y\n" +
+	"\rCalendarFeeds\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12.\n" +
+	"\x13my_reservations_url\x18\x02 \x01(\tR\x11myReservationsUrl\x12\"\n" +
+	"\rfeed_base_url\x18\x03 \x01(\tR\vfeedBaseUrl2\xca\n" +
+	"\n" +
 	"\fUsersService\x12A\n" +
 	"\x0eGetUserByEmail\x12\x1d.api.users.UserByEmailRequest\x1a\x10.api.users.Users\x126\n" +
 	"\aGetUser\x12\x19.api.users.GetUserRequest\x1a\x10.api.users.Users\x12H\n" +
@@ -1083,7 +1530,12 @@ const file_proto_users_users_proto_rawDesc = "" +
 	"\x14GetUserNotifications\x12&.api.users.GetUserNotificationsRequest\x1a'.api.users.GetUserNotificationsResponse\"\x03\x90\x02\x01\x12T\n" +
 	"\x12CreateNotification\x12$.api.users.CreateNotificationRequest\x1a\x18.api.users.Notifications\x12P\n" +
 	"\x10EditNotification\x12\".api.users.EditNotificationRequest\x1a\x18.api.users.Notifications\x12a\n" +
-	"\x12DeleteNotification\x12$.api.users.DeleteNotificationRequest\x1a%.api.users.DeleteNotificationResponseB\x87\x01\n" +
+	"\x12DeleteNotification\x12$.api.users.DeleteNotificationRequest\x1a%.api.users.DeleteNotificationResponse\x12c\n" +
+	"\x11GetBuildingAdmins\x12#.api.users.GetBuildingAdminsRequest\x1a$.api.users.GetBuildingAdminsResponse\"\x03\x90\x02\x01\x12V\n" +
+	"\x13CreateBuildingAdmin\x12%.api.users.CreateBuildingAdminRequest\x1a\x18.api.users.BuildingAdmin\x12d\n" +
+	"\x13DeleteBuildingAdmin\x12%.api.users.DeleteBuildingAdminRequest\x1a&.api.users.DeleteBuildingAdminResponse\x12P\n" +
+	"\x10GetCalendarFeeds\x12\".api.users.GetCalendarFeedsRequest\x1a\x18.api.users.CalendarFeeds\x12^\n" +
+	"\x17RotateCalendarFeedToken\x12).api.users.RotateCalendarFeedTokenRequest\x1a\x18.api.users.CalendarFeedsB\x87\x01\n" +
 	"\rcom.api.usersB\n" +
 	"UsersProtoP\x01Z%api/internal/proto/users;usersservice\xa2\x02\x03AUX\xaa\x02\tApi.Users\xca\x02\tApi\\Users\xe2\x02\x15Api\\Users\\GPBMetadata\xea\x02\n" +
 	"Api::Usersb\x06proto3"
@@ -1100,64 +1552,85 @@ func file_proto_users_users_proto_rawDescGZIP() []byte {
 	return file_proto_users_users_proto_rawDescData
 }
 
-var file_proto_users_users_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_proto_users_users_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_proto_users_users_proto_goTypes = []any{
-	(*Notifications)(nil),                // 0: api.users.Notifications
-	(*NotificationsReadable)(nil),        // 1: api.users.NotificationsReadable
-	(*Users)(nil),                        // 2: api.users.Users
-	(*VerificationToken)(nil),            // 3: api.users.VerificationToken
-	(*GetUserNotificationsResponse)(nil), // 4: api.users.GetUserNotificationsResponse
-	(*GetNotificationsResponse)(nil),     // 5: api.users.GetNotificationsResponse
-	(*GetUsersResponse)(nil),             // 6: api.users.GetUsersResponse
-	(*UserByEmailRequest)(nil),           // 7: api.users.UserByEmailRequest
-	(*GetUserRequest)(nil),               // 8: api.users.GetUserRequest
-	(*GetUsersRequest)(nil),              // 9: api.users.GetUsersRequest
-	(*CreateUserRequest)(nil),            // 10: api.users.CreateUserRequest
-	(*UpdateUserRequest)(nil),            // 11: api.users.UpdateUserRequest
-	(*DeleteUserRequest)(nil),            // 12: api.users.DeleteUserRequest
-	(*DeleteUserResponse)(nil),           // 13: api.users.DeleteUserResponse
-	(*GetNotificationsRequest)(nil),      // 14: api.users.GetNotificationsRequest
-	(*GetUserNotificationsRequest)(nil),  // 15: api.users.GetUserNotificationsRequest
-	(*CreateNotificationRequest)(nil),    // 16: api.users.CreateNotificationRequest
-	(*EditNotificationRequest)(nil),      // 17: api.users.EditNotificationRequest
-	(*DeleteNotificationRequest)(nil),    // 18: api.users.DeleteNotificationRequest
-	(*DeleteNotificationResponse)(nil),   // 19: api.users.DeleteNotificationResponse
+	(*Notifications)(nil),                  // 0: api.users.Notifications
+	(*NotificationsReadable)(nil),          // 1: api.users.NotificationsReadable
+	(*BuildingAdmin)(nil),                  // 2: api.users.BuildingAdmin
+	(*Users)(nil),                          // 3: api.users.Users
+	(*VerificationToken)(nil),              // 4: api.users.VerificationToken
+	(*GetUserNotificationsResponse)(nil),   // 5: api.users.GetUserNotificationsResponse
+	(*GetNotificationsResponse)(nil),       // 6: api.users.GetNotificationsResponse
+	(*GetUsersResponse)(nil),               // 7: api.users.GetUsersResponse
+	(*UserByEmailRequest)(nil),             // 8: api.users.UserByEmailRequest
+	(*GetUserRequest)(nil),                 // 9: api.users.GetUserRequest
+	(*GetUsersRequest)(nil),                // 10: api.users.GetUsersRequest
+	(*CreateUserRequest)(nil),              // 11: api.users.CreateUserRequest
+	(*UpdateUserRequest)(nil),              // 12: api.users.UpdateUserRequest
+	(*DeleteUserRequest)(nil),              // 13: api.users.DeleteUserRequest
+	(*DeleteUserResponse)(nil),             // 14: api.users.DeleteUserResponse
+	(*GetNotificationsRequest)(nil),        // 15: api.users.GetNotificationsRequest
+	(*GetUserNotificationsRequest)(nil),    // 16: api.users.GetUserNotificationsRequest
+	(*CreateNotificationRequest)(nil),      // 17: api.users.CreateNotificationRequest
+	(*EditNotificationRequest)(nil),        // 18: api.users.EditNotificationRequest
+	(*DeleteNotificationRequest)(nil),      // 19: api.users.DeleteNotificationRequest
+	(*DeleteNotificationResponse)(nil),     // 20: api.users.DeleteNotificationResponse
+	(*GetBuildingAdminsRequest)(nil),       // 21: api.users.GetBuildingAdminsRequest
+	(*GetBuildingAdminsResponse)(nil),      // 22: api.users.GetBuildingAdminsResponse
+	(*CreateBuildingAdminRequest)(nil),     // 23: api.users.CreateBuildingAdminRequest
+	(*DeleteBuildingAdminRequest)(nil),     // 24: api.users.DeleteBuildingAdminRequest
+	(*DeleteBuildingAdminResponse)(nil),    // 25: api.users.DeleteBuildingAdminResponse
+	(*GetCalendarFeedsRequest)(nil),        // 26: api.users.GetCalendarFeedsRequest
+	(*RotateCalendarFeedTokenRequest)(nil), // 27: api.users.RotateCalendarFeedTokenRequest
+	(*CalendarFeeds)(nil),                  // 28: api.users.CalendarFeeds
 }
 var file_proto_users_users_proto_depIdxs = []int32{
 	1,  // 0: api.users.GetUserNotificationsResponse.notifications:type_name -> api.users.NotificationsReadable
 	0,  // 1: api.users.GetNotificationsResponse.notifications:type_name -> api.users.Notifications
-	2,  // 2: api.users.GetUsersResponse.users:type_name -> api.users.Users
-	2,  // 3: api.users.CreateUserRequest.user:type_name -> api.users.Users
-	2,  // 4: api.users.UpdateUserRequest.user:type_name -> api.users.Users
+	3,  // 2: api.users.GetUsersResponse.users:type_name -> api.users.Users
+	3,  // 3: api.users.CreateUserRequest.user:type_name -> api.users.Users
+	3,  // 4: api.users.UpdateUserRequest.user:type_name -> api.users.Users
 	0,  // 5: api.users.CreateNotificationRequest.notification:type_name -> api.users.Notifications
 	0,  // 6: api.users.EditNotificationRequest.notification:type_name -> api.users.Notifications
-	7,  // 7: api.users.UsersService.GetUserByEmail:input_type -> api.users.UserByEmailRequest
-	8,  // 8: api.users.UsersService.GetUser:input_type -> api.users.GetUserRequest
-	9,  // 9: api.users.UsersService.GetUsers:input_type -> api.users.GetUsersRequest
-	10, // 10: api.users.UsersService.CreateUser:input_type -> api.users.CreateUserRequest
-	11, // 11: api.users.UsersService.UpdateUser:input_type -> api.users.UpdateUserRequest
-	12, // 12: api.users.UsersService.DeleteUser:input_type -> api.users.DeleteUserRequest
-	14, // 13: api.users.UsersService.GetNotifications:input_type -> api.users.GetNotificationsRequest
-	15, // 14: api.users.UsersService.GetUserNotifications:input_type -> api.users.GetUserNotificationsRequest
-	16, // 15: api.users.UsersService.CreateNotification:input_type -> api.users.CreateNotificationRequest
-	17, // 16: api.users.UsersService.EditNotification:input_type -> api.users.EditNotificationRequest
-	18, // 17: api.users.UsersService.DeleteNotification:input_type -> api.users.DeleteNotificationRequest
-	2,  // 18: api.users.UsersService.GetUserByEmail:output_type -> api.users.Users
-	2,  // 19: api.users.UsersService.GetUser:output_type -> api.users.Users
-	6,  // 20: api.users.UsersService.GetUsers:output_type -> api.users.GetUsersResponse
-	2,  // 21: api.users.UsersService.CreateUser:output_type -> api.users.Users
-	2,  // 22: api.users.UsersService.UpdateUser:output_type -> api.users.Users
-	13, // 23: api.users.UsersService.DeleteUser:output_type -> api.users.DeleteUserResponse
-	5,  // 24: api.users.UsersService.GetNotifications:output_type -> api.users.GetNotificationsResponse
-	4,  // 25: api.users.UsersService.GetUserNotifications:output_type -> api.users.GetUserNotificationsResponse
-	0,  // 26: api.users.UsersService.CreateNotification:output_type -> api.users.Notifications
-	0,  // 27: api.users.UsersService.EditNotification:output_type -> api.users.Notifications
-	19, // 28: api.users.UsersService.DeleteNotification:output_type -> api.users.DeleteNotificationResponse
-	18, // [18:29] is the sub-list for method output_type
-	7,  // [7:18] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	2,  // 7: api.users.GetBuildingAdminsResponse.admins:type_name -> api.users.BuildingAdmin
+	2,  // 8: api.users.CreateBuildingAdminRequest.admin:type_name -> api.users.BuildingAdmin
+	8,  // 9: api.users.UsersService.GetUserByEmail:input_type -> api.users.UserByEmailRequest
+	9,  // 10: api.users.UsersService.GetUser:input_type -> api.users.GetUserRequest
+	10, // 11: api.users.UsersService.GetUsers:input_type -> api.users.GetUsersRequest
+	11, // 12: api.users.UsersService.CreateUser:input_type -> api.users.CreateUserRequest
+	12, // 13: api.users.UsersService.UpdateUser:input_type -> api.users.UpdateUserRequest
+	13, // 14: api.users.UsersService.DeleteUser:input_type -> api.users.DeleteUserRequest
+	15, // 15: api.users.UsersService.GetNotifications:input_type -> api.users.GetNotificationsRequest
+	16, // 16: api.users.UsersService.GetUserNotifications:input_type -> api.users.GetUserNotificationsRequest
+	17, // 17: api.users.UsersService.CreateNotification:input_type -> api.users.CreateNotificationRequest
+	18, // 18: api.users.UsersService.EditNotification:input_type -> api.users.EditNotificationRequest
+	19, // 19: api.users.UsersService.DeleteNotification:input_type -> api.users.DeleteNotificationRequest
+	21, // 20: api.users.UsersService.GetBuildingAdmins:input_type -> api.users.GetBuildingAdminsRequest
+	23, // 21: api.users.UsersService.CreateBuildingAdmin:input_type -> api.users.CreateBuildingAdminRequest
+	24, // 22: api.users.UsersService.DeleteBuildingAdmin:input_type -> api.users.DeleteBuildingAdminRequest
+	26, // 23: api.users.UsersService.GetCalendarFeeds:input_type -> api.users.GetCalendarFeedsRequest
+	27, // 24: api.users.UsersService.RotateCalendarFeedToken:input_type -> api.users.RotateCalendarFeedTokenRequest
+	3,  // 25: api.users.UsersService.GetUserByEmail:output_type -> api.users.Users
+	3,  // 26: api.users.UsersService.GetUser:output_type -> api.users.Users
+	7,  // 27: api.users.UsersService.GetUsers:output_type -> api.users.GetUsersResponse
+	3,  // 28: api.users.UsersService.CreateUser:output_type -> api.users.Users
+	3,  // 29: api.users.UsersService.UpdateUser:output_type -> api.users.Users
+	14, // 30: api.users.UsersService.DeleteUser:output_type -> api.users.DeleteUserResponse
+	6,  // 31: api.users.UsersService.GetNotifications:output_type -> api.users.GetNotificationsResponse
+	5,  // 32: api.users.UsersService.GetUserNotifications:output_type -> api.users.GetUserNotificationsResponse
+	0,  // 33: api.users.UsersService.CreateNotification:output_type -> api.users.Notifications
+	0,  // 34: api.users.UsersService.EditNotification:output_type -> api.users.Notifications
+	20, // 35: api.users.UsersService.DeleteNotification:output_type -> api.users.DeleteNotificationResponse
+	22, // 36: api.users.UsersService.GetBuildingAdmins:output_type -> api.users.GetBuildingAdminsResponse
+	2,  // 37: api.users.UsersService.CreateBuildingAdmin:output_type -> api.users.BuildingAdmin
+	25, // 38: api.users.UsersService.DeleteBuildingAdmin:output_type -> api.users.DeleteBuildingAdminResponse
+	28, // 39: api.users.UsersService.GetCalendarFeeds:output_type -> api.users.CalendarFeeds
+	28, // 40: api.users.UsersService.RotateCalendarFeedToken:output_type -> api.users.CalendarFeeds
+	25, // [25:41] is the sub-list for method output_type
+	9,  // [9:25] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_proto_users_users_proto_init() }
@@ -1171,7 +1644,7 @@ func file_proto_users_users_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_users_users_proto_rawDesc), len(file_proto_users_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -61,6 +61,21 @@ const (
 	// UsersServiceDeleteNotificationProcedure is the fully-qualified name of the UsersService's
 	// DeleteNotification RPC.
 	UsersServiceDeleteNotificationProcedure = "/api.users.UsersService/DeleteNotification"
+	// UsersServiceGetBuildingAdminsProcedure is the fully-qualified name of the UsersService's
+	// GetBuildingAdmins RPC.
+	UsersServiceGetBuildingAdminsProcedure = "/api.users.UsersService/GetBuildingAdmins"
+	// UsersServiceCreateBuildingAdminProcedure is the fully-qualified name of the UsersService's
+	// CreateBuildingAdmin RPC.
+	UsersServiceCreateBuildingAdminProcedure = "/api.users.UsersService/CreateBuildingAdmin"
+	// UsersServiceDeleteBuildingAdminProcedure is the fully-qualified name of the UsersService's
+	// DeleteBuildingAdmin RPC.
+	UsersServiceDeleteBuildingAdminProcedure = "/api.users.UsersService/DeleteBuildingAdmin"
+	// UsersServiceGetCalendarFeedsProcedure is the fully-qualified name of the UsersService's
+	// GetCalendarFeeds RPC.
+	UsersServiceGetCalendarFeedsProcedure = "/api.users.UsersService/GetCalendarFeeds"
+	// UsersServiceRotateCalendarFeedTokenProcedure is the fully-qualified name of the UsersService's
+	// RotateCalendarFeedToken RPC.
+	UsersServiceRotateCalendarFeedTokenProcedure = "/api.users.UsersService/RotateCalendarFeedToken"
 )
 
 // UsersServiceClient is a client for the api.users.UsersService service.
@@ -76,6 +91,12 @@ type UsersServiceClient interface {
 	CreateNotification(context.Context, *connect.Request[users.CreateNotificationRequest]) (*connect.Response[users.Notifications], error)
 	EditNotification(context.Context, *connect.Request[users.EditNotificationRequest]) (*connect.Response[users.Notifications], error)
 	DeleteNotification(context.Context, *connect.Request[users.DeleteNotificationRequest]) (*connect.Response[users.DeleteNotificationResponse], error)
+	GetBuildingAdmins(context.Context, *connect.Request[users.GetBuildingAdminsRequest]) (*connect.Response[users.GetBuildingAdminsResponse], error)
+	CreateBuildingAdmin(context.Context, *connect.Request[users.CreateBuildingAdminRequest]) (*connect.Response[users.BuildingAdmin], error)
+	DeleteBuildingAdmin(context.Context, *connect.Request[users.DeleteBuildingAdminRequest]) (*connect.Response[users.DeleteBuildingAdminResponse], error)
+	// issues a feed token on first use
+	GetCalendarFeeds(context.Context, *connect.Request[users.GetCalendarFeedsRequest]) (*connect.Response[users.CalendarFeeds], error)
+	RotateCalendarFeedToken(context.Context, *connect.Request[users.RotateCalendarFeedTokenRequest]) (*connect.Response[users.CalendarFeeds], error)
 }
 
 // NewUsersServiceClient constructs a client for the api.users.UsersService service. By default, it
@@ -158,22 +179,58 @@ func NewUsersServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(usersServiceMethods.ByName("DeleteNotification")),
 			connect.WithClientOptions(opts...),
 		),
+		getBuildingAdmins: connect.NewClient[users.GetBuildingAdminsRequest, users.GetBuildingAdminsResponse](
+			httpClient,
+			baseURL+UsersServiceGetBuildingAdminsProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("GetBuildingAdmins")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createBuildingAdmin: connect.NewClient[users.CreateBuildingAdminRequest, users.BuildingAdmin](
+			httpClient,
+			baseURL+UsersServiceCreateBuildingAdminProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("CreateBuildingAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteBuildingAdmin: connect.NewClient[users.DeleteBuildingAdminRequest, users.DeleteBuildingAdminResponse](
+			httpClient,
+			baseURL+UsersServiceDeleteBuildingAdminProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("DeleteBuildingAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		getCalendarFeeds: connect.NewClient[users.GetCalendarFeedsRequest, users.CalendarFeeds](
+			httpClient,
+			baseURL+UsersServiceGetCalendarFeedsProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("GetCalendarFeeds")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateCalendarFeedToken: connect.NewClient[users.RotateCalendarFeedTokenRequest, users.CalendarFeeds](
+			httpClient,
+			baseURL+UsersServiceRotateCalendarFeedTokenProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("RotateCalendarFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // usersServiceClient implements UsersServiceClient.
 type usersServiceClient struct {
-	getUserByEmail       *connect.Client[users.UserByEmailRequest, users.Users]
-	getUser              *connect.Client[users.GetUserRequest, users.Users]
-	getUsers             *connect.Client[users.GetUsersRequest, users.GetUsersResponse]
-	createUser           *connect.Client[users.CreateUserRequest, users.Users]
-	updateUser           *connect.Client[users.UpdateUserRequest, users.Users]
-	deleteUser           *connect.Client[users.DeleteUserRequest, users.DeleteUserResponse]
-	getNotifications     *connect.Client[users.GetNotificationsRequest, users.GetNotificationsResponse]
-	getUserNotifications *connect.Client[users.GetUserNotificationsRequest, users.GetUserNotificationsResponse]
-	createNotification   *connect.Client[users.CreateNotificationRequest, users.Notifications]
-	editNotification     *connect.Client[users.EditNotificationRequest, users.Notifications]
-	deleteNotification   *connect.Client[users.DeleteNotificationRequest, users.DeleteNotificationResponse]
+	getUserByEmail          *connect.Client[users.UserByEmailRequest, users.Users]
+	getUser                 *connect.Client[users.GetUserRequest, users.Users]
+	getUsers                *connect.Client[users.GetUsersRequest, users.GetUsersResponse]
+	createUser              *connect.Client[users.CreateUserRequest, users.Users]
+	updateUser              *connect.Client[users.UpdateUserRequest, users.Users]
+	deleteUser              *connect.Client[users.DeleteUserRequest, users.DeleteUserResponse]
+	getNotifications        *connect.Client[users.GetNotificationsRequest, users.GetNotificationsResponse]
+	getUserNotifications    *connect.Client[users.GetUserNotificationsRequest, users.GetUserNotificationsResponse]
+	createNotification      *connect.Client[users.CreateNotificationRequest, users.Notifications]
+	editNotification        *connect.Client[users.EditNotificationRequest, users.Notifications]
+	deleteNotification      *connect.Client[users.DeleteNotificationRequest, users.DeleteNotificationResponse]
+	getBuildingAdmins       *connect.Client[users.GetBuildingAdminsRequest, users.GetBuildingAdminsResponse]
+	createBuildingAdmin     *connect.Client[users.CreateBuildingAdminRequest, users.BuildingAdmin]
+	deleteBuildingAdmin     *connect.Client[users.DeleteBuildingAdminRequest, users.DeleteBuildingAdminResponse]
+	getCalendarFeeds        *connect.Client[users.GetCalendarFeedsRequest, users.CalendarFeeds]
+	rotateCalendarFeedToken *connect.Client[users.RotateCalendarFeedTokenRequest, users.CalendarFeeds]
 }
 
 // GetUserByEmail calls api.users.UsersService.GetUserByEmail.
@@ -231,6 +288,31 @@ func (c *usersServiceClient) DeleteNotification(ctx context.Context, req *connec
 	return c.deleteNotification.CallUnary(ctx, req)
 }
 
+// GetBuildingAdmins calls api.users.UsersService.GetBuildingAdmins.
+func (c *usersServiceClient) GetBuildingAdmins(ctx context.Context, req *connect.Request[users.GetBuildingAdminsRequest]) (*connect.Response[users.GetBuildingAdminsResponse], error) {
+	return c.getBuildingAdmins.CallUnary(ctx, req)
+}
+
+// CreateBuildingAdmin calls api.users.UsersService.CreateBuildingAdmin.
+func (c *usersServiceClient) CreateBuildingAdmin(ctx context.Context, req *connect.Request[users.CreateBuildingAdminRequest]) (*connect.Response[users.BuildingAdmin], error) {
+	return c.createBuildingAdmin.CallUnary(ctx, req)
+}
+
+// DeleteBuildingAdmin calls api.users.UsersService.DeleteBuildingAdmin.
+func (c *usersServiceClient) DeleteBuildingAdmin(ctx context.Context, req *connect.Request[users.DeleteBuildingAdminRequest]) (*connect.Response[users.DeleteBuildingAdminResponse], error) {
+	return c.deleteBuildingAdmin.CallUnary(ctx, req)
+}
+
+// GetCalendarFeeds calls api.users.UsersService.GetCalendarFeeds.
+func (c *usersServiceClient) GetCalendarFeeds(ctx context.Context, req *connect.Request[users.GetCalendarFeedsRequest]) (*connect.Response[users.CalendarFeeds], error) {
+	return c.getCalendarFeeds.CallUnary(ctx, req)
+}
+
+// RotateCalendarFeedToken calls api.users.UsersService.RotateCalendarFeedToken.
+func (c *usersServiceClient) RotateCalendarFeedToken(ctx context.Context, req *connect.Request[users.RotateCalendarFeedTokenRequest]) (*connect.Response[users.CalendarFeeds], error) {
+	return c.rotateCalendarFeedToken.CallUnary(ctx, req)
+}
+
 // UsersServiceHandler is an implementation of the api.users.UsersService service.
 type UsersServiceHandler interface {
 	GetUserByEmail(context.Context, *connect.Request[users.UserByEmailRequest]) (*connect.Response[users.Users], error)
@@ -244,6 +326,12 @@ type UsersServiceHandler interface {
 	CreateNotification(context.Context, *connect.Request[users.CreateNotificationRequest]) (*connect.Response[users.Notifications], error)
 	EditNotification(context.Context, *connect.Request[users.EditNotificationRequest]) (*connect.Response[users.Notifications], error)
 	DeleteNotification(context.Context, *connect.Request[users.DeleteNotificationRequest]) (*connect.Response[users.DeleteNotificationResponse], error)
+	GetBuildingAdmins(context.Context, *connect.Request[users.GetBuildingAdminsRequest]) (*connect.Response[users.GetBuildingAdminsResponse], error)
+	CreateBuildingAdmin(context.Context, *connect.Request[users.CreateBuildingAdminRequest]) (*connect.Response[users.BuildingAdmin], error)
+	DeleteBuildingAdmin(context.Context, *connect.Request[users.DeleteBuildingAdminRequest]) (*connect.Response[users.DeleteBuildingAdminResponse], error)
+	// issues a feed token on first use
+	GetCalendarFeeds(context.Context, *connect.Request[users.GetCalendarFeedsRequest]) (*connect.Response[users.CalendarFeeds], error)
+	RotateCalendarFeedToken(context.Context, *connect.Request[users.RotateCalendarFeedTokenRequest]) (*connect.Response[users.CalendarFeeds], error)
 }
 
 // NewUsersServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -322,6 +410,37 @@ func NewUsersServiceHandler(svc UsersServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(usersServiceMethods.ByName("DeleteNotification")),
 		connect.WithHandlerOptions(opts...),
 	)
+	usersServiceGetBuildingAdminsHandler := connect.NewUnaryHandler(
+		UsersServiceGetBuildingAdminsProcedure,
+		svc.GetBuildingAdmins,
+		connect.WithSchema(usersServiceMethods.ByName("GetBuildingAdmins")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceCreateBuildingAdminHandler := connect.NewUnaryHandler(
+		UsersServiceCreateBuildingAdminProcedure,
+		svc.CreateBuildingAdmin,
+		connect.WithSchema(usersServiceMethods.ByName("CreateBuildingAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceDeleteBuildingAdminHandler := connect.NewUnaryHandler(
+		UsersServiceDeleteBuildingAdminProcedure,
+		svc.DeleteBuildingAdmin,
+		connect.WithSchema(usersServiceMethods.ByName("DeleteBuildingAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceGetCalendarFeedsHandler := connect.NewUnaryHandler(
+		UsersServiceGetCalendarFeedsProcedure,
+		svc.GetCalendarFeeds,
+		connect.WithSchema(usersServiceMethods.ByName("GetCalendarFeeds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceRotateCalendarFeedTokenHandler := connect.NewUnaryHandler(
+		UsersServiceRotateCalendarFeedTokenProcedure,
+		svc.RotateCalendarFeedToken,
+		connect.WithSchema(usersServiceMethods.ByName("RotateCalendarFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.users.UsersService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UsersServiceGetUserByEmailProcedure:
@@ -346,6 +465,16 @@ func NewUsersServiceHandler(svc UsersServiceHandler, opts ...connect.HandlerOpti
 			usersServiceEditNotificationHandler.ServeHTTP(w, r)
 		case UsersServiceDeleteNotificationProcedure:
 			usersServiceDeleteNotificationHandler.ServeHTTP(w, r)
+		case UsersServiceGetBuildingAdminsProcedure:
+			usersServiceGetBuildingAdminsHandler.ServeHTTP(w, r)
+		case UsersServiceCreateBuildingAdminProcedure:
+			usersServiceCreateBuildingAdminHandler.ServeHTTP(w, r)
+		case UsersServiceDeleteBuildingAdminProcedure:
+			usersServiceDeleteBuildingAdminHandler.ServeHTTP(w, r)
+		case UsersServiceGetCalendarFeedsProcedure:
+			usersServiceGetCalendarFeedsHandler.ServeHTTP(w, r)
+		case UsersServiceRotateCalendarFeedTokenProcedure:
+			usersServiceRotateCalendarFeedTokenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -398,3 +527,23 @@ func (UnimplementedUsersServiceHandler) EditNotification(context.Context, *conne
 func (UnimplementedUsersServiceHandler) DeleteNotification(context.Context, *connect.Request[users.DeleteNotificationRequest]) (*connect.Response[users.DeleteNotificationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.users.UsersService.DeleteNotification is not implemented"))
 }
+
+func (UnimplementedUsersServiceHandler) GetBuildingAdmins(context.Context, *connect.Request[users.GetBuildingAdminsRequest]) (*connect.Response[users.GetBuildingAdminsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.users.UsersService.GetBuildingAdmins is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) CreateBuildingAdmin(context.Context, *connect.Request[users.CreateBuildingAdminRequest]) (*connect.Response[users.BuildingAdmin], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.users.UsersService.CreateBuildingAdmin is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) DeleteBuildingAdmin(context.Context, *connect.Request[users.DeleteBuildingAdminRequest]) (*connect.Response[users.DeleteBuildingAdminResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.users.UsersService.DeleteBuildingAdmin is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) GetCalendarFeeds(context.Context, *connect.Request[users.GetCalendarFeedsRequest]) (*connect.Response[users.CalendarFeeds], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.users.UsersService.GetCalendarFeeds is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) RotateCalendarFeedToken(context.Context, *connect.Request[users.RotateCalendarFeedTokenRequest]) (*connect.Response[users.CalendarFeeds], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.users.UsersService.RotateCalendarFeedToken is not implemented"))
+}
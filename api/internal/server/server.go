@@ -5,8 +5,10 @@ import (
 	"api/internal/lib/utils"
 	authMux "api/internal/proto/auth/authserviceconnect"
 	facilityMux "api/internal/proto/facilities/facilitiesserviceconnect"
+	organizationMux "api/internal/proto/organizations/organizationsserviceconnect"
 	paymentMux "api/internal/proto/payments/paymentsserviceconnect"
 	reservationMux "api/internal/proto/reservation/reservationserviceconnect"
+	staffMux "api/internal/proto/staff/staffserviceconnect"
 	userMux "api/internal/proto/users/usersserviceconnect"
 	utilityMux "api/internal/proto/utility/utilityserviceconnect"
 	"context"
@@ -38,6 +40,12 @@ func NewServer(handlers *handlers.Handlers, log *slog.Logger) *http.ServeMux {
 	paymentPath, paymentHandler := paymentMux.NewPaymentsServiceHandler(handlers.PaymentHandler, panicInterceptor)
 	api.Handle(paymentPath, handlers.Auth.AuthMiddleware(paymentHandler))
 
+	organizationPath, organizationHandler := organizationMux.NewOrganizationsServiceHandler(handlers.OrganizationHandler, panicInterceptor)
+	api.Handle(organizationPath, handlers.Auth.AuthMiddleware(organizationHandler))
+
+	staffPath, staffHandler := staffMux.NewStaffServiceHandler(handlers.StaffHandler, panicInterceptor)
+	api.Handle(staffPath, handlers.Auth.AuthMiddleware(staffHandler))
+
 	api.Handle(utilityMux.NewUtilityServiceHandler(handlers.UtilityHandler, panicInterceptor))
 
 	api.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -58,7 +66,17 @@ func NewServer(handlers *handlers.Handlers, log *slog.Logger) *http.ServeMux {
 		r.With(handlers.Auth.AuthMiddleware).Post("/images/{building}/{facility}", handlers.FilesHandler.UploadFacilityImage)
 		r.With(handlers.Auth.AuthMiddleware).Get("/documents/{reservationID}/{file}", handlers.FilesHandler.GetReservationFile)
 		r.With(handlers.Auth.AuthMiddleware).Post("/documents/{reservationID}", handlers.FilesHandler.UploadReservationFile)
+		r.With(handlers.Auth.AuthMiddleware).Post("/uploads", handlers.FilesHandler.UploadIntakeFile)
+		r.With(handlers.Auth.AuthMiddleware).Get("/organizations/{organizationID}/{file}", handlers.FilesHandler.GetOrganizationFile)
+		r.With(handlers.Auth.AuthMiddleware).Get("/invoices/{reservationID}/{file}", handlers.FilesHandler.GetInvoice)
+		r.With(handlers.Auth.AuthMiddleware).Post("/organizations/{organizationID}", handlers.FilesHandler.UploadOrganizationFile)
+	})
+	r.Route("/calendar", func(r chi.Router) {
+		r.Get("/facility/{id}.ics", handlers.FeedHandler.FacilityFeed)
+		r.Get("/building/{id}.ics", handlers.FeedHandler.BuildingFeed)
+		r.Get("/user/{token}.ics", handlers.FeedHandler.UserFeed)
 	})
+	r.Post("/webhooks/stripe", handlers.WebhookHandler.Stripe)
 	api.Handle("/", r)
 	return api
 }
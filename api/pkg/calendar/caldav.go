@@ -0,0 +1,536 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CalDAVConfig configures the CalDAV provider. Calendar IDs are collection
+// URLs, either absolute or relative to BaseURL.
+type CalDAVConfig struct {
+	BaseURL    string
+	Username   string
+	Password   string
+	HTTPClient *http.Client
+	Logger     *slog.Logger
+}
+
+// CalDAV publishes events to a CalDAV server such as Nextcloud. Series are
+// stored as one VEVENT with RRULE/EXDATE/RDATE; single events are one
+// resource each. Event IDs are the resources' UIDs.
+type CalDAV struct {
+	client   *http.Client
+	base     *url.URL
+	username string
+	password string
+	loc      time.Location
+	tz       string
+	logger   *slog.Logger
+}
+
+func NewCalDAV(cfg CalDAVConfig, loc time.Location, tz string) (*CalDAV, error) {
+	base, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CalDAV URL: %w", err)
+	}
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &CalDAV{
+		client:   client,
+		base:     base,
+		username: cfg.Username,
+		password: cfg.Password,
+		loc:      loc,
+		tz:       tz,
+		logger:   logger.With("provider", ProviderCalDAV),
+	}, nil
+}
+
+// DAVError is a failed CalDAV request.
+type DAVError struct {
+	Method string
+	URL    string
+	Status int
+}
+
+func (e *DAVError) Error() string {
+	return fmt.Sprintf("caldav: %s %s: %d %s", e.Method, e.URL, e.Status, http.StatusText(e.Status))
+}
+
+func (c *CalDAV) collectionURL(calendarID string) (*url.URL, error) {
+	ref, err := url.Parse(calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar id %q: %w", calendarID, err)
+	}
+	u := c.base.ResolveReference(ref)
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	return u, nil
+}
+
+func (c *CalDAV) resourceURL(calendarID, uid string) (string, error) {
+	u, err := c.collectionURL(calendarID)
+	if err != nil {
+		return "", err
+	}
+	return u.JoinPath(uid + ".ics").String(), nil
+}
+
+func (c *CalDAV) request(ctx context.Context, method, target string, body []byte, headers map[string]string) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp, data, &DAVError{Method: method, URL: target, Status: resp.StatusCode}
+	}
+	return resp, data, nil
+}
+
+func (c *CalDAV) put(ctx context.Context, calendarID string, events []ICSEvent, etag string) error {
+	target, err := c.resourceURL(calendarID, events[0].UID)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := WriteICS(&buf, "", &c.loc, c.tz, events); err != nil {
+		return err
+	}
+	headers := map[string]string{"Content-Type": "text/calendar; charset=utf-8"}
+	if etag == "" {
+		headers["If-None-Match"] = "*"
+	} else {
+		headers["If-Match"] = etag
+	}
+	_, _, err = c.request(ctx, http.MethodPut, target, buf.Bytes(), headers)
+	return err
+}
+
+func (c *CalDAV) get(ctx context.Context, calendarID, uid string) ([]ICSEvent, string, error) {
+	target, err := c.resourceURL(calendarID, uid)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, data, err := c.request(ctx, http.MethodGet, target, nil, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, "", ErrEventNotFound
+		}
+		return nil, "", err
+	}
+	events, err := ParseICS(bytes.NewReader(data), &c.loc)
+	if err != nil {
+		return nil, "", err
+	}
+	return events, resp.Header.Get("ETag"), nil
+}
+
+func (c *CalDAV) Publish(ctx context.Context, plan *PublishPlan, opts PublishOptions) (*PublishResult, error) {
+	if plan == nil {
+		return nil, fmt.Errorf("publish plan is nil")
+	}
+	if opts.CalendarID == "" {
+		return nil, fmt.Errorf("calendarID is required")
+	}
+	switch plan.Mode {
+	case ModeSeries:
+		if plan.Series == nil {
+			return nil, fmt.Errorf("missing series spec")
+		}
+		ev := ICSEvent{
+			UID:         newEventID(),
+			Summary:     firstNonEmpty(opts.Summary, plan.Series.Summary),
+			Description: firstNonEmpty(opts.Description, plan.Series.Description),
+			Location:    firstNonEmpty(opts.Location, plan.Series.Location),
+			Start:       plan.Series.Start,
+			End:         plan.Series.End,
+			Recurrence:  buildRecurrence(c.tz, plan.Series.RRULE, plan.Series.EXDATEs),
+			Status:      publishStatus(opts),
+		}
+		if err := c.put(ctx, opts.CalendarID, []ICSEvent{ev}, ""); err != nil {
+			return nil, err
+		}
+		return &PublishResult{MasterEventID: &ev.UID}, nil
+
+	case ModeSingles:
+		if len(plan.Singles) == 0 {
+			return &PublishResult{SingleEventID: map[int64]string{}}, nil
+		}
+		result := &PublishResult{
+			SingleEventID: make(map[int64]string, len(plan.Singles)),
+			EventIDs:      make([]string, 0, len(plan.Singles)),
+		}
+		for i, oc := range plan.Singles {
+			ev := ICSEvent{
+				UID:         newEventID(),
+				Summary:     firstNonEmpty(oc.Summary, opts.Summary),
+				Description: firstNonEmpty(oc.Description, opts.Description),
+				Location:    firstNonEmpty(oc.Location, opts.Location),
+				Start:       oc.Start,
+				End:         oc.End,
+				AllDay:      oc.AllDay,
+				Status:      publishStatus(opts),
+			}
+			if err := c.put(ctx, opts.CalendarID, []ICSEvent{ev}, ""); err != nil {
+				return result, fmt.Errorf("failed to create event: %d: %w", i, err)
+			}
+			result.EventIDs = append(result.EventIDs, ev.UID)
+			if oc.RefID != 0 {
+				result.SingleEventID[oc.RefID] = ev.UID
+			}
+		}
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("unknown mode: %s", plan.Mode)
+	}
+}
+
+const calendarQueryBody = `<?xml version="1.0" encoding="utf-8"?>
+<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:prop>
+    <C:calendar-data>
+      <C:expand start="%[1]s" end="%[2]s"/>
+    </C:calendar-data>
+  </D:prop>
+  <C:filter>
+    <C:comp-filter name="VCALENDAR">
+      <C:comp-filter name="VEVENT">
+        <C:time-range start="%[1]s" end="%[2]s"/>
+      </C:comp-filter>
+    </C:comp-filter>
+  </C:filter>
+</C:calendar-query>`
+
+type davMultistatus struct {
+	Responses []struct {
+		Href     string `xml:"DAV: href"`
+		Propstat []struct {
+			Status string `xml:"DAV: status"`
+			Prop   struct {
+				CalendarData string `xml:"urn:ietf:params:xml:ns:caldav calendar-data"`
+			} `xml:"DAV: prop"`
+		} `xml:"DAV: propstat"`
+	} `xml:"DAV: response"`
+}
+
+// ListEvents runs a calendar-query over the usual window and asks the server
+// to expand series. Servers that ignore <expand> return masters, which are
+// expanded here instead.
+func (c *CalDAV) ListEvents(ctx context.Context, calendarID string) ([]Event, error) {
+	from, to := ListWindow(time.Now().In(&c.loc))
+	return c.ListEventsBetween(ctx, calendarID, from, to)
+}
+
+// ListEventsBetween lists the events overlapping [from, to) with series
+// expanded into occurrences.
+func (c *CalDAV) ListEventsBetween(ctx context.Context, calendarID string, from, to time.Time) ([]Event, error) {
+	collection, err := c.collectionURL(calendarID)
+	if err != nil {
+		return nil, err
+	}
+	body := fmt.Sprintf(calendarQueryBody, from.UTC().Format(icsDateTime)+"Z", to.UTC().Format(icsDateTime)+"Z")
+	_, data, err := c.request(ctx, "REPORT", collection.String(), []byte(body), map[string]string{
+		"Content-Type": "application/xml; charset=utf-8",
+		"Depth":        "1",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ms davMultistatus
+	if err := xml.Unmarshal(data, &ms); err != nil {
+		return nil, fmt.Errorf("parse multistatus: %w", err)
+	}
+
+	var events []Event
+	for _, r := range ms.Responses {
+		for _, ps := range r.Propstat {
+			if ps.Prop.CalendarData == "" {
+				continue
+			}
+			parsed, err := ParseICS(strings.NewReader(ps.Prop.CalendarData), &c.loc)
+			if err != nil {
+				c.logger.Warn("Skipping unparsable calendar resource", "href", r.Href, "error", err)
+				continue
+			}
+			occs, err := c.occurrences(parsed, from, to)
+			if err != nil {
+				c.logger.Warn("Skipping series with invalid recurrence", "href", r.Href, "error", err)
+				continue
+			}
+			events = append(events, occs...)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	return events, nil
+}
+
+func (c *CalDAV) occurrences(parsed []ICSEvent, from, to time.Time) ([]Event, error) {
+	overrides := make(map[string]map[int64]Event)
+	cancelled := make(map[string][]time.Time)
+	for _, ev := range parsed {
+		if !ev.IsOverride() {
+			continue
+		}
+		if ev.Status == "CANCELLED" {
+			cancelled[ev.UID] = append(cancelled[ev.UID], ev.RecurrenceID)
+			continue
+		}
+		if overrides[ev.UID] == nil {
+			overrides[ev.UID] = make(map[int64]Event)
+		}
+		overrides[ev.UID][ev.RecurrenceID.Unix()] = icsToEvent(ev)
+	}
+	var out []Event
+	for _, ev := range parsed {
+		switch {
+		case ev.Status == "CANCELLED":
+			// cancelled events are hidden, as Google hides them
+		case len(ev.Recurrence) > 0:
+			rec := ev.Recurrence
+			if starts := cancelled[ev.UID]; len(starts) > 0 {
+				rec = append(rec[:len(rec):len(rec)], exdateLine(c.tz, starts))
+			}
+			occs, err := expandSeries(icsToEvent(ev), rec, overrides[ev.UID], &c.loc, c.tz, from, to)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, occs...)
+		case ev.IsOverride():
+			// an expanded instance, or an override whose master is not in the set
+			if hasRecurringMaster(parsed, ev.UID) {
+				continue
+			}
+			e := icsToEvent(ev)
+			e.ID = InstanceID(ev.UID, ev.RecurrenceID)
+			out = append(out, e)
+		default:
+			out = append(out, icsToEvent(ev))
+		}
+	}
+	return out, nil
+}
+
+func hasRecurringMaster(parsed []ICSEvent, uid string) bool {
+	for _, ev := range parsed {
+		if ev.UID == uid && !ev.IsOverride() && len(ev.Recurrence) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func icsToEvent(ev ICSEvent) Event {
+	return Event{
+		ID:          ev.UID,
+		Summary:     ev.Summary,
+		Description: ev.Description,
+		Location:    ev.Location,
+		Start:       ev.Start,
+		End:         ev.End,
+		AllDay:      ev.AllDay,
+		Status:      strings.ToLower(ev.Status),
+	}
+}
+
+// publishStatus is the iCalendar STATUS of newly published events.
+func publishStatus(opts PublishOptions) string {
+	if opts.Tentative {
+		return "TENTATIVE"
+	}
+	return ""
+}
+
+// DeleteEvent removes an event resource. Deleting an expanded occurrence
+// adds an EXDATE to its series instead.
+func (c *CalDAV) DeleteEvent(ctx context.Context, calendarID string, eventID string) error {
+	if masterID, start, isInstance := SplitInstanceID(eventID); isInstance {
+		return c.updateSeries(ctx, calendarID, masterID, func(master *ICSEvent, _ []ICSEvent) []ICSEvent {
+			master.Recurrence = append(master.Recurrence, exdateLine(c.tz, []time.Time{start.In(&c.loc)}))
+			return nil
+		})
+	}
+	target, err := c.resourceURL(calendarID, eventID)
+	if err != nil {
+		return err
+	}
+	resp, _, err := c.request(ctx, http.MethodDelete, target, nil, nil)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrEventNotFound
+	}
+	return err
+}
+
+// GetEvent fetches an event resource and returns its master. Cancelled
+// events count as deleted.
+func (c *CalDAV) GetEvent(ctx context.Context, calendarID string, eventID string) (*Event, error) {
+	parsed, _, err := c.get(ctx, calendarID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range parsed {
+		if ev.IsOverride() {
+			continue
+		}
+		if ev.Status == "CANCELLED" {
+			return nil, ErrEventNotFound
+		}
+		out := icsToEvent(ev)
+		return &out, nil
+	}
+	return nil, ErrEventNotFound
+}
+
+// UpdateEvent rewrites an event's times and text, keeping any recurrence.
+// Updating an expanded occurrence writes a RECURRENCE-ID override instead.
+func (c *CalDAV) UpdateEvent(ctx context.Context, calendarID string, ev Event) error {
+	masterID, start, isInstance := SplitInstanceID(ev.ID)
+	if !isInstance {
+		return c.updateSeries(ctx, calendarID, ev.ID, func(master *ICSEvent, _ []ICSEvent) []ICSEvent {
+			master.Summary, master.Description, master.Location = ev.Summary, ev.Description, ev.Location
+			master.Start, master.End, master.AllDay = ev.Start, ev.End, ev.AllDay
+			if ev.Status != "" {
+				master.Status = strings.ToUpper(ev.Status)
+			}
+			return nil
+		})
+	}
+	original := start.In(&c.loc)
+	return c.updateSeries(ctx, calendarID, masterID, func(master *ICSEvent, overrides []ICSEvent) []ICSEvent {
+		status := master.Status
+		if ev.Status != "" {
+			status = strings.ToUpper(ev.Status)
+		}
+		kept := make([]ICSEvent, 0, len(overrides)+1)
+		for _, o := range overrides {
+			if !o.RecurrenceID.Equal(original) {
+				kept = append(kept, o)
+			}
+		}
+		return append(kept, ICSEvent{
+			UID:          master.UID,
+			Summary:      ev.Summary,
+			Description:  ev.Description,
+			Location:     ev.Location,
+			Start:        ev.Start,
+			End:          ev.End,
+			AllDay:       ev.AllDay,
+			Status:       status,
+			RecurrenceID: original,
+		})
+	})
+}
+
+// updateSeries loads a series resource, lets fn edit the master and return
+// the overrides to keep, and writes it back guarded by its ETag.
+func (c *CalDAV) updateSeries(ctx context.Context, calendarID, masterEventID string, fn func(master *ICSEvent, overrides []ICSEvent) []ICSEvent) error {
+	parsed, etag, err := c.get(ctx, calendarID, masterEventID)
+	if err != nil {
+		return err
+	}
+	var (
+		master    *ICSEvent
+		overrides []ICSEvent
+	)
+	for i := range parsed {
+		if parsed[i].IsOverride() {
+			overrides = append(overrides, parsed[i])
+		} else if master == nil {
+			master = &parsed[i]
+		}
+	}
+	if master == nil {
+		return ErrEventNotFound
+	}
+	if kept := fn(master, overrides); kept != nil {
+		overrides = kept
+	}
+	return c.put(ctx, calendarID, append([]ICSEvent{*master}, overrides...), etag)
+}
+
+// AddExdatesToMaster replaces the master's recurrence with the RRULE and
+// EXDATEs given, matching the Google provider's patch semantics.
+func (c *CalDAV) AddExdatesToMaster(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time) error {
+	return c.updateSeries(ctx, calendarID, masterEventID, func(master *ICSEvent, _ []ICSEvent) []ICSEvent {
+		master.Recurrence = buildRecurrence(c.tz, rrule, exdates)
+		return nil
+	})
+}
+
+// AddRdatesWithOverrides adds RDATEs to the master and writes a RECURRENCE-ID
+// override for every added date whose duration differs from the master's.
+func (c *CalDAV) AddRdatesWithOverrides(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time, adds []RDateSpec) error {
+	if len(adds) == 0 {
+		return nil
+	}
+	rdateStarts := make([]time.Time, len(adds))
+	for i := range adds {
+		rdateStarts[i] = adds[i].Start
+	}
+	return c.updateSeries(ctx, calendarID, masterEventID, func(master *ICSEvent, overrides []ICSEvent) []ICSEvent {
+		master.Recurrence = buildRecurrenceWithRdates(c.tz, rrule, exdates, rdateStarts, &c.loc)
+		masterDur := master.End.Sub(master.Start)
+		byStart := make(map[int64]int, len(overrides))
+		for i, o := range overrides {
+			byStart[o.RecurrenceID.Unix()] = i
+		}
+		for _, spec := range adds {
+			if spec.End.Sub(spec.Start) == masterDur {
+				continue
+			}
+			start := spec.Start.In(&c.loc)
+			o := ICSEvent{
+				UID:          master.UID,
+				Summary:      firstNonEmpty(spec.Summary, master.Summary),
+				Description:  firstNonEmpty(spec.Description, master.Description),
+				Location:     firstNonEmpty(spec.Location, master.Location),
+				Start:        start,
+				End:          spec.End.In(&c.loc),
+				RecurrenceID: start,
+			}
+			if i, ok := byStart[start.Unix()]; ok {
+				overrides[i] = o
+			} else {
+				overrides = append(overrides, o)
+			}
+		}
+		if overrides == nil {
+			overrides = []ICSEvent{}
+		}
+		return overrides
+	})
+}
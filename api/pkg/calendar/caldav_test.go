@@ -0,0 +1,503 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testDAVCalendar = "calendars/room/"
+	testDAVUser     = "booker"
+	testDAVPassword = "secret"
+)
+
+// davRequest is one request the fake CalDAV server received.
+type davRequest struct {
+	method string
+	path   string
+	header http.Header
+	body   string
+}
+
+type davResource struct {
+	data []byte
+	etag string
+}
+
+// fakeDAV is a CalDAV collection kept in memory. It honours If-Match and
+// If-None-Match on PUT and answers REPORT with every resource unexpanded,
+// as servers that ignore <expand> do.
+type fakeDAV struct {
+	mu        sync.Mutex
+	resources map[string]davResource
+	requests  []davRequest
+	version   int
+}
+
+func (f *fakeDAV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, davRequest{method: r.Method, path: r.URL.Path, header: r.Header.Clone(), body: string(body)})
+
+	if user, pass, ok := r.BasicAuth(); !ok || user != testDAVUser || pass != testDAVPassword {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	res, exists := f.resources[r.URL.Path]
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("If-None-Match") == "*" && exists {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		if match := r.Header.Get("If-Match"); match != "" && (!exists || match != res.etag) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		f.version++
+		f.resources[r.URL.Path] = davResource{data: body, etag: fmt.Sprintf(`"%d"`, f.version)}
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodGet:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", res.etag)
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		_, _ = w.Write(res.data)
+	case http.MethodDelete:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.resources, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	case "REPORT":
+		paths := make([]string, 0, len(f.resources))
+		for p := range f.resources {
+			if strings.HasPrefix(p, r.URL.Path) {
+				paths = append(paths, p)
+			}
+		}
+		sort.Strings(paths)
+		var buf bytes.Buffer
+		buf.WriteString(`<?xml version="1.0" encoding="utf-8"?><D:multistatus xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">`)
+		for _, p := range paths {
+			fmt.Fprintf(&buf, "<D:response><D:href>%s</D:href><D:propstat><D:prop><C:calendar-data>", p)
+			_ = xml.EscapeText(&buf, f.resources[p].data)
+			buf.WriteString("</C:calendar-data></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>")
+		}
+		buf.WriteString("</D:multistatus>")
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		w.WriteHeader(http.StatusMultiStatus)
+		_, _ = w.Write(buf.Bytes())
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// calls lists the requests as "METHOD /path", in order, and forgets them.
+func (f *fakeDAV) calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make([]string, len(f.requests))
+	for i, r := range f.requests {
+		out[i] = r.method + " " + r.path
+	}
+	f.requests = nil
+	return out
+}
+
+// last returns the last request made with method.
+func (f *fakeDAV) last(t *testing.T, method string) davRequest {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := len(f.requests) - 1; i >= 0; i-- {
+		if f.requests[i].method == method {
+			return f.requests[i]
+		}
+	}
+	t.Fatalf("no %s request", method)
+	return davRequest{}
+}
+
+// stored parses the resource held for uid.
+func (f *fakeDAV) stored(t *testing.T, loc *time.Location, uid string) []ICSEvent {
+	t.Helper()
+	f.mu.Lock()
+	res, ok := f.resources[davPath(uid)]
+	f.mu.Unlock()
+	if !ok {
+		t.Fatalf("no resource for %s", uid)
+	}
+	events, err := ParseICS(bytes.NewReader(res.data), loc)
+	if err != nil {
+		t.Fatalf("parse stored %s: %v", uid, err)
+	}
+	return events
+}
+
+func newTestCalDAV(t *testing.T) (*CalDAV, *fakeDAV) {
+	t.Helper()
+	loc := testLocation(t)
+	fake := &fakeDAV{resources: map[string]davResource{}}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	c, err := NewCalDAV(CalDAVConfig{
+		BaseURL:    srv.URL + "/dav",
+		Username:   testDAVUser,
+		Password:   testDAVPassword,
+		HTTPClient: srv.Client(),
+		Logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}, *loc, testTZ)
+	if err != nil {
+		t.Fatalf("NewCalDAV: %v", err)
+	}
+	return c, fake
+}
+
+func davPath(uid string) string {
+	return "/dav/" + testDAVCalendar + uid + ".ics"
+}
+
+// publishTestSeries publishes four Monday rehearsals from 5 January 2026
+// with the second one excluded, and forgets the requests it made.
+func publishTestSeries(t *testing.T, c *CalDAV, fake *fakeDAV) (string, time.Time) {
+	t.Helper()
+	start := time.Date(2026, 1, 5, 10, 0, 0, 0, &c.loc)
+	res, err := c.Publish(context.Background(), &PublishPlan{
+		Mode: ModeSeries,
+		Series: &SeriesSpec{
+			Start:   start,
+			End:     start.Add(time.Hour),
+			RRULE:   "FREQ=WEEKLY;COUNT=4",
+			EXDATEs: []time.Time{start.AddDate(0, 0, 7)},
+			Summary: "Rehearsal",
+		},
+	}, PublishOptions{CalendarID: testDAVCalendar, Location: "Main Hall"})
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if res.MasterEventID == nil || *res.MasterEventID == "" {
+		t.Fatalf("no master id")
+	}
+	fake.calls()
+	return *res.MasterEventID, start
+}
+
+func eventIDs(events []Event) []string {
+	out := make([]string, len(events))
+	for i, ev := range events {
+		out[i] = ev.ID
+	}
+	return out
+}
+
+func TestCalDAVPublishSeries(t *testing.T) {
+	c, fake := newTestCalDAV(t)
+	loc := &c.loc
+	start := time.Date(2026, 1, 5, 10, 0, 0, 0, loc)
+	res, err := c.Publish(context.Background(), &PublishPlan{
+		Mode: ModeSeries,
+		Series: &SeriesSpec{
+			Start:   start,
+			End:     start.Add(time.Hour),
+			RRULE:   "RRULE:FREQ=WEEKLY;COUNT=4",
+			EXDATEs: []time.Time{start.AddDate(0, 0, 21), start.AddDate(0, 0, 7)},
+			Summary: "Rehearsal",
+		},
+	}, PublishOptions{CalendarID: testDAVCalendar, Location: "Main Hall", Tentative: true})
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	uid := *res.MasterEventID
+	put := fake.last(t, http.MethodPut)
+	if got, want := fake.calls(), []string{"PUT " + davPath(uid)}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+	if got := put.header.Get("If-None-Match"); got != "*" {
+		t.Errorf("If-None-Match = %q, want * for a new resource", got)
+	}
+	if got := put.header.Get("Content-Type"); !strings.HasPrefix(got, "text/calendar") {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if !strings.Contains(put.body, "BEGIN:VTIMEZONE") || !strings.Contains(put.body, "TZID:"+testTZ) {
+		t.Errorf("resource has no VTIMEZONE for %s", testTZ)
+	}
+
+	events := fake.stored(t, loc, uid)
+	if len(events) != 1 {
+		t.Fatalf("stored %d events, want 1", len(events))
+	}
+	ev := events[0]
+	want := []string{
+		"RRULE:FREQ=WEEKLY;COUNT=4",
+		"EXDATE;TZID=" + testTZ + ":20260112T100000,20260126T100000",
+	}
+	if !reflect.DeepEqual(ev.Recurrence, want) {
+		t.Errorf("recurrence = %q, want %q", ev.Recurrence, want)
+	}
+	if ev.UID != uid || ev.Summary != "Rehearsal" || ev.Location != "Main Hall" || ev.Status != "TENTATIVE" {
+		t.Errorf("event = %+v", ev)
+	}
+	if !ev.Start.Equal(start) || !ev.End.Equal(start.Add(time.Hour)) {
+		t.Errorf("times = %v..%v", ev.Start, ev.End)
+	}
+}
+
+func TestCalDAVPublishSingles(t *testing.T) {
+	c, fake := newTestCalDAV(t)
+	loc := &c.loc
+	first := time.Date(2026, 3, 2, 9, 30, 0, 0, loc)
+	res, err := c.Publish(context.Background(), &PublishPlan{
+		Mode: ModeSingles,
+		Singles: []OccSpec{
+			{Start: first, End: first.Add(2 * time.Hour), RefID: 11},
+			{Start: time.Date(2026, 3, 3, 0, 0, 0, 0, loc), End: time.Date(2026, 3, 5, 0, 0, 0, 0, loc), RefID: 12, Summary: "Move in", AllDay: true},
+		},
+	}, PublishOptions{CalendarID: testDAVCalendar, Summary: "Gala"})
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if len(res.EventIDs) != 2 || res.SingleEventID[11] != res.EventIDs[0] || res.SingleEventID[12] != res.EventIDs[1] {
+		t.Fatalf("result = %+v", res)
+	}
+	want := []string{"PUT " + davPath(res.EventIDs[0]), "PUT " + davPath(res.EventIDs[1])}
+	if got := fake.calls(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+
+	timed := fake.stored(t, loc, res.EventIDs[0])[0]
+	if timed.Summary != "Gala" || len(timed.Recurrence) != 0 || timed.Status != "" {
+		t.Errorf("timed event = %+v", timed)
+	}
+	allDay := fake.stored(t, loc, res.EventIDs[1])[0]
+	if !allDay.AllDay || allDay.Summary != "Move in" {
+		t.Errorf("all-day event = %+v", allDay)
+	}
+	if allDay.Start.Format(icsDate) != "20260303" || allDay.End.Format(icsDate) != "20260305" {
+		t.Errorf("all-day dates = %v..%v", allDay.Start, allDay.End)
+	}
+}
+
+func TestCalDAVListEvents(t *testing.T) {
+	c, fake := newTestCalDAV(t)
+	uid, start := publishTestSeries(t, c, fake)
+	single := time.Date(2026, 1, 20, 14, 0, 0, 0, &c.loc)
+	res, err := c.Publish(context.Background(), &PublishPlan{
+		Mode:    ModeSingles,
+		Singles: []OccSpec{{Start: single, End: single.Add(time.Hour), Summary: "Talk"}},
+	}, PublishOptions{CalendarID: testDAVCalendar})
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	fake.calls()
+
+	from, to := time.Date(2026, 1, 1, 0, 0, 0, 0, &c.loc), time.Date(2026, 2, 15, 0, 0, 0, 0, &c.loc)
+	events, err := c.ListEventsBetween(context.Background(), testDAVCalendar, from, to)
+	if err != nil {
+		t.Fatalf("ListEventsBetween: %v", err)
+	}
+	report := fake.last(t, "REPORT")
+	if got, want := fake.calls(), []string{"REPORT /dav/" + testDAVCalendar}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+	if got := report.header.Get("Depth"); got != "1" {
+		t.Errorf("Depth = %q, want 1", got)
+	}
+	if !strings.Contains(report.body, `<C:time-range start="20260101T050000Z" end="20260215T050000Z"/>`) {
+		t.Errorf("query has no time range for the window:\n%s", report.body)
+	}
+
+	want := []string{
+		InstanceID(uid, start),
+		InstanceID(uid, start.AddDate(0, 0, 14)),
+		res.EventIDs[0],
+		InstanceID(uid, start.AddDate(0, 0, 21)),
+	}
+	if got := eventIDs(events); !reflect.DeepEqual(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	if events[2].Summary != "Talk" || events[0].Summary != "Rehearsal" || events[0].Location != "Main Hall" {
+		t.Errorf("events = %+v", events)
+	}
+}
+
+func TestCalDAVUpdateEventInstance(t *testing.T) {
+	c, fake := newTestCalDAV(t)
+	loc := &c.loc
+	uid, start := publishTestSeries(t, c, fake)
+	original := start.AddDate(0, 0, 14)
+	instance := InstanceID(uid, original)
+
+	for _, moved := range []time.Time{original.Add(2 * time.Hour), original.Add(3 * time.Hour)} {
+		err := c.UpdateEvent(context.Background(), testDAVCalendar, Event{
+			ID:      instance,
+			Summary: "Dress rehearsal",
+			Start:   moved,
+			End:     moved.Add(90 * time.Minute),
+		})
+		if err != nil {
+			t.Fatalf("UpdateEvent: %v", err)
+		}
+		if got, want := fake.calls(), []string{"GET " + davPath(uid), "PUT " + davPath(uid)}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("requests = %v, want %v", got, want)
+		}
+	}
+
+	events := fake.stored(t, loc, uid)
+	if len(events) != 2 {
+		t.Fatalf("stored %d events, want the master and one override", len(events))
+	}
+	master, override := events[0], events[1]
+	if master.IsOverride() || !override.IsOverride() {
+		t.Fatalf("events = %+v", events)
+	}
+	if len(master.Recurrence) != 2 {
+		t.Errorf("master recurrence = %q, want it kept", master.Recurrence)
+	}
+	if !override.RecurrenceID.Equal(original) || override.UID != uid {
+		t.Errorf("override RECURRENCE-ID = %v, want %v", override.RecurrenceID, original)
+	}
+	if !override.Start.Equal(original.Add(3*time.Hour)) || override.Summary != "Dress rehearsal" {
+		t.Errorf("override = %+v", override)
+	}
+
+	listed, err := c.ListEventsBetween(context.Background(), testDAVCalendar, original.AddDate(0, 0, -1), original.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListEventsBetween: %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != instance || !listed[0].Start.Equal(original.Add(3*time.Hour)) {
+		t.Fatalf("events = %+v, want the moved occurrence under its instance id", listed)
+	}
+}
+
+func TestCalDAVUpdateEvent(t *testing.T) {
+	c, fake := newTestCalDAV(t)
+	loc := &c.loc
+	uid, start := publishTestSeries(t, c, fake)
+	err := c.UpdateEvent(context.Background(), testDAVCalendar, Event{
+		ID:      uid,
+		Summary: "Orchestra rehearsal",
+		Start:   start,
+		End:     start.Add(2 * time.Hour),
+		Status:  StatusConfirmed,
+	})
+	if err != nil {
+		t.Fatalf("UpdateEvent: %v", err)
+	}
+	put := fake.last(t, http.MethodPut)
+	if got := put.header.Get("If-Match"); got == "" || put.header.Get("If-None-Match") != "" {
+		t.Errorf("update is not guarded by the ETag: If-Match %q", got)
+	}
+	ev := fake.stored(t, loc, uid)[0]
+	if ev.Summary != "Orchestra rehearsal" || ev.Status != "CONFIRMED" || !ev.End.Equal(start.Add(2*time.Hour)) {
+		t.Errorf("event = %+v", ev)
+	}
+	if len(ev.Recurrence) != 2 {
+		t.Errorf("recurrence = %q, want it kept", ev.Recurrence)
+	}
+
+	err = c.UpdateEvent(context.Background(), testDAVCalendar, Event{ID: "missing", Start: start, End: start.Add(time.Hour)})
+	if !errors.Is(err, ErrEventNotFound) {
+		t.Fatalf("err = %v, want ErrEventNotFound", err)
+	}
+}
+
+func TestCalDAVDeleteEvent(t *testing.T) {
+	c, fake := newTestCalDAV(t)
+	loc := &c.loc
+	uid, start := publishTestSeries(t, c, fake)
+
+	if err := c.DeleteEvent(context.Background(), testDAVCalendar, InstanceID(uid, start.AddDate(0, 0, 14))); err != nil {
+		t.Fatalf("DeleteEvent instance: %v", err)
+	}
+	if got, want := fake.calls(), []string{"GET " + davPath(uid), "PUT " + davPath(uid)}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+	want := []string{
+		"RRULE:FREQ=WEEKLY;COUNT=4",
+		"EXDATE;TZID=" + testTZ + ":20260112T100000",
+		"EXDATE;TZID=" + testTZ + ":20260119T100000",
+	}
+	if got := fake.stored(t, loc, uid)[0].Recurrence; !reflect.DeepEqual(got, want) {
+		t.Errorf("recurrence = %q, want %q", got, want)
+	}
+
+	if err := c.DeleteEvent(context.Background(), testDAVCalendar, uid); err != nil {
+		t.Fatalf("DeleteEvent: %v", err)
+	}
+	if got, want := fake.calls(), []string{"DELETE " + davPath(uid)}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+	if err := c.DeleteEvent(context.Background(), testDAVCalendar, uid); !errors.Is(err, ErrEventNotFound) {
+		t.Fatalf("err = %v, want ErrEventNotFound", err)
+	}
+	if _, err := c.GetEvent(context.Background(), testDAVCalendar, uid); !errors.Is(err, ErrEventNotFound) {
+		t.Fatalf("GetEvent err = %v, want ErrEventNotFound", err)
+	}
+}
+
+func TestCalDAVAddExdatesToMaster(t *testing.T) {
+	c, fake := newTestCalDAV(t)
+	loc := &c.loc
+	uid, start := publishTestSeries(t, c, fake)
+	err := c.AddExdatesToMaster(context.Background(), testDAVCalendar, uid, "FREQ=WEEKLY;UNTIL=20260202T150000Z",
+		[]time.Time{start.AddDate(0, 0, 21), start.AddDate(0, 0, 14)})
+	if err != nil {
+		t.Fatalf("AddExdatesToMaster: %v", err)
+	}
+	want := []string{
+		"RRULE:FREQ=WEEKLY;UNTIL=20260202T150000Z",
+		"EXDATE;TZID=" + testTZ + ":20260119T100000,20260126T100000",
+	}
+	if got := fake.stored(t, loc, uid)[0].Recurrence; !reflect.DeepEqual(got, want) {
+		t.Errorf("recurrence = %q, want %q", got, want)
+	}
+
+	events, err := c.ListEventsBetween(context.Background(), testDAVCalendar, start, start.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("ListEventsBetween: %v", err)
+	}
+	if got, want := eventIDs(events), []string{
+		InstanceID(uid, start),
+		InstanceID(uid, start.AddDate(0, 0, 7)),
+		InstanceID(uid, start.AddDate(0, 0, 28)),
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
+
+func TestCalDAVStaleETag(t *testing.T) {
+	c, fake := newTestCalDAV(t)
+	uid, start := publishTestSeries(t, c, fake)
+	// another client rewrites the resource between our GET and PUT
+	err := c.updateSeries(context.Background(), testDAVCalendar, uid, func(master *ICSEvent, _ []ICSEvent) []ICSEvent {
+		fake.mu.Lock()
+		res := fake.resources[davPath(uid)]
+		res.etag = `"other"`
+		fake.resources[davPath(uid)] = res
+		fake.mu.Unlock()
+		master.End = start.Add(2 * time.Hour)
+		return nil
+	})
+	var davErr *DAVError
+	if !errors.As(err, &davErr) || davErr.Status != http.StatusPreconditionFailed {
+		t.Fatalf("err = %v, want 412", err)
+	}
+}
@@ -2,9 +2,11 @@ package calendar
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
+	"net/http"
 	"strings"
 	"time"
 
@@ -153,32 +155,32 @@ func isRateLimitError(err error) bool {
 	return false
 }
 
-func (c *Calendar) ListEvents(calendarID string) (*gcal.Events, error) {
-	return c.ListEventsWithContext(context.Background(), calendarID)
+func (c *Calendar) ListEvents(ctx context.Context, calendarID string) ([]Event, error) {
+	from, to := ListWindow(time.Now())
+	return c.ListEventsBetween(ctx, calendarID, from, to)
 }
 
-func (c *Calendar) ListEventsWithContext(ctx context.Context, calendarID string) (*gcal.Events, error) {
-	var result *gcal.Events
+// ListEventsBetween lists the events overlapping [from, to), following
+// every page. Recurring events come back as their occurrences.
+func (c *Calendar) ListEventsBetween(ctx context.Context, calendarID string, from, to time.Time) ([]Event, error) {
+	var result []Event
 
 	err := c.withRateLimit(ctx, "ListEvents", func() error {
-		now := time.Now()
-		// only go back 1 month
-		timeMin := now.AddDate(0, -1, 0).Format(time.RFC3339)
-		timeMax := now.AddDate(0, 3, 0).Format(time.RFC3339)
-
-		events, err := c.svc.Events.
+		result = nil
+		return c.svc.Events.
 			List(calendarID).
 			SingleEvents(true).
-			TimeMin(timeMin).
-			TimeMax(timeMax).
-			MaxResults(1000).
+			TimeMin(from.Format(time.RFC3339)).
+			TimeMax(to.Format(time.RFC3339)).
+			MaxResults(2500).
 			OrderBy("startTime").
-			Do()
-
-		if err == nil {
-			result = events
-		}
-		return err
+			Context(ctx).
+			Pages(ctx, func(page *gcal.Events) error {
+				for _, ev := range page.Items {
+					result = append(result, fromGoogleEvent(ev, &c.loc))
+				}
+				return nil
+			})
 	})
 
 	return result, err
@@ -202,12 +204,107 @@ func (c *Calendar) InsertEventWithContext(ctx context.Context, calendarID string
 	return result, err
 }
 
-func (c *Calendar) DeleteEvent(calendarID string, eventID string) error {
-	return c.DeleteEventWithContext(context.Background(), calendarID, eventID)
+func (c *Calendar) DeleteEvent(ctx context.Context, calendarID string, eventID string) error {
+	return c.withRateLimit(ctx, "DeleteEvent", func() error {
+		return c.svc.Events.Delete(calendarID, eventID).Context(ctx).Do()
+	})
 }
 
-func (c *Calendar) DeleteEventWithContext(ctx context.Context, calendarID string, eventID string) error {
-	return c.withRateLimit(ctx, "DeleteEvent", func() error {
-		return c.svc.Events.Delete(calendarID, eventID).Do()
+// GetEvent fetches one event. Deleted events, which Google keeps as
+// cancelled, are reported as ErrEventNotFound.
+func (c *Calendar) GetEvent(ctx context.Context, calendarID string, eventID string) (*Event, error) {
+	var ev *gcal.Event
+	err := c.withRateLimit(ctx, "GetEvent", func() error {
+		var err error
+		ev, err = c.svc.Events.Get(calendarID, eventID).Context(ctx).Do()
+		return err
+	})
+	if IsNotFound(err) || (err == nil && ev.Status == "cancelled") {
+		return nil, ErrEventNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := fromGoogleEvent(ev, &c.loc)
+	return &out, nil
+}
+
+// UpdateEvent replaces the times and text of an event, or of one occurrence
+// when ev.ID is an instance id. Confirming a tentative hold also drops its
+// color.
+func (c *Calendar) UpdateEvent(ctx context.Context, calendarID string, ev Event) error {
+	patch := &gcal.Event{
+		Summary:         ev.Summary,
+		Description:     ev.Description,
+		Location:        ev.Location,
+		Start:           c.eventDateTime(ev.Start, ev.AllDay),
+		End:             c.eventDateTime(ev.End, ev.AllDay),
+		ForceSendFields: []string{"Summary", "Description", "Location"},
+	}
+	switch ev.Status {
+	case StatusTentative:
+		markTentative(patch, true)
+	case StatusConfirmed:
+		patch.Status = StatusConfirmed
+		patch.NullFields = []string{"ColorId"}
+	}
+	return c.withRateLimit(ctx, "UpdateEvent", func() error {
+		_, err := c.svc.Events.Patch(calendarID, ev.ID, patch).SendUpdates("none").Context(ctx).Do()
+		return err
 	})
 }
+
+// eventDateTime clears the unused half of the start or end so a patch can
+// switch an event between timed and all-day.
+func (c *Calendar) eventDateTime(t time.Time, allDay bool) *gcal.EventDateTime {
+	if allDay {
+		return &gcal.EventDateTime{Date: t.Format("2006-01-02"), NullFields: []string{"DateTime", "TimeZone"}}
+	}
+	return &gcal.EventDateTime{DateTime: t.Format("2006-01-02T15:04:05"), TimeZone: c.tz, NullFields: []string{"Date"}}
+}
+
+// ListChanges returns the events changed since syncToken, or every event
+// when syncToken is empty, along with the token for the next call. Google
+// answers an expired token with 410, reported as ErrSyncTokenExpired.
+func (c *Calendar) ListChanges(ctx context.Context, calendarID, syncToken string) (*Changes, error) {
+	out := &Changes{}
+	pageToken := ""
+	for {
+		var page *gcal.Events
+		err := c.withRateLimit(ctx, "ListChanges", func() error {
+			call := c.svc.Events.List(calendarID).
+				SingleEvents(true).
+				ShowDeleted(true).
+				MaxResults(2500).
+				Context(ctx)
+			if syncToken != "" {
+				call = call.SyncToken(syncToken)
+			}
+			if pageToken != "" {
+				call = call.PageToken(pageToken)
+			}
+			var err error
+			page, err = call.Do()
+			return err
+		})
+		if err != nil {
+			var apiErr *googleapi.Error
+			if errors.As(err, &apiErr) && apiErr.Code == http.StatusGone {
+				return nil, ErrSyncTokenExpired
+			}
+			return nil, err
+		}
+		for _, ev := range page.Items {
+			if ev.Status == "cancelled" {
+				out.Deleted = append(out.Deleted, ev.Id)
+				continue
+			}
+			out.Events = append(out.Events, fromGoogleEvent(ev, &c.loc))
+		}
+		if page.NextPageToken == "" {
+			out.SyncToken = page.NextSyncToken
+			return out, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
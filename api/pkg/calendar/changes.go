@@ -0,0 +1,46 @@
+package calendar
+
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/api/googleapi"
+)
+
+var (
+	// ErrSyncTokenExpired means the provider no longer accepts a sync token
+	// and the calendar has to be listed in full again.
+	ErrSyncTokenExpired = errors.New("calendar sync token expired")
+	// ErrChangesUnsupported is returned by providers without incremental
+	// listing.
+	ErrChangesUnsupported = errors.New("calendar provider cannot list changes")
+)
+
+// Changes is what changed on a calendar since a sync token. Series are
+// expanded, so Events holds occurrences with instance ids.
+type Changes struct {
+	Events []Event
+	// Deleted holds ids of removed or cancelled events and occurrences.
+	Deleted   []string
+	SyncToken string
+}
+
+// IsNotFound reports whether err means the event is gone, for any provider.
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrEventNotFound) {
+		return true
+	}
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		return gerr.Code == http.StatusNotFound || gerr.Code == http.StatusGone
+	}
+	var graphErr *GraphError
+	if errors.As(err, &graphErr) {
+		return graphErr.Status == http.StatusNotFound
+	}
+	var davErr *DAVError
+	if errors.As(err, &davErr) {
+		return davErr.Status == http.StatusNotFound
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package calendar
+
+import (
+	"time"
+
+	gcal "google.golang.org/api/calendar/v3"
+)
+
+// Event is a provider-neutral calendar event. Start and End are wall-clock
+// times in the calendar's location; all-day events start at local midnight.
+type Event struct {
+	ID          string
+	Summary     string
+	Description string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	// Status is confirmed or tentative.
+	Status string
+}
+
+// ListWindow is the range ListEvents covers: one month back, three ahead.
+func ListWindow(now time.Time) (time.Time, time.Time) {
+	return now.AddDate(0, -1, 0), now.AddDate(0, 3, 0)
+}
+
+func fromGoogleEvent(ev *gcal.Event, loc *time.Location) Event {
+	out := Event{
+		ID:          ev.Id,
+		Summary:     ev.Summary,
+		Description: ev.Description,
+		Location:    ev.Location,
+		Status:      ev.Status,
+	}
+	out.Start, out.AllDay = fromGoogleTime(ev.Start, loc)
+	out.End, _ = fromGoogleTime(ev.End, loc)
+	return out
+}
+
+func fromGoogleTime(t *gcal.EventDateTime, loc *time.Location) (time.Time, bool) {
+	if t == nil {
+		return time.Time{}, false
+	}
+	if t.DateTime != "" {
+		parsed, err := time.Parse(time.RFC3339, t.DateTime)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return parsed.In(loc), false
+	}
+	parsed, err := time.ParseInLocation("2006-01-02", t.Date, loc)
+	if err != nil {
+		return time.Time{}, true
+	}
+	return parsed, true
+}
@@ -0,0 +1,627 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	graphBaseURL = "https://graph.microsoft.com/v1.0"
+	// graphMasterProperty tags standalone events that stand in for RDATEs,
+	// which Graph series cannot hold, with the id of their series master.
+	graphMasterProperty = "String {6f1ac2f4-3a1e-4c3a-9a0e-2f4b8f5c7d21} Name FlexMasterEventID"
+	graphDateTimeLayout = "2006-01-02T15:04:05"
+)
+
+// GraphConfig configures the Microsoft Graph provider. Calendar IDs are the
+// mailbox (user or resource) addresses whose default calendar is used.
+type GraphConfig struct {
+	TenantID     string
+	ClientID     string
+	ClientSecret string
+	// BaseURL overrides the Graph endpoint, e.g. for a fake server.
+	BaseURL string
+	// HTTPClient overrides the OAuth client-credentials client.
+	HTTPClient *http.Client
+	MaxRetries int
+	Logger     *slog.Logger
+}
+
+// Graph publishes events through the Microsoft Graph events API. It uses the
+// app's Entra registration with the Calendars.ReadWrite application permission.
+type Graph struct {
+	client     *http.Client
+	baseURL    string
+	loc        time.Location
+	tz         string
+	logger     *slog.Logger
+	maxRetries int
+}
+
+func NewGraph(ctx context.Context, cfg GraphConfig, loc time.Location, tz string) *Graph {
+	client := cfg.HTTPClient
+	if client == nil {
+		cc := &clientcredentials.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			TokenURL:     "https://login.microsoftonline.com/" + url.PathEscape(cfg.TenantID) + "/oauth2/v2.0/token",
+			Scopes:       []string{"https://graph.microsoft.com/.default"},
+		}
+		client = cc.Client(ctx)
+	}
+	base := cfg.BaseURL
+	if base == "" {
+		base = graphBaseURL
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = 5
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	return &Graph{
+		client:     client,
+		baseURL:    base,
+		loc:        loc,
+		tz:         tz,
+		logger:     cfg.Logger.With("provider", ProviderMicrosoft),
+		maxRetries: cfg.MaxRetries,
+	}
+}
+
+// GraphError is a non-2xx response from Graph.
+type GraphError struct {
+	Status  int
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *GraphError) Error() string {
+	return fmt.Sprintf("graph: %d %s: %s", e.Status, e.Code, e.Message)
+}
+
+type graphDateTime struct {
+	DateTime string `json:"dateTime"`
+	TimeZone string `json:"timeZone"`
+}
+
+type graphBody struct {
+	ContentType string `json:"contentType"`
+	Content     string `json:"content"`
+}
+
+type graphLocation struct {
+	DisplayName string `json:"displayName"`
+}
+
+type graphExtendedProperty struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+type graphEvent struct {
+	ID                            string                  `json:"id,omitempty"`
+	Subject                       string                  `json:"subject,omitempty"`
+	Body                          *graphBody              `json:"body,omitempty"`
+	Start                         *graphDateTime          `json:"start,omitempty"`
+	End                           *graphDateTime          `json:"end,omitempty"`
+	Location                      *graphLocation          `json:"location,omitempty"`
+	IsAllDay                      bool                    `json:"isAllDay,omitempty"`
+	ShowAs                        string                  `json:"showAs,omitempty"`
+	Recurrence                    *graphRecurrence        `json:"recurrence,omitempty"`
+	Type                          string                  `json:"type,omitempty"`
+	SeriesMasterID                string                  `json:"seriesMasterId,omitempty"`
+	OriginalStart                 string                  `json:"originalStart,omitempty"`
+	SingleValueExtendedProperties []graphExtendedProperty `json:"singleValueExtendedProperties,omitempty"`
+}
+
+type graphEventList struct {
+	Value    []graphEvent `json:"value"`
+	NextLink string       `json:"@odata.nextLink"`
+}
+
+func (g *Graph) dateTime(t time.Time) *graphDateTime {
+	return &graphDateTime{DateTime: t.Format(graphDateTimeLayout), TimeZone: g.tz}
+}
+
+func (g *Graph) newEvent(summary, description, location string, start, end time.Time, allDay bool) graphEvent {
+	ev := graphEvent{
+		Subject:  summary,
+		Body:     &graphBody{ContentType: "text", Content: description},
+		Start:    g.dateTime(start),
+		End:      g.dateTime(end),
+		IsAllDay: allDay,
+	}
+	if allDay {
+		// Graph requires all-day events to start and end at midnight.
+		ev.Start = g.dateTime(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location()))
+		ev.End = g.dateTime(time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location()))
+	}
+	if location != "" {
+		ev.Location = &graphLocation{DisplayName: location}
+	}
+	return ev
+}
+
+func (g *Graph) parseDateTime(dt *graphDateTime) time.Time {
+	if dt == nil {
+		return time.Time{}
+	}
+	loc := &g.loc
+	if dt.TimeZone != "" && dt.TimeZone != g.tz {
+		if l, err := time.LoadLocation(dt.TimeZone); err == nil {
+			loc = l
+		}
+	}
+	// Graph returns seven fractional digits, which time.Parse accepts.
+	t, err := time.ParseInLocation(graphDateTimeLayout, dt.DateTime, loc)
+	if err != nil {
+		return time.Time{}
+	}
+	return t.In(&g.loc)
+}
+
+// toEvent converts a Graph event. Occurrences of a series take instance ids
+// built from their original start, as Google's do, since callers track them
+// by master and start rather than by Graph's opaque ids.
+func (g *Graph) toEvent(ev graphEvent) Event {
+	out := Event{
+		ID:      ev.ID,
+		Summary: ev.Subject,
+		Start:   g.parseDateTime(ev.Start),
+		End:     g.parseDateTime(ev.End),
+		AllDay:  ev.IsAllDay,
+	}
+	if start, ok := originalStart(ev); ok {
+		out.ID = InstanceID(ev.SeriesMasterID, start)
+	}
+	if ev.ShowAs == StatusTentative {
+		out.Status = StatusTentative
+	} else if ev.ShowAs != "" {
+		out.Status = StatusConfirmed
+	}
+	if ev.Body != nil {
+		out.Description = ev.Body.Content
+	}
+	if ev.Location != nil {
+		out.Location = ev.Location.DisplayName
+	}
+	return out
+}
+
+// originalStart is the start the recurrence gives an occurrence or
+// exception, which Graph reports in UTC.
+func originalStart(ev graphEvent) (time.Time, bool) {
+	if ev.SeriesMasterID == "" || ev.OriginalStart == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, ev.OriginalStart)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func (g *Graph) eventsPath(calendarID string) string {
+	return "/users/" + url.PathEscape(calendarID) + "/calendar/events"
+}
+
+func (g *Graph) eventPath(calendarID, eventID string) string {
+	return "/users/" + url.PathEscape(calendarID) + "/events/" + url.PathEscape(eventID)
+}
+
+// do sends one request, retrying throttled (429) and unavailable (503)
+// responses with the server's Retry-After or exponential backoff.
+func (g *Graph) do(ctx context.Context, method, path string, body, out any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+	target := path
+	if len(path) > 0 && path[0] == '/' {
+		target = g.baseURL + path
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= g.maxRetries; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		req.Header.Set("Prefer", fmt.Sprintf("outlook.timezone=%q, outlook.body-content-type=\"text\"", g.tz))
+
+		resp, err := g.client.Do(req)
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			if out != nil && len(data) > 0 {
+				return json.Unmarshal(data, out)
+			}
+			return nil
+		}
+
+		gerr := &GraphError{Status: resp.StatusCode}
+		var envelope struct {
+			Error *GraphError `json:"error"`
+		}
+		if json.Unmarshal(data, &envelope) == nil && envelope.Error != nil {
+			gerr.Code, gerr.Message = envelope.Error.Code, envelope.Error.Message
+		}
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+			return gerr
+		}
+		lastErr = gerr
+
+		backoff := time.Duration(1<<attempt) * 100 * time.Millisecond
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			backoff = time.Duration(secs) * time.Second
+		}
+		if backoff > 30*time.Second {
+			backoff = 30 * time.Second
+		}
+		g.logger.Warn("Retrying Graph call after throttling", "method", method, "attempt", attempt+1, "backoff", backoff.String())
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+	return fmt.Errorf("max retries exceeded for %s %s: %w", method, path, lastErr)
+}
+
+func (g *Graph) create(ctx context.Context, calendarID string, ev graphEvent) (string, error) {
+	var created graphEvent
+	if err := g.do(ctx, http.MethodPost, g.eventsPath(calendarID), ev, &created); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+func (g *Graph) list(ctx context.Context, path string) ([]graphEvent, error) {
+	var out []graphEvent
+	for path != "" {
+		var page graphEventList
+		if err := g.do(ctx, http.MethodGet, path, nil, &page); err != nil {
+			return nil, err
+		}
+		out = append(out, page.Value...)
+		path = page.NextLink
+	}
+	return out, nil
+}
+
+func (g *Graph) Publish(ctx context.Context, plan *PublishPlan, opts PublishOptions) (*PublishResult, error) {
+	if plan == nil {
+		return nil, fmt.Errorf("publish plan is nil")
+	}
+	if opts.CalendarID == "" {
+		return nil, fmt.Errorf("calendarID is required")
+	}
+	switch plan.Mode {
+	case ModeSeries:
+		if plan.Series == nil {
+			return nil, fmt.Errorf("missing series spec")
+		}
+		ev := g.newEvent(
+			firstNonEmpty(opts.Summary, plan.Series.Summary),
+			firstNonEmpty(opts.Description, plan.Series.Description),
+			firstNonEmpty(opts.Location, plan.Series.Location),
+			plan.Series.Start, plan.Series.End, false,
+		)
+		rec, err := toGraphRecurrence(plan.Series.RRULE, plan.Series.Start, &g.loc, g.tz)
+		if err != nil {
+			return nil, err
+		}
+		ev.Recurrence = rec
+		ev.ShowAs = showAs(opts)
+		id, err := g.create(ctx, opts.CalendarID, ev)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.cancelOccurrences(ctx, opts.CalendarID, id, plan.Series.EXDATEs); err != nil {
+			return &PublishResult{MasterEventID: &id}, fmt.Errorf("apply exceptions: %w", err)
+		}
+		return &PublishResult{MasterEventID: &id}, nil
+
+	case ModeSingles:
+		if len(plan.Singles) == 0 {
+			return &PublishResult{SingleEventID: map[int64]string{}}, nil
+		}
+		result := &PublishResult{
+			SingleEventID: make(map[int64]string, len(plan.Singles)),
+			EventIDs:      make([]string, 0, len(plan.Singles)),
+		}
+		for i, oc := range plan.Singles {
+			ev := g.newEvent(
+				firstNonEmpty(oc.Summary, opts.Summary),
+				firstNonEmpty(oc.Description, opts.Description),
+				firstNonEmpty(oc.Location, opts.Location),
+				oc.Start, oc.End, oc.AllDay,
+			)
+			ev.ShowAs = showAs(opts)
+			id, err := g.create(ctx, opts.CalendarID, ev)
+			if err != nil {
+				return result, fmt.Errorf("failed to create event: %d: %w", i, err)
+			}
+			result.EventIDs = append(result.EventIDs, id)
+			if oc.RefID != 0 {
+				result.SingleEventID[oc.RefID] = id
+			}
+		}
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("unknown mode: %s", plan.Mode)
+	}
+}
+
+func (g *Graph) ListEvents(ctx context.Context, calendarID string) ([]Event, error) {
+	from, to := ListWindow(time.Now())
+	return g.ListEventsBetween(ctx, calendarID, from, to)
+}
+
+// ListEventsBetween lists the calendar view of [from, to), which expands
+// series into occurrences, following every page.
+func (g *Graph) ListEventsBetween(ctx context.Context, calendarID string, from, to time.Time) ([]Event, error) {
+	q := url.Values{}
+	q.Set("startDateTime", from.UTC().Format(time.RFC3339))
+	q.Set("endDateTime", to.UTC().Format(time.RFC3339))
+	q.Set("$top", "250")
+	q.Set("$orderby", "start/dateTime")
+	items, err := g.list(ctx, "/users/"+url.PathEscape(calendarID)+"/calendarView?"+q.Encode())
+	if err != nil {
+		return nil, err
+	}
+	events := make([]Event, 0, len(items))
+	for _, it := range items {
+		events = append(events, g.toEvent(it))
+	}
+	return events, nil
+}
+
+// DeleteEvent deletes an event or occurrence. Deleting a series master also
+// removes the standalone events created for its RDATEs.
+func (g *Graph) DeleteEvent(ctx context.Context, calendarID string, eventID string) error {
+	id, err := g.resolve(ctx, calendarID, eventID)
+	if err != nil {
+		return err
+	}
+	if err := g.do(ctx, http.MethodDelete, g.eventPath(calendarID, id), nil, nil); err != nil {
+		return err
+	}
+	if id != eventID {
+		return nil
+	}
+	if err := g.deleteRdateEvents(ctx, calendarID, eventID); err != nil {
+		g.logger.Warn("Failed to delete added dates of event", "event_id", eventID, "error", err)
+	}
+	return nil
+}
+
+// GetEvent fetches one event, occurrence or series master.
+func (g *Graph) GetEvent(ctx context.Context, calendarID string, eventID string) (*Event, error) {
+	id, err := g.resolve(ctx, calendarID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	var out graphEvent
+	if err := g.do(ctx, http.MethodGet, g.eventPath(calendarID, id), nil, &out); err != nil {
+		if IsNotFound(err) {
+			return nil, ErrEventNotFound
+		}
+		return nil, err
+	}
+	ev := g.toEvent(out)
+	return &ev, nil
+}
+
+// showAs marks tentative holds as tentative free/busy, which Outlook draws
+// hatched. Other events keep Graph's default of busy.
+func showAs(opts PublishOptions) string {
+	if opts.Tentative {
+		return StatusTentative
+	}
+	return ""
+}
+
+// graphEventPatch always sends isAllDay, which graphEvent omits when false,
+// so an update can turn an all-day event back into a timed one.
+type graphEventPatch struct {
+	graphEvent
+	IsAllDay bool `json:"isAllDay"`
+}
+
+// UpdateEvent replaces the times and text of an event or occurrence.
+func (g *Graph) UpdateEvent(ctx context.Context, calendarID string, ev Event) error {
+	patch := graphEventPatch{
+		graphEvent: g.newEvent(ev.Summary, ev.Description, ev.Location, ev.Start, ev.End, ev.AllDay),
+		IsAllDay:   ev.AllDay,
+	}
+	if patch.Location == nil {
+		patch.Location = &graphLocation{}
+	}
+	switch ev.Status {
+	case StatusTentative:
+		patch.ShowAs = StatusTentative
+	case StatusConfirmed:
+		patch.ShowAs = "busy"
+	}
+	id, err := g.resolve(ctx, calendarID, ev.ID)
+	if err != nil {
+		return err
+	}
+	return g.do(ctx, http.MethodPatch, g.eventPath(calendarID, id), patch, nil)
+}
+
+// resolve turns an instance id into the Graph id of the occurrence, found
+// among the master's instances by its original start. Graph has no
+// "<master>_<start>" ids of its own, so other ids are used as they are. The
+// lookup covers the list window around the original start, so an occurrence
+// that was moved within it is still found.
+func (g *Graph) resolve(ctx context.Context, calendarID, eventID string) (string, error) {
+	masterID, start, ok := SplitInstanceID(eventID)
+	if !ok {
+		return eventID, nil
+	}
+	from, to := ListWindow(start)
+	q := url.Values{}
+	q.Set("startDateTime", from.UTC().Format(time.RFC3339))
+	q.Set("endDateTime", to.UTC().Format(time.RFC3339))
+	q.Set("$top", "250")
+	q.Set("$select", "id,seriesMasterId,originalStart")
+	instances, err := g.list(ctx, g.eventPath(calendarID, masterID)+"/instances?"+q.Encode())
+	if err != nil {
+		if IsNotFound(err) {
+			return "", ErrEventNotFound
+		}
+		return "", fmt.Errorf("instances lookup: %w", err)
+	}
+	for _, inst := range instances {
+		if original, ok := originalStart(inst); ok && original.Equal(start) {
+			return inst.ID, nil
+		}
+	}
+	return "", ErrEventNotFound
+}
+
+// AddExdatesToMaster re-applies the master's recurrence and cancels the
+// occurrences at exdates. Graph restores cancelled occurrences when the
+// pattern changes, so the full list is applied every time.
+func (g *Graph) AddExdatesToMaster(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time) error {
+	if err := g.patchRecurrence(ctx, calendarID, masterEventID, rrule); err != nil {
+		return err
+	}
+	return g.cancelOccurrences(ctx, calendarID, masterEventID, exdates)
+}
+
+// AddRdatesWithOverrides applies the recurrence and exceptions, then creates
+// one standalone event per added date, since Graph series cannot hold RDATEs.
+// Events from earlier calls are replaced, matching Google's RDATE patch.
+func (g *Graph) AddRdatesWithOverrides(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time, adds []RDateSpec) error {
+	if len(adds) == 0 {
+		return nil
+	}
+	if err := g.AddExdatesToMaster(ctx, calendarID, masterEventID, rrule, exdates); err != nil {
+		return err
+	}
+	var master graphEvent
+	if err := g.do(ctx, http.MethodGet, g.eventPath(calendarID, masterEventID), nil, &master); err != nil {
+		return fmt.Errorf("get master: %w", err)
+	}
+	if err := g.deleteRdateEvents(ctx, calendarID, masterEventID); err != nil {
+		return err
+	}
+	for _, spec := range adds {
+		ev := g.newEvent(
+			firstNonEmpty(spec.Summary, master.Subject),
+			spec.Description,
+			spec.Location,
+			spec.Start.In(&g.loc), spec.End.In(&g.loc), false,
+		)
+		if ev.Body.Content == "" && master.Body != nil {
+			ev.Body = master.Body
+		}
+		if ev.Location == nil {
+			ev.Location = master.Location
+		}
+		ev.SingleValueExtendedProperties = []graphExtendedProperty{{ID: graphMasterProperty, Value: masterEventID}}
+		if _, err := g.create(ctx, calendarID, ev); err != nil {
+			return fmt.Errorf("create added date: %w", err)
+		}
+	}
+	return nil
+}
+
+func (g *Graph) patchRecurrence(ctx context.Context, calendarID, masterEventID, rrule string) error {
+	if rrule == "" {
+		return nil
+	}
+	var master graphEvent
+	if err := g.do(ctx, http.MethodGet, g.eventPath(calendarID, masterEventID), nil, &master); err != nil {
+		return fmt.Errorf("get master: %w", err)
+	}
+	rec, err := toGraphRecurrence(rrule, g.parseDateTime(master.Start), &g.loc, g.tz)
+	if err != nil {
+		return err
+	}
+	return g.do(ctx, http.MethodPatch, g.eventPath(calendarID, masterEventID), graphEvent{Recurrence: rec}, nil)
+}
+
+// cancelOccurrences deletes the series occurrences starting at the given
+// wall-clock times.
+func (g *Graph) cancelOccurrences(ctx context.Context, calendarID, masterEventID string, starts []time.Time) error {
+	if len(starts) == 0 {
+		return nil
+	}
+	sorted := append([]time.Time(nil), starts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+	want := make(map[string]bool, len(sorted))
+	for _, s := range sorted {
+		want[s.Format(graphDateTimeLayout)] = true
+	}
+	from := wallIn(sorted[0], &g.loc).AddDate(0, 0, -1)
+	to := wallIn(sorted[len(sorted)-1], &g.loc).AddDate(0, 0, 1)
+
+	q := url.Values{}
+	q.Set("startDateTime", from.UTC().Format(time.RFC3339))
+	q.Set("endDateTime", to.UTC().Format(time.RFC3339))
+	q.Set("$top", "250")
+	instances, err := g.list(ctx, g.eventPath(calendarID, masterEventID)+"/instances?"+q.Encode())
+	if err != nil {
+		return fmt.Errorf("instances lookup: %w", err)
+	}
+	for _, inst := range instances {
+		if !want[g.parseDateTime(inst.Start).Format(graphDateTimeLayout)] {
+			continue
+		}
+		if err := g.do(ctx, http.MethodDelete, g.eventPath(calendarID, inst.ID), nil, nil); err != nil {
+			return fmt.Errorf("cancel occurrence: %w", err)
+		}
+	}
+	return nil
+}
+
+func (g *Graph) deleteRdateEvents(ctx context.Context, calendarID, masterEventID string) error {
+	q := url.Values{}
+	q.Set("$filter", fmt.Sprintf("singleValueExtendedProperties/Any(ep: ep/id eq '%s' and ep/value eq '%s')", graphMasterProperty, masterEventID))
+	q.Set("$select", "id")
+	extras, err := g.list(ctx, "/users/"+url.PathEscape(calendarID)+"/events?"+q.Encode())
+	if err != nil {
+		return fmt.Errorf("find added dates: %w", err)
+	}
+	for _, ev := range extras {
+		if err := g.do(ctx, http.MethodDelete, g.eventPath(calendarID, ev.ID), nil, nil); err != nil {
+			return fmt.Errorf("delete added date: %w", err)
+		}
+	}
+	return nil
+}
+
+// wallIn keeps t's wall clock but places it in loc.
+func wallIn(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
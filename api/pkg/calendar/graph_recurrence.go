@@ -0,0 +1,129 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// graphRecurrence is Microsoft Graph's patternedRecurrence resource.
+type graphRecurrence struct {
+	Pattern graphPattern `json:"pattern"`
+	Range   graphRange   `json:"range"`
+}
+
+type graphPattern struct {
+	Type           string   `json:"type"`
+	Interval       int      `json:"interval"`
+	DaysOfWeek     []string `json:"daysOfWeek,omitempty"`
+	DayOfMonth     int      `json:"dayOfMonth,omitempty"`
+	Month          int      `json:"month,omitempty"`
+	Index          string   `json:"index,omitempty"`
+	FirstDayOfWeek string   `json:"firstDayOfWeek,omitempty"`
+}
+
+type graphRange struct {
+	Type                string `json:"type"`
+	StartDate           string `json:"startDate"`
+	EndDate             string `json:"endDate,omitempty"`
+	NumberOfOccurrences int    `json:"numberOfOccurrences,omitempty"`
+	RecurrenceTimeZone  string `json:"recurrenceTimeZone,omitempty"`
+}
+
+// graphWeekdays is indexed by rrule's weekday numbering (Monday = 0).
+var graphWeekdays = [...]string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+var graphWeekIndex = map[int]string{1: "first", 2: "second", 3: "third", 4: "fourth", -1: "last"}
+
+// toGraphRecurrence converts an RRULE into Graph's pattern/range form. Graph
+// has no EXDATE or RDATE; those are applied to individual occurrences.
+func toGraphRecurrence(rr string, start time.Time, loc *time.Location, tz string) (*graphRecurrence, error) {
+	clean := strings.TrimSpace(normalizeRRule(rr))
+	if hasPrefixCaseInsensitive(clean, "RRULE:") {
+		clean = clean[len("RRULE:"):]
+	}
+	opt, err := rrule.StrToROptionInLocation(clean, loc)
+	if err != nil {
+		return nil, fmt.Errorf("parse rrule: %w", err)
+	}
+	interval := opt.Interval
+	if interval <= 0 {
+		interval = 1
+	}
+	p := graphPattern{Interval: interval}
+
+	switch opt.Freq {
+	case rrule.DAILY:
+		p.Type = "daily"
+	case rrule.WEEKLY:
+		p.Type = "weekly"
+		p.FirstDayOfWeek = "sunday"
+		p.DaysOfWeek = graphDays(opt.Byweekday)
+		if len(p.DaysOfWeek) == 0 {
+			p.DaysOfWeek = []string{graphDay(start.Weekday())}
+		}
+	case rrule.MONTHLY:
+		switch {
+		case len(opt.Bymonthday) > 0:
+			p.Type = "absoluteMonthly"
+			p.DayOfMonth = opt.Bymonthday[0]
+		case len(opt.Byweekday) > 0:
+			p.Type = "relativeMonthly"
+			p.DaysOfWeek = graphDays(opt.Byweekday)
+			n := opt.Byweekday[0].N()
+			if n == 0 && len(opt.Bysetpos) > 0 {
+				n = opt.Bysetpos[0]
+			}
+			idx, ok := graphWeekIndex[n]
+			if !ok {
+				return nil, fmt.Errorf("unsupported monthly position %d", n)
+			}
+			p.Index = idx
+		default:
+			p.Type = "absoluteMonthly"
+			p.DayOfMonth = start.Day()
+		}
+	case rrule.YEARLY:
+		p.Type = "absoluteYearly"
+		p.Month = int(start.Month())
+		p.DayOfMonth = start.Day()
+		if len(opt.Bymonth) > 0 {
+			p.Month = opt.Bymonth[0]
+		}
+		if len(opt.Bymonthday) > 0 {
+			p.DayOfMonth = opt.Bymonthday[0]
+		}
+	default:
+		return nil, fmt.Errorf("unsupported frequency %s", opt.Freq)
+	}
+
+	r := graphRange{
+		Type:               "noEnd",
+		StartDate:          start.Format("2006-01-02"),
+		RecurrenceTimeZone: tz,
+	}
+	switch {
+	case !opt.Until.IsZero():
+		r.Type = "endDate"
+		r.EndDate = opt.Until.In(loc).Format("2006-01-02")
+	case opt.Count > 0:
+		r.Type = "numbered"
+		r.NumberOfOccurrences = opt.Count
+	}
+	return &graphRecurrence{Pattern: p, Range: r}, nil
+}
+
+func graphDays(days []rrule.Weekday) []string {
+	out := make([]string, 0, len(days))
+	for _, d := range days {
+		out = append(out, graphWeekdays[d.Day()])
+	}
+	return out
+}
+
+func graphDay(d time.Weekday) string {
+	// time.Weekday counts from Sunday; rrule and Graph tables from Monday.
+	return graphWeekdays[(int(d)+6)%7]
+}
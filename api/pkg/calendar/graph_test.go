@@ -0,0 +1,512 @@
+package calendar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testGraphCalendar = "room@example.com"
+	testTZ            = "America/New_York"
+)
+
+// graphCall is one request the fake Graph server received.
+type graphCall struct {
+	method string
+	path   string
+	query  url.Values
+	body   map[string]any
+}
+
+// fakeGraph answers "METHOD /path" with the JSON in routes, or with what a
+// func(graphCall) any route returns. A nil response is a 204; a request
+// without a route gets Graph's 404.
+type fakeGraph struct {
+	mu     sync.Mutex
+	routes map[string]any
+	calls  []graphCall
+}
+
+func (f *fakeGraph) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	call := graphCall{method: r.Method, path: r.URL.Path, query: r.URL.Query()}
+	if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+		if err := json.Unmarshal(data, &call.body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	f.mu.Lock()
+	f.calls = append(f.calls, call)
+	resp, ok := f.routes[r.Method+" "+r.URL.Path]
+	f.mu.Unlock()
+	if fn, isFunc := resp.(func(graphCall) any); isFunc {
+		resp = fn(call)
+	}
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":"ErrorItemNotFound","message":"The specified object was not found in the store."}}`))
+		return
+	}
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// requests lists the calls as "METHOD /path", in order.
+func (f *fakeGraph) requests() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make([]string, len(f.calls))
+	for i, c := range f.calls {
+		out[i] = c.method + " " + c.path
+	}
+	return out
+}
+
+func (f *fakeGraph) call(t *testing.T, request string) graphCall {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, c := range f.calls {
+		if c.method+" "+c.path == request {
+			return c
+		}
+	}
+	t.Fatalf("no %s request", request)
+	return graphCall{}
+}
+
+func newTestGraph(t *testing.T, routes map[string]any) (*Graph, *fakeGraph) {
+	t.Helper()
+	fake := &fakeGraph{routes: routes}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	g := NewGraph(context.Background(), GraphConfig{
+		BaseURL:    srv.URL,
+		HTTPClient: srv.Client(),
+		Logger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}, *testLocation(t), testTZ)
+	return g, fake
+}
+
+func testLocation(t *testing.T) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(testTZ)
+	if err != nil {
+		t.Skipf("time zone data: %v", err)
+	}
+	return loc
+}
+
+func graphPath(parts ...string) string {
+	path := "/users/" + testGraphCalendar
+	for _, p := range parts {
+		path += "/" + p
+	}
+	return path
+}
+
+// graphInstance is an occurrence as the instances endpoint returns it.
+func graphInstance(id, masterID string, start time.Time) map[string]any {
+	return map[string]any{
+		"id":             id,
+		"type":           "occurrence",
+		"seriesMasterId": masterID,
+		"originalStart":  start.UTC().Format("2006-01-02T15:04:05.0000000Z"),
+		"start":          map[string]string{"dateTime": start.Format("2006-01-02T15:04:05.0000000"), "timeZone": testTZ},
+		"end":            map[string]string{"dateTime": start.Add(time.Hour).Format("2006-01-02T15:04:05.0000000"), "timeZone": testTZ},
+	}
+}
+
+func graphList(items ...map[string]any) map[string]any {
+	return map[string]any{"value": items}
+}
+
+func field(t *testing.T, body map[string]any, path ...string) any {
+	t.Helper()
+	var cur any = body
+	for _, key := range path {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			t.Fatalf("%v: %v is not an object", path, cur)
+		}
+		cur = m[key]
+	}
+	return cur
+}
+
+func TestGraphPublishSeries(t *testing.T) {
+	loc := testLocation(t)
+	start := time.Date(2026, 1, 5, 10, 0, 0, 0, loc)
+	const master = "AAMk_master=="
+	g, fake := newTestGraph(t, map[string]any{
+		"POST " + graphPath("calendar", "events"): map[string]any{"id": master},
+		"GET " + graphPath("events", master, "instances"): graphList(
+			graphInstance("occ-1", master, start),
+			graphInstance("occ-2", master, start.AddDate(0, 0, 7)),
+			graphInstance("occ-3", master, start.AddDate(0, 0, 14)),
+		),
+		"DELETE " + graphPath("events", "occ-2"): nil,
+	})
+
+	res, err := g.Publish(context.Background(), &PublishPlan{
+		Mode: ModeSeries,
+		Series: &SeriesSpec{
+			Start:   start,
+			End:     start.Add(time.Hour),
+			RRULE:   "RRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=3",
+			EXDATEs: []time.Time{start.AddDate(0, 0, 7)},
+			Summary: "Rehearsal",
+		},
+	}, PublishOptions{CalendarID: testGraphCalendar, Location: "Main Hall"})
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if res.MasterEventID == nil || *res.MasterEventID != master {
+		t.Fatalf("master id = %v, want %q", res.MasterEventID, master)
+	}
+
+	want := []string{
+		"POST " + graphPath("calendar", "events"),
+		"GET " + graphPath("events", master, "instances"),
+		"DELETE " + graphPath("events", "occ-2"),
+	}
+	if got := fake.requests(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+	created := fake.call(t, want[0]).body
+	for _, c := range []struct {
+		path []string
+		want any
+	}{
+		{[]string{"subject"}, "Rehearsal"},
+		{[]string{"location", "displayName"}, "Main Hall"},
+		{[]string{"start", "dateTime"}, "2026-01-05T10:00:00"},
+		{[]string{"start", "timeZone"}, testTZ},
+		{[]string{"recurrence", "pattern", "type"}, "weekly"},
+		{[]string{"recurrence", "pattern", "daysOfWeek"}, []any{"monday"}},
+		{[]string{"recurrence", "range", "type"}, "numbered"},
+		{[]string{"recurrence", "range", "numberOfOccurrences"}, float64(3)},
+		{[]string{"recurrence", "range", "startDate"}, "2026-01-05"},
+	} {
+		if got := field(t, created, c.path...); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%v = %v, want %v", c.path, got, c.want)
+		}
+	}
+}
+
+func TestGraphPublishSingles(t *testing.T) {
+	loc := testLocation(t)
+	first := time.Date(2026, 3, 2, 9, 30, 0, 0, loc)
+	created := 0
+	g, fake := newTestGraph(t, map[string]any{
+		"POST " + graphPath("calendar", "events"): func(graphCall) any {
+			created++
+			return map[string]any{"id": fmt.Sprintf("ev-%d", created)}
+		},
+	})
+
+	res, err := g.Publish(context.Background(), &PublishPlan{
+		Mode: ModeSingles,
+		Singles: []OccSpec{
+			{Start: first, End: first.Add(2 * time.Hour), RefID: 11},
+			{Start: time.Date(2026, 3, 3, 8, 0, 0, 0, loc), End: time.Date(2026, 3, 4, 17, 0, 0, 0, loc), RefID: 12, Summary: "Move in", AllDay: true},
+		},
+	}, PublishOptions{CalendarID: testGraphCalendar, Summary: "Gala", Tentative: true})
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if want := map[int64]string{11: "ev-1", 12: "ev-2"}; !reflect.DeepEqual(res.SingleEventID, want) {
+		t.Fatalf("single ids = %v, want %v", res.SingleEventID, want)
+	}
+	if want := []string{"ev-1", "ev-2"}; !reflect.DeepEqual(res.EventIDs, want) {
+		t.Fatalf("event ids = %v, want %v", res.EventIDs, want)
+	}
+
+	fake.mu.Lock()
+	calls := append([]graphCall(nil), fake.calls...)
+	fake.mu.Unlock()
+	if len(calls) != 2 {
+		t.Fatalf("got %d requests, want 2", len(calls))
+	}
+	timed, allDay := calls[0].body, calls[1].body
+	if got := field(t, timed, "subject"); got != "Gala" {
+		t.Errorf("subject = %v, want the default summary", got)
+	}
+	if got := field(t, timed, "showAs"); got != StatusTentative {
+		t.Errorf("showAs = %v, want tentative", got)
+	}
+	if got := field(t, timed, "start", "dateTime"); got != "2026-03-02T09:30:00" {
+		t.Errorf("start = %v", got)
+	}
+	if _, ok := timed["recurrence"]; ok {
+		t.Errorf("single event carries a recurrence")
+	}
+	if got := field(t, allDay, "subject"); got != "Move in" {
+		t.Errorf("subject = %v, want the occurrence's own", got)
+	}
+	if got := field(t, allDay, "isAllDay"); got != true {
+		t.Errorf("isAllDay = %v", got)
+	}
+	if got := field(t, allDay, "start", "dateTime"); got != "2026-03-03T00:00:00" {
+		t.Errorf("all-day start = %v, want midnight", got)
+	}
+	if got := field(t, allDay, "end", "dateTime"); got != "2026-03-04T00:00:00" {
+		t.Errorf("all-day end = %v, want midnight", got)
+	}
+}
+
+func TestGraphUpdateEvent(t *testing.T) {
+	loc := testLocation(t)
+	g, fake := newTestGraph(t, map[string]any{
+		"PATCH " + graphPath("events", "ev-1"): nil,
+	})
+	start := time.Date(2026, 4, 10, 18, 0, 0, 0, loc)
+	err := g.UpdateEvent(context.Background(), testGraphCalendar, Event{
+		ID:      "ev-1",
+		Summary: "Recital",
+		Start:   start,
+		End:     start.Add(90 * time.Minute),
+		Status:  StatusConfirmed,
+	})
+	if err != nil {
+		t.Fatalf("UpdateEvent: %v", err)
+	}
+	patch := fake.call(t, "PATCH "+graphPath("events", "ev-1")).body
+	if got, ok := patch["isAllDay"]; !ok || got != false {
+		t.Errorf("isAllDay = %v (sent %v), want false sent", got, ok)
+	}
+	if got := field(t, patch, "showAs"); got != "busy" {
+		t.Errorf("showAs = %v, want busy", got)
+	}
+	if got := field(t, patch, "location", "displayName"); got != "" {
+		t.Errorf("location = %v, want cleared", got)
+	}
+	if got := field(t, patch, "end", "dateTime"); got != "2026-04-10T19:30:00" {
+		t.Errorf("end = %v", got)
+	}
+}
+
+func TestGraphUpdateEventInstance(t *testing.T) {
+	loc := testLocation(t)
+	const master = "AAMk_series_1=="
+	original := time.Date(2026, 2, 9, 10, 0, 0, 0, loc)
+	g, fake := newTestGraph(t, map[string]any{
+		"GET " + graphPath("events", master, "instances"): graphList(
+			graphInstance("occ-a", master, original.AddDate(0, 0, -7)),
+			graphInstance("occ-b", master, original),
+		),
+		"PATCH " + graphPath("events", "occ-b"): nil,
+	})
+	moved := original.Add(3 * time.Hour)
+	err := g.UpdateEvent(context.Background(), testGraphCalendar, Event{
+		ID:    InstanceID(master, original),
+		Start: moved,
+		End:   moved.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("UpdateEvent: %v", err)
+	}
+	want := []string{
+		"GET " + graphPath("events", master, "instances"),
+		"PATCH " + graphPath("events", "occ-b"),
+	}
+	if got := fake.requests(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+	q := fake.call(t, want[0]).query
+	from, to := ListWindow(original.UTC())
+	if q.Get("startDateTime") != from.UTC().Format(time.RFC3339) || q.Get("endDateTime") != to.UTC().Format(time.RFC3339) {
+		t.Errorf("instances window = %s..%s", q.Get("startDateTime"), q.Get("endDateTime"))
+	}
+	if got := field(t, fake.call(t, want[1]).body, "start", "dateTime"); got != "2026-02-09T13:00:00" {
+		t.Errorf("start = %v", got)
+	}
+}
+
+func TestGraphUpdateEventMissingInstance(t *testing.T) {
+	loc := testLocation(t)
+	const master = "master-1"
+	original := time.Date(2026, 2, 9, 10, 0, 0, 0, loc)
+	g, fake := newTestGraph(t, map[string]any{
+		"GET " + graphPath("events", master, "instances"): graphList(graphInstance("occ-a", master, original.AddDate(0, 0, 7))),
+	})
+	err := g.UpdateEvent(context.Background(), testGraphCalendar, Event{ID: InstanceID(master, original), Start: original, End: original.Add(time.Hour)})
+	if !errors.Is(err, ErrEventNotFound) {
+		t.Fatalf("err = %v, want ErrEventNotFound", err)
+	}
+	if got := fake.requests(); len(got) != 1 {
+		t.Fatalf("requests = %v, want only the lookup", got)
+	}
+
+	g, _ = newTestGraph(t, map[string]any{})
+	err = g.UpdateEvent(context.Background(), testGraphCalendar, Event{ID: InstanceID("gone", original), Start: original, End: original.Add(time.Hour)})
+	if !errors.Is(err, ErrEventNotFound) {
+		t.Fatalf("err = %v for a deleted master, want ErrEventNotFound", err)
+	}
+}
+
+func TestGraphDeleteEvent(t *testing.T) {
+	g, fake := newTestGraph(t, map[string]any{
+		"DELETE " + graphPath("events", "master-1"): nil,
+		"GET " + graphPath("events"):                graphList(map[string]any{"id": "extra-1"}),
+		"DELETE " + graphPath("events", "extra-1"):  nil,
+	})
+	if err := g.DeleteEvent(context.Background(), testGraphCalendar, "master-1"); err != nil {
+		t.Fatalf("DeleteEvent: %v", err)
+	}
+	want := []string{
+		"DELETE " + graphPath("events", "master-1"),
+		"GET " + graphPath("events"),
+		"DELETE " + graphPath("events", "extra-1"),
+	}
+	if got := fake.requests(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+	if filter := fake.call(t, want[1]).query.Get("$filter"); filter != "singleValueExtendedProperties/Any(ep: ep/id eq '"+graphMasterProperty+"' and ep/value eq 'master-1')" {
+		t.Errorf("filter = %s", filter)
+	}
+}
+
+func TestGraphDeleteEventInstance(t *testing.T) {
+	loc := testLocation(t)
+	const master = "AAMk_series_1=="
+	start := time.Date(2026, 5, 4, 19, 0, 0, 0, loc)
+	g, fake := newTestGraph(t, map[string]any{
+		"GET " + graphPath("events", master, "instances"): graphList(graphInstance("occ-1", master, start)),
+		"DELETE " + graphPath("events", "occ-1"):          nil,
+	})
+	if err := g.DeleteEvent(context.Background(), testGraphCalendar, InstanceID(master, start)); err != nil {
+		t.Fatalf("DeleteEvent: %v", err)
+	}
+	want := []string{
+		"GET " + graphPath("events", master, "instances"),
+		"DELETE " + graphPath("events", "occ-1"),
+	}
+	if got := fake.requests(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+
+	g, _ = newTestGraph(t, map[string]any{})
+	if err := g.DeleteEvent(context.Background(), testGraphCalendar, "ev-gone"); !IsNotFound(err) {
+		t.Fatalf("err = %v, want not found", err)
+	}
+}
+
+func TestGraphGetEventInstance(t *testing.T) {
+	loc := testLocation(t)
+	const master = "AAMk_series_1=="
+	start := time.Date(2026, 5, 4, 19, 0, 0, 0, loc)
+	occ := graphInstance("occ-1", master, start)
+	occ["subject"] = "Choir"
+	g, _ := newTestGraph(t, map[string]any{
+		"GET " + graphPath("events", master, "instances"): graphList(occ),
+		"GET " + graphPath("events", "occ-1"):             occ,
+	})
+	ev, err := g.GetEvent(context.Background(), testGraphCalendar, InstanceID(master, start))
+	if err != nil {
+		t.Fatalf("GetEvent: %v", err)
+	}
+	if ev.ID != InstanceID(master, start) {
+		t.Errorf("id = %q, want the instance id", ev.ID)
+	}
+	if ev.Summary != "Choir" || !ev.Start.Equal(start) || !ev.End.Equal(start.Add(time.Hour)) {
+		t.Errorf("event = %+v", ev)
+	}
+
+	if _, err := g.GetEvent(context.Background(), testGraphCalendar, "ev-gone"); !errors.Is(err, ErrEventNotFound) {
+		t.Fatalf("err = %v, want ErrEventNotFound", err)
+	}
+}
+
+func TestGraphListEventsInstanceIDs(t *testing.T) {
+	loc := testLocation(t)
+	const master = "AAMk_series_1=="
+	start := time.Date(2026, 5, 4, 19, 0, 0, 0, loc)
+	g, _ := newTestGraph(t, map[string]any{
+		"GET " + graphPath("calendarView"): graphList(
+			graphInstance("occ-1", master, start),
+			map[string]any{"id": "AAMk_single_2==", "type": "singleInstance", "subject": "Talk"},
+		),
+	})
+	events, err := g.ListEventsBetween(context.Background(), testGraphCalendar, start.AddDate(0, 0, -1), start.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListEventsBetween: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	if events[0].ID != InstanceID(master, start) {
+		t.Errorf("occurrence id = %q, want %q", events[0].ID, InstanceID(master, start))
+	}
+	if m, s, ok := SplitInstanceID(events[0].ID); !ok || m != master || !s.Equal(start) {
+		t.Errorf("split occurrence id = %q, %v, %v", m, s, ok)
+	}
+	if events[1].ID != "AAMk_single_2==" {
+		t.Errorf("single id = %q, want it kept", events[1].ID)
+	}
+	if _, _, ok := SplitInstanceID(events[1].ID); ok {
+		t.Errorf("single id %q taken for an instance id", events[1].ID)
+	}
+}
+
+func TestGraphAddExdatesToMaster(t *testing.T) {
+	loc := testLocation(t)
+	const master = "master-1"
+	start := time.Date(2026, 6, 1, 10, 0, 0, 0, loc)
+	g, fake := newTestGraph(t, map[string]any{
+		"GET " + graphPath("events", master): map[string]any{
+			"id":    master,
+			"type":  "seriesMaster",
+			"start": map[string]string{"dateTime": "2026-06-01T10:00:00.0000000", "timeZone": testTZ},
+		},
+		"PATCH " + graphPath("events", master): nil,
+		"GET " + graphPath("events", master, "instances"): graphList(
+			graphInstance("occ-1", master, start.AddDate(0, 0, 1)),
+			graphInstance("occ-2", master, start.AddDate(0, 0, 2)),
+			graphInstance("occ-3", master, start.AddDate(0, 0, 3)),
+		),
+		"DELETE " + graphPath("events", "occ-1"): nil,
+		"DELETE " + graphPath("events", "occ-3"): nil,
+	})
+	err := g.AddExdatesToMaster(context.Background(), testGraphCalendar, master, "FREQ=DAILY;UNTIL=20260630T140000Z",
+		[]time.Time{start.AddDate(0, 0, 3), start.AddDate(0, 0, 1)})
+	if err != nil {
+		t.Fatalf("AddExdatesToMaster: %v", err)
+	}
+	want := []string{
+		"GET " + graphPath("events", master),
+		"PATCH " + graphPath("events", master),
+		"GET " + graphPath("events", master, "instances"),
+		"DELETE " + graphPath("events", "occ-1"),
+		"DELETE " + graphPath("events", "occ-3"),
+	}
+	if got := fake.requests(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("requests = %v, want %v", got, want)
+	}
+	patch := fake.call(t, want[1]).body
+	if got := field(t, patch, "recurrence", "pattern", "type"); got != "daily" {
+		t.Errorf("pattern = %v, want daily", got)
+	}
+	if got := field(t, patch, "recurrence", "range", "endDate"); got != "2026-06-30" {
+		t.Errorf("end date = %v", got)
+	}
+	if _, ok := patch["subject"]; ok {
+		t.Errorf("recurrence patch rewrites the subject")
+	}
+}
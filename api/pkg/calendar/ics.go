@@ -0,0 +1,354 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	icsDateTime = "20060102T150405"
+	icsDate     = "20060102"
+	icsProdID   = "-//FlexFacilities//Calendar//EN"
+)
+
+// ICSEvent is one VEVENT. Times are wall-clock times in the calendar's
+// location. Overrides of a series occurrence carry the master's UID and the
+// occurrence's original start in RecurrenceID.
+type ICSEvent struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	Start        time.Time
+	End          time.Time
+	AllDay       bool
+	Recurrence   []string
+	RecurrenceID time.Time
+	Status       string
+	Stamp        time.Time
+}
+
+// IsOverride reports whether the event replaces one occurrence of a series.
+func (e ICSEvent) IsOverride() bool {
+	return !e.RecurrenceID.IsZero()
+}
+
+// WriteICS writes a VCALENDAR holding events, with a VTIMEZONE for tz so
+// TZID references resolve on any client.
+func WriteICS(w io.Writer, name string, loc *time.Location, tz string, events []ICSEvent) error {
+	bw := bufio.NewWriter(w)
+	line := func(s string) {
+		writeFolded(bw, s)
+	}
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:" + icsProdID)
+	line("CALSCALE:GREGORIAN")
+	if name != "" {
+		line("X-WR-CALNAME:" + escapeText(name))
+		line("X-WR-TIMEZONE:" + tz)
+	}
+	year := time.Now().In(loc).Year()
+	for _, ev := range events {
+		if ev.Start.Year() < year {
+			year = ev.Start.Year()
+		}
+	}
+	for _, l := range vtimezone(tz, loc, year) {
+		line(l)
+	}
+	for _, ev := range events {
+		for _, l := range ev.lines(tz) {
+			line(l)
+		}
+	}
+	line("END:VCALENDAR")
+	return bw.Flush()
+}
+
+func (e ICSEvent) lines(tz string) []string {
+	stamp := e.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	out := []string{
+		"BEGIN:VEVENT",
+		"UID:" + e.UID,
+		"DTSTAMP:" + stamp.UTC().Format(icsDateTime) + "Z",
+	}
+	if e.AllDay {
+		out = append(out,
+			"DTSTART;VALUE=DATE:"+e.Start.Format(icsDate),
+			"DTEND;VALUE=DATE:"+e.End.Format(icsDate),
+		)
+	} else {
+		out = append(out,
+			fmt.Sprintf("DTSTART;TZID=%s:%s", tz, e.Start.Format(icsDateTime)),
+			fmt.Sprintf("DTEND;TZID=%s:%s", tz, e.End.Format(icsDateTime)),
+		)
+	}
+	if e.IsOverride() {
+		out = append(out, fmt.Sprintf("RECURRENCE-ID;TZID=%s:%s", tz, e.RecurrenceID.Format(icsDateTime)))
+	}
+	out = append(out, e.Recurrence...)
+	if e.Summary != "" {
+		out = append(out, "SUMMARY:"+escapeText(e.Summary))
+	}
+	if e.Description != "" {
+		out = append(out, "DESCRIPTION:"+escapeText(e.Description))
+	}
+	if e.Location != "" {
+		out = append(out, "LOCATION:"+escapeText(e.Location))
+	}
+	if e.Status != "" {
+		out = append(out, "STATUS:"+e.Status)
+	}
+	return append(out, "END:VEVENT")
+}
+
+// ParseICS reads the VEVENTs of a VCALENDAR. Times without a TZID are read
+// in loc; all times are returned in loc.
+func ParseICS(r io.Reader, loc *time.Location) ([]ICSEvent, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		events []ICSEvent
+		cur    *ICSEvent
+		depth  int
+	)
+	for _, raw := range lines {
+		name, params, value := splitProperty(raw)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			cur = &ICSEvent{}
+			depth = 0
+			continue
+		case name == "BEGIN" && cur != nil:
+			// nested components such as VALARM
+			depth++
+			continue
+		case name == "END" && cur != nil && depth > 0:
+			depth--
+			continue
+		case name == "END" && value == "VEVENT" && cur != nil:
+			if cur.End.IsZero() {
+				cur.End = cur.Start
+				if cur.AllDay {
+					cur.End = cur.Start.AddDate(0, 0, 1)
+				}
+			}
+			events = append(events, *cur)
+			cur = nil
+			continue
+		}
+		if cur == nil || depth > 0 {
+			continue
+		}
+		switch name {
+		case "UID":
+			cur.UID = value
+		case "SUMMARY":
+			cur.Summary = unescapeText(value)
+		case "DESCRIPTION":
+			cur.Description = unescapeText(value)
+		case "LOCATION":
+			cur.Location = unescapeText(value)
+		case "STATUS":
+			cur.Status = strings.ToUpper(value)
+		case "DTSTART":
+			cur.Start, cur.AllDay, err = parseICSTime(params, value, loc)
+		case "DTEND":
+			cur.End, _, err = parseICSTime(params, value, loc)
+		case "RECURRENCE-ID":
+			cur.RecurrenceID, _, err = parseICSTime(params, value, loc)
+		case "RRULE", "EXDATE", "RDATE":
+			cur.Recurrence = append(cur.Recurrence, raw)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", name, err)
+		}
+	}
+	return events, nil
+}
+
+func parseICSTime(params map[string]string, value string, loc *time.Location) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len(icsDate) {
+		t, err := time.ParseInLocation(icsDate, value, loc)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(icsDateTime+"Z", value)
+		return t.In(loc), false, err
+	}
+	in := loc
+	if tzid := params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(strings.Trim(tzid, `"`)); err == nil {
+			in = l
+		}
+	}
+	t, err := time.ParseInLocation(icsDateTime, value, in)
+	return t.In(loc), false, err
+}
+
+func unfold(r io.Reader) ([]string, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	var lines []string
+	for sc.Scan() {
+		l := strings.TrimRight(sc.Text(), "\r")
+		if (strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+		if l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines, sc.Err()
+}
+
+// splitProperty splits "NAME;P1=a;P2=b:value" into its parts. Colons inside
+// quoted parameter values do not end the name section.
+func splitProperty(line string) (string, map[string]string, string) {
+	inQuote := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			inQuote = !inQuote
+		}
+		if r == ':' && !inQuote {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return strings.ToUpper(line), nil, ""
+	}
+	head, value := line[:colon], line[colon+1:]
+	parts := strings.Split(head, ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		params[strings.ToUpper(k)] = v
+	}
+	return strings.ToUpper(parts[0]), params, value
+}
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+var icsUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+func escapeText(s string) string   { return icsEscaper.Replace(s) }
+func unescapeText(s string) string { return icsUnescaper.Replace(s) }
+
+// writeFolded writes one content line, folding at 75 octets without
+// splitting UTF-8 sequences.
+func writeFolded(w *bufio.Writer, s string) {
+	const limit = 75
+	n := 0
+	for _, r := range s {
+		size := len(string(r))
+		if n+size > limit {
+			_, _ = w.WriteString("\r\n ")
+			n = 1
+		}
+		_, _ = w.WriteRune(r)
+		n += size
+	}
+	_, _ = w.WriteString("\r\n")
+}
+
+// vtimezone describes loc from the given year on. Zones without DST get a
+// single STANDARD block; others get yearly rules for each transition.
+func vtimezone(tz string, loc *time.Location, year int) []string {
+	if tz == "" || loc == time.UTC {
+		return nil
+	}
+	out := []string{"BEGIN:VTIMEZONE", "TZID:" + tz}
+	transitions := zoneTransitions(loc, year)
+	for _, tr := range transitions {
+		kind := "STANDARD"
+		if tr.to > tr.from {
+			kind = "DAYLIGHT"
+		}
+		wall := tr.at.In(time.FixedZone("", tr.from))
+		out = append(out,
+			"BEGIN:"+kind,
+			"DTSTART:"+wall.Format(icsDateTime),
+			"RRULE:FREQ=YEARLY;BYMONTH="+fmt.Sprint(int(wall.Month()))+";BYDAY="+nthWeekday(wall),
+			"TZOFFSETFROM:"+formatOffset(tr.from),
+			"TZOFFSETTO:"+formatOffset(tr.to),
+			"TZNAME:"+tr.name,
+			"END:"+kind,
+		)
+	}
+	if len(transitions) == 0 {
+		name, off := time.Date(year, 1, 1, 0, 0, 0, 0, loc).Zone()
+		out = append(out,
+			"BEGIN:STANDARD",
+			"DTSTART:19700101T000000",
+			"TZOFFSETFROM:"+formatOffset(off),
+			"TZOFFSETTO:"+formatOffset(off),
+			"TZNAME:"+name,
+			"END:STANDARD",
+		)
+	}
+	return append(out, "END:VTIMEZONE")
+}
+
+// nthWeekday renders t's day as an RRULE BYDAY value such as "2SU", using
+// "-1" when it falls in the month's last week.
+func nthWeekday(t time.Time) string {
+	day := [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}[t.Weekday()]
+	daysInMonth := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if t.Day()+7 > daysInMonth {
+		return "-1" + day
+	}
+	return fmt.Sprintf("%d%s", (t.Day()-1)/7+1, day)
+}
+
+type zoneTransition struct {
+	at       time.Time
+	from, to int
+	name     string
+}
+
+func zoneTransitions(loc *time.Location, year int) []zoneTransition {
+	var out []zoneTransition
+	day := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, prev := day.In(loc).Zone()
+	for day.Year() == year {
+		next := day.AddDate(0, 0, 1)
+		_, off := next.In(loc).Zone()
+		if off != prev {
+			// narrow the change down to the second
+			lo, hi := day, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if _, o := mid.In(loc).Zone(); o == prev {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			name, _ := hi.In(loc).Zone()
+			out = append(out, zoneTransition{at: hi, from: prev, to: off, name: name})
+			prev = off
+		}
+		day = next
+	}
+	return out
+}
+
+func formatOffset(secs int) string {
+	sign := "+"
+	if secs < 0 {
+		sign = "-"
+		secs = -secs
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, secs/3600, (secs%3600)/60)
+}
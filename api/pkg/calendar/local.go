@@ -0,0 +1,443 @@
+package calendar
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/teambition/rrule-go"
+)
+
+// ErrEventNotFound is returned when an event does not exist on a calendar.
+var ErrEventNotFound = errors.New("calendar event not found")
+
+// Local is a calendar provider backed by the calendar_events table. It needs
+// no external credentials, so the app runs fully offline with it.
+type Local struct {
+	db     *sqlx.DB
+	loc    time.Location
+	tz     string
+	logger *slog.Logger
+}
+
+func NewLocal(db *sqlx.DB, loc time.Location, tz string, logger *slog.Logger) *Local {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Local{db: db, loc: loc, tz: tz, logger: logger.With("provider", "local")}
+}
+
+type localEvent struct {
+	ID            string         `db:"id"`
+	CalendarID    string         `db:"calendar_id"`
+	MasterID      sql.NullString `db:"master_id"`
+	OriginalStart sql.NullTime   `db:"original_start"`
+	Summary       string         `db:"summary"`
+	Description   string         `db:"description"`
+	Location      string         `db:"location"`
+	Start         time.Time      `db:"start_time"`
+	End           time.Time      `db:"end_time"`
+	AllDay        bool           `db:"all_day"`
+	Recurrence    sql.NullString `db:"recurrence"`
+}
+
+// wall reinterprets a timestamp read from Postgres (returned as UTC) as a
+// wall-clock time in the calendar's location.
+func (l *Local) wall(t time.Time) time.Time {
+	return wallIn(t, &l.loc)
+}
+
+func (l *Local) toEvent(ev localEvent) Event {
+	return Event{
+		ID:          ev.ID,
+		Summary:     ev.Summary,
+		Description: ev.Description,
+		Location:    ev.Location,
+		Start:       l.wall(ev.Start),
+		End:         l.wall(ev.End),
+		AllDay:      ev.AllDay,
+	}
+}
+
+func newEventID() string {
+	return strings.ReplaceAll(uuid.NewString(), "-", "")
+}
+
+// InstanceID mirrors Google's "<master>_<utc start>" ids for expanded
+// occurrences so callers can treat both providers alike.
+func InstanceID(masterID string, start time.Time) string {
+	return masterID + "_" + start.UTC().Format("20060102T150405Z")
+}
+
+// SplitInstanceID splits an id made by InstanceID into its master id and
+// original start. The stamp is taken after the last underscore, so master
+// ids may contain underscores themselves, as Graph ids do.
+func SplitInstanceID(id string) (string, time.Time, bool) {
+	i := strings.LastIndex(id, "_")
+	if i <= 0 {
+		return "", time.Time{}, false
+	}
+	start, err := time.Parse("20060102T150405Z", id[i+1:])
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return id[:i], start, true
+}
+
+const insertLocalEventQuery = `INSERT INTO calendar_events (
+	id, calendar_id, master_id, original_start, summary, description, location, start_time, end_time, all_day, recurrence
+) VALUES (
+	:id, :calendar_id, :master_id, :original_start, :summary, :description, :location, :start_time, :end_time, :all_day, :recurrence
+)`
+
+func (l *Local) insert(ctx context.Context, ev localEvent) error {
+	_, err := l.db.NamedExecContext(ctx, insertLocalEventQuery, ev)
+	return err
+}
+
+func (l *Local) Publish(ctx context.Context, plan *PublishPlan, opts PublishOptions) (*PublishResult, error) {
+	if plan == nil {
+		return nil, fmt.Errorf("publish plan is nil")
+	}
+	if opts.CalendarID == "" {
+		return nil, fmt.Errorf("calendarID is required")
+	}
+	switch plan.Mode {
+	case ModeSeries:
+		if plan.Series == nil {
+			return nil, fmt.Errorf("missing series spec")
+		}
+		ev := localEvent{
+			ID:          newEventID(),
+			CalendarID:  opts.CalendarID,
+			Summary:     firstNonEmpty(opts.Summary, plan.Series.Summary),
+			Description: firstNonEmpty(opts.Description, plan.Series.Description),
+			Location:    firstNonEmpty(opts.Location, plan.Series.Location),
+			Start:       plan.Series.Start,
+			End:         plan.Series.End,
+			Recurrence:  joinRecurrence(buildRecurrence(l.tz, plan.Series.RRULE, plan.Series.EXDATEs)),
+		}
+		if err := l.insert(ctx, ev); err != nil {
+			return nil, err
+		}
+		return &PublishResult{MasterEventID: &ev.ID}, nil
+
+	case ModeSingles:
+		if len(plan.Singles) == 0 {
+			return &PublishResult{SingleEventID: map[int64]string{}}, nil
+		}
+		result := &PublishResult{
+			SingleEventID: make(map[int64]string, len(plan.Singles)),
+			EventIDs:      make([]string, 0, len(plan.Singles)),
+		}
+		for i, oc := range plan.Singles {
+			ev := localEvent{
+				ID:          newEventID(),
+				CalendarID:  opts.CalendarID,
+				Summary:     firstNonEmpty(oc.Summary, opts.Summary),
+				Description: firstNonEmpty(oc.Description, opts.Description),
+				Location:    firstNonEmpty(oc.Location, opts.Location),
+				Start:       oc.Start,
+				End:         oc.End,
+				AllDay:      oc.AllDay,
+			}
+			if err := l.insert(ctx, ev); err != nil {
+				return result, fmt.Errorf("failed to create event: %d: %w", i, err)
+			}
+			result.EventIDs = append(result.EventIDs, ev.ID)
+			if oc.RefID != 0 {
+				result.SingleEventID[oc.RefID] = ev.ID
+			}
+		}
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("unknown mode: %s", plan.Mode)
+	}
+}
+
+const listLocalEventsQuery = `SELECT id, calendar_id, master_id, original_start, summary, description, location, start_time, end_time, all_day, recurrence
+FROM calendar_events
+WHERE calendar_id = $1
+AND (recurrence IS NOT NULL OR master_id IS NOT NULL OR (start_time < $3 AND end_time > $2))
+ORDER BY start_time`
+
+// ListEvents returns single events and expanded series occurrences in the
+// same window the Google provider uses.
+func (l *Local) ListEvents(ctx context.Context, calendarID string) ([]Event, error) {
+	from, to := ListWindow(time.Now().In(&l.loc))
+	return l.ListEventsBetween(ctx, calendarID, from, to)
+}
+
+// ListEventsBetween lists the events overlapping [from, to) with series
+// expanded into occurrences.
+func (l *Local) ListEventsBetween(ctx context.Context, calendarID string, from, to time.Time) ([]Event, error) {
+	var rows []localEvent
+	if err := l.db.SelectContext(ctx, &rows, listLocalEventsQuery, calendarID, from, to); err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string]map[int64]Event)
+	for _, row := range rows {
+		if !row.MasterID.Valid || !row.OriginalStart.Valid {
+			continue
+		}
+		if overrides[row.MasterID.String] == nil {
+			overrides[row.MasterID.String] = make(map[int64]Event)
+		}
+		overrides[row.MasterID.String][l.wall(row.OriginalStart.Time).Unix()] = l.toEvent(row)
+	}
+
+	events := make([]Event, 0, len(rows))
+	for _, row := range rows {
+		switch {
+		case row.MasterID.Valid:
+			continue
+		case row.Recurrence.Valid:
+			occs, err := expandSeries(l.toEvent(row), strings.Split(row.Recurrence.String, "\n"), overrides[row.ID], &l.loc, l.tz, from, to)
+			if err != nil {
+				l.logger.Warn("Skipping series with invalid recurrence", "event_id", row.ID, "error", err)
+				continue
+			}
+			events = append(events, occs...)
+		default:
+			events = append(events, l.toEvent(row))
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	return events, nil
+}
+
+// expandSeries expands a series master over [from, to). Occurrences with an
+// override, keyed by the Unix time of their original start, take its times
+// and any text it sets.
+func expandSeries(master Event, recurrence []string, overrides map[int64]Event, loc *time.Location, tz string, from, to time.Time) ([]Event, error) {
+	lines := []string{fmt.Sprintf("DTSTART;TZID=%s:%s", tz, master.Start.Format("20060102T150405"))}
+	for _, line := range recurrence {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	set, err := rrule.StrSliceToRRuleSetInLoc(lines, loc)
+	if err != nil {
+		return nil, err
+	}
+	dur := master.End.Sub(master.Start)
+	starts := set.Between(from.Add(-dur), to, true)
+	events := make([]Event, 0, len(starts))
+	for _, s := range starts {
+		s = s.In(loc)
+		ev := master
+		ev.ID = InstanceID(master.ID, s)
+		ev.Start = s
+		ev.End = s.Add(dur)
+		if o, ok := overrides[s.Unix()]; ok {
+			ev.Start = o.Start
+			ev.End = o.End
+			ev.Summary = firstNonEmpty(o.Summary, ev.Summary)
+			ev.Description = firstNonEmpty(o.Description, ev.Description)
+			ev.Location = firstNonEmpty(o.Location, ev.Location)
+		}
+		if ev.End.After(from) && ev.Start.Before(to) {
+			events = append(events, ev)
+		}
+	}
+	return events, nil
+}
+
+const deleteLocalEventQuery = `DELETE FROM calendar_events WHERE calendar_id = $1 AND id = $2`
+
+// DeleteEvent removes an event. Deleting an expanded occurrence excludes it
+// from its series instead, as Google does.
+func (l *Local) DeleteEvent(ctx context.Context, calendarID string, eventID string) error {
+	res, err := l.db.ExecContext(ctx, deleteLocalEventQuery, calendarID, eventID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		return nil
+	}
+	masterID, start, ok := SplitInstanceID(eventID)
+	if !ok {
+		return ErrEventNotFound
+	}
+	return l.excludeInstance(ctx, calendarID, masterID, start.In(&l.loc))
+}
+
+const getLocalEventQuery = `SELECT id, calendar_id, master_id, original_start, summary, description, location, start_time, end_time, all_day, recurrence
+FROM calendar_events
+WHERE calendar_id = $1 AND id = $2`
+
+// GetEvent fetches one event or series master by id.
+func (l *Local) GetEvent(ctx context.Context, calendarID string, eventID string) (*Event, error) {
+	var row localEvent
+	if err := l.db.GetContext(ctx, &row, getLocalEventQuery, calendarID, eventID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEventNotFound
+		}
+		return nil, err
+	}
+	ev := l.toEvent(row)
+	return &ev, nil
+}
+
+const updateLocalEventQuery = `UPDATE calendar_events
+SET summary = $3, description = $4, location = $5, start_time = $6, end_time = $7, all_day = $8, updated_at = CURRENT_TIMESTAMP
+WHERE calendar_id = $1 AND id = $2`
+
+// UpdateEvent replaces an event's times and text. Updating an expanded
+// occurrence stores an override for it instead, as Google does.
+func (l *Local) UpdateEvent(ctx context.Context, calendarID string, ev Event) error {
+	res, err := l.db.ExecContext(ctx, updateLocalEventQuery, calendarID, ev.ID, ev.Summary, ev.Description, ev.Location, ev.Start, ev.End, ev.AllDay)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		return nil
+	}
+	masterID, start, ok := SplitInstanceID(ev.ID)
+	if !ok {
+		return ErrEventNotFound
+	}
+	if _, err := l.getMaster(ctx, calendarID, masterID); err != nil {
+		return err
+	}
+	override := localEvent{
+		ID:            newEventID(),
+		CalendarID:    calendarID,
+		MasterID:      sql.NullString{String: masterID, Valid: true},
+		OriginalStart: sql.NullTime{Time: start.In(&l.loc), Valid: true},
+		Summary:       ev.Summary,
+		Description:   ev.Description,
+		Location:      ev.Location,
+		Start:         ev.Start,
+		End:           ev.End,
+		AllDay:        ev.AllDay,
+	}
+	_, err = l.db.NamedExecContext(ctx, upsertLocalOverrideQuery, override)
+	return err
+}
+
+const getLocalMasterQuery = `SELECT id, calendar_id, master_id, original_start, summary, description, location, start_time, end_time, all_day, recurrence
+FROM calendar_events
+WHERE calendar_id = $1 AND id = $2 AND master_id IS NULL`
+
+func (l *Local) getMaster(ctx context.Context, calendarID, masterEventID string) (*localEvent, error) {
+	var ev localEvent
+	if err := l.db.GetContext(ctx, &ev, getLocalMasterQuery, calendarID, masterEventID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEventNotFound
+		}
+		return nil, err
+	}
+	return &ev, nil
+}
+
+const setLocalRecurrenceQuery = `UPDATE calendar_events SET recurrence = $3, updated_at = CURRENT_TIMESTAMP WHERE calendar_id = $1 AND id = $2`
+
+func (l *Local) setRecurrence(ctx context.Context, q sqlx.ExecerContext, calendarID, masterEventID string, recurrence []string) error {
+	res, err := q.ExecContext(ctx, setLocalRecurrenceQuery, calendarID, masterEventID, joinRecurrence(recurrence))
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrEventNotFound
+	}
+	return nil
+}
+
+func (l *Local) excludeInstance(ctx context.Context, calendarID, masterEventID string, start time.Time) error {
+	master, err := l.getMaster(ctx, calendarID, masterEventID)
+	if err != nil {
+		return err
+	}
+	if !master.Recurrence.Valid {
+		return ErrEventNotFound
+	}
+	rec := strings.Split(master.Recurrence.String, "\n")
+	rec = append(rec, exdateLine(l.tz, []time.Time{start}))
+	return l.setRecurrence(ctx, l.db, calendarID, masterEventID, rec)
+}
+
+// AddExdatesToMaster replaces the master's recurrence with the RRULE and
+// EXDATEs given, matching the Google provider's patch semantics.
+func (l *Local) AddExdatesToMaster(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time) error {
+	return l.setRecurrence(ctx, l.db, calendarID, masterEventID, buildRecurrence(l.tz, rrule, exdates))
+}
+
+const upsertLocalOverrideQuery = `INSERT INTO calendar_events (
+	id, calendar_id, master_id, original_start, summary, description, location, start_time, end_time, all_day
+) VALUES (
+	:id, :calendar_id, :master_id, :original_start, :summary, :description, :location, :start_time, :end_time, :all_day
+)
+ON CONFLICT (master_id, original_start) DO UPDATE SET
+	summary = EXCLUDED.summary,
+	description = EXCLUDED.description,
+	location = EXCLUDED.location,
+	start_time = EXCLUDED.start_time,
+	end_time = EXCLUDED.end_time,
+	updated_at = CURRENT_TIMESTAMP`
+
+// AddRdatesWithOverrides adds RDATEs to the master and stores an override for
+// every added occurrence whose duration differs from the master's.
+func (l *Local) AddRdatesWithOverrides(ctx context.Context, calendarID, masterEventID, rrule string, exdates []time.Time, adds []RDateSpec) error {
+	if len(adds) == 0 {
+		return nil
+	}
+	master, err := l.getMaster(ctx, calendarID, masterEventID)
+	if err != nil {
+		return err
+	}
+
+	rdateStarts := make([]time.Time, len(adds))
+	for i := range adds {
+		rdateStarts[i] = adds[i].Start
+	}
+
+	tx, err := l.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := l.setRecurrence(ctx, tx, calendarID, masterEventID, buildRecurrenceWithRdates(l.tz, rrule, exdates, rdateStarts, &l.loc)); err != nil {
+		return fmt.Errorf("patch master with RDATEs: %w", err)
+	}
+
+	masterDur := master.End.Sub(master.Start)
+	for _, spec := range adds {
+		if spec.End.Sub(spec.Start) == masterDur {
+			continue
+		}
+		override := localEvent{
+			ID:            newEventID(),
+			CalendarID:    calendarID,
+			MasterID:      sql.NullString{String: masterEventID, Valid: true},
+			OriginalStart: sql.NullTime{Time: spec.Start.In(&l.loc), Valid: true},
+			Summary:       spec.Summary,
+			Description:   spec.Description,
+			Location:      spec.Location,
+			Start:         spec.Start.In(&l.loc),
+			End:           spec.End.In(&l.loc),
+			AllDay:        master.AllDay,
+		}
+		if _, err := tx.NamedExecContext(ctx, upsertLocalOverrideQuery, override); err != nil {
+			return fmt.Errorf("override instance: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+func joinRecurrence(lines []string) sql.NullString {
+	if len(lines) == 0 {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: strings.Join(lines, "\n"), Valid: true}
+}
@@ -0,0 +1,14 @@
+package calendar
+
+// Provider names accepted by CALENDAR_PROVIDER and by per-building overrides.
+const (
+	ProviderGoogle    = "google"
+	ProviderLocal     = "local"
+	ProviderMicrosoft = "microsoft"
+	ProviderCalDAV    = "caldav"
+)
+
+// ProviderNames lists every provider this package implements.
+func ProviderNames() []string {
+	return []string{ProviderGoogle, ProviderLocal, ProviderMicrosoft, ProviderCalDAV}
+}
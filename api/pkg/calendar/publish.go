@@ -44,6 +44,7 @@ type OccSpec struct {
 	Summary     string
 	Description string
 	Location    string
+	AllDay      bool
 }
 
 type SendUpdates string
@@ -64,6 +65,28 @@ type PublishOptions struct {
 	Description string // default description
 	Location    string // default location
 	SendUpdates SendUpdates
+	// Tentative publishes holds for requests still awaiting approval. They
+	// are marked tentative and colored apart from confirmed bookings.
+	Tentative bool
+}
+
+// PendingPrefix starts the summary of a tentative hold.
+const PendingPrefix = "[PENDING] "
+
+// TentativeColorID is the Google event color of tentative holds (banana).
+const TentativeColorID = "5"
+
+// Event statuses UpdateEvent can set. An empty status is left alone.
+const (
+	StatusConfirmed = "confirmed"
+	StatusTentative = "tentative"
+)
+
+func markTentative(ev *gcal.Event, tentative bool) {
+	if tentative {
+		ev.Status = StatusTentative
+		ev.ColorId = TentativeColorID
+	}
 }
 
 type PublishResult struct {
@@ -112,6 +135,7 @@ func (c *Calendar) Publish(ctx context.Context, plan *PublishPlan, opts PublishO
 			},
 			Recurrence: buildRecurrence(c.tz, plan.Series.RRULE, exdates),
 		}
+		markTentative(ev, opts.Tentative)
 
 		call := c.svc.Events.Insert(opts.CalendarID, ev).SendUpdates(send)
 		created, err := call.Context(ctx).Do()
@@ -144,6 +168,11 @@ func (c *Calendar) Publish(ctx context.Context, plan *PublishPlan, opts PublishO
 					TimeZone: c.tz,
 				},
 			}
+			if oc.AllDay {
+				ev.Start = &gcal.EventDateTime{Date: oc.Start.Format("2006-01-02")}
+				ev.End = &gcal.EventDateTime{Date: oc.End.Format("2006-01-02")}
+			}
+			markTentative(ev, opts.Tentative)
 			call := c.svc.Events.Insert(opts.CalendarID, ev).SendUpdates(send)
 			created, err := call.Context(ctx).Do()
 			if err != nil {
@@ -23,6 +23,8 @@ type LocalFileStorage struct {
 type FileStorage interface {
 	Store(file multipart.File, header *multipart.FileHeader, path string) error
 	Get(path string) (io.ReadSeeker, error)
+	Save(path string, r io.Reader) error
+	Exists(path string) bool
 	Delete(path string) error
 }
 
@@ -77,6 +79,30 @@ func (s *LocalFileStorage) Store(file multipart.File, header *multipart.FileHead
 	return nil
 }
 
+// Save writes a file the server generated, such as an invoice, replacing
+// any file already at path
+func (s *LocalFileStorage) Save(path string, r io.Reader) error {
+	fullPath := filepath.Join(s.BasePath, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	dst, err := os.Create(fullPath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	if _, err := io.Copy(dst, r); err != nil {
+		dst.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return dst.Close()
+}
+
+// Exists reports whether a file is stored at path
+func (s *LocalFileStorage) Exists(path string) bool {
+	info, err := os.Stat(filepath.Join(s.BasePath, path))
+	return err == nil && !info.IsDir()
+}
+
 // Finds a file in the given path and returns a readseeker
 func (s *LocalFileStorage) Get(path string) (io.ReadSeeker, error) {
 	fullPath := filepath.Join(s.BasePath, path)